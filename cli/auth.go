@@ -6,10 +6,14 @@
 
 package cli
 
-import "github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+import (
+	"context"
 
-func Token(sdk mpesa.SDK) error {
-	token, err := sdk.Token()
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+)
+
+func Token(ctx context.Context, sdk mpesa.SDK) error {
+	token, err := sdk.Token(ctx)
 	if err != nil {
 		logError(err)
 
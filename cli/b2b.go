@@ -7,12 +7,14 @@
 package cli
 
 import (
+	"context"
+
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 	"github.com/AlecAivazis/survey/v2"
 )
 
 // BusinessPayBill initiates a B2B payment request.
-func BusinessPayBill(sdk mpesa.SDK) error {
+func BusinessPayBill(ctx context.Context, sdk mpesa.SDK) error {
 	req := mpesa.BusinessPayBillReq{}
 
 	qs := []*survey.Question{
@@ -33,6 +35,24 @@ func BusinessPayBill(sdk mpesa.SDK) error {
 			},
 			Validate: survey.Required,
 		},
+		{
+			Name: "CommandID",
+			Prompt: &survey.Select{
+				Message: "CommandID",
+				Options: []string{
+					"BusinessPayBill",
+					"BusinessBuyGoods",
+					"BusinessToBusinessTransfer",
+					"MerchantToMerchantTransfer",
+					"MerchantTransferFromMerchantToWorking",
+					"MerchantServicesMMFAccountTransfer",
+					"AgencyFloatAdvance",
+				},
+				Help:    "Type of B2B payment",
+				Default: "BusinessPayBill",
+			},
+			Validate: survey.Required,
+		},
 		{
 			Name: "Amount",
 			Prompt: &survey.Input{
@@ -55,7 +75,7 @@ func BusinessPayBill(sdk mpesa.SDK) error {
 			Name: "PartyB",
 			Prompt: &survey.Input{
 				Message: "PartyB",
-				Help:    "B2C organization shortcode from which the money is sent to",
+				Help:    "Shortcode or till number to which the money is sent",
 				Default: "600986",
 			},
 			Validate: survey.Required,
@@ -113,11 +133,7 @@ func BusinessPayBill(sdk mpesa.SDK) error {
 		return nil
 	}
 
-	req.CommandID = "BusinessPayBill"
-	req.SenderIdentifierType = 4
-	req.RecieverIdentifierType = 4
-
-	b2cResp, err := sdk.BusinessPayBill(req)
+	b2cResp, err := sdk.BusinessPayBill(ctx, req)
 	if err != nil {
 		logError(err)
 
@@ -128,3 +144,81 @@ func BusinessPayBill(sdk mpesa.SDK) error {
 
 	return nil
 }
+
+// B2BExpressCheckout sends a USSD push to a merchant's till to pay a paybill.
+func B2BExpressCheckout(ctx context.Context, sdk mpesa.SDK) error {
+	req := mpesa.B2BExpressCheckoutReq{}
+
+	qs := []*survey.Question{
+		{
+			Name: "PrimaryShortCode",
+			Prompt: &survey.Input{
+				Message: "PrimaryShortCode",
+				Help:    "Till number of the merchant that pays",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "ReceiverShortCode",
+			Prompt: &survey.Input{
+				Message: "ReceiverShortCode",
+				Help:    "Paybill that receives the payment",
+				Default: "600984",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "Amount",
+			Prompt: &survey.Input{
+				Message: "Amount",
+				Help:    "Amount to be paid",
+				Default: "1",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "PaymentRef",
+			Prompt: &survey.Input{
+				Message: "PaymentRef",
+				Help:    "Account number the merchant pays to, shown in the USSD push",
+				Default: "MpesaOverlay",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "CallbackURL",
+			Prompt: &survey.Input{
+				Message: "CallbackURL",
+				Help:    "Callback URL used to receive the outcome of the payment",
+				Default: "https://example.com/b2bexpress",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "PartnerName",
+			Prompt: &survey.Input{
+				Message: "PartnerName",
+				Help:    "Name of the organization shown to the merchant in the USSD push",
+				Default: "MpesaOverlay",
+			},
+			Validate: survey.Required,
+		},
+	}
+
+	if err := survey.Ask(qs, &req, survey.WithShowCursor(true)); err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	resp, err := sdk.B2BExpressCheckout(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
@@ -7,13 +7,15 @@
 package cli
 
 import (
+	"context"
+
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/oklog/ulid/v2"
 )
 
 // B2CPayment initiates a B2C payment request.
-func B2CPayment(sdk mpesa.SDK) error {
+func B2CPayment(ctx context.Context, sdk mpesa.SDK) error {
 	req := mpesa.B2CPaymentReq{}
 
 	qs := []*survey.Question{
@@ -129,7 +131,7 @@ func B2CPayment(sdk mpesa.SDK) error {
 		return nil
 	}
 
-	b2cResp, err := sdk.B2CPayment(req)
+	b2cResp, err := sdk.B2CPayment(ctx, req)
 	if err != nil {
 		logError(err)
 
@@ -140,3 +142,232 @@ func B2CPayment(sdk mpesa.SDK) error {
 
 	return nil
 }
+
+// B2CAccountTopUp loads the utility account of a B2C shortcode from a paybill.
+func B2CAccountTopUp(ctx context.Context, sdk mpesa.SDK) error {
+	req := mpesa.B2CAccountTopUpReq{}
+
+	qs := []*survey.Question{
+		{
+			Name: "Initiator",
+			Prompt: &survey.Input{
+				Message: "Initiator",
+				Help:    "User authorized to initiate B2B transactions via API",
+				Default: "testapi",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "InitiatorPassword",
+			Prompt: &survey.Password{
+				Message: "InitiatorPassword",
+				Help:    "Password of the API user",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "Amount",
+			Prompt: &survey.Input{
+				Message: "Amount",
+				Help:    "Amount loaded into the B2C utility account",
+				Default: "10",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "PartyA",
+			Prompt: &survey.Input{
+				Message: "PartyA",
+				Help:    "Paybill from which the money is sent",
+				Default: "600979",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "PartyB",
+			Prompt: &survey.Input{
+				Message: "PartyB",
+				Help:    "B2C shortcode whose utility account is loaded",
+				Default: "600000",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "AccountReference",
+			Prompt: &survey.Input{
+				Message: "AccountReference",
+				Help:    "Account number associated with the transaction",
+				Default: "353353",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "Requester",
+			Prompt: &survey.Input{
+				Message: "Requester",
+				Help:    "Customer's phone number on behalf of whom the transaction is made",
+				Default: "254700000000",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "Remarks",
+			Prompt: &survey.Input{
+				Message: "Remarks",
+				Help:    "Additional information to be associated with the transaction",
+				Default: "test",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "QueueTimeOutURL",
+			Prompt: &survey.Input{
+				Message: "QueueTimeOutURL",
+				Help:    "URL to send notification incase the payment request is timed out",
+				Default: "https://example.com/timeout",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "ResultURL",
+			Prompt: &survey.Input{
+				Message: "ResultURL",
+				Help:    "URL to send notification upon completion of the request",
+				Default: "https://example.com/result",
+			},
+			Validate: survey.Required,
+		},
+	}
+
+	if err := survey.Ask(qs, &req, survey.WithHideCharacter('*'), survey.WithShowCursor(true)); err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	resp, err := sdk.B2CAccountTopUp(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
+
+// B2Pochi pays into the Pochi la Biashara wallet of a customer.
+func B2Pochi(ctx context.Context, sdk mpesa.SDK) error {
+	req := mpesa.B2PochiReq{}
+
+	qs := []*survey.Question{
+		{
+			Name: "InitiatorName",
+			Prompt: &survey.Input{
+				Message: "InitiatorName",
+				Help:    "User authorized to initiate B2C transactions via API",
+				Default: "testapi",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "InitiatorPassword",
+			Prompt: &survey.Password{
+				Message: "InitiatorPassword",
+				Help:    "Password of the API user",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "OriginatorConversationID",
+			Prompt: &survey.Input{
+				Message: "OriginatorConversationID",
+				Help:    "Unique unique string you specify for a transaction",
+				Suggest: func(_ string) []string {
+					return []string{ulid.Make().String()}
+				},
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "Amount",
+			Prompt: &survey.Input{
+				Message: "Amount",
+				Help:    "Amount to be paid",
+				Default: "10",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "PartyA",
+			Prompt: &survey.Input{
+				Message: "PartyA",
+				Help:    "B2C organization shortcode from which the money is sent from",
+				Default: "600996",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "PartyB",
+			Prompt: &survey.Input{
+				Message: "PartyB",
+				Help:    "Mobile number of the Pochi la Biashara wallet to receive the amount",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "Remarks",
+			Prompt: &survey.Input{
+				Message: "Remarks",
+				Help:    "Additional information to be associated with the transaction",
+				Default: "test",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "QueueTimeOutURL",
+			Prompt: &survey.Input{
+				Message: "QueueTimeOutURL",
+				Help:    "URL to send notification incase the payment request is timed out",
+				Default: "https://example.com/timeout",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "ResultURL",
+			Prompt: &survey.Input{
+				Message: "ResultURL",
+				Help:    "URL to send notification upon completion of the request",
+				Default: "https://example.com/result",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "Occasion",
+			Prompt: &survey.Input{
+				Message: "Occasion",
+				Help:    "Additional information to be associated with the transaction",
+				Default: "test",
+			},
+			Validate: survey.Required,
+		},
+	}
+
+	if err := survey.Ask(qs, &req, survey.WithHideCharacter('*'), survey.WithShowCursor(true)); err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	resp, err := sdk.B2Pochi(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
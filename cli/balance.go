@@ -7,12 +7,14 @@
 package cli
 
 import (
+	"context"
+
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 	"github.com/AlecAivazis/survey/v2"
 )
 
 // AccountBalance gets the account balance from the mpesa api.
-func AccountBalance(sdk mpesa.SDK) error {
+func AccountBalance(ctx context.Context, sdk mpesa.SDK) error {
 	req := mpesa.AccountBalanceReq{}
 
 	qs := []*survey.Question{
@@ -88,7 +90,7 @@ func AccountBalance(sdk mpesa.SDK) error {
 
 	req.CommandID = "AccountBalance"
 
-	balance, err := sdk.AccountBalance(req)
+	balance, err := sdk.AccountBalance(ctx, req)
 	if err != nil {
 		logError(err)
 
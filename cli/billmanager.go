@@ -0,0 +1,385 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+	"github.com/AlecAivazis/survey/v2"
+)
+
+func billManagerOptInQuestions() []*survey.Question {
+	return []*survey.Question{
+		{
+			Name: "ShortCode",
+			Prompt: &survey.Input{
+				Message: "ShortCode",
+				Help:    "Organization's shortcode (Paybill or Buygoods) to onboard on Bill Manager",
+				Default: "600984",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "Email",
+			Prompt: &survey.Input{
+				Message: "Email",
+				Help:    "Official contact email address of the organization",
+				Default: "billing@example.com",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "OfficialContact",
+			Prompt: &survey.Input{
+				Message: "OfficialContact",
+				Help:    "Official contact phone number of the organization",
+				Default: "254700000000",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "SendReminders",
+			Prompt: &survey.Input{
+				Message: "SendReminders",
+				Help:    "Whether Bill Manager sends payment reminders to the customers. 1 enables and 0 disables reminders",
+				Default: "1",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "Logo",
+			Prompt: &survey.Input{
+				Message: "Logo",
+				Help:    "Optional logo of the organization shown on the invoices",
+			},
+		},
+		{
+			Name: "CallbackURL",
+			Prompt: &survey.Input{
+				Message: "CallbackURL",
+				Help:    "URL to send notification upon payment of an invoice",
+				Default: "https://example.com/billmanager",
+			},
+			Validate: survey.Required,
+		},
+	}
+}
+
+// BillManagerOptIn onboards a shortcode onto Bill Manager.
+func BillManagerOptIn(ctx context.Context, sdk mpesa.SDK) error {
+	req := mpesa.BillManagerOptInReq{}
+
+	if err := survey.Ask(billManagerOptInQuestions(), &req, survey.WithShowCursor(true)); err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	resp, err := sdk.BillManagerOptIn(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
+
+// BillManagerUpdateOptIn updates the opt-in details of a shortcode.
+func BillManagerUpdateOptIn(ctx context.Context, sdk mpesa.SDK) error {
+	req := mpesa.BillManagerUpdateOptInReq{}
+
+	if err := survey.Ask(billManagerOptInQuestions(), &req, survey.WithShowCursor(true)); err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	resp, err := sdk.BillManagerUpdateOptIn(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
+
+// BillManagerSingleInvoice sends an invoice to a customer.
+func BillManagerSingleInvoice(ctx context.Context, sdk mpesa.SDK) error {
+	req := mpesa.BillManagerSingleInvoiceReq{}
+
+	qs := []*survey.Question{
+		{
+			Name: "ExternalReference",
+			Prompt: &survey.Input{
+				Message: "ExternalReference",
+				Help:    "Unique reference of the invoice in your system",
+				Default: "INV-001",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "BilledFullName",
+			Prompt: &survey.Input{
+				Message: "BilledFullName",
+				Help:    "Full name of the customer being billed",
+				Default: "John Doe",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "BilledPhoneNumber",
+			Prompt: &survey.Input{
+				Message: "BilledPhoneNumber",
+				Help:    "Phone number of the customer that receives the invoice",
+				Default: "254700000000",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "BilledPeriod",
+			Prompt: &survey.Input{
+				Message: "BilledPeriod",
+				Help:    "Month and year of the billing period",
+				Default: "August 2021",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "InvoiceName",
+			Prompt: &survey.Input{
+				Message: "InvoiceName",
+				Help:    "Descriptive name of what the customer is billed for",
+				Default: "Rent",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "DueDate",
+			Prompt: &survey.Input{
+				Message: "DueDate",
+				Help:    "Date the customer is expected to pay by (format: YYYY-MM-DD HH:MM:SS)",
+				Default: "2021-10-12 00:00:00",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "AccountReference",
+			Prompt: &survey.Input{
+				Message: "AccountReference",
+				Help:    "Account number the customer pays the invoice to",
+				Default: "A1",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "Amount",
+			Prompt: &survey.Input{
+				Message: "Amount",
+				Help:    "Total amount of the invoice",
+				Default: "100",
+			},
+			Validate: survey.Required,
+		},
+	}
+
+	if err := survey.Ask(qs, &req, survey.WithShowCursor(true)); err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	resp, err := sdk.BillManagerSingleInvoice(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
+
+// BillManagerBulkInvoice sends the invoices listed in a JSON file.
+func BillManagerBulkInvoice(ctx context.Context, sdk mpesa.SDK) error {
+	var file string
+
+	prompt := &survey.Input{
+		Message: "File",
+		Help:    "Path to a JSON file with an array of invoices",
+		Default: "invoices.json",
+	}
+	if err := survey.AskOne(prompt, &file, survey.WithValidator(survey.Required), survey.WithShowCursor(true)); err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	req := mpesa.BillManagerBulkInvoiceReq{}
+	if err := json.Unmarshal(data, &req.Invoices); err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	resp, err := sdk.BillManagerBulkInvoice(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
+
+// BillManagerCancelInvoice cancels invoices that have not been paid.
+func BillManagerCancelInvoice(ctx context.Context, sdk mpesa.SDK) error {
+	var references string
+
+	prompt := &survey.Input{
+		Message: "ExternalReferences",
+		Help:    "Comma separated external references of the invoices to cancel",
+		Default: "INV-001",
+	}
+	if err := survey.AskOne(prompt, &references, survey.WithValidator(survey.Required), survey.WithShowCursor(true)); err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	req := mpesa.BillManagerCancelInvoiceReq{}
+	for _, reference := range strings.Split(references, ",") {
+		req.ExternalReferences = append(req.ExternalReferences, strings.TrimSpace(reference))
+	}
+
+	resp, err := sdk.BillManagerCancelInvoice(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
+
+// BillManagerReconcile acknowledges a payment received for an invoice.
+func BillManagerReconcile(ctx context.Context, sdk mpesa.SDK) error {
+	req := mpesa.BillManagerReconcileReq{}
+
+	qs := []*survey.Question{
+		{
+			Name: "PaymentDate",
+			Prompt: &survey.Input{
+				Message: "PaymentDate",
+				Help:    "Date the payment was made (format: YYYY-MM-DD)",
+				Default: "2021-10-01",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "PaidAmount",
+			Prompt: &survey.Input{
+				Message: "PaidAmount",
+				Help:    "Amount paid",
+				Default: "100",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "AccountReference",
+			Prompt: &survey.Input{
+				Message: "AccountReference",
+				Help:    "Account number the payment was made to",
+				Default: "A1",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "TransactionID",
+			Prompt: &survey.Input{
+				Message: "TransactionID",
+				Help:    "M-PESA transaction ID of the payment",
+				Default: "RJB53MYR1N",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "PhoneNumber",
+			Prompt: &survey.Input{
+				Message: "PhoneNumber",
+				Help:    "Phone number that made the payment",
+				Default: "254700000000",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "FullName",
+			Prompt: &survey.Input{
+				Message: "FullName",
+				Help:    "Full name of the customer that made the payment",
+				Default: "John Doe",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "InvoiceName",
+			Prompt: &survey.Input{
+				Message: "InvoiceName",
+				Help:    "Name of the invoice that was paid",
+				Default: "Rent",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "ExternalReference",
+			Prompt: &survey.Input{
+				Message: "ExternalReference",
+				Help:    "External reference of the invoice that was paid",
+				Default: "INV-001",
+			},
+			Validate: survey.Required,
+		},
+	}
+
+	if err := survey.Ask(qs, &req, survey.WithShowCursor(true)); err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	resp, err := sdk.BillManagerReconcile(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
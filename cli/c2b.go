@@ -7,12 +7,14 @@
 package cli
 
 import (
+	"context"
+
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 	"github.com/AlecAivazis/survey/v2"
 )
 
 // C2BRegisterURL registers the confirmation and validation urls.
-func C2BRegisterURL(sdk mpesa.SDK) error {
+func C2BRegisterURL(ctx context.Context, sdk mpesa.SDK) error {
 	req := mpesa.C2BRegisterURLReq{}
 
 	qs := []*survey.Question{
@@ -64,7 +66,7 @@ func C2BRegisterURL(sdk mpesa.SDK) error {
 		return nil
 	}
 
-	resp, err := sdk.C2BRegisterURL(req)
+	resp, err := sdk.C2BRegisterURL(ctx, req)
 	if err != nil {
 		logError(err)
 
@@ -76,7 +78,7 @@ func C2BRegisterURL(sdk mpesa.SDK) error {
 	return nil
 }
 
-func C2BSimulate(sdk mpesa.SDK) error {
+func C2BSimulate(ctx context.Context, sdk mpesa.SDK) error {
 	req := mpesa.C2BSimulateReq{}
 
 	qs := []*survey.Question{
@@ -135,7 +137,7 @@ func C2BSimulate(sdk mpesa.SDK) error {
 		return nil
 	}
 
-	resp, err := sdk.C2BSimulate(req)
+	resp, err := sdk.C2BSimulate(ctx, req)
 	if err != nil {
 		logError(err)
 
@@ -7,47 +7,85 @@
 package cli
 
 import (
+	"context"
+	"time"
+
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 	"github.com/choria-io/fisk"
 )
 
 // AddCommands adds the mpesa commands to the application.
-func AddCommands(app *fisk.Application, sdk mpesa.SDK) {
+func AddCommands(ctx context.Context, app *fisk.Application, sdk mpesa.SDK) {
 	token := app.Command("token", "Get a token")
-	token.Action(func(ctx *fisk.ParseContext) error {
-		return Token(sdk)
+	token.Action(func(_ *fisk.ParseContext) error {
+		return Token(ctx, sdk)
 	})
 	token.Alias("auth")
 	token.Cheat("token", `Get an access token
 For example: mpesa-cli token`)
 
+	var (
+		stkpushWait    bool
+		stkpushTimeout time.Duration
+	)
 	stkpush := app.Command("stkpush", "Simulate STK Push")
+	stkpush.Flag("wait", "Wait for the customer to complete or cancel the payment").BoolVar(&stkpushWait)
+	stkpush.Flag("wait-timeout", "How long to wait for the payment").Default("2m").DurationVar(&stkpushTimeout)
 	stkpush.Action(func(_ *fisk.ParseContext) error {
-		return STKPush(sdk)
+		if stkpushWait {
+			return STKPushAndWait(ctx, sdk, stkpushTimeout)
+		}
+
+		return STKPush(ctx, sdk)
 	})
 	stkpush.Cheat("stkpush", `Simulate STK Push
-For example: mpesa-cli stkpush`)
+For example: mpesa-cli stkpush
+Wait for the payment to complete: mpesa-cli stkpush --wait`)
 	stkpush.Alias("express")
 
 	stkpushquery := app.Command("stkpushquery", "Query STK Push")
 	stkpushquery.Action(func(_ *fisk.ParseContext) error {
-		return STKPushQuery(sdk)
+		return STKPushQuery(ctx, sdk)
 	})
 	stkpushquery.Cheat("stkpushquery", `Query STK Push
 For example: mpesa-cli stkpushquery`)
 	stkpushquery.Alias("expressquery")
 
-	b2c := app.Command("b2c", "Simulate B2C Payment")
-	b2c.Action(func(_ *fisk.ParseContext) error {
-		return B2CPayment(sdk)
+	standingorder := app.Command("standingorder", "Create an M-PESA Ratiba standing order")
+	standingorder.Action(func(_ *fisk.ParseContext) error {
+		return StandingOrder(ctx, sdk)
 	})
+	standingorder.Cheat("standingorder", `Create an M-PESA Ratiba standing order
+For example: mpesa-cli standingorder`)
+	standingorder.Alias("ratiba")
+
+	b2c := app.Command("b2c", "Simulate B2C Payment")
 	b2c.Cheat("b2c", `Simulate B2C Payment
-For example: mpesa-cli b2c`)
+For example: mpesa-cli b2c
+Load a B2C utility account: mpesa-cli b2c topup
+Pay a Pochi la Biashara wallet: mpesa-cli b2c pochi`)
 	b2c.Alias("pay")
 
+	b2cPayment := b2c.Command("payment", "Pay a customer").Default()
+	b2cPayment.Action(func(_ *fisk.ParseContext) error {
+		return B2CPayment(ctx, sdk)
+	})
+
+	b2cTopUp := b2c.Command("topup", "Load the utility account of a B2C shortcode from a paybill")
+	b2cTopUp.Action(func(_ *fisk.ParseContext) error {
+		return B2CAccountTopUp(ctx, sdk)
+	})
+	b2cTopUp.Alias("accounttopup")
+
+	b2cPochi := b2c.Command("pochi", "Pay a customer's Pochi la Biashara wallet")
+	b2cPochi.Action(func(_ *fisk.ParseContext) error {
+		return B2Pochi(ctx, sdk)
+	})
+	b2cPochi.Alias("b2pochi")
+
 	balance := app.Command("balance", "Check Account Balance")
 	balance.Action(func(_ *fisk.ParseContext) error {
-		return AccountBalance(sdk)
+		return AccountBalance(ctx, sdk)
 	})
 	balance.Cheat("balance", `Check Account Balance
 For example: mpesa-cli balance`)
@@ -56,7 +94,7 @@ For example: mpesa-cli balance`)
 
 	c2bregisterurl := app.Command("c2bregisterurl", "Register C2B URL")
 	c2bregisterurl.Action(func(_ *fisk.ParseContext) error {
-		return C2BRegisterURL(sdk)
+		return C2BRegisterURL(ctx, sdk)
 	})
 	c2bregisterurl.Cheat("c2bregisterurl", `Register C2B URL
 For example: mpesa-cli c2bregisterurl`)
@@ -65,25 +103,36 @@ For example: mpesa-cli c2bregisterurl`)
 
 	c2bsimulate := app.Command("c2bsimulate", "Simulate C2B Payment")
 	c2bsimulate.Action(func(_ *fisk.ParseContext) error {
-		return C2BSimulate(sdk)
+		return C2BSimulate(ctx, sdk)
 	})
 	c2bsimulate.Cheat("c2bsimulate", `Simulate C2B Payment
 For example: mpesa-cli c2bsimulate`)
 	c2bsimulate.Alias("simulate")
 	c2bsimulate.Alias("c2b")
 
+	var qrcodeOut QRCodeOutput
 	qrcode := app.Command("qrcode", "Generate QR Code")
+	qrcode.Flag("offline", "Build the QR code locally instead of calling Daraja").BoolVar(&qrcodeOut.Offline)
+	qrcode.Flag("output", "Save the QR code image to a file, as SVG when it ends with .svg and PNG otherwise").StringVar(&qrcodeOut.File)
+	qrcode.Flag("terminal", "Draw the QR code in the terminal").BoolVar(&qrcodeOut.Terminal)
 	qrcode.Action(func(_ *fisk.ParseContext) error {
-		return QRCode(sdk)
+		if qrcodeOut.Offline || qrcodeOut.File != "" || qrcodeOut.Terminal {
+			return QRCodeWithOutput(ctx, sdk, qrcodeOut)
+		}
+
+		return QRCode(ctx, sdk)
 	})
 	qrcode.Cheat("qrcode", `Generate QR Code
-For example: mpesa-cli qrcode`)
+For example: mpesa-cli qrcode
+Save the QR code from Daraja: mpesa-cli qrcode --output qr.png
+Draw the QR code from Daraja: mpesa-cli qrcode --terminal
+Draw a locally built QR code: mpesa-cli qrcode --offline --terminal`)
 	qrcode.Alias("qr")
 	qrcode.Alias("code")
 
 	reversal := app.Command("reversal", "Simulate Reversal")
 	reversal.Action(func(_ *fisk.ParseContext) error {
-		return Reversal(sdk)
+		return Reversal(ctx, sdk)
 	})
 	reversal.Cheat("reversal", `Simulate Reversal
 For example: mpesa-cli reversal`)
@@ -92,7 +141,7 @@ For example: mpesa-cli reversal`)
 
 	remittax := app.Command("remittax", "Simulate Remittance Tax")
 	remittax.Action(func(_ *fisk.ParseContext) error {
-		return RemitTax(sdk)
+		return RemitTax(ctx, sdk)
 	})
 	remittax.Cheat("remittax", `Simulate Remittance Tax
 For example: mpesa-cli remittax`)
@@ -101,7 +150,7 @@ For example: mpesa-cli remittax`)
 
 	transactionstatus := app.Command("transactionstatus", "Simulate Transaction Status")
 	transactionstatus.Action(func(_ *fisk.ParseContext) error {
-		return TransactionStatus(sdk)
+		return TransactionStatus(ctx, sdk)
 	})
 	transactionstatus.Cheat("transactionstatus", `Simulate Transaction Status
 For example: mpesa-cli transactionstatus`)
@@ -109,12 +158,92 @@ For example: mpesa-cli transactionstatus`)
 	transactionstatus.Alias("transstatus")
 	transactionstatus.Alias("transstat")
 
+	simswap := app.Command("simswap", "Check when the SIM of a customer was last swapped")
+	simswap.Action(func(_ *fisk.ParseContext) error {
+		return SIMSwap(ctx, sdk)
+	})
+	simswap.Cheat("simswap", `Check when the SIM of a customer was last swapped
+For example: mpesa-cli simswap`)
+	simswap.Alias("imsi")
+
 	b2b := app.Command("b2b", "Simulate B2B Payment")
 	b2b.Action(func(_ *fisk.ParseContext) error {
-		return BusinessPayBill(sdk)
+		return BusinessPayBill(ctx, sdk)
 	})
 	b2b.Cheat("b2b", `Simulate B2B Payment
 For example: mpesa-cli b2b`)
 	b2b.Alias("businesspaybill")
 	b2b.Alias("paybill")
+
+	b2bexpress := app.Command("b2bexpress", "Send a USSD push to a merchant's till to pay a paybill")
+	b2bexpress.Action(func(_ *fisk.ParseContext) error {
+		return B2BExpressCheckout(ctx, sdk)
+	})
+	b2bexpress.Cheat("b2bexpress", `Send a USSD push to a merchant's till to pay a paybill
+For example: mpesa-cli b2bexpress`)
+	b2bexpress.Alias("b2bexpresscheckout")
+
+	billmanager := app.Command("billmanager", "Manage Bill Manager invoices")
+	billmanager.Alias("bills")
+
+	optin := billmanager.Command("optin", "Onboard a shortcode onto Bill Manager")
+	optin.Action(func(_ *fisk.ParseContext) error {
+		return BillManagerOptIn(ctx, sdk)
+	})
+	optin.Cheat("optin", `Onboard a shortcode onto Bill Manager
+For example: mpesa-cli billmanager optin`)
+
+	updateoptin := billmanager.Command("updateoptin", "Update the Bill Manager opt-in details")
+	updateoptin.Action(func(_ *fisk.ParseContext) error {
+		return BillManagerUpdateOptIn(ctx, sdk)
+	})
+	updateoptin.Cheat("updateoptin", `Update the Bill Manager opt-in details
+For example: mpesa-cli billmanager updateoptin`)
+
+	invoice := billmanager.Command("invoice", "Send an invoice")
+	invoice.Action(func(_ *fisk.ParseContext) error {
+		return BillManagerSingleInvoice(ctx, sdk)
+	})
+	invoice.Cheat("invoice", `Send an invoice
+For example: mpesa-cli billmanager invoice`)
+
+	bulkinvoice := billmanager.Command("bulkinvoice", "Send the invoices listed in a JSON file")
+	bulkinvoice.Action(func(_ *fisk.ParseContext) error {
+		return BillManagerBulkInvoice(ctx, sdk)
+	})
+	bulkinvoice.Cheat("bulkinvoice", `Send the invoices listed in a JSON file
+For example: mpesa-cli billmanager bulkinvoice`)
+	bulkinvoice.Alias("bulk")
+
+	cancelinvoice := billmanager.Command("cancelinvoice", "Cancel invoices")
+	cancelinvoice.Action(func(_ *fisk.ParseContext) error {
+		return BillManagerCancelInvoice(ctx, sdk)
+	})
+	cancelinvoice.Cheat("cancelinvoice", `Cancel invoices
+For example: mpesa-cli billmanager cancelinvoice`)
+	cancelinvoice.Alias("cancel")
+
+	reconcile := billmanager.Command("reconcile", "Acknowledge an invoice payment")
+	reconcile.Action(func(_ *fisk.ParseContext) error {
+		return BillManagerReconcile(ctx, sdk)
+	})
+	reconcile.Cheat("reconcile", `Acknowledge an invoice payment
+For example: mpesa-cli billmanager reconcile`)
+
+	pull := app.Command("pull", "Pull the transactions of a shortcode")
+	pull.Alias("pulltransactions")
+
+	register := pull.Command("register", "Register a shortcode for pulling transactions")
+	register.Action(func(_ *fisk.ParseContext) error {
+		return PullTransactionsRegister(ctx, sdk)
+	})
+	register.Cheat("register", `Register a shortcode for pulling transactions
+For example: mpesa-cli pull register`)
+
+	query := pull.Command("query", "Fetch the transactions made within a time range")
+	query.Action(func(_ *fisk.ParseContext) error {
+		return PullTransactionsQuery(ctx, sdk)
+	})
+	query.Cheat("query", `Fetch the transactions made within a time range
+For example: mpesa-cli pull query`)
 }
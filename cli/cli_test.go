@@ -7,19 +7,23 @@
 package cli
 
 import (
+	"context"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa/mocks"
 	"github.com/choria-io/fisk"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestToken(t *testing.T) {
 	sdk := new(mocks.SDK)
 
-	call := sdk.On("Token").Return(mpesa.TokenResp{}, nil)
+	call := sdk.On("Token", mock.Anything).Return(mpesa.TokenResp{}, nil)
 
-	if err := Token(sdk); err != nil {
+	if err := Token(context.Background(), sdk); err != nil {
 		t.Errorf("Token() error = %v", err)
 	}
 
@@ -29,23 +33,47 @@ func TestToken(t *testing.T) {
 func TestSTKPush(t *testing.T) {
 	sdk := new(mocks.SDK)
 
-	if err := STKPush(sdk); err != nil {
+	if err := STKPush(context.Background(), sdk); err != nil {
 		t.Errorf("STKPush() error = %v", err)
 	}
 }
 
+func TestSTKPushAndWait(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	if err := STKPushAndWait(context.Background(), sdk, time.Second); err != nil {
+		t.Errorf("STKPushAndWait() error = %v", err)
+	}
+}
+
 func TestB2CPayment(t *testing.T) {
 	sdk := new(mocks.SDK)
 
-	if err := B2CPayment(sdk); err != nil {
+	if err := B2CPayment(context.Background(), sdk); err != nil {
 		t.Errorf("B2CPayment() error = %v", err)
 	}
 }
 
+func TestB2CAccountTopUp(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	if err := B2CAccountTopUp(context.Background(), sdk); err != nil {
+		t.Errorf("B2CAccountTopUp() error = %v", err)
+	}
+}
+
+func TestB2Pochi(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	if err := B2Pochi(context.Background(), sdk); err != nil {
+		t.Errorf("B2Pochi() error = %v", err)
+	}
+}
+
 func TestAccountBalance(t *testing.T) {
 	sdk := new(mocks.SDK)
 
-	if err := AccountBalance(sdk); err != nil {
+	if err := AccountBalance(context.Background(), sdk); err != nil {
 		t.Errorf("AccountBalance() error = %v", err)
 	}
 }
@@ -53,7 +81,7 @@ func TestAccountBalance(t *testing.T) {
 func TestC2BRegisterURL(t *testing.T) {
 	sdk := new(mocks.SDK)
 
-	if err := C2BRegisterURL(sdk); err != nil {
+	if err := C2BRegisterURL(context.Background(), sdk); err != nil {
 		t.Errorf("C2BRegisterURL() error = %v", err)
 	}
 }
@@ -61,7 +89,7 @@ func TestC2BRegisterURL(t *testing.T) {
 func TestSTKPushQuery(t *testing.T) {
 	sdk := new(mocks.SDK)
 
-	if err := STKPushQuery(sdk); err != nil {
+	if err := STKPushQuery(context.Background(), sdk); err != nil {
 		t.Errorf("STKPushQuery() error = %v", err)
 	}
 }
@@ -69,7 +97,7 @@ func TestSTKPushQuery(t *testing.T) {
 func TestC2BSimulate(t *testing.T) {
 	sdk := new(mocks.SDK)
 
-	if err := C2BSimulate(sdk); err != nil {
+	if err := C2BSimulate(context.Background(), sdk); err != nil {
 		t.Errorf("C2BSimulate() error = %v", err)
 	}
 }
@@ -77,15 +105,31 @@ func TestC2BSimulate(t *testing.T) {
 func TestQRGenerate(t *testing.T) {
 	sdk := new(mocks.SDK)
 
-	if err := QRCode(sdk); err != nil {
+	if err := QRCode(context.Background(), sdk); err != nil {
 		t.Errorf("QRGenerate() error = %v", err)
 	}
 }
 
+func TestQRGenerateWithOutput(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	outputs := []QRCodeOutput{
+		{Terminal: true},
+		{Offline: true, Terminal: true},
+		{File: filepath.Join(t.TempDir(), "qr.svg")},
+		{Offline: true, File: filepath.Join(t.TempDir(), "qr.png")},
+	}
+	for _, out := range outputs {
+		if err := QRCodeWithOutput(context.Background(), sdk, out); err != nil {
+			t.Errorf("QRCodeWithOutput() error = %v", err)
+		}
+	}
+}
+
 func TestReversal(t *testing.T) {
 	sdk := new(mocks.SDK)
 
-	if err := Reversal(sdk); err != nil {
+	if err := Reversal(context.Background(), sdk); err != nil {
 		t.Errorf("Reversal() error = %v", err)
 	}
 }
@@ -93,7 +137,7 @@ func TestReversal(t *testing.T) {
 func TestRemitTax(t *testing.T) {
 	sdk := new(mocks.SDK)
 
-	if err := RemitTax(sdk); err != nil {
+	if err := RemitTax(context.Background(), sdk); err != nil {
 		t.Errorf("RemitTax() error = %v", err)
 	}
 }
@@ -101,21 +145,109 @@ func TestRemitTax(t *testing.T) {
 func TestTransactionStatus(t *testing.T) {
 	sdk := new(mocks.SDK)
 
-	if err := TransactionStatus(sdk); err != nil {
+	if err := TransactionStatus(context.Background(), sdk); err != nil {
 		t.Errorf("TransactionStatus() error = %v", err)
 	}
 }
 
+func TestSIMSwap(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	if err := SIMSwap(context.Background(), sdk); err != nil {
+		t.Errorf("SIMSwap() error = %v", err)
+	}
+}
+
 func TestBusinessPayBill(t *testing.T) {
 	sdk := new(mocks.SDK)
 
-	if err := BusinessPayBill(sdk); err != nil {
+	if err := BusinessPayBill(context.Background(), sdk); err != nil {
 		t.Errorf("BusinessPayBill() error = %v", err)
 	}
 }
 
+func TestB2BExpressCheckout(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	if err := B2BExpressCheckout(context.Background(), sdk); err != nil {
+		t.Errorf("B2BExpressCheckout() error = %v", err)
+	}
+}
+
+func TestBillManagerOptIn(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	if err := BillManagerOptIn(context.Background(), sdk); err != nil {
+		t.Errorf("BillManagerOptIn() error = %v", err)
+	}
+}
+
+func TestBillManagerUpdateOptIn(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	if err := BillManagerUpdateOptIn(context.Background(), sdk); err != nil {
+		t.Errorf("BillManagerUpdateOptIn() error = %v", err)
+	}
+}
+
+func TestBillManagerSingleInvoice(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	if err := BillManagerSingleInvoice(context.Background(), sdk); err != nil {
+		t.Errorf("BillManagerSingleInvoice() error = %v", err)
+	}
+}
+
+func TestBillManagerBulkInvoice(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	if err := BillManagerBulkInvoice(context.Background(), sdk); err != nil {
+		t.Errorf("BillManagerBulkInvoice() error = %v", err)
+	}
+}
+
+func TestBillManagerCancelInvoice(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	if err := BillManagerCancelInvoice(context.Background(), sdk); err != nil {
+		t.Errorf("BillManagerCancelInvoice() error = %v", err)
+	}
+}
+
+func TestBillManagerReconcile(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	if err := BillManagerReconcile(context.Background(), sdk); err != nil {
+		t.Errorf("BillManagerReconcile() error = %v", err)
+	}
+}
+
+func TestPullTransactionsRegister(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	if err := PullTransactionsRegister(context.Background(), sdk); err != nil {
+		t.Errorf("PullTransactionsRegister() error = %v", err)
+	}
+}
+
+func TestPullTransactionsQuery(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	if err := PullTransactionsQuery(context.Background(), sdk); err != nil {
+		t.Errorf("PullTransactionsQuery() error = %v", err)
+	}
+}
+
+func TestStandingOrder(t *testing.T) {
+	sdk := new(mocks.SDK)
+
+	if err := StandingOrder(context.Background(), sdk); err != nil {
+		t.Errorf("StandingOrder() error = %v", err)
+	}
+}
+
 func TestAddCommands(_ *testing.T) {
 	sdk := new(mocks.SDK)
 	app := fisk.New("mpesa-cli", "0.0.1")
-	AddCommands(app, sdk)
+	AddCommands(context.Background(), app, sdk)
 }
@@ -12,21 +12,34 @@
 //
 //  1. auth: responsible for the GetToken command.
 //
-//  2. b2c: responsible for the B2CPayment command.
+//  2. b2b: responsible for the BusinessPayBill and B2BExpressCheckout commands.
 //
-//  3. balance: responsible for the AccountBalance command.
+//  3. b2c: responsible for the B2CPayment, B2CAccountTopUp and B2Pochi
+//     commands.
 //
-//  4. c2b: responsible for the C2BRegisterURL and C2BSimulate commands.
+//  4. balance: responsible for the AccountBalance command.
 //
-//  5. express: responsible for the stkpush and stkpushquery commands.
+//  5. billmanager: responsible for the billmanager optin, updateoptin,
+//     invoice, bulkinvoice, cancelinvoice and reconcile commands.
 //
-//  6. qrcode: responsible for the generateqrcode command.
+//  6. c2b: responsible for the C2BRegisterURL and C2BSimulate commands.
 //
-//  7. reversal: responsible for the Reversal command.
+//  7. express: responsible for the stkpush and stkpushquery commands.
 //
-//  8. tax: responsible for the RemitTax command.
+//  8. qrcode: responsible for the generateqrcode command.
 //
-//  9. transaction: responsible for the TransactionStatus command.
+//  9. pulltransactions: responsible for the pull register and pull query
+//     commands.
 //
-//  10. log: responsible for the logError and logJSON functions.
+//  10. reversal: responsible for the Reversal command.
+//
+//  11. simswap: responsible for the SIMSwap command.
+//
+//  12. standingorder: responsible for the standingorder command.
+//
+//  13. tax: responsible for the RemitTax command.
+//
+//  14. transaction: responsible for the TransactionStatus command.
+//
+//  15. log: responsible for the logError and logJSON functions.
 package cli
@@ -7,11 +7,59 @@
 package cli
 
 import (
+	"context"
+	"time"
+
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 	"github.com/AlecAivazis/survey/v2"
 )
 
-func STKPush(sdk mpesa.SDK) error {
+func STKPush(ctx context.Context, sdk mpesa.SDK) error {
+	req, err := askSTKPush()
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	resp, err := sdk.ExpressSimulate(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
+
+// STKPushAndWait sends an STK push and waits up to timeout for its outcome.
+func STKPushAndWait(ctx context.Context, sdk mpesa.SDK, timeout time.Duration) error {
+	req, err := askSTKPush()
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	outcome, err := mpesa.ExpressPayAndWait(ctx, sdk, req, mpesa.ExpressWaitConfig{})
+	if err != nil {
+		logError(err)
+		if outcome.CheckoutRequestID == "" {
+			return nil
+		}
+	}
+
+	logJSON(outcome)
+
+	return nil
+}
+
+func askSTKPush() (mpesa.ExpressSimulateReq, error) {
 	req := mpesa.ExpressSimulateReq{}
 
 	qs := []*survey.Question{
@@ -109,24 +157,13 @@ func STKPush(sdk mpesa.SDK) error {
 	}
 
 	if err := survey.Ask(qs, &req, survey.WithHideCharacter('*'), survey.WithShowCursor(true)); err != nil {
-		logError(err)
-
-		return nil
+		return mpesa.ExpressSimulateReq{}, err
 	}
 
-	resp, err := sdk.ExpressSimulate(req)
-	if err != nil {
-		logError(err)
-
-		return nil
-	}
-
-	logJSON(resp)
-
-	return nil
+	return req, nil
 }
 
-func STKPushQuery(sdk mpesa.SDK) error {
+func STKPushQuery(ctx context.Context, sdk mpesa.SDK) error {
 	req := mpesa.ExpressQueryReq{}
 
 	qs := []*survey.Question{
@@ -163,7 +200,7 @@ func STKPushQuery(sdk mpesa.SDK) error {
 		return nil
 	}
 
-	resp, err := sdk.ExpressQuery(req)
+	resp, err := sdk.ExpressQuery(ctx, req)
 	if err != nil {
 		logError(err)
 
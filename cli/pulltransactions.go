@@ -0,0 +1,127 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// PullTransactionsRegister registers a shortcode for the Pull Transactions API.
+func PullTransactionsRegister(ctx context.Context, sdk mpesa.SDK) error {
+	req := mpesa.PullTransactionsRegisterReq{}
+
+	qs := []*survey.Question{
+		{
+			Name: "ShortCode",
+			Prompt: &survey.Input{
+				Message: "ShortCode",
+				Help:    "Organization's shortcode (Paybill or Buygoods) whose transactions are pulled",
+				Default: "600984",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "NominatedNumber",
+			Prompt: &survey.Input{
+				Message: "NominatedNumber",
+				Help:    "Safaricom phone number that receives the registration confirmation",
+				Default: "254700000000",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "CallBackURL",
+			Prompt: &survey.Input{
+				Message: "CallBackURL",
+				Help:    "URL to send notification upon registration",
+				Default: "https://example.com/pulltransactions",
+			},
+			Validate: survey.Required,
+		},
+	}
+
+	if err := survey.Ask(qs, &req, survey.WithShowCursor(true)); err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	resp, err := sdk.PullTransactionsRegister(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
+
+// PullTransactionsQuery fetches the transactions of a shortcode made within a time range.
+func PullTransactionsQuery(ctx context.Context, sdk mpesa.SDK) error {
+	req := mpesa.PullTransactionsQueryReq{}
+
+	qs := []*survey.Question{
+		{
+			Name: "ShortCode",
+			Prompt: &survey.Input{
+				Message: "ShortCode",
+				Help:    "Organization's shortcode (Paybill or Buygoods) whose transactions are pulled",
+				Default: "600984",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "StartDate",
+			Prompt: &survey.Input{
+				Message: "StartDate",
+				Help:    "Start of the time range (format: YYYY-MM-DD HH:MM:SS)",
+				Default: "2024-03-01 00:00:00",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "EndDate",
+			Prompt: &survey.Input{
+				Message: "EndDate",
+				Help:    "End of the time range (format: YYYY-MM-DD HH:MM:SS)",
+				Default: "2024-03-02 00:00:00",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "OffSetValue",
+			Prompt: &survey.Input{
+				Message: "OffSetValue",
+				Help:    "Number of transactions to skip",
+				Default: "0",
+			},
+			Validate: survey.Required,
+		},
+	}
+
+	if err := survey.Ask(qs, &req, survey.WithShowCursor(true)); err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	resp, err := sdk.PullTransactionsQuery(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
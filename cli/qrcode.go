@@ -7,11 +7,142 @@
 package cli
 
 import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 	"github.com/AlecAivazis/survey/v2"
 )
 
-func QRCode(sdk mpesa.SDK) error {
+// errSVGRequiresOffline indicates that a QR code from Daraja can not be saved as SVG.
+var errSVGRequiresOffline = errors.New("daraja returns a png image, saving the qr code as svg requires --offline")
+
+// QRCodeOutput selects where the QR code image goes.
+type QRCodeOutput struct {
+	Offline  bool   // Build the QR code locally instead of calling Daraja.
+	File     string // Save the image to the file, as SVG when it ends with .svg and PNG otherwise.
+	Terminal bool   // Draw the QR code in the terminal.
+}
+
+func QRCode(ctx context.Context, sdk mpesa.SDK) error {
+	req, err := askQRCode()
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	resp, err := sdk.GenerateQR(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
+
+// QRCodeWithOutput generates a QR code and saves it to a file or draws it in the terminal.
+func QRCodeWithOutput(ctx context.Context, sdk mpesa.SDK, out QRCodeOutput) error {
+	req, err := askQRCode()
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	if !out.Offline {
+		resp, err := sdk.GenerateQR(ctx, req)
+		if err != nil {
+			logError(err)
+
+			return nil
+		}
+		logJSON(resp)
+
+		if out.File == "" && !out.Terminal {
+			return nil
+		}
+		img, err := base64.StdEncoding.DecodeString(resp.QRCode)
+		if err != nil {
+			logError(err)
+
+			return nil
+		}
+
+		if out.File != "" {
+			if strings.EqualFold(filepath.Ext(out.File), ".svg") {
+				logError(errSVGRequiresOffline)
+
+				return nil
+			}
+			if err := os.WriteFile(out.File, img, 0o644); err != nil {
+				logError(err)
+
+				return nil
+			}
+		}
+
+		if out.Terminal {
+			text, err := mpesa.QRImageTerminal(img)
+			if err != nil {
+				logError(err)
+
+				return nil
+			}
+			fmt.Fprintf(os.Stdout, "\n%s\n", text)
+		}
+
+		return nil
+	}
+
+	qr, err := mpesa.NewQRCode(req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+	logJSON(qr)
+
+	if out.File != "" {
+		render := qr.PNG
+		if strings.EqualFold(filepath.Ext(out.File), ".svg") {
+			render = qr.SVG
+		}
+		img, err := render()
+		if err != nil {
+			logError(err)
+
+			return nil
+		}
+		if err := os.WriteFile(out.File, img, 0o644); err != nil {
+			logError(err)
+
+			return nil
+		}
+	}
+
+	if out.Terminal {
+		text, err := qr.Terminal()
+		if err != nil {
+			logError(err)
+
+			return nil
+		}
+		fmt.Fprintf(os.Stdout, "\n%s\n", text)
+	}
+
+	return nil
+}
+
+func askQRCode() (mpesa.GenerateQRReq, error) {
 	req := mpesa.GenerateQRReq{}
 
 	qs := []*survey.Question{
@@ -79,19 +210,8 @@ func QRCode(sdk mpesa.SDK) error {
 	}
 
 	if err := survey.Ask(qs, &req, survey.WithHideCharacter('*'), survey.WithShowCursor(true)); err != nil {
-		logError(err)
-
-		return nil
-	}
-
-	resp, err := sdk.GenerateQR(req)
-	if err != nil {
-		logError(err)
-
-		return nil
+		return mpesa.GenerateQRReq{}, err
 	}
 
-	logJSON(resp)
-
-	return nil
+	return req, nil
 }
@@ -7,11 +7,13 @@
 package cli
 
 import (
+	"context"
+
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 	"github.com/AlecAivazis/survey/v2"
 )
 
-func Reversal(sdk mpesa.SDK) error {
+func Reversal(ctx context.Context, sdk mpesa.SDK) error {
 	req := mpesa.ReverseReq{}
 
 	qs := []*survey.Question{
@@ -111,7 +113,7 @@ func Reversal(sdk mpesa.SDK) error {
 
 	req.CommandID = "TransactionReversal"
 
-	resp, err := sdk.Reverse(req)
+	resp, err := sdk.Reverse(ctx, req)
 	if err != nil {
 		logError(err)
 
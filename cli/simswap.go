@@ -0,0 +1,48 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// SIMSwap checks when the SIM of a customer was last swapped.
+func SIMSwap(ctx context.Context, sdk mpesa.SDK) error {
+	req := mpesa.SIMSwapReq{}
+
+	qs := []*survey.Question{
+		{
+			Name: "CustomerNumber",
+			Prompt: &survey.Input{
+				Message: "CustomerNumber",
+				Help:    "Phone number of the customer (format: 2547XXXXXXXX)",
+				Default: "254722000000",
+			},
+			Validate: survey.Required,
+		},
+	}
+
+	if err := survey.Ask(qs, &req, survey.WithShowCursor(true)); err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	resp, err := sdk.SIMSwap(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// StandingOrder creates an M-PESA Ratiba standing order.
+func StandingOrder(ctx context.Context, sdk mpesa.SDK) error {
+	req := mpesa.StandingOrderReq{}
+
+	qs := []*survey.Question{
+		{
+			Name: "StandingOrderName",
+			Prompt: &survey.Input{
+				Message: "StandingOrderName",
+				Help:    "A name of the standing order that is unique for the customer",
+				Default: "MpesaOverlay",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "StartDate",
+			Prompt: &survey.Input{
+				Message: "StartDate",
+				Help:    "The date of the first payment (format: YYYYMMDD)",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "EndDate",
+			Prompt: &survey.Input{
+				Message: "EndDate",
+				Help:    "The date after which no more payments are made (format: YYYYMMDD)",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "BusinessShortCode",
+			Prompt: &survey.Input{
+				Message: "BusinessShortCode",
+				Help:    "Organization's shortcode (Paybill or Buygoods) that receives the payments",
+				Default: "174379",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "TransactionType",
+			Prompt: &survey.Select{
+				Message: "TransactionType",
+				Options: []string{
+					"Standing Order Customer Pay Bill",
+					"Standing Order Customer Pay Marchant",
+				},
+				Help:    "Standing Order Customer Pay Bill for a Paybill or Standing Order Customer Pay Marchant for a Till number",
+				Default: "Standing Order Customer Pay Bill",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "ReceiverPartyIdentifierType",
+			Prompt: &survey.Input{
+				Message: "ReceiverPartyIdentifierType",
+				Help:    "Type of BusinessShortCode: 4 for a Paybill and 2 for a Till number",
+				Default: "4",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "Amount",
+			Prompt: &survey.Input{
+				Message: "Amount",
+				Help:    "The amount of every payment",
+				Default: "1",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "PartyA",
+			Prompt: &survey.Input{
+				Message: "PartyA",
+				Help:    "Phone number of the customer that pays (format: 2547XXXXXXXX)",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "CallBackURL",
+			Prompt: &survey.Input{
+				Message: "CallBackURL",
+				Help:    "Callback URL used to receive the outcome of the standing order",
+				Default: "https://example.com/standingorder",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "AccountReference",
+			Prompt: &survey.Input{
+				Message: "AccountReference",
+				Help:    "The account number the customer pays to. Only used for a Paybill",
+				Default: "MpesaOverlay",
+			},
+		},
+		{
+			Name: "TransactionDesc",
+			Prompt: &survey.Input{
+				Message: "TransactionDesc",
+				Help:    "Any additional information about the payments",
+				Default: "MpesaOverlay",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "Frequency",
+			Prompt: &survey.Input{
+				Message: "Frequency",
+				Help:    "1 One Off, 2 Daily, 3 Weekly, 4 Monthly, 5 Bi-Monthly, 6 Quarterly, 7 Half Year or 8 Yearly",
+				Default: "4",
+			},
+			Validate: survey.Required,
+		},
+	}
+
+	if err := survey.Ask(qs, &req, survey.WithShowCursor(true)); err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	resp, err := sdk.StandingOrder(ctx, req)
+	if err != nil {
+		logError(err)
+
+		return nil
+	}
+
+	logJSON(resp)
+
+	return nil
+}
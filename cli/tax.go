@@ -7,11 +7,13 @@
 package cli
 
 import (
+	"context"
+
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 	"github.com/AlecAivazis/survey/v2"
 )
 
-func RemitTax(sdk mpesa.SDK) error {
+func RemitTax(ctx context.Context, sdk mpesa.SDK) error {
 	req := mpesa.RemitTaxReq{}
 
 	qs := []*survey.Question{
@@ -122,7 +124,7 @@ func RemitTax(sdk mpesa.SDK) error {
 
 	req.CommandID = "PayTaxToKRA"
 
-	resp, err := sdk.RemitTax(req)
+	resp, err := sdk.RemitTax(ctx, req)
 	if err != nil {
 		logError(err)
 
@@ -7,11 +7,13 @@
 package cli
 
 import (
+	"context"
+
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 	"github.com/AlecAivazis/survey/v2"
 )
 
-func TransactionStatus(sdk mpesa.SDK) error {
+func TransactionStatus(ctx context.Context, sdk mpesa.SDK) error {
 	req := mpesa.TransactionStatusReq{}
 
 	qs := []*survey.Question{
@@ -54,10 +56,16 @@ func TransactionStatus(sdk mpesa.SDK) error {
 			Name: "TransactionID",
 			Prompt: &survey.Input{
 				Message: "TransactionID",
-				Help:    "Unique identifier to identify a transaction on M-Pesa",
+				Help:    "Unique identifier to identify a transaction on M-Pesa. Leave empty to look up by OriginatorConversationID",
 				Default: "RI704KI9RW",
 			},
-			Validate: survey.Required,
+		},
+		{
+			Name: "OriginatorConversationID",
+			Prompt: &survey.Input{
+				Message: "OriginatorConversationID",
+				Help:    "OriginatorConversationID the transaction was requested with, e.g. of a B2C v3 payment. Used when TransactionID is empty",
+			},
 		},
 		{
 			Name: "QueueTimeOutURL",
@@ -104,7 +112,7 @@ func TransactionStatus(sdk mpesa.SDK) error {
 
 	req.CommandID = "TransactionStatusQuery"
 
-	resp, err := sdk.TransactionStatus(req)
+	resp, err := sdk.TransactionStatus(ctx, req)
 	if err != nil {
 		logError(err)
 
@@ -11,9 +11,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
 
 	"github.com/0x6flab/mpesaoverlay"
 	"github.com/0x6flab/mpesaoverlay/cli"
@@ -26,6 +28,12 @@ type Config struct {
 	ConsumerKey    string `env:"MPESA_CONSUMER_KEY"`
 	ConsumerSecret string `env:"MPESA_CONSUMER_SECRET"`
 	BaseURL        string `env:"MPESA_BASE_URL"        envDefault:"https://sandbox.safaricom.co.ke"`
+	Environment    string `env:"MPESA_ENVIRONMENT"     envDefault:""`
+	CertFile       string `env:"MPESA_CERT_FILE"       envDefault:""`
+	DownloadCert   bool   `env:"MPESA_DOWNLOAD_CERT"   envDefault:"true"`
+	B2CVersion     string `env:"MPESA_B2C_VERSION"     envDefault:""`
+
+	C2BV2ShortCodes []uint64 `env:"MPESA_C2B_V2_SHORTCODES" envSeparator:","`
 }
 
 var help = `Mpesa Daraja CLI
@@ -39,11 +47,21 @@ func main() {
 		log.Fatalf(fmt.Sprintf("failed to parse env: %v", err))
 	}
 
+	c2bVersions := make(map[uint64]mpesa.C2BVersion, len(cfg.C2BV2ShortCodes))
+	for _, shortCode := range cfg.C2BV2ShortCodes {
+		c2bVersions[shortCode] = mpesa.C2BV2
+	}
+
 	mpesaCfg := mpesa.Config{
-		BaseURL:   cfg.BaseURL,
-		AppKey:    cfg.ConsumerKey,
-		AppSecret: cfg.ConsumerSecret,
+		BaseURL:     cfg.BaseURL,
+		Environment: mpesa.Environment(cfg.Environment),
+		AppKey:      cfg.ConsumerKey,
+		AppSecret:   cfg.ConsumerSecret,
+		CertFile:    cfg.CertFile,
+		B2CVersion:  mpesa.B2CVersion(cfg.B2CVersion),
+		C2BVersions: c2bVersions,
 	}
+	mpesaCfg.DownloadCertificate = cfg.DownloadCert
 	sdk, err := mpesa.NewSDK(mpesaCfg)
 	if err != nil {
 		log.Fatalf(fmt.Sprintf("failed to create mpesa sdk: %v", err))
@@ -60,7 +78,10 @@ func main() {
 	mpesaCLI.Flag("consumer-secret", "Mpesa Consumer Secret").Short('s').Envar("MPESA_CONSUMER_SECRET").StringVar(&cfg.ConsumerSecret)
 	mpesaCLI.Flag("base-url", "Mpesa Base URL").Short('b').Envar("MPESA_BASE_URL").StringVar(&cfg.BaseURL)
 
-	cli.AddCommands(mpesaCLI, sdk)
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	cli.AddCommands(ctx, mpesaCLI, sdk)
 
 	log.SetFlags(log.Ltime)
 	log.SetPrefix("[mpesa] ")
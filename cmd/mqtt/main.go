@@ -20,6 +20,7 @@ import (
 
 	mqttadapter "github.com/0x6flab/mpesaoverlay/mqtt"
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+	simswapm "github.com/0x6flab/mpesaoverlay/pkg/mpesa/middleware/fraud/simswap"
 	zapm "github.com/0x6flab/mpesaoverlay/pkg/mpesa/middleware/logging/zap"
 	prometheusm "github.com/0x6flab/mpesaoverlay/pkg/mpesa/middleware/metrics/prometheus"
 	"github.com/caarlos0/env/v9"
@@ -40,10 +41,20 @@ type config struct {
 	ConsumerKey    string `env:"MPESA_CONSUMER_KEY"`
 	ConsumerSecret string `env:"MPESA_CONSUMER_SECRET"`
 	BaseURL        string `env:"MPESA_BASE_URL"        envDefault:"https://sandbox.safaricom.co.ke"`
+	Environment    string `env:"MPESA_ENVIRONMENT"     envDefault:""`
+	TokenFile      string `env:"MPESA_TOKEN_FILE"      envDefault:""`
+	CertFile       string `env:"MPESA_CERT_FILE"       envDefault:""`
+	DownloadCert   bool   `env:"MPESA_DOWNLOAD_CERT"   envDefault:"true"`
+	B2CVersion     string `env:"MPESA_B2C_VERSION"     envDefault:""`
 	MQTTURL        string `env:"MO_MQTT_URL"           envDefault:"localhost:1883"`
 	MQTTServerCert string `env:"MO_MQTT_SERVER_CERT"`
 	MQTTServerKey  string `env:"MO_MQTT_SERVER_KEY"`
 	PrometheusURL  string `env:"MO_PROMETHEUS_URL"     envDefault:""`
+
+	C2BV2ShortCodes []uint64 `env:"MPESA_C2B_V2_SHORTCODES" envSeparator:","`
+
+	SIMSwapWindow    time.Duration `env:"MO_SIM_SWAP_WINDOW"     envDefault:"0"`
+	SIMSwapMinAmount uint64        `env:"MO_SIM_SWAP_MIN_AMOUNT" envDefault:"0"`
 }
 
 func main() {
@@ -90,16 +101,38 @@ func main() {
 }
 
 func newService(cfg config, logger *zap.Logger) (*mqttadapter.Hook, error) {
+	c2bVersions := make(map[uint64]mpesa.C2BVersion, len(cfg.C2BV2ShortCodes))
+	for _, shortCode := range cfg.C2BV2ShortCodes {
+		c2bVersions[shortCode] = mpesa.C2BV2
+	}
+
 	mpesaCfg := mpesa.Config{
-		BaseURL:   cfg.BaseURL,
-		AppKey:    cfg.ConsumerKey,
-		AppSecret: cfg.ConsumerSecret,
+		BaseURL:     cfg.BaseURL,
+		Environment: mpesa.Environment(cfg.Environment),
+		AppKey:      cfg.ConsumerKey,
+		AppSecret:   cfg.ConsumerSecret,
+		CertFile:    cfg.CertFile,
+		B2CVersion:  mpesa.B2CVersion(cfg.B2CVersion),
+		C2BVersions: c2bVersions,
+	}
+	mpesaCfg.DownloadCertificate = cfg.DownloadCert
+	if cfg.TokenFile != "" {
+		mpesaCfg.TokenStore = mpesa.NewFileTokenStore(cfg.TokenFile)
 	}
 
 	opts := []mpesa.Option{zapm.WithLogger(logger)}
 	if cfg.PrometheusURL != "" {
 		opts = append(opts, prometheusm.WithMetrics(svcName, cfg.PrometheusURL))
 	}
+	if cfg.SIMSwapWindow > 0 {
+		opts = append(opts, simswapm.WithGuard(simswapm.Config{
+			Window:    cfg.SIMSwapWindow,
+			MinAmount: cfg.SIMSwapMinAmount,
+			OnFlag: func(_ context.Context, swap simswapm.Swap) {
+				logger.Warn(fmt.Sprintf("%s to %d of %d blocked, sim swapped on %s", swap.Op, swap.MSISDN, swap.Amount, swap.LastSwapDate))
+			},
+		}))
+	}
 
 	sdk, err := mpesa.NewSDK(mpesaCfg, opts...)
 	if err != nil {
@@ -0,0 +1,71 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main provides an example of how to send a Bill Manager invoice.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+var (
+	respTopic = "mpesa/billmanager/invoice/response"
+	topic     = "mpesa/billmanager/invoice"
+	closeChan = make(chan struct{})
+)
+
+func onMessageReceived(_ mqtt.Client, message mqtt.Message) {
+	log.Printf("Received message: %s from topic: %s\n", string(message.Payload()), message.Topic())
+	close(closeChan)
+}
+
+func main() {
+	opts := mqtt.NewClientOptions()
+	opts.AddBroker("tcp://localhost:1883")
+
+	client := mqtt.NewClient(opts)
+	if token := client.Connect(); token.Wait() && token.Error() != nil {
+		log.Fatal(fmt.Errorf("failed to connect to MQTT broker: %w", token.Error()))
+	}
+
+	if token := client.Subscribe(respTopic, 0, onMessageReceived); token.Wait() && token.Error() != nil {
+		log.Fatal(fmt.Errorf("error subscribing to topic: %w", token.Error()))
+	}
+
+	invoiceReq := mpesa.BillManagerSingleInvoiceReq{
+		ExternalReference: "INV-001",
+		BilledFullName:    "John Doe",
+		BilledPhoneNumber: 254700000000,
+		BilledPeriod:      "August 2021",
+		InvoiceName:       "Rent",
+		DueDate:           "2021-10-12 00:00:00",
+		AccountReference:  "A1",
+		Amount:            100,
+	}
+	message, err := json.Marshal(invoiceReq)
+	if err != nil {
+		log.Fatal(fmt.Errorf("failed to marshal invoice request: %w", err))
+	}
+
+	token := client.Publish(topic, 0, false, message)
+	token.Wait()
+
+	select {
+	case <-closeChan:
+		log.Println("received message")
+	case <-time.After(5 * time.Second):
+		log.Println("timed out")
+	}
+
+	client.Unsubscribe(topic)
+	client.Disconnect(250)
+}
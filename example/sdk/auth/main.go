@@ -8,6 +8,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
@@ -31,7 +32,7 @@ func main() {
 		log.Fatal(err)
 	}
 
-	token, err := mp.Token()
+	token, err := mp.Token(context.Background())
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,50 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main provides an example of how to use B2BExpressCheckout method.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+)
+
+var (
+	cKey    = os.Getenv("MPESA_CONSUMER_KEY")
+	cSecret = os.Getenv("MPESA_CONSUMER_SECRET")
+)
+
+func main() {
+	conf := mpesa.Config{
+		BaseURL:   "https://sandbox.safaricom.co.ke",
+		AppKey:    cKey,
+		AppSecret: cSecret,
+	}
+
+	mp, err := mpesa.NewSDK(conf)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	b2bReq := mpesa.B2BExpressCheckoutReq{
+		PrimaryShortCode:  600992,
+		ReceiverShortCode: 600984,
+		Amount:            100,
+		PaymentRef:        "INV001",
+		CallbackURL:       "https://example.com/b2bexpress",
+		PartnerName:       "Vendor",
+	}
+
+	resp, err := mp.B2BExpressCheckout(context.Background(), b2bReq)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Resp: %+v\n", resp)
+}
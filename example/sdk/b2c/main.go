@@ -8,6 +8,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
@@ -46,7 +47,7 @@ func main() {
 		Occasion:                 "test",
 	}
 
-	resp, err := mp.B2CPayment(b2cReq)
+	resp, err := mp.B2CPayment(context.Background(), b2cReq)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,55 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main provides an example of how to use B2Pochi method.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+	"github.com/oklog/ulid/v2"
+)
+
+var (
+	cKey    = os.Getenv("MPESA_CONSUMER_KEY")
+	cSecret = os.Getenv("MPESA_CONSUMER_SECRET")
+)
+
+func main() {
+	conf := mpesa.Config{
+		BaseURL:   "https://sandbox.safaricom.co.ke",
+		AppKey:    cKey,
+		AppSecret: cSecret,
+	}
+
+	mp, err := mpesa.NewSDK(conf)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	pochiReq := mpesa.B2PochiReq{
+		OriginatorConversationID: ulid.Make().String(),
+		InitiatorName:            "testapi",
+		InitiatorPassword:        "Safaricom999!*!",
+		Amount:                   10,
+		PartyA:                   600996,
+		PartyB:                   254712345678,
+		QueueTimeOutURL:          "https://example.com/timeout",
+		ResultURL:                "https://example.com/result",
+		Remarks:                  "test",
+		Occasion:                 "test",
+	}
+
+	resp, err := mp.B2Pochi(context.Background(), pochiReq)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Resp: %+v\n", resp)
+}
@@ -8,6 +8,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
@@ -42,7 +43,7 @@ func main() {
 		Remarks:           "test",
 	}
 
-	resp, err := mp.AccountBalance(balReq)
+	resp, err := mp.AccountBalance(context.Background(), balReq)
 	if err != nil {
 		log.Fatal(err)
 	}
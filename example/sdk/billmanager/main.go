@@ -0,0 +1,98 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main provides an example of how to use Bill Manager methods.
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+)
+
+var (
+	cKey    = os.Getenv("MPESA_CONSUMER_KEY")
+	cSecret = os.Getenv("MPESA_CONSUMER_SECRET")
+)
+
+func main() {
+	conf := mpesa.Config{
+		BaseURL:   "https://sandbox.safaricom.co.ke",
+		AppKey:    cKey,
+		AppSecret: cSecret,
+	}
+
+	mp, err := mpesa.NewSDK(conf)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	optInReq := mpesa.BillManagerOptInReq{
+		ShortCode:       600984,
+		Email:           "billing@example.com",
+		OfficialContact: "254700000000",
+		SendReminders:   1,
+		CallbackURL:     "https://example.com/billmanager",
+	}
+
+	optInResp, err := mp.BillManagerOptIn(context.Background(), optInReq)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Opt in resp: %+v\n", optInResp)
+
+	invoiceReq := mpesa.BillManagerSingleInvoiceReq{
+		ExternalReference: "INV-001",
+		BilledFullName:    "John Doe",
+		BilledPhoneNumber: 254700000000,
+		BilledPeriod:      "August 2021",
+		InvoiceName:       "Rent",
+		DueDate:           "2021-10-12 00:00:00",
+		AccountReference:  "A1",
+		Amount:            100,
+		InvoiceItems: []mpesa.BillManagerInvoiceItem{
+			{ItemName: "Water", Amount: 20},
+		},
+	}
+
+	invoiceResp, err := mp.BillManagerSingleInvoice(context.Background(), invoiceReq)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Invoice resp: %+v\n", invoiceResp)
+
+	// Reconcile every payment so that the customer receives an e-receipt.
+	handler, err := mpesa.NewBillManagerPaymentHandler(func(ctx context.Context, payment mpesa.BillManagerPayment) error {
+		resp, err := mp.BillManagerReconcile(ctx, mpesa.BillManagerReconcileReq{
+			PaymentDate:       payment.DateCreated.Format("2006-01-02"),
+			PaidAmount:        uint64(payment.PaidAmount.Float64()),
+			AccountReference:  payment.AccountReference,
+			TransactionID:     payment.TransactionID,
+			PhoneNumber:       invoiceReq.BilledPhoneNumber,
+			FullName:          invoiceReq.BilledFullName,
+			InvoiceName:       invoiceReq.InvoiceName,
+			ExternalReference: invoiceReq.ExternalReference,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Printf("Reconcile resp: %+v\n", resp)
+
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	http.Handle("/billmanager", handler)
+	log.Fatal(http.ListenAndServe(":8080", nil))
+}
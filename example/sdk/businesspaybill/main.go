@@ -8,6 +8,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
@@ -47,7 +48,7 @@ func main() {
 		Remarks:                "test",
 	}
 
-	resp, err := mp.BusinessPayBill(b2cReq)
+	resp, err := mp.BusinessPayBill(context.Background(), b2cReq)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -8,6 +8,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
@@ -38,7 +39,7 @@ func main() {
 		ValidationURL:   "https://example.com/validation",
 	}
 
-	resp, err := mp.C2BRegisterURL(c2bReq)
+	resp, err := mp.C2BRegisterURL(context.Background(), c2bReq)
 	if err != nil {
 		log.Fatal(err)
 	}
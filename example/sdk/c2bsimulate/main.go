@@ -8,6 +8,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
@@ -39,7 +40,7 @@ func main() {
 		ShortCode:     600986,
 	}
 
-	resp, err := mp.C2BSimulate(c2bReq)
+	resp, err := mp.C2BSimulate(context.Background(), c2bReq)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,65 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main provides an example of how to use Pull Transactions methods.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+)
+
+var (
+	cKey    = os.Getenv("MPESA_CONSUMER_KEY")
+	cSecret = os.Getenv("MPESA_CONSUMER_SECRET")
+)
+
+func main() {
+	conf := mpesa.Config{
+		BaseURL:   "https://sandbox.safaricom.co.ke",
+		AppKey:    cKey,
+		AppSecret: cSecret,
+	}
+
+	mp, err := mpesa.NewSDK(conf)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	registerReq := mpesa.PullTransactionsRegisterReq{
+		ShortCode:       600984,
+		NominatedNumber: "0722000000",
+		CallBackURL:     "https://example.com/pulltransactions",
+	}
+
+	registerResp, err := mp.PullTransactionsRegister(context.Background(), registerReq)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Register resp: %+v\n", registerResp)
+
+	// Backfill the transactions of the last hour.
+	end := time.Now()
+	queryReq := mpesa.PullTransactionsQueryReq{
+		ShortCode: 600984,
+		StartDate: end.Add(-time.Hour).Format("2006-01-02 15:04:05"),
+		EndDate:   end.Format("2006-01-02 15:04:05"),
+	}
+
+	queryResp, err := mp.PullTransactionsQuery(context.Background(), queryReq)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, trx := range queryResp.Transactions {
+		log.Printf("Transaction: %+v\n", trx)
+	}
+}
@@ -8,6 +8,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
@@ -40,7 +41,7 @@ func main() {
 		Size:         "300",
 	}
 
-	qrcode, err := mp.GenerateQR(qrReq)
+	qrcode, err := mp.GenerateQR(context.Background(), qrReq)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -8,6 +8,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
@@ -37,7 +38,7 @@ func main() {
 		CheckoutRequestID: "ws_CO_07092023195244460720136609",
 	}
 
-	resp, err := mp.ExpressQuery(qrReq)
+	resp, err := mp.ExpressQuery(context.Background(), qrReq)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -8,6 +8,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
@@ -45,7 +46,7 @@ func main() {
 		Occasion:               "test",
 	}
 
-	resp, err := mp.Reverse(reverseReq)
+	resp, err := mp.Reverse(context.Background(), reverseReq)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,50 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main provides an example of how to use SIM swap method.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+)
+
+var (
+	cKey    = os.Getenv("MPESA_CONSUMER_KEY")
+	cSecret = os.Getenv("MPESA_CONSUMER_SECRET")
+)
+
+func main() {
+	conf := mpesa.Config{
+		BaseURL:   "https://sandbox.safaricom.co.ke",
+		AppKey:    cKey,
+		AppSecret: cSecret,
+	}
+
+	mp, err := mpesa.NewSDK(conf)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ssReq := mpesa.SIMSwapReq{
+		CustomerNumber: 254722000000,
+	}
+
+	resp, err := mp.SIMSwap(context.Background(), ssReq)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if resp.SwappedWithin(72*time.Hour, time.Now()) {
+		log.Printf("SIM swapped recently on %s\n", resp.LastSwapDate)
+	}
+
+	log.Printf("Resp: %+v\n", resp)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main provides an example of how to use StandingOrder method.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+)
+
+var (
+	cKey    = os.Getenv("MPESA_CONSUMER_KEY")
+	cSecret = os.Getenv("MPESA_CONSUMER_SECRET")
+)
+
+func main() {
+	conf := mpesa.Config{
+		BaseURL:   "https://sandbox.safaricom.co.ke",
+		AppKey:    cKey,
+		AppSecret: cSecret,
+	}
+
+	mp, err := mpesa.NewSDK(conf)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	soReq := mpesa.StandingOrderReq{
+		StandingOrderName:           "Gym Membership",
+		StartDate:                   "20240301",
+		EndDate:                     "20250301",
+		BusinessShortCode:           174379,
+		TransactionType:             "Standing Order Customer Pay Bill",
+		ReceiverPartyIdentifierType: 4,
+		Amount:                      500,
+		PartyA:                      254712345678,
+		CallBackURL:                 "https://example.com/standingorder",
+		AccountReference:            "GYM001",
+		TransactionDesc:             "Membership",
+		Frequency:                   mpesa.StandingOrderMonthly,
+	}
+
+	resp, err := mp.StandingOrder(context.Background(), soReq)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Resp: %+v\n", resp)
+}
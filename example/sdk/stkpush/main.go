@@ -8,6 +8,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
@@ -44,7 +45,7 @@ func main() {
 		TransactionDesc:   "Payment of X",
 	}
 
-	resp, err := mp.ExpressSimulate(qrReq)
+	resp, err := mp.ExpressSimulate(context.Background(), qrReq)
 	if err != nil {
 		log.Fatal(err)
 	}
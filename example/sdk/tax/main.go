@@ -8,6 +8,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
@@ -46,7 +47,7 @@ func main() {
 		Remarks:                "test",
 	}
 
-	resp, err := mp.RemitTax(taxReq)
+	resp, err := mp.RemitTax(context.Background(), taxReq)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -8,6 +8,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
@@ -44,7 +45,7 @@ func main() {
 		Occasion:          "test",
 	}
 
-	resp, err := mp.TransactionStatus(trxReq)
+	resp, err := mp.TransactionStatus(context.Background(), trxReq)
 	if err != nil {
 		log.Fatal(err)
 	}
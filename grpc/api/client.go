@@ -23,19 +23,32 @@ var _ grpcadapter.ServiceClient = (*grpcClient)(nil)
 
 // grpcClient implements the gRPC ServiceClient interface.
 type grpcClient struct {
-	token             endpoint.Endpoint
-	expressQuery      endpoint.Endpoint
-	expressSimulate   endpoint.Endpoint
-	b2c               endpoint.Endpoint
-	accountBalance    endpoint.Endpoint
-	c2bRegisterURL    endpoint.Endpoint
-	c2bSimulate       endpoint.Endpoint
-	generateQR        endpoint.Endpoint
-	reverse           endpoint.Endpoint
-	transactionStatus endpoint.Endpoint
-	remitTax          endpoint.Endpoint
-	businessPayBill   endpoint.Endpoint
-	timeout           time.Duration
+	token                    endpoint.Endpoint
+	expressQuery             endpoint.Endpoint
+	expressSimulate          endpoint.Endpoint
+	b2c                      endpoint.Endpoint
+	accountBalance           endpoint.Endpoint
+	c2bRegisterURL           endpoint.Endpoint
+	c2bSimulate              endpoint.Endpoint
+	generateQR               endpoint.Endpoint
+	reverse                  endpoint.Endpoint
+	transactionStatus        endpoint.Endpoint
+	remitTax                 endpoint.Endpoint
+	businessPayBill          endpoint.Endpoint
+	billManagerOptIn         endpoint.Endpoint
+	billManagerUpdateOptIn   endpoint.Endpoint
+	billManagerSingleInvoice endpoint.Endpoint
+	billManagerBulkInvoice   endpoint.Endpoint
+	billManagerCancelInvoice endpoint.Endpoint
+	billManagerReconcile     endpoint.Endpoint
+	pullTransactionsRegister endpoint.Endpoint
+	pullTransactionsQuery    endpoint.Endpoint
+	standingOrder            endpoint.Endpoint
+	b2bExpressCheckout       endpoint.Endpoint
+	b2cAccountTopUp          endpoint.Endpoint
+	b2Pochi                  endpoint.Endpoint
+	simSwap                  endpoint.Endpoint
+	timeout                  time.Duration
 }
 
 // NewClient returns new gRPC client instance.
@@ -138,6 +151,110 @@ func NewClient(conn *grpc.ClientConn, timeout time.Duration) grpcadapter.Service
 			decodeBusinessPayBillResponse,
 			grpcadapter.BusinessPayBillResp{},
 		).Endpoint(),
+		billManagerOptIn: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"BillManagerOptIn",
+			encodeBillManagerOptInRequest,
+			decodeBillManagerOptInResponse,
+			grpcadapter.BillManagerOptInResp{},
+		).Endpoint(),
+		billManagerUpdateOptIn: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"BillManagerUpdateOptIn",
+			encodeBillManagerUpdateOptInRequest,
+			decodeBillManagerUpdateOptInResponse,
+			grpcadapter.BillManagerUpdateOptInResp{},
+		).Endpoint(),
+		billManagerSingleInvoice: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"BillManagerSingleInvoice",
+			encodeBillManagerSingleInvoiceRequest,
+			decodeBillManagerSingleInvoiceResponse,
+			grpcadapter.BillManagerSingleInvoiceResp{},
+		).Endpoint(),
+		billManagerBulkInvoice: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"BillManagerBulkInvoice",
+			encodeBillManagerBulkInvoiceRequest,
+			decodeBillManagerBulkInvoiceResponse,
+			grpcadapter.BillManagerBulkInvoiceResp{},
+		).Endpoint(),
+		billManagerCancelInvoice: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"BillManagerCancelInvoice",
+			encodeBillManagerCancelInvoiceRequest,
+			decodeBillManagerCancelInvoiceResponse,
+			grpcadapter.BillManagerCancelInvoiceResp{},
+		).Endpoint(),
+		billManagerReconcile: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"BillManagerReconcile",
+			encodeBillManagerReconcileRequest,
+			decodeBillManagerReconcileResponse,
+			grpcadapter.BillManagerReconcileResp{},
+		).Endpoint(),
+		pullTransactionsRegister: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"PullTransactionsRegister",
+			encodePullTransactionsRegisterRequest,
+			decodePullTransactionsRegisterResponse,
+			grpcadapter.PullTransactionsRegisterResp{},
+		).Endpoint(),
+		pullTransactionsQuery: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"PullTransactionsQuery",
+			encodePullTransactionsQueryRequest,
+			decodePullTransactionsQueryResponse,
+			grpcadapter.PullTransactionsQueryResp{},
+		).Endpoint(),
+		standingOrder: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"StandingOrder",
+			encodeStandingOrderRequest,
+			decodeStandingOrderResponse,
+			grpcadapter.StandingOrderResp{},
+		).Endpoint(),
+		b2bExpressCheckout: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"B2BExpressCheckout",
+			encodeB2BExpressCheckoutRequest,
+			decodeB2BExpressCheckoutResponse,
+			grpcadapter.B2BExpressCheckoutResp{},
+		).Endpoint(),
+		b2cAccountTopUp: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"B2CAccountTopUp",
+			encodeB2CAccountTopUpRequest,
+			decodeB2CAccountTopUpResponse,
+			grpcadapter.B2CAccountTopUpResp{},
+		).Endpoint(),
+		b2Pochi: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"B2Pochi",
+			encodeB2PochiRequest,
+			decodeB2PochiResponse,
+			grpcadapter.B2PochiResp{},
+		).Endpoint(),
+		simSwap: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"SIMSwap",
+			encodeSIMSwapRequest,
+			decodeSIMSwapResponse,
+			grpcadapter.SIMSwapResp{},
+		).Endpoint(),
 
 		timeout: timeout,
 	}
@@ -201,7 +318,7 @@ func (client grpcClient) ExpressQuery(ctx context.Context, req *grpcadapter.Expr
 		MerchantRequestID:   ares.MerchantRequestID,
 		CheckoutRequestID:   ares.CheckoutRequestID,
 		CustomerMessage:     ares.CustomerMessage,
-		ResultCode:          ares.ResultCode,
+		ResultCode:          ares.ResultCode.String(),
 		ResultDesc:          ares.ResultDesc,
 	}, err
 }
@@ -216,7 +333,7 @@ func decodeExpressQueryResponse(_ context.Context, grpcRes interface{}) (interfa
 			MerchantRequestID:   res.GetMerchantRequestID(),
 			CheckoutRequestID:   res.GetCheckoutRequestID(),
 			CustomerMessage:     res.GetCustomerMessage(),
-			ResultCode:          res.GetResultCode(),
+			ResultCode:          mpesa.ResultCode(res.GetResultCode()),
 			ResultDesc:          res.GetResultDesc(),
 		},
 	}, nil
@@ -675,16 +792,17 @@ func (client grpcClient) TransactionStatus(ctx context.Context, req *grpcadapter
 
 	transactionReq := transactionReq{
 		mpesa.TransactionStatusReq{
-			InitiatorName:      req.GetInitiatorName(),
-			SecurityCredential: req.GetSecurityCredential(),
-			CommandID:          req.GetCommandID(),
-			TransactionID:      req.GetTransactionID(),
-			PartyA:             req.GetPartyA(),
-			IdentifierType:     uint8(req.GetIdentifierType()),
-			ResultURL:          req.GetResultURL(),
-			QueueTimeOutURL:    req.GetQueueTimeOutURL(),
-			Remarks:            req.GetRemarks(),
-			Occasion:           req.GetOccasion(),
+			InitiatorName:            req.GetInitiatorName(),
+			SecurityCredential:       req.GetSecurityCredential(),
+			CommandID:                req.GetCommandID(),
+			TransactionID:            req.GetTransactionID(),
+			PartyA:                   req.GetPartyA(),
+			IdentifierType:           uint8(req.GetIdentifierType()),
+			ResultURL:                req.GetResultURL(),
+			QueueTimeOutURL:          req.GetQueueTimeOutURL(),
+			Remarks:                  req.GetRemarks(),
+			Occasion:                 req.GetOccasion(),
+			OriginatorConversationID: req.GetOriginatorConversationID(),
 		},
 	}
 	res, err := client.transactionStatus(ctx, transactionReq)
@@ -723,16 +841,17 @@ func encodeTransactionStatusRequest(_ context.Context, grpcReq interface{}) (int
 	req := grpcReq.(transactionReq)
 
 	return &grpcadapter.TransactionStatusReq{
-		InitiatorName:      req.InitiatorName,
-		SecurityCredential: req.SecurityCredential,
-		CommandID:          req.CommandID,
-		TransactionID:      req.TransactionID,
-		PartyA:             req.PartyA,
-		IdentifierType:     uint32(req.IdentifierType),
-		ResultURL:          req.ResultURL,
-		QueueTimeOutURL:    req.QueueTimeOutURL,
-		Remarks:            req.Remarks,
-		Occasion:           req.Occasion,
+		InitiatorName:            req.InitiatorName,
+		SecurityCredential:       req.SecurityCredential,
+		CommandID:                req.CommandID,
+		TransactionID:            req.TransactionID,
+		PartyA:                   req.PartyA,
+		IdentifierType:           uint32(req.IdentifierType),
+		ResultURL:                req.ResultURL,
+		QueueTimeOutURL:          req.QueueTimeOutURL,
+		Remarks:                  req.Remarks,
+		Occasion:                 req.Occasion,
+		OriginatorConversationID: req.OriginatorConversationID,
 	}, nil
 }
 
@@ -873,3 +992,696 @@ func encodeBusinessPayBillRequest(_ context.Context, grpcReq interface{}) (inter
 		ResultURL:              req.ResultURL,
 	}, nil
 }
+
+func (client grpcClient) BillManagerOptIn(ctx context.Context, req *grpcadapter.BillManagerOptInReq, _ ...grpc.CallOption) (r *grpcadapter.BillManagerOptInResp, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	billManagerOptInReq := billManagerOptInReq{
+		mpesa.BillManagerOptInReq{
+			ShortCode:       req.GetShortCode(),
+			Email:           req.GetEmail(),
+			OfficialContact: req.GetOfficialContact(),
+			SendReminders:   uint8(req.GetSendReminders()),
+			Logo:            req.GetLogo(),
+			CallbackURL:     req.GetCallbackURL(),
+		},
+	}
+	res, err := client.billManagerOptIn(ctx, billManagerOptInReq)
+	if err != nil {
+		return &grpcadapter.BillManagerOptInResp{}, err
+	}
+
+	ares := res.(billManagerOptInResp)
+
+	return &grpcadapter.BillManagerOptInResp{
+		AppKey:          ares.AppKey,
+		BillManagerResp: billManagerRespToGRPC(ares.BillManagerResp),
+	}, err
+}
+
+func decodeBillManagerOptInResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*grpcadapter.BillManagerOptInResp)
+
+	return billManagerOptInResp{
+		BillManagerOptInResp: mpesa.BillManagerOptInResp{
+			AppKey:          res.GetAppKey(),
+			BillManagerResp: billManagerRespFromGRPC(res.GetBillManagerResp()),
+		},
+	}, nil
+}
+
+func encodeBillManagerOptInRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(billManagerOptInReq)
+
+	return &grpcadapter.BillManagerOptInReq{
+		ShortCode:       req.ShortCode,
+		Email:           req.Email,
+		OfficialContact: req.OfficialContact,
+		SendReminders:   uint32(req.SendReminders),
+		Logo:            req.Logo,
+		CallbackURL:     req.CallbackURL,
+	}, nil
+}
+
+func (client grpcClient) BillManagerUpdateOptIn(ctx context.Context, req *grpcadapter.BillManagerUpdateOptInReq, _ ...grpc.CallOption) (r *grpcadapter.BillManagerUpdateOptInResp, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	billManagerUpdateOptInReq := billManagerUpdateOptInReq{
+		mpesa.BillManagerUpdateOptInReq{
+			ShortCode:       req.GetShortCode(),
+			Email:           req.GetEmail(),
+			OfficialContact: req.GetOfficialContact(),
+			SendReminders:   uint8(req.GetSendReminders()),
+			Logo:            req.GetLogo(),
+			CallbackURL:     req.GetCallbackURL(),
+		},
+	}
+	res, err := client.billManagerUpdateOptIn(ctx, billManagerUpdateOptInReq)
+	if err != nil {
+		return &grpcadapter.BillManagerUpdateOptInResp{}, err
+	}
+
+	ares := res.(billManagerUpdateOptInResp)
+
+	return &grpcadapter.BillManagerUpdateOptInResp{
+		BillManagerResp: billManagerRespToGRPC(ares.BillManagerResp),
+	}, err
+}
+
+func decodeBillManagerUpdateOptInResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*grpcadapter.BillManagerUpdateOptInResp)
+
+	return billManagerUpdateOptInResp{
+		BillManagerUpdateOptInResp: mpesa.BillManagerUpdateOptInResp{
+			BillManagerResp: billManagerRespFromGRPC(res.GetBillManagerResp()),
+		},
+	}, nil
+}
+
+func encodeBillManagerUpdateOptInRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(billManagerUpdateOptInReq)
+
+	return &grpcadapter.BillManagerUpdateOptInReq{
+		ShortCode:       req.ShortCode,
+		Email:           req.Email,
+		OfficialContact: req.OfficialContact,
+		SendReminders:   uint32(req.SendReminders),
+		Logo:            req.Logo,
+		CallbackURL:     req.CallbackURL,
+	}, nil
+}
+
+func (client grpcClient) BillManagerSingleInvoice(ctx context.Context, req *grpcadapter.BillManagerSingleInvoiceReq, _ ...grpc.CallOption) (r *grpcadapter.BillManagerSingleInvoiceResp, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	billManagerSingleInvoiceReq := billManagerSingleInvoiceReq{
+		billManagerInvoiceFromGRPC(req),
+	}
+	res, err := client.billManagerSingleInvoice(ctx, billManagerSingleInvoiceReq)
+	if err != nil {
+		return &grpcadapter.BillManagerSingleInvoiceResp{}, err
+	}
+
+	ares := res.(billManagerSingleInvoiceResp)
+
+	return &grpcadapter.BillManagerSingleInvoiceResp{
+		BillManagerResp: billManagerRespToGRPC(ares.BillManagerResp),
+	}, err
+}
+
+func decodeBillManagerSingleInvoiceResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*grpcadapter.BillManagerSingleInvoiceResp)
+
+	return billManagerSingleInvoiceResp{
+		BillManagerSingleInvoiceResp: mpesa.BillManagerSingleInvoiceResp{
+			BillManagerResp: billManagerRespFromGRPC(res.GetBillManagerResp()),
+		},
+	}, nil
+}
+
+func encodeBillManagerSingleInvoiceRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(billManagerSingleInvoiceReq)
+
+	return billManagerInvoiceToGRPC(req.BillManagerSingleInvoiceReq), nil
+}
+
+func (client grpcClient) BillManagerBulkInvoice(ctx context.Context, req *grpcadapter.BillManagerBulkInvoiceReq, _ ...grpc.CallOption) (r *grpcadapter.BillManagerBulkInvoiceResp, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	billManagerBulkInvoiceReq := billManagerBulkInvoiceReq{
+		mpesa.BillManagerBulkInvoiceReq{
+			Invoices: billManagerInvoicesFromGRPC(req.GetInvoices()),
+		},
+	}
+	res, err := client.billManagerBulkInvoice(ctx, billManagerBulkInvoiceReq)
+	if err != nil {
+		return &grpcadapter.BillManagerBulkInvoiceResp{}, err
+	}
+
+	ares := res.(billManagerBulkInvoiceResp)
+
+	return &grpcadapter.BillManagerBulkInvoiceResp{
+		BillManagerResp: billManagerRespToGRPC(ares.BillManagerResp),
+	}, err
+}
+
+func decodeBillManagerBulkInvoiceResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*grpcadapter.BillManagerBulkInvoiceResp)
+
+	return billManagerBulkInvoiceResp{
+		BillManagerBulkInvoiceResp: mpesa.BillManagerBulkInvoiceResp{
+			BillManagerResp: billManagerRespFromGRPC(res.GetBillManagerResp()),
+		},
+	}, nil
+}
+
+func encodeBillManagerBulkInvoiceRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(billManagerBulkInvoiceReq)
+
+	return &grpcadapter.BillManagerBulkInvoiceReq{
+		Invoices: billManagerInvoicesToGRPC(req.Invoices),
+	}, nil
+}
+
+func (client grpcClient) BillManagerCancelInvoice(ctx context.Context, req *grpcadapter.BillManagerCancelInvoiceReq, _ ...grpc.CallOption) (r *grpcadapter.BillManagerCancelInvoiceResp, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	billManagerCancelInvoiceReq := billManagerCancelInvoiceReq{
+		mpesa.BillManagerCancelInvoiceReq{
+			ExternalReferences: req.GetExternalReferences(),
+		},
+	}
+	res, err := client.billManagerCancelInvoice(ctx, billManagerCancelInvoiceReq)
+	if err != nil {
+		return &grpcadapter.BillManagerCancelInvoiceResp{}, err
+	}
+
+	ares := res.(billManagerCancelInvoiceResp)
+
+	return &grpcadapter.BillManagerCancelInvoiceResp{
+		BillManagerResp: billManagerRespToGRPC(ares.BillManagerResp),
+	}, err
+}
+
+func decodeBillManagerCancelInvoiceResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*grpcadapter.BillManagerCancelInvoiceResp)
+
+	return billManagerCancelInvoiceResp{
+		BillManagerCancelInvoiceResp: mpesa.BillManagerCancelInvoiceResp{
+			BillManagerResp: billManagerRespFromGRPC(res.GetBillManagerResp()),
+		},
+	}, nil
+}
+
+func encodeBillManagerCancelInvoiceRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(billManagerCancelInvoiceReq)
+
+	return &grpcadapter.BillManagerCancelInvoiceReq{
+		ExternalReferences: req.ExternalReferences,
+	}, nil
+}
+
+func (client grpcClient) BillManagerReconcile(ctx context.Context, req *grpcadapter.BillManagerReconcileReq, _ ...grpc.CallOption) (r *grpcadapter.BillManagerReconcileResp, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	billManagerReconcileReq := billManagerReconcileReq{
+		mpesa.BillManagerReconcileReq{
+			PaymentDate:       req.GetPaymentDate(),
+			PaidAmount:        req.GetPaidAmount(),
+			AccountReference:  req.GetAccountReference(),
+			TransactionID:     req.GetTransactionID(),
+			PhoneNumber:       req.GetPhoneNumber(),
+			FullName:          req.GetFullName(),
+			InvoiceName:       req.GetInvoiceName(),
+			ExternalReference: req.GetExternalReference(),
+		},
+	}
+	res, err := client.billManagerReconcile(ctx, billManagerReconcileReq)
+	if err != nil {
+		return &grpcadapter.BillManagerReconcileResp{}, err
+	}
+
+	ares := res.(billManagerReconcileResp)
+
+	return &grpcadapter.BillManagerReconcileResp{
+		BillManagerResp: billManagerRespToGRPC(ares.BillManagerResp),
+	}, err
+}
+
+func decodeBillManagerReconcileResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*grpcadapter.BillManagerReconcileResp)
+
+	return billManagerReconcileResp{
+		BillManagerReconcileResp: mpesa.BillManagerReconcileResp{
+			BillManagerResp: billManagerRespFromGRPC(res.GetBillManagerResp()),
+		},
+	}, nil
+}
+
+func encodeBillManagerReconcileRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(billManagerReconcileReq)
+
+	return &grpcadapter.BillManagerReconcileReq{
+		PaymentDate:       req.PaymentDate,
+		PaidAmount:        req.PaidAmount,
+		AccountReference:  req.AccountReference,
+		TransactionID:     req.TransactionID,
+		PhoneNumber:       req.PhoneNumber,
+		FullName:          req.FullName,
+		InvoiceName:       req.InvoiceName,
+		ExternalReference: req.ExternalReference,
+	}, nil
+}
+
+func (client grpcClient) PullTransactionsRegister(ctx context.Context, req *grpcadapter.PullTransactionsRegisterReq, _ ...grpc.CallOption) (r *grpcadapter.PullTransactionsRegisterResp, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	pullTransactionsRegisterReq := pullTransactionsRegisterReq{
+		mpesa.PullTransactionsRegisterReq{
+			ShortCode:       req.GetShortCode(),
+			RequestType:     req.GetRequestType(),
+			NominatedNumber: req.GetNominatedNumber(),
+			CallBackURL:     req.GetCallBackURL(),
+		},
+	}
+	res, err := client.pullTransactionsRegister(ctx, pullTransactionsRegisterReq)
+	if err != nil {
+		return &grpcadapter.PullTransactionsRegisterResp{}, err
+	}
+
+	ares := res.(pullTransactionsRegisterResp)
+
+	return &grpcadapter.PullTransactionsRegisterResp{
+		ResponseRefID:   ares.ResponseRefID,
+		ResponseCode:    ares.ResponseCode,
+		ResponseMessage: ares.ResponseMessage,
+		ShortCode:       ares.ShortCode,
+	}, err
+}
+
+func decodePullTransactionsRegisterResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*grpcadapter.PullTransactionsRegisterResp)
+
+	return pullTransactionsRegisterResp{
+		PullTransactionsRegisterResp: mpesa.PullTransactionsRegisterResp{
+			ResponseRefID:   res.GetResponseRefID(),
+			ResponseCode:    res.GetResponseCode(),
+			ResponseMessage: res.GetResponseMessage(),
+			ShortCode:       res.GetShortCode(),
+		},
+	}, nil
+}
+
+func encodePullTransactionsRegisterRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(pullTransactionsRegisterReq)
+
+	return &grpcadapter.PullTransactionsRegisterReq{
+		ShortCode:       req.ShortCode,
+		RequestType:     req.RequestType,
+		NominatedNumber: req.NominatedNumber,
+		CallBackURL:     req.CallBackURL,
+	}, nil
+}
+
+func (client grpcClient) PullTransactionsQuery(ctx context.Context, req *grpcadapter.PullTransactionsQueryReq, _ ...grpc.CallOption) (r *grpcadapter.PullTransactionsQueryResp, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	pullTransactionsQueryReq := pullTransactionsQueryReq{
+		mpesa.PullTransactionsQueryReq{
+			ShortCode:   req.GetShortCode(),
+			StartDate:   req.GetStartDate(),
+			EndDate:     req.GetEndDate(),
+			OffSetValue: req.GetOffSetValue(),
+		},
+	}
+	res, err := client.pullTransactionsQuery(ctx, pullTransactionsQueryReq)
+	if err != nil {
+		return &grpcadapter.PullTransactionsQueryResp{}, err
+	}
+
+	ares := res.(pullTransactionsQueryResp)
+
+	return &grpcadapter.PullTransactionsQueryResp{
+		ResponseRefID:   ares.ResponseRefID,
+		ResponseCode:    ares.ResponseCode,
+		ResponseMessage: ares.ResponseMessage,
+		Transactions:    pullTransactionsToGRPC(ares.Transactions),
+	}, err
+}
+
+func decodePullTransactionsQueryResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*grpcadapter.PullTransactionsQueryResp)
+
+	transactions, err := pullTransactionsFromGRPC(res.GetTransactions())
+	if err != nil {
+		return pullTransactionsQueryResp{}, err
+	}
+
+	return pullTransactionsQueryResp{
+		PullTransactionsQueryResp: mpesa.PullTransactionsQueryResp{
+			ResponseRefID:   res.GetResponseRefID(),
+			ResponseCode:    res.GetResponseCode(),
+			ResponseMessage: res.GetResponseMessage(),
+			Transactions:    transactions,
+		},
+	}, nil
+}
+
+func encodePullTransactionsQueryRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(pullTransactionsQueryReq)
+
+	return &grpcadapter.PullTransactionsQueryReq{
+		ShortCode:   req.ShortCode,
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+		OffSetValue: req.OffSetValue,
+	}, nil
+}
+
+func (client grpcClient) StandingOrder(ctx context.Context, req *grpcadapter.StandingOrderReq, _ ...grpc.CallOption) (r *grpcadapter.StandingOrderResp, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	standingOrderReq := standingOrderReq{
+		mpesa.StandingOrderReq{
+			StandingOrderName:           req.GetStandingOrderName(),
+			StartDate:                   req.GetStartDate(),
+			EndDate:                     req.GetEndDate(),
+			BusinessShortCode:           req.GetBusinessShortCode(),
+			TransactionType:             req.GetTransactionType(),
+			ReceiverPartyIdentifierType: uint8(req.GetReceiverPartyIdentifierType()),
+			Amount:                      req.GetAmount(),
+			PartyA:                      req.GetPartyA(),
+			CallBackURL:                 req.GetCallBackURL(),
+			AccountReference:            req.GetAccountReference(),
+			TransactionDesc:             req.GetTransactionDesc(),
+			Frequency:                   mpesa.StandingOrderFrequency(req.GetFrequency()),
+		},
+	}
+	res, err := client.standingOrder(ctx, standingOrderReq)
+	if err != nil {
+		return &grpcadapter.StandingOrderResp{}, err
+	}
+
+	ares := res.(standingOrderResp)
+
+	return &grpcadapter.StandingOrderResp{
+		ResponseRefID:       ares.ResponseRefID,
+		ResponseCode:        ares.ResponseCode,
+		ResponseDescription: ares.ResponseDescription,
+		ResultDesc:          ares.ResultDesc,
+	}, err
+}
+
+func decodeStandingOrderResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*grpcadapter.StandingOrderResp)
+
+	return standingOrderResp{
+		StandingOrderResp: mpesa.StandingOrderResp{
+			ResponseRefID:       res.GetResponseRefID(),
+			ResponseCode:        res.GetResponseCode(),
+			ResponseDescription: res.GetResponseDescription(),
+			ResultDesc:          res.GetResultDesc(),
+		},
+	}, nil
+}
+
+func encodeStandingOrderRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(standingOrderReq)
+
+	return &grpcadapter.StandingOrderReq{
+		StandingOrderName:           req.StandingOrderName,
+		StartDate:                   req.StartDate,
+		EndDate:                     req.EndDate,
+		BusinessShortCode:           req.BusinessShortCode,
+		TransactionType:             req.TransactionType,
+		ReceiverPartyIdentifierType: uint32(req.ReceiverPartyIdentifierType),
+		Amount:                      req.Amount,
+		PartyA:                      req.PartyA,
+		CallBackURL:                 req.CallBackURL,
+		AccountReference:            req.AccountReference,
+		TransactionDesc:             req.TransactionDesc,
+		Frequency:                   uint32(req.Frequency),
+	}, nil
+}
+
+func (client grpcClient) B2BExpressCheckout(ctx context.Context, req *grpcadapter.B2BExpressCheckoutReq, _ ...grpc.CallOption) (r *grpcadapter.B2BExpressCheckoutResp, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	b2bExpressCheckoutReq := b2bExpressCheckoutReq{
+		mpesa.B2BExpressCheckoutReq{
+			PrimaryShortCode:  req.GetPrimaryShortCode(),
+			ReceiverShortCode: req.GetReceiverShortCode(),
+			Amount:            req.GetAmount(),
+			PaymentRef:        req.GetPaymentRef(),
+			CallbackURL:       req.GetCallbackURL(),
+			PartnerName:       req.GetPartnerName(),
+			RequestRefID:      req.GetRequestRefID(),
+		},
+	}
+	res, err := client.b2bExpressCheckout(ctx, b2bExpressCheckoutReq)
+	if err != nil {
+		return &grpcadapter.B2BExpressCheckoutResp{}, err
+	}
+
+	ares := res.(b2bExpressCheckoutResp)
+
+	return &grpcadapter.B2BExpressCheckoutResp{
+		Code:         ares.Code,
+		Status:       ares.Status,
+		RequestRefID: ares.RequestRefID,
+	}, err
+}
+
+func decodeB2BExpressCheckoutResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*grpcadapter.B2BExpressCheckoutResp)
+
+	return b2bExpressCheckoutResp{
+		B2BExpressCheckoutResp: mpesa.B2BExpressCheckoutResp{
+			Code:         res.GetCode(),
+			Status:       res.GetStatus(),
+			RequestRefID: res.GetRequestRefID(),
+		},
+	}, nil
+}
+
+func encodeB2BExpressCheckoutRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(b2bExpressCheckoutReq)
+
+	return &grpcadapter.B2BExpressCheckoutReq{
+		PrimaryShortCode:  req.PrimaryShortCode,
+		ReceiverShortCode: req.ReceiverShortCode,
+		Amount:            req.Amount,
+		PaymentRef:        req.PaymentRef,
+		CallbackURL:       req.CallbackURL,
+		PartnerName:       req.PartnerName,
+		RequestRefID:      req.RequestRefID,
+	}, nil
+}
+
+func (client grpcClient) B2CAccountTopUp(ctx context.Context, req *grpcadapter.B2CAccountTopUpReq, _ ...grpc.CallOption) (r *grpcadapter.B2CAccountTopUpResp, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	b2cAccountTopUpReq := b2cAccountTopUpReq{
+		mpesa.B2CAccountTopUpReq{
+			Initiator:              req.GetInitiator(),
+			InitiatorPassword:      req.GetInitiatorPassword(),
+			SecurityCredential:     req.GetSecurityCredential(),
+			CommandID:              req.GetCommandID(),
+			SenderIdentifierType:   uint8(req.GetSenderIdentifierType()),
+			RecieverIdentifierType: uint8(req.GetRecieverIdentifierType()),
+			Amount:                 req.GetAmount(),
+			PartyA:                 req.GetPartyA(),
+			PartyB:                 req.GetPartyB(),
+			AccountReference:       req.GetAccountReference(),
+			QueueTimeOutURL:        req.GetQueueTimeOutURL(),
+			ResultURL:              req.GetResultURL(),
+			Remarks:                req.GetRemarks(),
+			Requester:              req.GetRequester(),
+		},
+	}
+	res, err := client.b2cAccountTopUp(ctx, b2cAccountTopUpReq)
+	if err != nil {
+		return &grpcadapter.B2CAccountTopUpResp{}, err
+	}
+
+	ares := res.(b2cAccountTopUpResp)
+
+	return &grpcadapter.B2CAccountTopUpResp{
+		ValidResp: &grpcadapter.ValidResp{
+			OriginatorConversationID: ares.OriginatorConversationID,
+			ResponseCode:             ares.ResponseCode,
+			ResponseDescription:      ares.ResponseDescription,
+			ConversationID:           ares.ConversationID,
+		},
+	}, err
+}
+
+func decodeB2CAccountTopUpResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*grpcadapter.B2CAccountTopUpResp)
+
+	return b2cAccountTopUpResp{
+		B2CAccountTopUpResp: mpesa.B2CAccountTopUpResp{
+			ValidResp: mpesa.ValidResp{
+				OriginatorConversationID: res.ValidResp.GetOriginatorConversationID(),
+				ResponseCode:             res.ValidResp.GetResponseCode(),
+				ResponseDescription:      res.ValidResp.GetResponseDescription(),
+				ConversationID:           res.ValidResp.GetConversationID(),
+			},
+		},
+	}, nil
+}
+
+func encodeB2CAccountTopUpRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(b2cAccountTopUpReq)
+
+	return &grpcadapter.B2CAccountTopUpReq{
+		Initiator:              req.Initiator,
+		InitiatorPassword:      req.InitiatorPassword,
+		SecurityCredential:     req.SecurityCredential,
+		CommandID:              req.CommandID,
+		SenderIdentifierType:   uint32(req.SenderIdentifierType),
+		RecieverIdentifierType: uint32(req.RecieverIdentifierType),
+		Amount:                 req.Amount,
+		PartyA:                 req.PartyA,
+		PartyB:                 req.PartyB,
+		AccountReference:       req.AccountReference,
+		QueueTimeOutURL:        req.QueueTimeOutURL,
+		ResultURL:              req.ResultURL,
+		Remarks:                req.Remarks,
+		Requester:              req.Requester,
+	}, nil
+}
+
+func (client grpcClient) B2Pochi(ctx context.Context, req *grpcadapter.B2PochiReq, _ ...grpc.CallOption) (r *grpcadapter.B2PochiResp, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	b2PochiReq := b2PochiReq{
+		mpesa.B2PochiReq{
+			OriginatorConversationID: req.GetOriginatorConversationID(),
+			InitiatorName:            req.GetInitiatorName(),
+			InitiatorPassword:        req.GetInitiatorPassword(),
+			SecurityCredential:       req.GetSecurityCredential(),
+			CommandID:                req.GetCommandID(),
+			Amount:                   req.GetAmount(),
+			PartyA:                   req.GetPartyA(),
+			PartyB:                   req.GetPartyB(),
+			Remarks:                  req.GetRemarks(),
+			QueueTimeOutURL:          req.GetQueueTimeOutURL(),
+			ResultURL:                req.GetResultURL(),
+			Occasion:                 req.GetOccasion(),
+		},
+	}
+	res, err := client.b2Pochi(ctx, b2PochiReq)
+	if err != nil {
+		return &grpcadapter.B2PochiResp{}, err
+	}
+
+	ares := res.(b2PochiResp)
+
+	return &grpcadapter.B2PochiResp{
+		ValidResp: &grpcadapter.ValidResp{
+			OriginatorConversationID: ares.OriginatorConversationID,
+			ResponseCode:             ares.ResponseCode,
+			ResponseDescription:      ares.ResponseDescription,
+			ConversationID:           ares.ConversationID,
+		},
+	}, err
+}
+
+func decodeB2PochiResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*grpcadapter.B2PochiResp)
+
+	return b2PochiResp{
+		B2PochiResp: mpesa.B2PochiResp{
+			ValidResp: mpesa.ValidResp{
+				OriginatorConversationID: res.ValidResp.GetOriginatorConversationID(),
+				ResponseCode:             res.ValidResp.GetResponseCode(),
+				ResponseDescription:      res.ValidResp.GetResponseDescription(),
+				ConversationID:           res.ValidResp.GetConversationID(),
+			},
+		},
+	}, nil
+}
+
+func encodeB2PochiRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(b2PochiReq)
+
+	return &grpcadapter.B2PochiReq{
+		OriginatorConversationID: req.OriginatorConversationID,
+		InitiatorName:            req.InitiatorName,
+		InitiatorPassword:        req.InitiatorPassword,
+		SecurityCredential:       req.SecurityCredential,
+		CommandID:                req.CommandID,
+		Amount:                   req.Amount,
+		PartyA:                   req.PartyA,
+		PartyB:                   req.PartyB,
+		Remarks:                  req.Remarks,
+		QueueTimeOutURL:          req.QueueTimeOutURL,
+		ResultURL:                req.ResultURL,
+		Occasion:                 req.Occasion,
+	}, nil
+}
+
+func (client grpcClient) SIMSwap(ctx context.Context, req *grpcadapter.SIMSwapReq, _ ...grpc.CallOption) (r *grpcadapter.SIMSwapResp, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	simSwapReq := simSwapReq{
+		mpesa.SIMSwapReq{
+			CustomerNumber: req.GetCustomerNumber(),
+		},
+	}
+	res, err := client.simSwap(ctx, simSwapReq)
+	if err != nil {
+		return &grpcadapter.SIMSwapResp{}, err
+	}
+
+	ares := res.(simSwapResp)
+
+	return &grpcadapter.SIMSwapResp{
+		ResponseRefID:       ares.ResponseRefID,
+		ResponseCode:        ares.ResponseCode,
+		ResponseDescription: ares.ResponseDescription,
+		CustomerNumber:      ares.CustomerNumber,
+		LastSwapDate:        timeToGRPC(ares.LastSwapDate),
+	}, err
+}
+
+func decodeSIMSwapResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*grpcadapter.SIMSwapResp)
+
+	lastSwapDate, err := timeFromGRPC(res.GetLastSwapDate())
+	if err != nil {
+		return simSwapResp{}, err
+	}
+
+	return simSwapResp{
+		SIMSwapResp: mpesa.SIMSwapResp{
+			ResponseRefID:       res.GetResponseRefID(),
+			ResponseCode:        res.GetResponseCode(),
+			ResponseDescription: res.GetResponseDescription(),
+			CustomerNumber:      res.GetCustomerNumber(),
+			LastSwapDate:        lastSwapDate,
+		},
+	}, nil
+}
+
+func encodeSIMSwapRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(simSwapReq)
+
+	return &grpcadapter.SIMSwapReq{
+		CustomerNumber: req.CustomerNumber,
+	}, nil
+}
@@ -18,13 +18,13 @@ import (
 var errValidation = errors.New("validation error")
 
 func tokenEndpoint(svc grpc.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(tokenReq)
 		if err := req.validate(); err != nil {
 			return tokenResp{}, errors.Join(errValidation, err)
 		}
 
-		resp, err := svc.Token()
+		resp, err := svc.Token(ctx)
 		if err != nil {
 			return tokenResp{}, err
 		}
@@ -34,13 +34,13 @@ func tokenEndpoint(svc grpc.Service) endpoint.Endpoint {
 }
 
 func expressQueryEndpoint(svc grpc.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(expressQueryReq)
 		if err := req.validate(); err != nil {
 			return expressQueryResp{}, errors.Join(errValidation, err)
 		}
 
-		resp, err := svc.ExpressQuery(req.ExpressQueryReq)
+		resp, err := svc.ExpressQuery(ctx, req.ExpressQueryReq)
 		if err != nil {
 			return expressQueryResp{}, err
 		}
@@ -50,13 +50,13 @@ func expressQueryEndpoint(svc grpc.Service) endpoint.Endpoint {
 }
 
 func expressSimulateEndpoint(svc grpc.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(expressSimulateReq)
 		if err := req.validate(); err != nil {
 			return expressSimulateResp{}, errors.Join(errValidation, err)
 		}
 
-		resp, err := svc.ExpressSimulate(req.ExpressSimulateReq)
+		resp, err := svc.ExpressSimulate(ctx, req.ExpressSimulateReq)
 		if err != nil {
 			return expressSimulateResp{}, err
 		}
@@ -66,13 +66,13 @@ func expressSimulateEndpoint(svc grpc.Service) endpoint.Endpoint {
 }
 
 func b2cEndpoint(svc grpc.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(b2cReq)
 		if err := req.validate(); err != nil {
 			return b2cResp{}, errors.Join(errValidation, err)
 		}
 
-		resp, err := svc.B2CPayment(req.B2CPaymentReq)
+		resp, err := svc.B2CPayment(ctx, req.B2CPaymentReq)
 		if err != nil {
 			return b2cResp{}, err
 		}
@@ -82,13 +82,13 @@ func b2cEndpoint(svc grpc.Service) endpoint.Endpoint {
 }
 
 func accountBalanceEndpoint(svc grpc.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(accountBalanceReq)
 		if err := req.validate(); err != nil {
 			return accountBalanceResp{}, errors.Join(errValidation, err)
 		}
 
-		resp, err := svc.AccountBalance(req.AccountBalanceReq)
+		resp, err := svc.AccountBalance(ctx, req.AccountBalanceReq)
 		if err != nil {
 			return accountBalanceResp{}, err
 		}
@@ -98,13 +98,13 @@ func accountBalanceEndpoint(svc grpc.Service) endpoint.Endpoint {
 }
 
 func c2bRegisterURLEndpoint(svc grpc.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(c2bRegisterURLReq)
 		if err := req.validate(); err != nil {
 			return c2bRegisterURLResp{}, errors.Join(errValidation, err)
 		}
 
-		resp, err := svc.C2BRegisterURL(req.C2BRegisterURLReq)
+		resp, err := svc.C2BRegisterURL(ctx, req.C2BRegisterURLReq)
 		if err != nil {
 			return c2bRegisterURLResp{}, err
 		}
@@ -114,13 +114,13 @@ func c2bRegisterURLEndpoint(svc grpc.Service) endpoint.Endpoint {
 }
 
 func c2bSimulateEndpoint(svc grpc.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(c2bSimulateReq)
 		if err := req.validate(); err != nil {
 			return c2bSimulateResp{}, errors.Join(errValidation, err)
 		}
 
-		resp, err := svc.C2BSimulate(req.C2BSimulateReq)
+		resp, err := svc.C2BSimulate(ctx, req.C2BSimulateReq)
 		if err != nil {
 			return c2bSimulateResp{}, err
 		}
@@ -130,13 +130,13 @@ func c2bSimulateEndpoint(svc grpc.Service) endpoint.Endpoint {
 }
 
 func generateQREndpoint(svc grpc.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(generateQRReq)
 		if err := req.validate(); err != nil {
 			return generateQRResp{}, errors.Join(errValidation, err)
 		}
 
-		resp, err := svc.GenerateQR(req.GenerateQRReq)
+		resp, err := svc.GenerateQR(ctx, req.GenerateQRReq)
 		if err != nil {
 			return generateQRResp{}, err
 		}
@@ -146,13 +146,13 @@ func generateQREndpoint(svc grpc.Service) endpoint.Endpoint {
 }
 
 func reverseEndpoint(svc grpc.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(reversalReq)
 		if err := req.validate(); err != nil {
 			return reverseResp{}, errors.Join(errValidation, err)
 		}
 
-		resp, err := svc.Reverse(req.ReverseReq)
+		resp, err := svc.Reverse(ctx, req.ReverseReq)
 		if err != nil {
 			return reverseResp{}, err
 		}
@@ -162,13 +162,13 @@ func reverseEndpoint(svc grpc.Service) endpoint.Endpoint {
 }
 
 func transactionStatusEndpoint(svc grpc.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(transactionReq)
 		if err := req.validate(); err != nil {
 			return transactionStatusResp{}, errors.Join(errValidation, err)
 		}
 
-		resp, err := svc.TransactionStatus(req.TransactionStatusReq)
+		resp, err := svc.TransactionStatus(ctx, req.TransactionStatusReq)
 		if err != nil {
 			return transactionStatusResp{}, err
 		}
@@ -178,13 +178,13 @@ func transactionStatusEndpoint(svc grpc.Service) endpoint.Endpoint {
 }
 
 func remitTaxEndpoint(svc grpc.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(remitTaxReq)
 		if err := req.validate(); err != nil {
 			return remitTaxResp{}, errors.Join(errValidation, err)
 		}
 
-		resp, err := svc.RemitTax(req.RemitTaxReq)
+		resp, err := svc.RemitTax(ctx, req.RemitTaxReq)
 		if err != nil {
 			return remitTaxResp{}, err
 		}
@@ -194,13 +194,13 @@ func remitTaxEndpoint(svc grpc.Service) endpoint.Endpoint {
 }
 
 func businessPayBillEndpoint(svc grpc.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(businessPayBillReq)
 		if err := req.validate(); err != nil {
 			return businessPayBillResp{}, errors.Join(errValidation, err)
 		}
 
-		resp, err := svc.BusinessPayBill(req.BusinessPayBillReq)
+		resp, err := svc.BusinessPayBill(ctx, req.BusinessPayBillReq)
 		if err != nil {
 			return businessPayBillResp{}, err
 		}
@@ -208,3 +208,211 @@ func businessPayBillEndpoint(svc grpc.Service) endpoint.Endpoint {
 		return businessPayBillResp{resp}, nil
 	}
 }
+
+func billManagerOptInEndpoint(svc grpc.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(billManagerOptInReq)
+		if err := req.validate(); err != nil {
+			return billManagerOptInResp{}, errors.Join(errValidation, err)
+		}
+
+		resp, err := svc.BillManagerOptIn(ctx, req.BillManagerOptInReq)
+		if err != nil {
+			return billManagerOptInResp{}, err
+		}
+
+		return billManagerOptInResp{resp}, nil
+	}
+}
+
+func billManagerUpdateOptInEndpoint(svc grpc.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(billManagerUpdateOptInReq)
+		if err := req.validate(); err != nil {
+			return billManagerUpdateOptInResp{}, errors.Join(errValidation, err)
+		}
+
+		resp, err := svc.BillManagerUpdateOptIn(ctx, req.BillManagerUpdateOptInReq)
+		if err != nil {
+			return billManagerUpdateOptInResp{}, err
+		}
+
+		return billManagerUpdateOptInResp{resp}, nil
+	}
+}
+
+func billManagerSingleInvoiceEndpoint(svc grpc.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(billManagerSingleInvoiceReq)
+		if err := req.validate(); err != nil {
+			return billManagerSingleInvoiceResp{}, errors.Join(errValidation, err)
+		}
+
+		resp, err := svc.BillManagerSingleInvoice(ctx, req.BillManagerSingleInvoiceReq)
+		if err != nil {
+			return billManagerSingleInvoiceResp{}, err
+		}
+
+		return billManagerSingleInvoiceResp{resp}, nil
+	}
+}
+
+func billManagerBulkInvoiceEndpoint(svc grpc.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(billManagerBulkInvoiceReq)
+		if err := req.validate(); err != nil {
+			return billManagerBulkInvoiceResp{}, errors.Join(errValidation, err)
+		}
+
+		resp, err := svc.BillManagerBulkInvoice(ctx, req.BillManagerBulkInvoiceReq)
+		if err != nil {
+			return billManagerBulkInvoiceResp{}, err
+		}
+
+		return billManagerBulkInvoiceResp{resp}, nil
+	}
+}
+
+func billManagerCancelInvoiceEndpoint(svc grpc.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(billManagerCancelInvoiceReq)
+		if err := req.validate(); err != nil {
+			return billManagerCancelInvoiceResp{}, errors.Join(errValidation, err)
+		}
+
+		resp, err := svc.BillManagerCancelInvoice(ctx, req.BillManagerCancelInvoiceReq)
+		if err != nil {
+			return billManagerCancelInvoiceResp{}, err
+		}
+
+		return billManagerCancelInvoiceResp{resp}, nil
+	}
+}
+
+func billManagerReconcileEndpoint(svc grpc.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(billManagerReconcileReq)
+		if err := req.validate(); err != nil {
+			return billManagerReconcileResp{}, errors.Join(errValidation, err)
+		}
+
+		resp, err := svc.BillManagerReconcile(ctx, req.BillManagerReconcileReq)
+		if err != nil {
+			return billManagerReconcileResp{}, err
+		}
+
+		return billManagerReconcileResp{resp}, nil
+	}
+}
+
+func pullTransactionsRegisterEndpoint(svc grpc.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(pullTransactionsRegisterReq)
+		if err := req.validate(); err != nil {
+			return pullTransactionsRegisterResp{}, errors.Join(errValidation, err)
+		}
+
+		resp, err := svc.PullTransactionsRegister(ctx, req.PullTransactionsRegisterReq)
+		if err != nil {
+			return pullTransactionsRegisterResp{}, err
+		}
+
+		return pullTransactionsRegisterResp{resp}, nil
+	}
+}
+
+func pullTransactionsQueryEndpoint(svc grpc.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(pullTransactionsQueryReq)
+		if err := req.validate(); err != nil {
+			return pullTransactionsQueryResp{}, errors.Join(errValidation, err)
+		}
+
+		resp, err := svc.PullTransactionsQuery(ctx, req.PullTransactionsQueryReq)
+		if err != nil {
+			return pullTransactionsQueryResp{}, err
+		}
+
+		return pullTransactionsQueryResp{resp}, nil
+	}
+}
+
+func standingOrderEndpoint(svc grpc.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(standingOrderReq)
+		if err := req.validate(); err != nil {
+			return standingOrderResp{}, errors.Join(errValidation, err)
+		}
+
+		resp, err := svc.StandingOrder(ctx, req.StandingOrderReq)
+		if err != nil {
+			return standingOrderResp{}, err
+		}
+
+		return standingOrderResp{resp}, nil
+	}
+}
+
+func b2bExpressCheckoutEndpoint(svc grpc.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(b2bExpressCheckoutReq)
+		if err := req.validate(); err != nil {
+			return b2bExpressCheckoutResp{}, errors.Join(errValidation, err)
+		}
+
+		resp, err := svc.B2BExpressCheckout(ctx, req.B2BExpressCheckoutReq)
+		if err != nil {
+			return b2bExpressCheckoutResp{}, err
+		}
+
+		return b2bExpressCheckoutResp{resp}, nil
+	}
+}
+
+func b2cAccountTopUpEndpoint(svc grpc.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(b2cAccountTopUpReq)
+		if err := req.validate(); err != nil {
+			return b2cAccountTopUpResp{}, errors.Join(errValidation, err)
+		}
+
+		resp, err := svc.B2CAccountTopUp(ctx, req.B2CAccountTopUpReq)
+		if err != nil {
+			return b2cAccountTopUpResp{}, err
+		}
+
+		return b2cAccountTopUpResp{resp}, nil
+	}
+}
+
+func b2PochiEndpoint(svc grpc.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(b2PochiReq)
+		if err := req.validate(); err != nil {
+			return b2PochiResp{}, errors.Join(errValidation, err)
+		}
+
+		resp, err := svc.B2Pochi(ctx, req.B2PochiReq)
+		if err != nil {
+			return b2PochiResp{}, err
+		}
+
+		return b2PochiResp{resp}, nil
+	}
+}
+
+func simSwapEndpoint(svc grpc.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(simSwapReq)
+		if err := req.validate(); err != nil {
+			return simSwapResp{}, errors.Join(errValidation, err)
+		}
+
+		resp, err := svc.SIMSwap(ctx, req.SIMSwapReq)
+		if err != nil {
+			return simSwapResp{}, err
+		}
+
+		return simSwapResp{resp}, nil
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"testing"
 	"time"
@@ -40,6 +41,24 @@ var (
 		ResponseDescription:      "Accept the service request successfully.",
 		ResponseCode:             "0",
 	}
+	billManagerResp = mpesa.BillManagerResp{
+		ResponseMessage: "Success",
+		ResponseCode:    "200",
+		StatusMessage:   "Invoice sent successfully",
+	}
+	billManagerInvoice = &grpcadapter.BillManagerSingleInvoiceReq{
+		ExternalReference: "INV-2024-03-001",
+		BilledFullName:    "John Doe",
+		BilledPhoneNumber: 254712345678,
+		BilledPeriod:      "March 2024",
+		InvoiceName:       "Rent",
+		DueDate:           "2024-03-10 00:00:00",
+		AccountReference:  "A1",
+		Amount:            15000,
+		InvoiceItems: []*grpcadapter.BillManagerInvoiceItem{
+			{ItemName: "Water", Amount: 500},
+		},
+	}
 )
 
 func TestMain(m *testing.M) {
@@ -86,10 +105,25 @@ func TestToken(t *testing.T) {
 			sdkResponse: mpesa.TokenResp{},
 			sdkError:    errMock,
 		},
+		"get token with deadline exceeded": {
+			code:        codes.DeadlineExceeded,
+			sdkResponse: mpesa.TokenResp{},
+			sdkError:    context.DeadlineExceeded,
+		},
+		"get token with cancelled context": {
+			code:        codes.Canceled,
+			sdkResponse: mpesa.TokenResp{},
+			sdkError:    context.Canceled,
+		},
+		"get token with invalid credentials": {
+			code:        codes.Unauthenticated,
+			sdkResponse: mpesa.TokenResp{},
+			sdkError:    mpesa.NewError(http.StatusBadRequest, []byte(`{"requestId":"11728-2929992-1","errorCode":"400.008.01","errorMessage":"Invalid Authentication passed"}`)),
+		},
 	}
 
 	for desc, tc := range cases {
-		call := sdk.On("Token").Return(tc.sdkResponse, tc.sdkError)
+		call := sdk.On("Token", mock.Anything).Return(tc.sdkResponse, tc.sdkError)
 		_, err := cli.Token(ctx, &grpcadapter.Empty{})
 		e, ok := status.FromError(err)
 		assert.True(t, ok, "OK expected to be true")
@@ -138,7 +172,7 @@ func TestAccountBalance(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		call := sdk.On("AccountBalance", mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		call := sdk.On("AccountBalance", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
 		_, err := cli.AccountBalance(ctx, tc.req)
 		e, ok := status.FromError(err)
 		assert.True(t, ok, "OK expected to be true")
@@ -183,7 +217,7 @@ func TestC2BRegisterURL(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		call := sdk.On("C2BRegisterURL", mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		call := sdk.On("C2BRegisterURL", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
 		_, err := cli.C2BRegisterURL(ctx, tc.req)
 		e, ok := status.FromError(err)
 		assert.True(t, ok, "OK expected to be true")
@@ -229,7 +263,7 @@ func TestC2BSimulate(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		call := sdk.On("C2BSimulate", mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		call := sdk.On("C2BSimulate", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
 		_, err := cli.C2BSimulate(ctx, tc.req)
 		e, ok := status.FromError(err)
 		assert.True(t, ok, "OK expected to be true")
@@ -279,7 +313,7 @@ func TestGenerateQR(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		call := sdk.On("GenerateQR", mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		call := sdk.On("GenerateQR", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
 		_, err := cli.GenerateQR(ctx, tc.req)
 		e, ok := status.FromError(err)
 		assert.True(t, ok, "OK expected to be true")
@@ -329,7 +363,7 @@ func TestExpressQuery(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		call := sdk.On("ExpressQuery", mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		call := sdk.On("ExpressQuery", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
 		_, err := cli.ExpressQuery(ctx, tc.req)
 		e, ok := status.FromError(err)
 		assert.True(t, ok, "OK expected to be true")
@@ -381,7 +415,7 @@ func TestReverse(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		call := sdk.On("Reverse", mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		call := sdk.On("Reverse", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
 		_, err := cli.Reverse(ctx, tc.req)
 		e, ok := status.FromError(err)
 		assert.True(t, ok, "OK expected to be true")
@@ -436,7 +470,7 @@ func TestExpressSimulate(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		call := sdk.On("ExpressSimulate", mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		call := sdk.On("ExpressSimulate", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
 		_, err := cli.ExpressSimulate(ctx, tc.req)
 		e, ok := status.FromError(err)
 		assert.True(t, ok, "OK expected to be true")
@@ -489,7 +523,7 @@ func TestRemitTax(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		call := sdk.On("RemitTax", mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		call := sdk.On("RemitTax", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
 		_, err := cli.RemitTax(ctx, tc.req)
 		e, ok := status.FromError(err)
 		assert.True(t, ok, "OK expected to be true")
@@ -532,6 +566,23 @@ func TestTransactionStatus(t *testing.T) {
 			},
 			sdkError: nil,
 		},
+		"transaction status by originator conversation id": {
+			code: codes.OK,
+			req: &grpcadapter.TransactionStatusReq{
+				InitiatorName:            "testapi",
+				InitiatorPassword:        "Safaricom999!*!",
+				CommandID:                "TransactionStatusQuery",
+				IdentifierType:           4,
+				OriginatorConversationID: "feb5e3f2-fbbc-4745-844c-ee37b546f627",
+				PartyA:                   600986,
+				QueueTimeOutURL:          "https://example.com/timeout",
+				ResultURL:                "https://example.com/result",
+			},
+			sdkResponse: mpesa.TransactionStatusResp{
+				ValidResp: validResp,
+			},
+			sdkError: nil,
+		},
 		"transaction status failure": {
 			code:        codes.InvalidArgument,
 			sdkResponse: mpesa.TransactionStatusResp{},
@@ -540,7 +591,7 @@ func TestTransactionStatus(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		call := sdk.On("TransactionStatus", mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		call := sdk.On("TransactionStatus", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
 		_, err := cli.TransactionStatus(ctx, tc.req)
 		e, ok := status.FromError(err)
 		assert.True(t, ok, "OK expected to be true")
@@ -558,6 +609,20 @@ func TestB2CPayment(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 	defer cancel()
 
+	validReq := &grpcadapter.B2CPaymentReq{
+		OriginatorConversationID: ulid.Make().String(),
+		InitiatorName:            "testapi",
+		InitiatorPassword:        "Safaricom999!*!",
+		CommandID:                "BusinessPayment",
+		Amount:                   10,
+		PartyA:                   600986,
+		PartyB:                   254712345678,
+		QueueTimeOutURL:          "https://example.com/timeout",
+		ResultURL:                "https://example.com/result",
+		Remarks:                  "test",
+		Occasion:                 "test",
+	}
+
 	cases := map[string]struct {
 		code        codes.Code
 		req         *grpcadapter.B2CPaymentReq
@@ -566,19 +631,7 @@ func TestB2CPayment(t *testing.T) {
 	}{
 		"b2c payment success": {
 			code: codes.OK,
-			req: &grpcadapter.B2CPaymentReq{
-				OriginatorConversationID: ulid.Make().String(),
-				InitiatorName:            "testapi",
-				InitiatorPassword:        "Safaricom999!*!",
-				CommandID:                "BusinessPayment",
-				Amount:                   10,
-				PartyA:                   600986,
-				PartyB:                   254712345678,
-				QueueTimeOutURL:          "https://example.com/timeout",
-				ResultURL:                "https://example.com/result",
-				Remarks:                  "test",
-				Occasion:                 "test",
-			},
+			req:  validReq,
 			sdkResponse: mpesa.B2CPaymentResp{
 				ValidResp: validResp,
 			},
@@ -589,10 +642,52 @@ func TestB2CPayment(t *testing.T) {
 			sdkResponse: mpesa.B2CPaymentResp{},
 			sdkError:    errMock,
 		},
+		"b2c payment with duplicate originator conversation id": {
+			code:        codes.AlreadyExists,
+			req:         validReq,
+			sdkResponse: mpesa.B2CPaymentResp{},
+			sdkError:    mpesa.NewError(http.StatusBadRequest, []byte(`{"requestId":"11728-2929992-1","errorCode":"400.002.02","errorMessage":"Bad Request - Duplicate OriginatorConversationID"}`)),
+		},
+		"b2c payment with insufficient balance": {
+			code:        codes.FailedPrecondition,
+			req:         validReq,
+			sdkResponse: mpesa.B2CPaymentResp{},
+			sdkError:    mpesa.NewError(http.StatusInternalServerError, []byte(`{"requestId":"11728-2929992-1","errorCode":"500.001.1001","errorMessage":"The balance is insufficient for the transaction"}`)),
+		},
+		"b2c payment to a swapped sim": {
+			code:        codes.FailedPrecondition,
+			req:         validReq,
+			sdkResponse: mpesa.B2CPaymentResp{},
+			sdkError:    fmt.Errorf("%w: 254712345678", mpesa.ErrSIMSwapped),
+		},
+		"b2c payment with spike arrest": {
+			code:        codes.ResourceExhausted,
+			req:         validReq,
+			sdkResponse: mpesa.B2CPaymentResp{},
+			sdkError:    mpesa.NewError(http.StatusTooManyRequests, []byte(`{"requestId":"11728-2929992-1","errorCode":"500.003.02","errorMessage":"Error Occurred: Spike Arrest Violation"}`)),
+		},
+		"b2c payment with invalid access token": {
+			code:        codes.Unauthenticated,
+			req:         validReq,
+			sdkResponse: mpesa.B2CPaymentResp{},
+			sdkError:    mpesa.NewError(http.StatusUnauthorized, []byte(`{"requestId":"11728-2929992-1","errorCode":"404.001.03","errorMessage":"Invalid Access Token"}`)),
+		},
+		"b2c payment with gateway timeout": {
+			code:        codes.Unavailable,
+			req:         validReq,
+			sdkResponse: mpesa.B2CPaymentResp{},
+			sdkError:    mpesa.NewError(http.StatusGatewayTimeout, []byte(`<html><body>Gateway Timeout</body></html>`)),
+		},
+		"b2c payment rejected in strict mode": {
+			code:        codes.FailedPrecondition,
+			req:         validReq,
+			sdkResponse: mpesa.B2CPaymentResp{},
+			sdkError:    &mpesa.RejectionError{Op: mpesa.OpB2CPayment, ResponseCode: "1", ResponseDescription: "Rejected"},
+		},
 	}
 
 	for desc, tc := range cases {
-		call := sdk.On("B2CPayment", mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		call := sdk.On("B2CPayment", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
 		_, err := cli.B2CPayment(ctx, tc.req)
 		e, ok := status.FromError(err)
 		assert.True(t, ok, "OK expected to be true")
@@ -638,6 +733,44 @@ func TestBusinessPayBill(t *testing.T) {
 			},
 			sdkError: nil,
 		},
+		"b2b buy goods success": {
+			code: codes.OK,
+			req: &grpcadapter.BusinessPayBillReq{
+				Initiator:              "testapi",
+				InitiatorPassword:      "Safaricom999!*!",
+				CommandID:              "BusinessBuyGoods",
+				SenderIdentifierType:   4,
+				RecieverIdentifierType: 2,
+				Amount:                 10,
+				PartyA:                 600986,
+				PartyB:                 174379,
+				QueueTimeOutURL:        "https://example.com/timeout",
+				ResultURL:              "https://example.com/result",
+				Remarks:                "test",
+			},
+			sdkResponse: mpesa.BusinessPayBillResp{
+				ValidResp: validResp,
+			},
+			sdkError: nil,
+		},
+		"b2b buy goods with paybill receiver": {
+			code: codes.InvalidArgument,
+			req: &grpcadapter.BusinessPayBillReq{
+				Initiator:              "testapi",
+				InitiatorPassword:      "Safaricom999!*!",
+				CommandID:              "BusinessBuyGoods",
+				SenderIdentifierType:   4,
+				RecieverIdentifierType: 4,
+				Amount:                 10,
+				PartyA:                 600986,
+				PartyB:                 174379,
+				QueueTimeOutURL:        "https://example.com/timeout",
+				ResultURL:              "https://example.com/result",
+				Remarks:                "test",
+			},
+			sdkResponse: mpesa.BusinessPayBillResp{},
+			sdkError:    nil,
+		},
 		"b2b payment failure": {
 			code:        codes.InvalidArgument,
 			sdkResponse: mpesa.BusinessPayBillResp{},
@@ -646,7 +779,7 @@ func TestBusinessPayBill(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		call := sdk.On("BusinessPayBill", mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		call := sdk.On("BusinessPayBill", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
 		_, err := cli.BusinessPayBill(ctx, tc.req)
 		e, ok := status.FromError(err)
 		assert.True(t, ok, "OK expected to be true")
@@ -654,3 +787,678 @@ func TestBusinessPayBill(t *testing.T) {
 		call.Unset()
 	}
 }
+
+func TestBillManagerOptIn(t *testing.T) {
+	mpesaAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(mpesaAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cli := grpcapi.NewClient(conn, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cases := map[string]struct {
+		code        codes.Code
+		req         *grpcadapter.BillManagerOptInReq
+		sdkResponse mpesa.BillManagerOptInResp
+		sdkError    error
+	}{
+		"bill manager opt in success": {
+			code: codes.OK,
+			req: &grpcadapter.BillManagerOptInReq{
+				ShortCode:       600984,
+				Email:           "billing@example.com",
+				OfficialContact: "254712345678",
+				SendReminders:   1,
+				CallbackURL:     "https://example.com/billmanager",
+			},
+			sdkResponse: mpesa.BillManagerOptInResp{
+				AppKey:          "AG_2376487236_126732989KJ",
+				BillManagerResp: billManagerResp,
+			},
+			sdkError: nil,
+		},
+		"bill manager opt in failure": {
+			code:        codes.InvalidArgument,
+			sdkResponse: mpesa.BillManagerOptInResp{},
+			sdkError:    errMock,
+		},
+	}
+
+	for desc, tc := range cases {
+		call := sdk.On("BillManagerOptIn", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		resp, err := cli.BillManagerOptIn(ctx, tc.req)
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.code, e.Code()))
+		if tc.code == codes.OK {
+			assert.Equal(t, tc.sdkResponse.ResponseCode, resp.GetBillManagerResp().GetResponseCode(), fmt.Sprintf("%s: expected response code %s got %s\n", desc, tc.sdkResponse.ResponseCode, resp.GetBillManagerResp().GetResponseCode()))
+		}
+		call.Unset()
+	}
+}
+
+func TestBillManagerUpdateOptIn(t *testing.T) {
+	mpesaAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(mpesaAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cli := grpcapi.NewClient(conn, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cases := map[string]struct {
+		code        codes.Code
+		req         *grpcadapter.BillManagerUpdateOptInReq
+		sdkResponse mpesa.BillManagerUpdateOptInResp
+		sdkError    error
+	}{
+		"bill manager update opt in success": {
+			code: codes.OK,
+			req: &grpcadapter.BillManagerUpdateOptInReq{
+				ShortCode:       600984,
+				Email:           "billing@example.com",
+				OfficialContact: "254712345678",
+				SendReminders:   0,
+				CallbackURL:     "https://example.com/billmanager",
+			},
+			sdkResponse: mpesa.BillManagerUpdateOptInResp{
+				BillManagerResp: billManagerResp,
+			},
+			sdkError: nil,
+		},
+		"bill manager update opt in failure": {
+			code:        codes.InvalidArgument,
+			sdkResponse: mpesa.BillManagerUpdateOptInResp{},
+			sdkError:    errMock,
+		},
+	}
+
+	for desc, tc := range cases {
+		call := sdk.On("BillManagerUpdateOptIn", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		resp, err := cli.BillManagerUpdateOptIn(ctx, tc.req)
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.code, e.Code()))
+		if tc.code == codes.OK {
+			assert.Equal(t, tc.sdkResponse.ResponseCode, resp.GetBillManagerResp().GetResponseCode(), fmt.Sprintf("%s: expected response code %s got %s\n", desc, tc.sdkResponse.ResponseCode, resp.GetBillManagerResp().GetResponseCode()))
+		}
+		call.Unset()
+	}
+}
+
+func TestBillManagerSingleInvoice(t *testing.T) {
+	mpesaAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(mpesaAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cli := grpcapi.NewClient(conn, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cases := map[string]struct {
+		code        codes.Code
+		req         *grpcadapter.BillManagerSingleInvoiceReq
+		sdkResponse mpesa.BillManagerSingleInvoiceResp
+		sdkError    error
+	}{
+		"bill manager single invoice success": {
+			code: codes.OK,
+			req:  billManagerInvoice,
+			sdkResponse: mpesa.BillManagerSingleInvoiceResp{
+				BillManagerResp: billManagerResp,
+			},
+			sdkError: nil,
+		},
+		"bill manager single invoice failure": {
+			code:        codes.InvalidArgument,
+			sdkResponse: mpesa.BillManagerSingleInvoiceResp{},
+			sdkError:    errMock,
+		},
+	}
+
+	for desc, tc := range cases {
+		call := sdk.On("BillManagerSingleInvoice", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		resp, err := cli.BillManagerSingleInvoice(ctx, tc.req)
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.code, e.Code()))
+		if tc.code == codes.OK {
+			assert.Equal(t, tc.sdkResponse.ResponseCode, resp.GetBillManagerResp().GetResponseCode(), fmt.Sprintf("%s: expected response code %s got %s\n", desc, tc.sdkResponse.ResponseCode, resp.GetBillManagerResp().GetResponseCode()))
+		}
+		call.Unset()
+	}
+}
+
+func TestBillManagerBulkInvoice(t *testing.T) {
+	mpesaAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(mpesaAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cli := grpcapi.NewClient(conn, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cases := map[string]struct {
+		code        codes.Code
+		req         *grpcadapter.BillManagerBulkInvoiceReq
+		sdkResponse mpesa.BillManagerBulkInvoiceResp
+		sdkError    error
+	}{
+		"bill manager bulk invoice success": {
+			code: codes.OK,
+			req: &grpcadapter.BillManagerBulkInvoiceReq{
+				Invoices: []*grpcadapter.BillManagerSingleInvoiceReq{billManagerInvoice},
+			},
+			sdkResponse: mpesa.BillManagerBulkInvoiceResp{
+				BillManagerResp: billManagerResp,
+			},
+			sdkError: nil,
+		},
+		"bill manager bulk invoice failure": {
+			code:        codes.InvalidArgument,
+			sdkResponse: mpesa.BillManagerBulkInvoiceResp{},
+			sdkError:    errMock,
+		},
+	}
+
+	for desc, tc := range cases {
+		call := sdk.On("BillManagerBulkInvoice", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		resp, err := cli.BillManagerBulkInvoice(ctx, tc.req)
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.code, e.Code()))
+		if tc.code == codes.OK {
+			assert.Equal(t, tc.sdkResponse.ResponseCode, resp.GetBillManagerResp().GetResponseCode(), fmt.Sprintf("%s: expected response code %s got %s\n", desc, tc.sdkResponse.ResponseCode, resp.GetBillManagerResp().GetResponseCode()))
+		}
+		call.Unset()
+	}
+}
+
+func TestBillManagerCancelInvoice(t *testing.T) {
+	mpesaAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(mpesaAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cli := grpcapi.NewClient(conn, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cases := map[string]struct {
+		code        codes.Code
+		req         *grpcadapter.BillManagerCancelInvoiceReq
+		sdkResponse mpesa.BillManagerCancelInvoiceResp
+		sdkError    error
+	}{
+		"bill manager cancel invoice success": {
+			code: codes.OK,
+			req: &grpcadapter.BillManagerCancelInvoiceReq{
+				ExternalReferences: []string{"INV-2024-03-001"},
+			},
+			sdkResponse: mpesa.BillManagerCancelInvoiceResp{
+				BillManagerResp: billManagerResp,
+			},
+			sdkError: nil,
+		},
+		"bill manager cancel invoice failure": {
+			code:        codes.InvalidArgument,
+			sdkResponse: mpesa.BillManagerCancelInvoiceResp{},
+			sdkError:    errMock,
+		},
+	}
+
+	for desc, tc := range cases {
+		call := sdk.On("BillManagerCancelInvoice", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		resp, err := cli.BillManagerCancelInvoice(ctx, tc.req)
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.code, e.Code()))
+		if tc.code == codes.OK {
+			assert.Equal(t, tc.sdkResponse.ResponseCode, resp.GetBillManagerResp().GetResponseCode(), fmt.Sprintf("%s: expected response code %s got %s\n", desc, tc.sdkResponse.ResponseCode, resp.GetBillManagerResp().GetResponseCode()))
+		}
+		call.Unset()
+	}
+}
+
+func TestBillManagerReconcile(t *testing.T) {
+	mpesaAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(mpesaAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cli := grpcapi.NewClient(conn, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cases := map[string]struct {
+		code        codes.Code
+		req         *grpcadapter.BillManagerReconcileReq
+		sdkResponse mpesa.BillManagerReconcileResp
+		sdkError    error
+	}{
+		"bill manager reconcile success": {
+			code: codes.OK,
+			req: &grpcadapter.BillManagerReconcileReq{
+				PaymentDate:       "2024-03-05",
+				PaidAmount:        15000,
+				AccountReference:  "A1",
+				TransactionID:     "SC54HJ8K9L",
+				PhoneNumber:       254712345678,
+				FullName:          "John Doe",
+				InvoiceName:       "Rent",
+				ExternalReference: "INV-2024-03-001",
+			},
+			sdkResponse: mpesa.BillManagerReconcileResp{
+				BillManagerResp: billManagerResp,
+			},
+			sdkError: nil,
+		},
+		"bill manager reconcile failure": {
+			code:        codes.InvalidArgument,
+			sdkResponse: mpesa.BillManagerReconcileResp{},
+			sdkError:    errMock,
+		},
+	}
+
+	for desc, tc := range cases {
+		call := sdk.On("BillManagerReconcile", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		resp, err := cli.BillManagerReconcile(ctx, tc.req)
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.code, e.Code()))
+		if tc.code == codes.OK {
+			assert.Equal(t, tc.sdkResponse.ResponseCode, resp.GetBillManagerResp().GetResponseCode(), fmt.Sprintf("%s: expected response code %s got %s\n", desc, tc.sdkResponse.ResponseCode, resp.GetBillManagerResp().GetResponseCode()))
+		}
+		call.Unset()
+	}
+}
+
+func TestPullTransactionsRegister(t *testing.T) {
+	mpesaAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(mpesaAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cli := grpcapi.NewClient(conn, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cases := map[string]struct {
+		code        codes.Code
+		req         *grpcadapter.PullTransactionsRegisterReq
+		sdkResponse mpesa.PullTransactionsRegisterResp
+		sdkError    error
+	}{
+		"pull transactions register success": {
+			code: codes.OK,
+			req: &grpcadapter.PullTransactionsRegisterReq{
+				ShortCode:       600984,
+				NominatedNumber: "254712345678",
+				CallBackURL:     "https://example.com/pull",
+			},
+			sdkResponse: mpesa.PullTransactionsRegisterResp{
+				ResponseRefID:   "1a2b3c4d",
+				ResponseCode:    "1000",
+				ResponseMessage: "Success",
+				ShortCode:       "600984",
+			},
+			sdkError: nil,
+		},
+		"pull transactions register failure": {
+			code:        codes.InvalidArgument,
+			sdkResponse: mpesa.PullTransactionsRegisterResp{},
+			sdkError:    errMock,
+		},
+	}
+
+	for desc, tc := range cases {
+		call := sdk.On("PullTransactionsRegister", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		resp, err := cli.PullTransactionsRegister(ctx, tc.req)
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.code, e.Code()))
+		if tc.code == codes.OK {
+			assert.Equal(t, tc.sdkResponse.ResponseCode, resp.GetResponseCode(), fmt.Sprintf("%s: expected response code %s got %s\n", desc, tc.sdkResponse.ResponseCode, resp.GetResponseCode()))
+		}
+		call.Unset()
+	}
+}
+
+func TestPullTransactionsQuery(t *testing.T) {
+	mpesaAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(mpesaAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cli := grpcapi.NewClient(conn, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	trxDate := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.FixedZone("EAT", 3*60*60))
+
+	cases := map[string]struct {
+		code        codes.Code
+		req         *grpcadapter.PullTransactionsQueryReq
+		sdkResponse mpesa.PullTransactionsQueryResp
+		sdkError    error
+	}{
+		"pull transactions query success": {
+			code: codes.OK,
+			req: &grpcadapter.PullTransactionsQueryReq{
+				ShortCode: 600984,
+				StartDate: "2024-03-01 00:00:00",
+				EndDate:   "2024-03-02 00:00:00",
+			},
+			sdkResponse: mpesa.PullTransactionsQueryResp{
+				ResponseRefID:   "1a2b3c4d",
+				ResponseCode:    "1000",
+				ResponseMessage: "Success",
+				Transactions: []mpesa.PullTransaction{
+					{TransactionID: "RJB53MYR1N", TransactionDate: trxDate, MSISDN: "254712345678", Amount: 100},
+				},
+			},
+			sdkError: nil,
+		},
+		"pull transactions query with invalid dates": {
+			code: codes.InvalidArgument,
+			req: &grpcadapter.PullTransactionsQueryReq{
+				ShortCode: 600984,
+				StartDate: "2024-03-02 00:00:00",
+				EndDate:   "2024-03-01 00:00:00",
+			},
+			sdkResponse: mpesa.PullTransactionsQueryResp{},
+			sdkError:    nil,
+		},
+		"pull transactions query failure": {
+			code:        codes.InvalidArgument,
+			sdkResponse: mpesa.PullTransactionsQueryResp{},
+			sdkError:    errMock,
+		},
+	}
+
+	for desc, tc := range cases {
+		call := sdk.On("PullTransactionsQuery", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		resp, err := cli.PullTransactionsQuery(ctx, tc.req)
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.code, e.Code()))
+		if tc.code == codes.OK {
+			assert.Equal(t, tc.sdkResponse.ResponseCode, resp.GetResponseCode(), fmt.Sprintf("%s: expected response code %s got %s\n", desc, tc.sdkResponse.ResponseCode, resp.GetResponseCode()))
+			assert.Len(t, resp.GetTransactions(), len(tc.sdkResponse.Transactions))
+			assert.Equal(t, trxDate.Format(time.RFC3339), resp.GetTransactions()[0].GetTransactionDate())
+		}
+		call.Unset()
+	}
+}
+
+func TestStandingOrder(t *testing.T) {
+	mpesaAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(mpesaAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cli := grpcapi.NewClient(conn, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cases := map[string]struct {
+		code        codes.Code
+		req         *grpcadapter.StandingOrderReq
+		sdkResponse mpesa.StandingOrderResp
+		sdkError    error
+	}{
+		"standing order success": {
+			code: codes.OK,
+			req: &grpcadapter.StandingOrderReq{
+				StandingOrderName:           "Gym Membership",
+				StartDate:                   "20240401",
+				EndDate:                     "20250401",
+				BusinessShortCode:           174379,
+				TransactionType:             "Standing Order Customer Pay Bill",
+				ReceiverPartyIdentifierType: 4,
+				Amount:                      1500,
+				PartyA:                      254708374149,
+				CallBackURL:                 "https://example.com/ratiba",
+				AccountReference:            "GYM001",
+				TransactionDesc:             "Membership",
+				Frequency:                   4,
+			},
+			sdkResponse: mpesa.StandingOrderResp{
+				ResponseRefID:       "4dd9b5d9-d738-42ba-9326-2cc99e966000",
+				ResponseCode:        "200",
+				ResponseDescription: "Request accepted for processing",
+			},
+			sdkError: nil,
+		},
+		"standing order failure": {
+			code:        codes.InvalidArgument,
+			sdkResponse: mpesa.StandingOrderResp{},
+			sdkError:    errMock,
+		},
+	}
+
+	for desc, tc := range cases {
+		call := sdk.On("StandingOrder", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		resp, err := cli.StandingOrder(ctx, tc.req)
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.code, e.Code()))
+		if tc.code == codes.OK {
+			assert.Equal(t, tc.sdkResponse.ResponseCode, resp.GetResponseCode(), fmt.Sprintf("%s: expected ResponseCode %v got %v\n", desc, tc.sdkResponse.ResponseCode, resp.GetResponseCode()))
+		}
+		call.Unset()
+	}
+}
+
+func TestB2BExpressCheckout(t *testing.T) {
+	mpesaAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(mpesaAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cli := grpcapi.NewClient(conn, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cases := map[string]struct {
+		code        codes.Code
+		req         *grpcadapter.B2BExpressCheckoutReq
+		sdkResponse mpesa.B2BExpressCheckoutResp
+		sdkError    error
+	}{
+		"b2b express checkout success": {
+			code: codes.OK,
+			req: &grpcadapter.B2BExpressCheckoutReq{
+				PrimaryShortCode:  600992,
+				ReceiverShortCode: 600984,
+				Amount:            100,
+				PaymentRef:        "INV001",
+				CallbackURL:       "https://example.com/b2bexpress",
+				PartnerName:       "Vendor",
+				RequestRefID:      "01HRZ8Y4M2B2X0E6Q5N8J3K7TA",
+			},
+			sdkResponse: mpesa.B2BExpressCheckoutResp{
+				Code:         "0",
+				Status:       "USSD Initiated Successfully",
+				RequestRefID: "01HRZ8Y4M2B2X0E6Q5N8J3K7TA",
+			},
+			sdkError: nil,
+		},
+		"b2b express checkout failure": {
+			code:        codes.InvalidArgument,
+			sdkResponse: mpesa.B2BExpressCheckoutResp{},
+			sdkError:    errMock,
+		},
+	}
+
+	for desc, tc := range cases {
+		call := sdk.On("B2BExpressCheckout", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		resp, err := cli.B2BExpressCheckout(ctx, tc.req)
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.code, e.Code()))
+		if tc.code == codes.OK {
+			assert.Equal(t, tc.sdkResponse.Code, resp.GetCode(), fmt.Sprintf("%s: expected Code %v got %v\n", desc, tc.sdkResponse.Code, resp.GetCode()))
+			assert.Equal(t, tc.sdkResponse.RequestRefID, resp.GetRequestRefID(), fmt.Sprintf("%s: expected RequestRefID %v got %v\n", desc, tc.sdkResponse.RequestRefID, resp.GetRequestRefID()))
+		}
+		call.Unset()
+	}
+}
+
+func TestB2CAccountTopUp(t *testing.T) {
+	mpesaAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(mpesaAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cli := grpcapi.NewClient(conn, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cases := map[string]struct {
+		code        codes.Code
+		req         *grpcadapter.B2CAccountTopUpReq
+		sdkResponse mpesa.B2CAccountTopUpResp
+		sdkError    error
+	}{
+		"b2c account top up success": {
+			code: codes.OK,
+			req: &grpcadapter.B2CAccountTopUpReq{
+				Initiator:              "testapi",
+				InitiatorPassword:      "Safaricom999!*!",
+				SecurityCredential:     "credential",
+				CommandID:              "BusinessPayToBulk",
+				SenderIdentifierType:   4,
+				RecieverIdentifierType: 4,
+				Amount:                 239,
+				PartyA:                 600979,
+				PartyB:                 600000,
+				AccountReference:       "353353",
+				QueueTimeOutURL:        "https://example.com/timeout",
+				ResultURL:              "https://example.com/result",
+				Remarks:                "OK",
+				Requester:              254708374149,
+			},
+			sdkResponse: mpesa.B2CAccountTopUpResp{
+				ValidResp: validResp,
+			},
+			sdkError: nil,
+		},
+		"b2c account top up failure": {
+			code:        codes.InvalidArgument,
+			sdkResponse: mpesa.B2CAccountTopUpResp{},
+			sdkError:    errMock,
+		},
+	}
+
+	for desc, tc := range cases {
+		call := sdk.On("B2CAccountTopUp", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		resp, err := cli.B2CAccountTopUp(ctx, tc.req)
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.code, e.Code()))
+		if tc.code == codes.OK {
+			assert.Equal(t, tc.sdkResponse.ResponseCode, resp.GetValidResp().GetResponseCode(), fmt.Sprintf("%s: expected ResponseCode %v got %v\n", desc, tc.sdkResponse.ResponseCode, resp.GetValidResp().GetResponseCode()))
+		}
+		call.Unset()
+	}
+}
+
+func TestB2Pochi(t *testing.T) {
+	mpesaAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(mpesaAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cli := grpcapi.NewClient(conn, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cases := map[string]struct {
+		code        codes.Code
+		req         *grpcadapter.B2PochiReq
+		sdkResponse mpesa.B2PochiResp
+		sdkError    error
+	}{
+		"b2pochi success": {
+			code: codes.OK,
+			req: &grpcadapter.B2PochiReq{
+				OriginatorConversationID: "01HS0G4D3N2M6P1R9V5Q8K7T2W",
+				InitiatorName:            "testapi",
+				InitiatorPassword:        "Safaricom999!*!",
+				SecurityCredential:       "credential",
+				CommandID:                "BusinessPayToPochi",
+				Amount:                   10,
+				PartyA:                   600996,
+				PartyB:                   254728762287,
+				Remarks:                  "here are my remarks",
+				QueueTimeOutURL:          "https://example.com/timeout",
+				ResultURL:                "https://example.com/result",
+				Occasion:                 "Christmas",
+			},
+			sdkResponse: mpesa.B2PochiResp{
+				ValidResp: validResp,
+			},
+			sdkError: nil,
+		},
+		"b2pochi failure": {
+			code:        codes.InvalidArgument,
+			sdkResponse: mpesa.B2PochiResp{},
+			sdkError:    errMock,
+		},
+	}
+
+	for desc, tc := range cases {
+		call := sdk.On("B2Pochi", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		resp, err := cli.B2Pochi(ctx, tc.req)
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.code, e.Code()))
+		if tc.code == codes.OK {
+			assert.Equal(t, tc.sdkResponse.ResponseCode, resp.GetValidResp().GetResponseCode(), fmt.Sprintf("%s: expected ResponseCode %v got %v\n", desc, tc.sdkResponse.ResponseCode, resp.GetValidResp().GetResponseCode()))
+		}
+		call.Unset()
+	}
+}
+
+func TestSIMSwap(t *testing.T) {
+	mpesaAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(mpesaAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cli := grpcapi.NewClient(conn, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cases := map[string]struct {
+		code        codes.Code
+		req         *grpcadapter.SIMSwapReq
+		sdkResponse mpesa.SIMSwapResp
+		sdkError    error
+	}{
+		"sim swap success": {
+			code: codes.OK,
+			req: &grpcadapter.SIMSwapReq{
+				CustomerNumber: 254722000000,
+			},
+			sdkResponse: mpesa.SIMSwapResp{
+				ResponseCode:        "200",
+				ResponseDescription: "Success",
+				CustomerNumber:      "254722000000",
+				LastSwapDate:        time.Date(2024, time.January, 15, 7, 30, 0, 0, time.UTC),
+			},
+			sdkError: nil,
+		},
+		"sim swap failure": {
+			code:        codes.InvalidArgument,
+			sdkResponse: mpesa.SIMSwapResp{},
+			sdkError:    errMock,
+		},
+	}
+
+	for desc, tc := range cases {
+		call := sdk.On("SIMSwap", mock.Anything, mock.Anything).Return(tc.sdkResponse, tc.sdkError)
+		resp, err := cli.SIMSwap(ctx, tc.req)
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.code, e.Code()))
+		if tc.code == codes.OK {
+			assert.Equal(t, tc.sdkResponse.ResponseCode, resp.GetResponseCode(), fmt.Sprintf("%s: expected ResponseCode %v got %v\n", desc, tc.sdkResponse.ResponseCode, resp.GetResponseCode()))
+			assert.Equal(t, "2024-01-15T07:30:00Z", resp.GetLastSwapDate())
+		}
+		call.Unset()
+	}
+}
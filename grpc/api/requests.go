@@ -101,3 +101,112 @@ type businessPayBillReq struct {
 func (req businessPayBillReq) validate() error {
 	return req.BusinessPayBillReq.Validate()
 }
+
+type billManagerOptInReq struct {
+	mpesa.BillManagerOptInReq
+}
+
+func (req billManagerOptInReq) validate() error {
+	return req.BillManagerOptInReq.Validate()
+}
+
+type billManagerUpdateOptInReq struct {
+	mpesa.BillManagerUpdateOptInReq
+}
+
+func (req billManagerUpdateOptInReq) validate() error {
+	return req.BillManagerUpdateOptInReq.Validate()
+}
+
+type billManagerSingleInvoiceReq struct {
+	mpesa.BillManagerSingleInvoiceReq
+}
+
+func (req billManagerSingleInvoiceReq) validate() error {
+	return req.BillManagerSingleInvoiceReq.Validate()
+}
+
+type billManagerBulkInvoiceReq struct {
+	mpesa.BillManagerBulkInvoiceReq
+}
+
+func (req billManagerBulkInvoiceReq) validate() error {
+	return req.BillManagerBulkInvoiceReq.Validate()
+}
+
+type billManagerCancelInvoiceReq struct {
+	mpesa.BillManagerCancelInvoiceReq
+}
+
+func (req billManagerCancelInvoiceReq) validate() error {
+	return req.BillManagerCancelInvoiceReq.Validate()
+}
+
+type billManagerReconcileReq struct {
+	mpesa.BillManagerReconcileReq
+}
+
+func (req billManagerReconcileReq) validate() error {
+	return req.BillManagerReconcileReq.Validate()
+}
+
+type pullTransactionsRegisterReq struct {
+	mpesa.PullTransactionsRegisterReq
+}
+
+func (req pullTransactionsRegisterReq) validate() error {
+	// The SDK defaults an empty request type to "Pull".
+	if req.RequestType == "" {
+		req.RequestType = "Pull"
+	}
+
+	return req.PullTransactionsRegisterReq.Validate()
+}
+
+type pullTransactionsQueryReq struct {
+	mpesa.PullTransactionsQueryReq
+}
+
+func (req pullTransactionsQueryReq) validate() error {
+	return req.PullTransactionsQueryReq.Validate()
+}
+
+type standingOrderReq struct {
+	mpesa.StandingOrderReq
+}
+
+func (req standingOrderReq) validate() error {
+	return req.StandingOrderReq.Validate()
+}
+
+type b2bExpressCheckoutReq struct {
+	mpesa.B2BExpressCheckoutReq
+}
+
+func (req b2bExpressCheckoutReq) validate() error {
+	return req.B2BExpressCheckoutReq.Validate()
+}
+
+type b2cAccountTopUpReq struct {
+	mpesa.B2CAccountTopUpReq
+}
+
+func (req b2cAccountTopUpReq) validate() error {
+	return req.B2CAccountTopUpReq.Validate()
+}
+
+type b2PochiReq struct {
+	mpesa.B2PochiReq
+}
+
+func (req b2PochiReq) validate() error {
+	return req.B2PochiReq.Validate()
+}
+
+type simSwapReq struct {
+	mpesa.SIMSwapReq
+}
+
+func (req simSwapReq) validate() error {
+	return req.SIMSwapReq.Validate()
+}
@@ -55,3 +55,55 @@ type remitTaxResp struct {
 type businessPayBillResp struct {
 	mpesa.BusinessPayBillResp
 }
+
+type billManagerOptInResp struct {
+	mpesa.BillManagerOptInResp
+}
+
+type billManagerUpdateOptInResp struct {
+	mpesa.BillManagerUpdateOptInResp
+}
+
+type billManagerSingleInvoiceResp struct {
+	mpesa.BillManagerSingleInvoiceResp
+}
+
+type billManagerBulkInvoiceResp struct {
+	mpesa.BillManagerBulkInvoiceResp
+}
+
+type billManagerCancelInvoiceResp struct {
+	mpesa.BillManagerCancelInvoiceResp
+}
+
+type billManagerReconcileResp struct {
+	mpesa.BillManagerReconcileResp
+}
+
+type pullTransactionsRegisterResp struct {
+	mpesa.PullTransactionsRegisterResp
+}
+
+type pullTransactionsQueryResp struct {
+	mpesa.PullTransactionsQueryResp
+}
+
+type standingOrderResp struct {
+	mpesa.StandingOrderResp
+}
+
+type b2bExpressCheckoutResp struct {
+	mpesa.B2BExpressCheckoutResp
+}
+
+type b2cAccountTopUpResp struct {
+	mpesa.B2CAccountTopUpResp
+}
+
+type b2PochiResp struct {
+	mpesa.B2PochiResp
+}
+
+type simSwapResp struct {
+	mpesa.SIMSwapResp
+}
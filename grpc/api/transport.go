@@ -9,6 +9,8 @@ package api
 import (
 	"context"
 	"errors"
+	"net/http"
+	"time"
 
 	"github.com/0x6flab/mpesaoverlay/grpc"
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
@@ -21,18 +23,31 @@ var _ grpc.ServiceServer = (*grpcServer)(nil)
 
 // grpcServer implements the gRPC ServiceServer interface.
 type grpcServer struct {
-	token             kitgrpc.Handler
-	expressQuery      kitgrpc.Handler
-	expressSimulate   kitgrpc.Handler
-	b2c               kitgrpc.Handler
-	accountBalance    kitgrpc.Handler
-	c2bRegisterURL    kitgrpc.Handler
-	c2bSimulate       kitgrpc.Handler
-	generateQR        kitgrpc.Handler
-	reverse           kitgrpc.Handler
-	transactionStatus kitgrpc.Handler
-	remitTax          kitgrpc.Handler
-	businessPayBill   kitgrpc.Handler
+	token                    kitgrpc.Handler
+	expressQuery             kitgrpc.Handler
+	expressSimulate          kitgrpc.Handler
+	b2c                      kitgrpc.Handler
+	accountBalance           kitgrpc.Handler
+	c2bRegisterURL           kitgrpc.Handler
+	c2bSimulate              kitgrpc.Handler
+	generateQR               kitgrpc.Handler
+	reverse                  kitgrpc.Handler
+	transactionStatus        kitgrpc.Handler
+	remitTax                 kitgrpc.Handler
+	businessPayBill          kitgrpc.Handler
+	billManagerOptIn         kitgrpc.Handler
+	billManagerUpdateOptIn   kitgrpc.Handler
+	billManagerSingleInvoice kitgrpc.Handler
+	billManagerBulkInvoice   kitgrpc.Handler
+	billManagerCancelInvoice kitgrpc.Handler
+	billManagerReconcile     kitgrpc.Handler
+	pullTransactionsRegister kitgrpc.Handler
+	pullTransactionsQuery    kitgrpc.Handler
+	standingOrder            kitgrpc.Handler
+	b2bExpressCheckout       kitgrpc.Handler
+	b2cAccountTopUp          kitgrpc.Handler
+	b2Pochi                  kitgrpc.Handler
+	simSwap                  kitgrpc.Handler
 	grpc.UnimplementedServiceServer
 }
 
@@ -100,6 +115,71 @@ func NewServer(svc grpc.Service) grpc.ServiceServer {
 			decodeBusinessPayBillRequest,
 			encodeBusinessPayBillResponse,
 		),
+		billManagerOptIn: kitgrpc.NewServer(
+			billManagerOptInEndpoint(svc),
+			decodeBillManagerOptInRequest,
+			encodeBillManagerOptInResponse,
+		),
+		billManagerUpdateOptIn: kitgrpc.NewServer(
+			billManagerUpdateOptInEndpoint(svc),
+			decodeBillManagerUpdateOptInRequest,
+			encodeBillManagerUpdateOptInResponse,
+		),
+		billManagerSingleInvoice: kitgrpc.NewServer(
+			billManagerSingleInvoiceEndpoint(svc),
+			decodeBillManagerSingleInvoiceRequest,
+			encodeBillManagerSingleInvoiceResponse,
+		),
+		billManagerBulkInvoice: kitgrpc.NewServer(
+			billManagerBulkInvoiceEndpoint(svc),
+			decodeBillManagerBulkInvoiceRequest,
+			encodeBillManagerBulkInvoiceResponse,
+		),
+		billManagerCancelInvoice: kitgrpc.NewServer(
+			billManagerCancelInvoiceEndpoint(svc),
+			decodeBillManagerCancelInvoiceRequest,
+			encodeBillManagerCancelInvoiceResponse,
+		),
+		billManagerReconcile: kitgrpc.NewServer(
+			billManagerReconcileEndpoint(svc),
+			decodeBillManagerReconcileRequest,
+			encodeBillManagerReconcileResponse,
+		),
+		pullTransactionsRegister: kitgrpc.NewServer(
+			pullTransactionsRegisterEndpoint(svc),
+			decodePullTransactionsRegisterRequest,
+			encodePullTransactionsRegisterResponse,
+		),
+		pullTransactionsQuery: kitgrpc.NewServer(
+			pullTransactionsQueryEndpoint(svc),
+			decodePullTransactionsQueryRequest,
+			encodePullTransactionsQueryResponse,
+		),
+		standingOrder: kitgrpc.NewServer(
+			standingOrderEndpoint(svc),
+			decodeStandingOrderRequest,
+			encodeStandingOrderResponse,
+		),
+		b2bExpressCheckout: kitgrpc.NewServer(
+			b2bExpressCheckoutEndpoint(svc),
+			decodeB2BExpressCheckoutRequest,
+			encodeB2BExpressCheckoutResponse,
+		),
+		b2cAccountTopUp: kitgrpc.NewServer(
+			b2cAccountTopUpEndpoint(svc),
+			decodeB2CAccountTopUpRequest,
+			encodeB2CAccountTopUpResponse,
+		),
+		b2Pochi: kitgrpc.NewServer(
+			b2PochiEndpoint(svc),
+			decodeB2PochiRequest,
+			encodeB2PochiResponse,
+		),
+		simSwap: kitgrpc.NewServer(
+			simSwapEndpoint(svc),
+			decodeSIMSwapRequest,
+			encodeSIMSwapResponse,
+		),
 	}
 }
 
@@ -154,7 +234,7 @@ func encodeExpressQueryResponse(_ context.Context, grpcRes interface{}) (interfa
 		ResponseCode:        res.ResponseCode,
 		ResponseDescription: res.ResponseDescription,
 		CustomerMessage:     res.CustomerMessage,
-		ResultCode:          res.ResultCode,
+		ResultCode:          res.ResultCode.String(),
 		ResultDesc:          res.ResultDesc,
 	}, nil
 }
@@ -433,17 +513,18 @@ func decodeTransactionStatusRequest(_ context.Context, grpcReq interface{}) (int
 	req := grpcReq.(*grpc.TransactionStatusReq)
 
 	return transactionReq{TransactionStatusReq: mpesa.TransactionStatusReq{
-		CommandID:          req.GetCommandID(),
-		PartyA:             req.GetPartyA(),
-		IdentifierType:     uint8(req.GetIdentifierType()),
-		Remarks:            req.GetRemarks(),
-		InitiatorName:      req.GetInitiatorName(),
-		InitiatorPassword:  req.GetInitiatorPassword(),
-		SecurityCredential: req.GetSecurityCredential(),
-		QueueTimeOutURL:    req.GetQueueTimeOutURL(),
-		ResultURL:          req.GetResultURL(),
-		TransactionID:      req.GetTransactionID(),
-		Occasion:           req.GetOccasion(),
+		CommandID:                req.GetCommandID(),
+		PartyA:                   req.GetPartyA(),
+		IdentifierType:           uint8(req.GetIdentifierType()),
+		Remarks:                  req.GetRemarks(),
+		InitiatorName:            req.GetInitiatorName(),
+		InitiatorPassword:        req.GetInitiatorPassword(),
+		SecurityCredential:       req.GetSecurityCredential(),
+		QueueTimeOutURL:          req.GetQueueTimeOutURL(),
+		ResultURL:                req.GetResultURL(),
+		TransactionID:            req.GetTransactionID(),
+		Occasion:                 req.GetOccasion(),
+		OriginatorConversationID: req.GetOriginatorConversationID(),
 	}}, nil
 }
 
@@ -544,13 +625,633 @@ func encodeBusinessPayBillResponse(_ context.Context, grpcRes interface{}) (inte
 	}, nil
 }
 
+func (s *grpcServer) BillManagerOptIn(ctx context.Context, req *grpc.BillManagerOptInReq) (*grpc.BillManagerOptInResp, error) {
+	_, res, err := s.billManagerOptIn.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*grpc.BillManagerOptInResp), nil
+}
+
+func decodeBillManagerOptInRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpc.BillManagerOptInReq)
+
+	return billManagerOptInReq{BillManagerOptInReq: mpesa.BillManagerOptInReq{
+		ShortCode:       req.GetShortCode(),
+		Email:           req.GetEmail(),
+		OfficialContact: req.GetOfficialContact(),
+		SendReminders:   uint8(req.GetSendReminders()),
+		Logo:            req.GetLogo(),
+		CallbackURL:     req.GetCallbackURL(),
+	}}, nil
+}
+
+func encodeBillManagerOptInResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(billManagerOptInResp)
+
+	return &grpc.BillManagerOptInResp{
+		AppKey:          res.AppKey,
+		BillManagerResp: billManagerRespToGRPC(res.BillManagerResp),
+	}, nil
+}
+
+func (s *grpcServer) BillManagerUpdateOptIn(ctx context.Context, req *grpc.BillManagerUpdateOptInReq) (*grpc.BillManagerUpdateOptInResp, error) {
+	_, res, err := s.billManagerUpdateOptIn.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*grpc.BillManagerUpdateOptInResp), nil
+}
+
+func decodeBillManagerUpdateOptInRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpc.BillManagerUpdateOptInReq)
+
+	return billManagerUpdateOptInReq{BillManagerUpdateOptInReq: mpesa.BillManagerUpdateOptInReq{
+		ShortCode:       req.GetShortCode(),
+		Email:           req.GetEmail(),
+		OfficialContact: req.GetOfficialContact(),
+		SendReminders:   uint8(req.GetSendReminders()),
+		Logo:            req.GetLogo(),
+		CallbackURL:     req.GetCallbackURL(),
+	}}, nil
+}
+
+func encodeBillManagerUpdateOptInResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(billManagerUpdateOptInResp)
+
+	return &grpc.BillManagerUpdateOptInResp{
+		BillManagerResp: billManagerRespToGRPC(res.BillManagerResp),
+	}, nil
+}
+
+func (s *grpcServer) BillManagerSingleInvoice(ctx context.Context, req *grpc.BillManagerSingleInvoiceReq) (*grpc.BillManagerSingleInvoiceResp, error) {
+	_, res, err := s.billManagerSingleInvoice.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*grpc.BillManagerSingleInvoiceResp), nil
+}
+
+func decodeBillManagerSingleInvoiceRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpc.BillManagerSingleInvoiceReq)
+
+	return billManagerSingleInvoiceReq{BillManagerSingleInvoiceReq: billManagerInvoiceFromGRPC(req)}, nil
+}
+
+func encodeBillManagerSingleInvoiceResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(billManagerSingleInvoiceResp)
+
+	return &grpc.BillManagerSingleInvoiceResp{
+		BillManagerResp: billManagerRespToGRPC(res.BillManagerResp),
+	}, nil
+}
+
+func (s *grpcServer) BillManagerBulkInvoice(ctx context.Context, req *grpc.BillManagerBulkInvoiceReq) (*grpc.BillManagerBulkInvoiceResp, error) {
+	_, res, err := s.billManagerBulkInvoice.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*grpc.BillManagerBulkInvoiceResp), nil
+}
+
+func decodeBillManagerBulkInvoiceRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpc.BillManagerBulkInvoiceReq)
+
+	return billManagerBulkInvoiceReq{BillManagerBulkInvoiceReq: mpesa.BillManagerBulkInvoiceReq{
+		Invoices: billManagerInvoicesFromGRPC(req.GetInvoices()),
+	}}, nil
+}
+
+func encodeBillManagerBulkInvoiceResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(billManagerBulkInvoiceResp)
+
+	return &grpc.BillManagerBulkInvoiceResp{
+		BillManagerResp: billManagerRespToGRPC(res.BillManagerResp),
+	}, nil
+}
+
+func (s *grpcServer) BillManagerCancelInvoice(ctx context.Context, req *grpc.BillManagerCancelInvoiceReq) (*grpc.BillManagerCancelInvoiceResp, error) {
+	_, res, err := s.billManagerCancelInvoice.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*grpc.BillManagerCancelInvoiceResp), nil
+}
+
+func decodeBillManagerCancelInvoiceRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpc.BillManagerCancelInvoiceReq)
+
+	return billManagerCancelInvoiceReq{BillManagerCancelInvoiceReq: mpesa.BillManagerCancelInvoiceReq{
+		ExternalReferences: req.GetExternalReferences(),
+	}}, nil
+}
+
+func encodeBillManagerCancelInvoiceResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(billManagerCancelInvoiceResp)
+
+	return &grpc.BillManagerCancelInvoiceResp{
+		BillManagerResp: billManagerRespToGRPC(res.BillManagerResp),
+	}, nil
+}
+
+func (s *grpcServer) BillManagerReconcile(ctx context.Context, req *grpc.BillManagerReconcileReq) (*grpc.BillManagerReconcileResp, error) {
+	_, res, err := s.billManagerReconcile.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*grpc.BillManagerReconcileResp), nil
+}
+
+func decodeBillManagerReconcileRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpc.BillManagerReconcileReq)
+
+	return billManagerReconcileReq{BillManagerReconcileReq: mpesa.BillManagerReconcileReq{
+		PaymentDate:       req.GetPaymentDate(),
+		PaidAmount:        req.GetPaidAmount(),
+		AccountReference:  req.GetAccountReference(),
+		TransactionID:     req.GetTransactionID(),
+		PhoneNumber:       req.GetPhoneNumber(),
+		FullName:          req.GetFullName(),
+		InvoiceName:       req.GetInvoiceName(),
+		ExternalReference: req.GetExternalReference(),
+	}}, nil
+}
+
+func encodeBillManagerReconcileResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(billManagerReconcileResp)
+
+	return &grpc.BillManagerReconcileResp{
+		BillManagerResp: billManagerRespToGRPC(res.BillManagerResp),
+	}, nil
+}
+
+func (s *grpcServer) PullTransactionsRegister(ctx context.Context, req *grpc.PullTransactionsRegisterReq) (*grpc.PullTransactionsRegisterResp, error) {
+	_, res, err := s.pullTransactionsRegister.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*grpc.PullTransactionsRegisterResp), nil
+}
+
+func decodePullTransactionsRegisterRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpc.PullTransactionsRegisterReq)
+
+	return pullTransactionsRegisterReq{PullTransactionsRegisterReq: mpesa.PullTransactionsRegisterReq{
+		ShortCode:       req.GetShortCode(),
+		RequestType:     req.GetRequestType(),
+		NominatedNumber: req.GetNominatedNumber(),
+		CallBackURL:     req.GetCallBackURL(),
+	}}, nil
+}
+
+func encodePullTransactionsRegisterResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(pullTransactionsRegisterResp)
+
+	return &grpc.PullTransactionsRegisterResp{
+		ResponseRefID:   res.ResponseRefID,
+		ResponseCode:    res.ResponseCode,
+		ResponseMessage: res.ResponseMessage,
+		ShortCode:       res.ShortCode,
+	}, nil
+}
+
+func (s *grpcServer) PullTransactionsQuery(ctx context.Context, req *grpc.PullTransactionsQueryReq) (*grpc.PullTransactionsQueryResp, error) {
+	_, res, err := s.pullTransactionsQuery.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*grpc.PullTransactionsQueryResp), nil
+}
+
+func decodePullTransactionsQueryRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpc.PullTransactionsQueryReq)
+
+	return pullTransactionsQueryReq{PullTransactionsQueryReq: mpesa.PullTransactionsQueryReq{
+		ShortCode:   req.GetShortCode(),
+		StartDate:   req.GetStartDate(),
+		EndDate:     req.GetEndDate(),
+		OffSetValue: req.GetOffSetValue(),
+	}}, nil
+}
+
+func encodePullTransactionsQueryResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(pullTransactionsQueryResp)
+
+	return &grpc.PullTransactionsQueryResp{
+		ResponseRefID:   res.ResponseRefID,
+		ResponseCode:    res.ResponseCode,
+		ResponseMessage: res.ResponseMessage,
+		Transactions:    pullTransactionsToGRPC(res.Transactions),
+	}, nil
+}
+
+func (s *grpcServer) StandingOrder(ctx context.Context, req *grpc.StandingOrderReq) (*grpc.StandingOrderResp, error) {
+	_, res, err := s.standingOrder.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*grpc.StandingOrderResp), nil
+}
+
+func decodeStandingOrderRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpc.StandingOrderReq)
+
+	return standingOrderReq{StandingOrderReq: mpesa.StandingOrderReq{
+		StandingOrderName:           req.GetStandingOrderName(),
+		StartDate:                   req.GetStartDate(),
+		EndDate:                     req.GetEndDate(),
+		BusinessShortCode:           req.GetBusinessShortCode(),
+		TransactionType:             req.GetTransactionType(),
+		ReceiverPartyIdentifierType: uint8(req.GetReceiverPartyIdentifierType()),
+		Amount:                      req.GetAmount(),
+		PartyA:                      req.GetPartyA(),
+		CallBackURL:                 req.GetCallBackURL(),
+		AccountReference:            req.GetAccountReference(),
+		TransactionDesc:             req.GetTransactionDesc(),
+		Frequency:                   mpesa.StandingOrderFrequency(req.GetFrequency()),
+	}}, nil
+}
+
+func encodeStandingOrderResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(standingOrderResp)
+
+	return &grpc.StandingOrderResp{
+		ResponseRefID:       res.ResponseRefID,
+		ResponseCode:        res.ResponseCode,
+		ResponseDescription: res.ResponseDescription,
+		ResultDesc:          res.ResultDesc,
+	}, nil
+}
+
+func (s *grpcServer) B2BExpressCheckout(ctx context.Context, req *grpc.B2BExpressCheckoutReq) (*grpc.B2BExpressCheckoutResp, error) {
+	_, res, err := s.b2bExpressCheckout.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*grpc.B2BExpressCheckoutResp), nil
+}
+
+func decodeB2BExpressCheckoutRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpc.B2BExpressCheckoutReq)
+
+	return b2bExpressCheckoutReq{B2BExpressCheckoutReq: mpesa.B2BExpressCheckoutReq{
+		PrimaryShortCode:  req.GetPrimaryShortCode(),
+		ReceiverShortCode: req.GetReceiverShortCode(),
+		Amount:            req.GetAmount(),
+		PaymentRef:        req.GetPaymentRef(),
+		CallbackURL:       req.GetCallbackURL(),
+		PartnerName:       req.GetPartnerName(),
+		RequestRefID:      req.GetRequestRefID(),
+	}}, nil
+}
+
+func encodeB2BExpressCheckoutResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(b2bExpressCheckoutResp)
+
+	return &grpc.B2BExpressCheckoutResp{
+		Code:         res.Code,
+		Status:       res.Status,
+		RequestRefID: res.RequestRefID,
+	}, nil
+}
+
+func (s *grpcServer) B2CAccountTopUp(ctx context.Context, req *grpc.B2CAccountTopUpReq) (*grpc.B2CAccountTopUpResp, error) {
+	_, res, err := s.b2cAccountTopUp.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*grpc.B2CAccountTopUpResp), nil
+}
+
+func decodeB2CAccountTopUpRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpc.B2CAccountTopUpReq)
+
+	return b2cAccountTopUpReq{B2CAccountTopUpReq: mpesa.B2CAccountTopUpReq{
+		Initiator:              req.GetInitiator(),
+		InitiatorPassword:      req.GetInitiatorPassword(),
+		SecurityCredential:     req.GetSecurityCredential(),
+		CommandID:              req.GetCommandID(),
+		SenderIdentifierType:   uint8(req.GetSenderIdentifierType()),
+		RecieverIdentifierType: uint8(req.GetRecieverIdentifierType()),
+		Amount:                 req.GetAmount(),
+		PartyA:                 req.GetPartyA(),
+		PartyB:                 req.GetPartyB(),
+		AccountReference:       req.GetAccountReference(),
+		QueueTimeOutURL:        req.GetQueueTimeOutURL(),
+		ResultURL:              req.GetResultURL(),
+		Remarks:                req.GetRemarks(),
+		Requester:              req.GetRequester(),
+	}}, nil
+}
+
+func encodeB2CAccountTopUpResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(b2cAccountTopUpResp)
+
+	return &grpc.B2CAccountTopUpResp{
+		ValidResp: &grpc.ValidResp{
+			OriginatorConversationID: res.OriginatorConversationID,
+			ResponseCode:             res.ResponseCode,
+			ResponseDescription:      res.ResponseDescription,
+			ConversationID:           res.ConversationID,
+		},
+	}, nil
+}
+
+func (s *grpcServer) B2Pochi(ctx context.Context, req *grpc.B2PochiReq) (*grpc.B2PochiResp, error) {
+	_, res, err := s.b2Pochi.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*grpc.B2PochiResp), nil
+}
+
+func decodeB2PochiRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpc.B2PochiReq)
+
+	return b2PochiReq{B2PochiReq: mpesa.B2PochiReq{
+		OriginatorConversationID: req.GetOriginatorConversationID(),
+		InitiatorName:            req.GetInitiatorName(),
+		InitiatorPassword:        req.GetInitiatorPassword(),
+		SecurityCredential:       req.GetSecurityCredential(),
+		CommandID:                req.GetCommandID(),
+		Amount:                   req.GetAmount(),
+		PartyA:                   req.GetPartyA(),
+		PartyB:                   req.GetPartyB(),
+		Remarks:                  req.GetRemarks(),
+		QueueTimeOutURL:          req.GetQueueTimeOutURL(),
+		ResultURL:                req.GetResultURL(),
+		Occasion:                 req.GetOccasion(),
+	}}, nil
+}
+
+func encodeB2PochiResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(b2PochiResp)
+
+	return &grpc.B2PochiResp{
+		ValidResp: &grpc.ValidResp{
+			OriginatorConversationID: res.OriginatorConversationID,
+			ResponseCode:             res.ResponseCode,
+			ResponseDescription:      res.ResponseDescription,
+			ConversationID:           res.ConversationID,
+		},
+	}, nil
+}
+
+func (s *grpcServer) SIMSwap(ctx context.Context, req *grpc.SIMSwapReq) (*grpc.SIMSwapResp, error) {
+	_, res, err := s.simSwap.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*grpc.SIMSwapResp), nil
+}
+
+func decodeSIMSwapRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpc.SIMSwapReq)
+
+	return simSwapReq{SIMSwapReq: mpesa.SIMSwapReq{
+		CustomerNumber: req.GetCustomerNumber(),
+	}}, nil
+}
+
+func encodeSIMSwapResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(simSwapResp)
+
+	return &grpc.SIMSwapResp{
+		ResponseRefID:       res.ResponseRefID,
+		ResponseCode:        res.ResponseCode,
+		ResponseDescription: res.ResponseDescription,
+		CustomerNumber:      res.CustomerNumber,
+		LastSwapDate:        timeToGRPC(res.LastSwapDate),
+	}, nil
+}
+
+func billManagerInvoiceFromGRPC(req *grpc.BillManagerSingleInvoiceReq) mpesa.BillManagerSingleInvoiceReq {
+	invoice := mpesa.BillManagerSingleInvoiceReq{
+		ExternalReference: req.GetExternalReference(),
+		BilledFullName:    req.GetBilledFullName(),
+		BilledPhoneNumber: req.GetBilledPhoneNumber(),
+		BilledPeriod:      req.GetBilledPeriod(),
+		InvoiceName:       req.GetInvoiceName(),
+		DueDate:           req.GetDueDate(),
+		AccountReference:  req.GetAccountReference(),
+		Amount:            req.GetAmount(),
+	}
+	for _, item := range req.GetInvoiceItems() {
+		invoice.InvoiceItems = append(invoice.InvoiceItems, mpesa.BillManagerInvoiceItem{
+			ItemName: item.GetItemName(),
+			Amount:   item.GetAmount(),
+		})
+	}
+
+	return invoice
+}
+
+func billManagerInvoicesFromGRPC(reqs []*grpc.BillManagerSingleInvoiceReq) []mpesa.BillManagerSingleInvoiceReq {
+	var invoices []mpesa.BillManagerSingleInvoiceReq
+	for _, req := range reqs {
+		invoices = append(invoices, billManagerInvoiceFromGRPC(req))
+	}
+
+	return invoices
+}
+
+func billManagerInvoiceToGRPC(invoice mpesa.BillManagerSingleInvoiceReq) *grpc.BillManagerSingleInvoiceReq {
+	req := &grpc.BillManagerSingleInvoiceReq{
+		ExternalReference: invoice.ExternalReference,
+		BilledFullName:    invoice.BilledFullName,
+		BilledPhoneNumber: invoice.BilledPhoneNumber,
+		BilledPeriod:      invoice.BilledPeriod,
+		InvoiceName:       invoice.InvoiceName,
+		DueDate:           invoice.DueDate,
+		AccountReference:  invoice.AccountReference,
+		Amount:            invoice.Amount,
+	}
+	for _, item := range invoice.InvoiceItems {
+		req.InvoiceItems = append(req.InvoiceItems, &grpc.BillManagerInvoiceItem{
+			ItemName: item.ItemName,
+			Amount:   item.Amount,
+		})
+	}
+
+	return req
+}
+
+func billManagerInvoicesToGRPC(invoices []mpesa.BillManagerSingleInvoiceReq) []*grpc.BillManagerSingleInvoiceReq {
+	var reqs []*grpc.BillManagerSingleInvoiceReq
+	for _, invoice := range invoices {
+		reqs = append(reqs, billManagerInvoiceToGRPC(invoice))
+	}
+
+	return reqs
+}
+
+func billManagerRespToGRPC(res mpesa.BillManagerResp) *grpc.BillManagerResp {
+	return &grpc.BillManagerResp{
+		ResponseMessage: res.ResponseMessage,
+		ResponseCode:    res.ResponseCode,
+		StatusMessage:   res.StatusMessage,
+	}
+}
+
+func billManagerRespFromGRPC(res *grpc.BillManagerResp) mpesa.BillManagerResp {
+	return mpesa.BillManagerResp{
+		ResponseMessage: res.GetResponseMessage(),
+		ResponseCode:    res.GetResponseCode(),
+		StatusMessage:   res.GetStatusMessage(),
+	}
+}
+
+// timeToGRPC formats a time as an RFC 3339 timestamp. A zero time is sent
+// as an empty string.
+func timeToGRPC(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+// timeFromGRPC parses an RFC 3339 timestamp. An empty string is a zero time.
+func timeFromGRPC(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+// pullTransactionsToGRPC converts transactions to their gRPC messages. The
+// transaction date is sent as an RFC 3339 timestamp.
+func pullTransactionsToGRPC(transactions []mpesa.PullTransaction) []*grpc.PullTransaction {
+	var res []*grpc.PullTransaction
+	for _, trx := range transactions {
+		res = append(res, &grpc.PullTransaction{
+			TransactionID:    trx.TransactionID,
+			TransactionDate:  timeToGRPC(trx.TransactionDate),
+			Msisdn:           trx.MSISDN,
+			Sender:           trx.Sender,
+			TransactionType:  trx.TransactionType,
+			BillReference:    trx.BillReference,
+			Amount:           trx.Amount.String(),
+			OrganizationName: trx.OrganizationName,
+		})
+	}
+
+	return res
+}
+
+func pullTransactionsFromGRPC(res []*grpc.PullTransaction) ([]mpesa.PullTransaction, error) {
+	var transactions []mpesa.PullTransaction
+	for _, trx := range res {
+		date, err := timeFromGRPC(trx.GetTransactionDate())
+		if err != nil {
+			return nil, err
+		}
+		var amount mpesa.Amount
+		if trx.GetAmount() != "" {
+			if amount, err = mpesa.ParseAmount(trx.GetAmount()); err != nil {
+				return nil, err
+			}
+		}
+		transactions = append(transactions, mpesa.PullTransaction{
+			TransactionID:    trx.GetTransactionID(),
+			TransactionDate:  date,
+			MSISDN:           trx.GetMsisdn(),
+			Sender:           trx.GetSender(),
+			TransactionType:  trx.GetTransactionType(),
+			BillReference:    trx.GetBillReference(),
+			Amount:           amount,
+			OrganizationName: trx.GetOrganizationName(),
+		})
+	}
+
+	return transactions, nil
+}
+
 func encodeError(err error) error {
+	var (
+		darajaErr    *mpesa.Error
+		rejectionErr *mpesa.RejectionError
+	)
 	switch {
 	case errors.Is(err, nil):
 		return nil
 	case errors.Is(err, errValidation):
 		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.As(err, &darajaErr):
+		return encodeDarajaError(darajaErr)
+	case errors.As(err, &rejectionErr):
+		return encodeRejectionError(rejectionErr)
+	case errors.Is(err, mpesa.ErrSIMSwapped):
+		return status.Error(codes.FailedPrecondition, err.Error())
 	default:
 		return status.Error(codes.Internal, "internal server error")
 	}
 }
+
+// encodeDarajaError maps a Daraja failure to a gRPC status. The Daraja
+// request id, error code and message are attached as a RespError detail.
+func encodeDarajaError(err *mpesa.Error) error {
+	code := codes.Internal
+	switch {
+	case err.IsAuth():
+		code = codes.Unauthenticated
+	case errors.Is(err, mpesa.ErrSpikeArrest), errors.Is(err, mpesa.ErrQuotaViolation):
+		code = codes.ResourceExhausted
+	case err.IsRetryable():
+		code = codes.Unavailable
+	case errors.Is(err, mpesa.ErrDuplicateOriginatorConversationID):
+		code = codes.AlreadyExists
+	case errors.Is(err, mpesa.ErrInsufficientBalance):
+		code = codes.FailedPrecondition
+	case errors.Is(err, mpesa.ErrResourceNotFound):
+		code = codes.NotFound
+	case errors.Is(err, mpesa.ErrInvalidRequest), err.StatusCode == http.StatusBadRequest:
+		code = codes.InvalidArgument
+	}
+
+	st := status.New(code, err.Error())
+	detailed, derr := st.WithDetails(&grpc.RespError{
+		RequestID: err.RequestID,
+		Code:      err.Code,
+		Message:   err.Message,
+	})
+	if derr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// encodeRejectionError maps a request that Daraja did not accept in strict
+// mode to a gRPC status. The response code and description are attached as a
+// RespError detail.
+func encodeRejectionError(err *mpesa.RejectionError) error {
+	st := status.New(codes.FailedPrecondition, err.Error())
+	detailed, derr := st.WithDetails(&grpc.RespError{
+		Code:    err.ResponseCode,
+		Message: err.ResponseDescription,
+	})
+	if derr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
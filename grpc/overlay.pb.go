@@ -72,7 +72,7 @@ var file_grpc_overlay_proto_rawDesc = []byte{
 	0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x1a, 0x13, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x72,
 	0x70, 0x63, 0x2f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xb8, 0x08, 0x0a, 0x07,
+	0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xbe, 0x13, 0x0a, 0x07,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
 	0x12, 0x18, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e,
 	0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x6d, 0x70, 0x65,
@@ -140,8 +140,96 @@ var file_grpc_overlay_proto_rawDesc = []byte{
 	0x73, 0x73, 0x50, 0x61, 0x79, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6d,
 	0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63,
 	0x2e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79, 0x42, 0x69, 0x6c, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x08, 0x5a, 0x06, 0x2e, 0x2f, 0x67, 0x72, 0x70, 0x63,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x10, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x49, 0x6e, 0x12, 0x26, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x49, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61,
+	0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x4f, 0x70, 0x74, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x77, 0x0a,
+	0x16, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4f, 0x70, 0x74, 0x49, 0x6e, 0x12, 0x2c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f,
+	0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74,
+	0x49, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65,
+	0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x49, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x18, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x12, 0x2e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61,
+	0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61,
+	0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x16, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12,
+	0x2c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x42,
+	0x75, 0x6c, 0x6b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x42, 0x75, 0x6c,
+	0x6b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x7d,
+	0x0a, 0x18, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x71, 0x0a,
+	0x14, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65,
+	0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x2b, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x7d, 0x0a, 0x18, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x74, 0x0a, 0x15, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2b, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x75, 0x6c,
+	0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65,
+	0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76,
+	0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6d, 0x70,
+	0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x12, 0x42, 0x32, 0x42, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x28, 0x2e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x32,
+	0x42, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c,
+	0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x32, 0x42, 0x45, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x62, 0x0a, 0x0f, 0x42, 0x32, 0x43, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f,
+	0x70, 0x55, 0x70, 0x12, 0x25, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c,
+	0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x32, 0x43, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x07, 0x42, 0x32, 0x50, 0x6f, 0x63, 0x68, 0x69, 0x12,
+	0x1d, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x42, 0x32, 0x50, 0x6f, 0x63, 0x68, 0x69, 0x52, 0x65, 0x71, 0x1a, 0x1e,
+	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x32, 0x50, 0x6f, 0x63, 0x68, 0x69, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x4a, 0x0a, 0x07, 0x53, 0x49, 0x4d, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1d, 0x2e, 0x6d, 0x70,
+	0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x49, 0x4d, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x49, 0x4d, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x08, 0x5a, 0x06,
+	0x2e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -158,30 +246,56 @@ func file_grpc_overlay_proto_rawDescGZIP() []byte {
 
 var file_grpc_overlay_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_grpc_overlay_proto_goTypes = []interface{}{
-	(*Empty)(nil),                 // 0: mpesaoverlay.grpc.Empty
-	(*ExpressQueryReq)(nil),       // 1: mpesaoverlay.grpc.ExpressQueryReq
-	(*ExpressSimulateReq)(nil),    // 2: mpesaoverlay.grpc.ExpressSimulateReq
-	(*B2CPaymentReq)(nil),         // 3: mpesaoverlay.grpc.B2CPaymentReq
-	(*AccountBalanceReq)(nil),     // 4: mpesaoverlay.grpc.AccountBalanceReq
-	(*C2BRegisterURLReq)(nil),     // 5: mpesaoverlay.grpc.C2BRegisterURLReq
-	(*C2BSimulateReq)(nil),        // 6: mpesaoverlay.grpc.C2BSimulateReq
-	(*GenerateQRReq)(nil),         // 7: mpesaoverlay.grpc.GenerateQRReq
-	(*ReverseReq)(nil),            // 8: mpesaoverlay.grpc.ReverseReq
-	(*TransactionStatusReq)(nil),  // 9: mpesaoverlay.grpc.TransactionStatusReq
-	(*RemitTaxReq)(nil),           // 10: mpesaoverlay.grpc.RemitTaxReq
-	(*BusinessPayBillReq)(nil),    // 11: mpesaoverlay.grpc.BusinessPayBillReq
-	(*TokenResp)(nil),             // 12: mpesaoverlay.grpc.TokenResp
-	(*ExpressQueryResp)(nil),      // 13: mpesaoverlay.grpc.ExpressQueryResp
-	(*ExpressSimulateResp)(nil),   // 14: mpesaoverlay.grpc.ExpressSimulateResp
-	(*B2CPaymentResp)(nil),        // 15: mpesaoverlay.grpc.B2CPaymentResp
-	(*AccountBalanceResp)(nil),    // 16: mpesaoverlay.grpc.AccountBalanceResp
-	(*C2BRegisterURLResp)(nil),    // 17: mpesaoverlay.grpc.C2BRegisterURLResp
-	(*C2BSimulateResp)(nil),       // 18: mpesaoverlay.grpc.C2BSimulateResp
-	(*GenerateQRResp)(nil),        // 19: mpesaoverlay.grpc.GenerateQRResp
-	(*ReverseResp)(nil),           // 20: mpesaoverlay.grpc.ReverseResp
-	(*TransactionStatusResp)(nil), // 21: mpesaoverlay.grpc.TransactionStatusResp
-	(*RemitTaxResp)(nil),          // 22: mpesaoverlay.grpc.RemitTaxResp
-	(*BusinessPayBillResp)(nil),   // 23: mpesaoverlay.grpc.BusinessPayBillResp
+	(*Empty)(nil),                        // 0: mpesaoverlay.grpc.Empty
+	(*ExpressQueryReq)(nil),              // 1: mpesaoverlay.grpc.ExpressQueryReq
+	(*ExpressSimulateReq)(nil),           // 2: mpesaoverlay.grpc.ExpressSimulateReq
+	(*B2CPaymentReq)(nil),                // 3: mpesaoverlay.grpc.B2CPaymentReq
+	(*AccountBalanceReq)(nil),            // 4: mpesaoverlay.grpc.AccountBalanceReq
+	(*C2BRegisterURLReq)(nil),            // 5: mpesaoverlay.grpc.C2BRegisterURLReq
+	(*C2BSimulateReq)(nil),               // 6: mpesaoverlay.grpc.C2BSimulateReq
+	(*GenerateQRReq)(nil),                // 7: mpesaoverlay.grpc.GenerateQRReq
+	(*ReverseReq)(nil),                   // 8: mpesaoverlay.grpc.ReverseReq
+	(*TransactionStatusReq)(nil),         // 9: mpesaoverlay.grpc.TransactionStatusReq
+	(*RemitTaxReq)(nil),                  // 10: mpesaoverlay.grpc.RemitTaxReq
+	(*BusinessPayBillReq)(nil),           // 11: mpesaoverlay.grpc.BusinessPayBillReq
+	(*BillManagerOptInReq)(nil),          // 12: mpesaoverlay.grpc.BillManagerOptInReq
+	(*BillManagerUpdateOptInReq)(nil),    // 13: mpesaoverlay.grpc.BillManagerUpdateOptInReq
+	(*BillManagerSingleInvoiceReq)(nil),  // 14: mpesaoverlay.grpc.BillManagerSingleInvoiceReq
+	(*BillManagerBulkInvoiceReq)(nil),    // 15: mpesaoverlay.grpc.BillManagerBulkInvoiceReq
+	(*BillManagerCancelInvoiceReq)(nil),  // 16: mpesaoverlay.grpc.BillManagerCancelInvoiceReq
+	(*BillManagerReconcileReq)(nil),      // 17: mpesaoverlay.grpc.BillManagerReconcileReq
+	(*PullTransactionsRegisterReq)(nil),  // 18: mpesaoverlay.grpc.PullTransactionsRegisterReq
+	(*PullTransactionsQueryReq)(nil),     // 19: mpesaoverlay.grpc.PullTransactionsQueryReq
+	(*StandingOrderReq)(nil),             // 20: mpesaoverlay.grpc.StandingOrderReq
+	(*B2BExpressCheckoutReq)(nil),        // 21: mpesaoverlay.grpc.B2BExpressCheckoutReq
+	(*B2CAccountTopUpReq)(nil),           // 22: mpesaoverlay.grpc.B2CAccountTopUpReq
+	(*B2PochiReq)(nil),                   // 23: mpesaoverlay.grpc.B2PochiReq
+	(*SIMSwapReq)(nil),                   // 24: mpesaoverlay.grpc.SIMSwapReq
+	(*TokenResp)(nil),                    // 25: mpesaoverlay.grpc.TokenResp
+	(*ExpressQueryResp)(nil),             // 26: mpesaoverlay.grpc.ExpressQueryResp
+	(*ExpressSimulateResp)(nil),          // 27: mpesaoverlay.grpc.ExpressSimulateResp
+	(*B2CPaymentResp)(nil),               // 28: mpesaoverlay.grpc.B2CPaymentResp
+	(*AccountBalanceResp)(nil),           // 29: mpesaoverlay.grpc.AccountBalanceResp
+	(*C2BRegisterURLResp)(nil),           // 30: mpesaoverlay.grpc.C2BRegisterURLResp
+	(*C2BSimulateResp)(nil),              // 31: mpesaoverlay.grpc.C2BSimulateResp
+	(*GenerateQRResp)(nil),               // 32: mpesaoverlay.grpc.GenerateQRResp
+	(*ReverseResp)(nil),                  // 33: mpesaoverlay.grpc.ReverseResp
+	(*TransactionStatusResp)(nil),        // 34: mpesaoverlay.grpc.TransactionStatusResp
+	(*RemitTaxResp)(nil),                 // 35: mpesaoverlay.grpc.RemitTaxResp
+	(*BusinessPayBillResp)(nil),          // 36: mpesaoverlay.grpc.BusinessPayBillResp
+	(*BillManagerOptInResp)(nil),         // 37: mpesaoverlay.grpc.BillManagerOptInResp
+	(*BillManagerUpdateOptInResp)(nil),   // 38: mpesaoverlay.grpc.BillManagerUpdateOptInResp
+	(*BillManagerSingleInvoiceResp)(nil), // 39: mpesaoverlay.grpc.BillManagerSingleInvoiceResp
+	(*BillManagerBulkInvoiceResp)(nil),   // 40: mpesaoverlay.grpc.BillManagerBulkInvoiceResp
+	(*BillManagerCancelInvoiceResp)(nil), // 41: mpesaoverlay.grpc.BillManagerCancelInvoiceResp
+	(*BillManagerReconcileResp)(nil),     // 42: mpesaoverlay.grpc.BillManagerReconcileResp
+	(*PullTransactionsRegisterResp)(nil), // 43: mpesaoverlay.grpc.PullTransactionsRegisterResp
+	(*PullTransactionsQueryResp)(nil),    // 44: mpesaoverlay.grpc.PullTransactionsQueryResp
+	(*StandingOrderResp)(nil),            // 45: mpesaoverlay.grpc.StandingOrderResp
+	(*B2BExpressCheckoutResp)(nil),       // 46: mpesaoverlay.grpc.B2BExpressCheckoutResp
+	(*B2CAccountTopUpResp)(nil),          // 47: mpesaoverlay.grpc.B2CAccountTopUpResp
+	(*B2PochiResp)(nil),                  // 48: mpesaoverlay.grpc.B2PochiResp
+	(*SIMSwapResp)(nil),                  // 49: mpesaoverlay.grpc.SIMSwapResp
 }
 var file_grpc_overlay_proto_depIdxs = []int32{
 	0,  // 0: mpesaoverlay.grpc.Service.Token:input_type -> mpesaoverlay.grpc.Empty
@@ -196,20 +310,46 @@ var file_grpc_overlay_proto_depIdxs = []int32{
 	9,  // 9: mpesaoverlay.grpc.Service.TransactionStatus:input_type -> mpesaoverlay.grpc.TransactionStatusReq
 	10, // 10: mpesaoverlay.grpc.Service.RemitTax:input_type -> mpesaoverlay.grpc.RemitTaxReq
 	11, // 11: mpesaoverlay.grpc.Service.BusinessPayBill:input_type -> mpesaoverlay.grpc.BusinessPayBillReq
-	12, // 12: mpesaoverlay.grpc.Service.Token:output_type -> mpesaoverlay.grpc.TokenResp
-	13, // 13: mpesaoverlay.grpc.Service.ExpressQuery:output_type -> mpesaoverlay.grpc.ExpressQueryResp
-	14, // 14: mpesaoverlay.grpc.Service.ExpressSimulate:output_type -> mpesaoverlay.grpc.ExpressSimulateResp
-	15, // 15: mpesaoverlay.grpc.Service.B2CPayment:output_type -> mpesaoverlay.grpc.B2CPaymentResp
-	16, // 16: mpesaoverlay.grpc.Service.AccountBalance:output_type -> mpesaoverlay.grpc.AccountBalanceResp
-	17, // 17: mpesaoverlay.grpc.Service.C2BRegisterURL:output_type -> mpesaoverlay.grpc.C2BRegisterURLResp
-	18, // 18: mpesaoverlay.grpc.Service.C2BSimulate:output_type -> mpesaoverlay.grpc.C2BSimulateResp
-	19, // 19: mpesaoverlay.grpc.Service.GenerateQR:output_type -> mpesaoverlay.grpc.GenerateQRResp
-	20, // 20: mpesaoverlay.grpc.Service.Reverse:output_type -> mpesaoverlay.grpc.ReverseResp
-	21, // 21: mpesaoverlay.grpc.Service.TransactionStatus:output_type -> mpesaoverlay.grpc.TransactionStatusResp
-	22, // 22: mpesaoverlay.grpc.Service.RemitTax:output_type -> mpesaoverlay.grpc.RemitTaxResp
-	23, // 23: mpesaoverlay.grpc.Service.BusinessPayBill:output_type -> mpesaoverlay.grpc.BusinessPayBillResp
-	12, // [12:24] is the sub-list for method output_type
-	0,  // [0:12] is the sub-list for method input_type
+	12, // 12: mpesaoverlay.grpc.Service.BillManagerOptIn:input_type -> mpesaoverlay.grpc.BillManagerOptInReq
+	13, // 13: mpesaoverlay.grpc.Service.BillManagerUpdateOptIn:input_type -> mpesaoverlay.grpc.BillManagerUpdateOptInReq
+	14, // 14: mpesaoverlay.grpc.Service.BillManagerSingleInvoice:input_type -> mpesaoverlay.grpc.BillManagerSingleInvoiceReq
+	15, // 15: mpesaoverlay.grpc.Service.BillManagerBulkInvoice:input_type -> mpesaoverlay.grpc.BillManagerBulkInvoiceReq
+	16, // 16: mpesaoverlay.grpc.Service.BillManagerCancelInvoice:input_type -> mpesaoverlay.grpc.BillManagerCancelInvoiceReq
+	17, // 17: mpesaoverlay.grpc.Service.BillManagerReconcile:input_type -> mpesaoverlay.grpc.BillManagerReconcileReq
+	18, // 18: mpesaoverlay.grpc.Service.PullTransactionsRegister:input_type -> mpesaoverlay.grpc.PullTransactionsRegisterReq
+	19, // 19: mpesaoverlay.grpc.Service.PullTransactionsQuery:input_type -> mpesaoverlay.grpc.PullTransactionsQueryReq
+	20, // 20: mpesaoverlay.grpc.Service.StandingOrder:input_type -> mpesaoverlay.grpc.StandingOrderReq
+	21, // 21: mpesaoverlay.grpc.Service.B2BExpressCheckout:input_type -> mpesaoverlay.grpc.B2BExpressCheckoutReq
+	22, // 22: mpesaoverlay.grpc.Service.B2CAccountTopUp:input_type -> mpesaoverlay.grpc.B2CAccountTopUpReq
+	23, // 23: mpesaoverlay.grpc.Service.B2Pochi:input_type -> mpesaoverlay.grpc.B2PochiReq
+	24, // 24: mpesaoverlay.grpc.Service.SIMSwap:input_type -> mpesaoverlay.grpc.SIMSwapReq
+	25, // 25: mpesaoverlay.grpc.Service.Token:output_type -> mpesaoverlay.grpc.TokenResp
+	26, // 26: mpesaoverlay.grpc.Service.ExpressQuery:output_type -> mpesaoverlay.grpc.ExpressQueryResp
+	27, // 27: mpesaoverlay.grpc.Service.ExpressSimulate:output_type -> mpesaoverlay.grpc.ExpressSimulateResp
+	28, // 28: mpesaoverlay.grpc.Service.B2CPayment:output_type -> mpesaoverlay.grpc.B2CPaymentResp
+	29, // 29: mpesaoverlay.grpc.Service.AccountBalance:output_type -> mpesaoverlay.grpc.AccountBalanceResp
+	30, // 30: mpesaoverlay.grpc.Service.C2BRegisterURL:output_type -> mpesaoverlay.grpc.C2BRegisterURLResp
+	31, // 31: mpesaoverlay.grpc.Service.C2BSimulate:output_type -> mpesaoverlay.grpc.C2BSimulateResp
+	32, // 32: mpesaoverlay.grpc.Service.GenerateQR:output_type -> mpesaoverlay.grpc.GenerateQRResp
+	33, // 33: mpesaoverlay.grpc.Service.Reverse:output_type -> mpesaoverlay.grpc.ReverseResp
+	34, // 34: mpesaoverlay.grpc.Service.TransactionStatus:output_type -> mpesaoverlay.grpc.TransactionStatusResp
+	35, // 35: mpesaoverlay.grpc.Service.RemitTax:output_type -> mpesaoverlay.grpc.RemitTaxResp
+	36, // 36: mpesaoverlay.grpc.Service.BusinessPayBill:output_type -> mpesaoverlay.grpc.BusinessPayBillResp
+	37, // 37: mpesaoverlay.grpc.Service.BillManagerOptIn:output_type -> mpesaoverlay.grpc.BillManagerOptInResp
+	38, // 38: mpesaoverlay.grpc.Service.BillManagerUpdateOptIn:output_type -> mpesaoverlay.grpc.BillManagerUpdateOptInResp
+	39, // 39: mpesaoverlay.grpc.Service.BillManagerSingleInvoice:output_type -> mpesaoverlay.grpc.BillManagerSingleInvoiceResp
+	40, // 40: mpesaoverlay.grpc.Service.BillManagerBulkInvoice:output_type -> mpesaoverlay.grpc.BillManagerBulkInvoiceResp
+	41, // 41: mpesaoverlay.grpc.Service.BillManagerCancelInvoice:output_type -> mpesaoverlay.grpc.BillManagerCancelInvoiceResp
+	42, // 42: mpesaoverlay.grpc.Service.BillManagerReconcile:output_type -> mpesaoverlay.grpc.BillManagerReconcileResp
+	43, // 43: mpesaoverlay.grpc.Service.PullTransactionsRegister:output_type -> mpesaoverlay.grpc.PullTransactionsRegisterResp
+	44, // 44: mpesaoverlay.grpc.Service.PullTransactionsQuery:output_type -> mpesaoverlay.grpc.PullTransactionsQueryResp
+	45, // 45: mpesaoverlay.grpc.Service.StandingOrder:output_type -> mpesaoverlay.grpc.StandingOrderResp
+	46, // 46: mpesaoverlay.grpc.Service.B2BExpressCheckout:output_type -> mpesaoverlay.grpc.B2BExpressCheckoutResp
+	47, // 47: mpesaoverlay.grpc.Service.B2CAccountTopUp:output_type -> mpesaoverlay.grpc.B2CAccountTopUpResp
+	48, // 48: mpesaoverlay.grpc.Service.B2Pochi:output_type -> mpesaoverlay.grpc.B2PochiResp
+	49, // 49: mpesaoverlay.grpc.Service.SIMSwap:output_type -> mpesaoverlay.grpc.SIMSwapResp
+	25, // [25:50] is the sub-list for method output_type
+	0,  // [0:25] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -25,18 +25,31 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_Token_FullMethodName             = "/mpesaoverlay.grpc.Service/Token"
-	Service_ExpressQuery_FullMethodName      = "/mpesaoverlay.grpc.Service/ExpressQuery"
-	Service_ExpressSimulate_FullMethodName   = "/mpesaoverlay.grpc.Service/ExpressSimulate"
-	Service_B2CPayment_FullMethodName        = "/mpesaoverlay.grpc.Service/B2CPayment"
-	Service_AccountBalance_FullMethodName    = "/mpesaoverlay.grpc.Service/AccountBalance"
-	Service_C2BRegisterURL_FullMethodName    = "/mpesaoverlay.grpc.Service/C2BRegisterURL"
-	Service_C2BSimulate_FullMethodName       = "/mpesaoverlay.grpc.Service/C2BSimulate"
-	Service_GenerateQR_FullMethodName        = "/mpesaoverlay.grpc.Service/GenerateQR"
-	Service_Reverse_FullMethodName           = "/mpesaoverlay.grpc.Service/Reverse"
-	Service_TransactionStatus_FullMethodName = "/mpesaoverlay.grpc.Service/TransactionStatus"
-	Service_RemitTax_FullMethodName          = "/mpesaoverlay.grpc.Service/RemitTax"
-	Service_BusinessPayBill_FullMethodName   = "/mpesaoverlay.grpc.Service/BusinessPayBill"
+	Service_Token_FullMethodName                    = "/mpesaoverlay.grpc.Service/Token"
+	Service_ExpressQuery_FullMethodName             = "/mpesaoverlay.grpc.Service/ExpressQuery"
+	Service_ExpressSimulate_FullMethodName          = "/mpesaoverlay.grpc.Service/ExpressSimulate"
+	Service_B2CPayment_FullMethodName               = "/mpesaoverlay.grpc.Service/B2CPayment"
+	Service_AccountBalance_FullMethodName           = "/mpesaoverlay.grpc.Service/AccountBalance"
+	Service_C2BRegisterURL_FullMethodName           = "/mpesaoverlay.grpc.Service/C2BRegisterURL"
+	Service_C2BSimulate_FullMethodName              = "/mpesaoverlay.grpc.Service/C2BSimulate"
+	Service_GenerateQR_FullMethodName               = "/mpesaoverlay.grpc.Service/GenerateQR"
+	Service_Reverse_FullMethodName                  = "/mpesaoverlay.grpc.Service/Reverse"
+	Service_TransactionStatus_FullMethodName        = "/mpesaoverlay.grpc.Service/TransactionStatus"
+	Service_RemitTax_FullMethodName                 = "/mpesaoverlay.grpc.Service/RemitTax"
+	Service_BusinessPayBill_FullMethodName          = "/mpesaoverlay.grpc.Service/BusinessPayBill"
+	Service_BillManagerOptIn_FullMethodName         = "/mpesaoverlay.grpc.Service/BillManagerOptIn"
+	Service_BillManagerUpdateOptIn_FullMethodName   = "/mpesaoverlay.grpc.Service/BillManagerUpdateOptIn"
+	Service_BillManagerSingleInvoice_FullMethodName = "/mpesaoverlay.grpc.Service/BillManagerSingleInvoice"
+	Service_BillManagerBulkInvoice_FullMethodName   = "/mpesaoverlay.grpc.Service/BillManagerBulkInvoice"
+	Service_BillManagerCancelInvoice_FullMethodName = "/mpesaoverlay.grpc.Service/BillManagerCancelInvoice"
+	Service_BillManagerReconcile_FullMethodName     = "/mpesaoverlay.grpc.Service/BillManagerReconcile"
+	Service_PullTransactionsRegister_FullMethodName = "/mpesaoverlay.grpc.Service/PullTransactionsRegister"
+	Service_PullTransactionsQuery_FullMethodName    = "/mpesaoverlay.grpc.Service/PullTransactionsQuery"
+	Service_StandingOrder_FullMethodName            = "/mpesaoverlay.grpc.Service/StandingOrder"
+	Service_B2BExpressCheckout_FullMethodName       = "/mpesaoverlay.grpc.Service/B2BExpressCheckout"
+	Service_B2CAccountTopUp_FullMethodName          = "/mpesaoverlay.grpc.Service/B2CAccountTopUp"
+	Service_B2Pochi_FullMethodName                  = "/mpesaoverlay.grpc.Service/B2Pochi"
+	Service_SIMSwap_FullMethodName                  = "/mpesaoverlay.grpc.Service/SIMSwap"
 )
 
 // ServiceClient is the client API for Service service.
@@ -55,6 +68,19 @@ type ServiceClient interface {
 	TransactionStatus(ctx context.Context, in *TransactionStatusReq, opts ...grpc.CallOption) (*TransactionStatusResp, error)
 	RemitTax(ctx context.Context, in *RemitTaxReq, opts ...grpc.CallOption) (*RemitTaxResp, error)
 	BusinessPayBill(ctx context.Context, in *BusinessPayBillReq, opts ...grpc.CallOption) (*BusinessPayBillResp, error)
+	BillManagerOptIn(ctx context.Context, in *BillManagerOptInReq, opts ...grpc.CallOption) (*BillManagerOptInResp, error)
+	BillManagerUpdateOptIn(ctx context.Context, in *BillManagerUpdateOptInReq, opts ...grpc.CallOption) (*BillManagerUpdateOptInResp, error)
+	BillManagerSingleInvoice(ctx context.Context, in *BillManagerSingleInvoiceReq, opts ...grpc.CallOption) (*BillManagerSingleInvoiceResp, error)
+	BillManagerBulkInvoice(ctx context.Context, in *BillManagerBulkInvoiceReq, opts ...grpc.CallOption) (*BillManagerBulkInvoiceResp, error)
+	BillManagerCancelInvoice(ctx context.Context, in *BillManagerCancelInvoiceReq, opts ...grpc.CallOption) (*BillManagerCancelInvoiceResp, error)
+	BillManagerReconcile(ctx context.Context, in *BillManagerReconcileReq, opts ...grpc.CallOption) (*BillManagerReconcileResp, error)
+	PullTransactionsRegister(ctx context.Context, in *PullTransactionsRegisterReq, opts ...grpc.CallOption) (*PullTransactionsRegisterResp, error)
+	PullTransactionsQuery(ctx context.Context, in *PullTransactionsQueryReq, opts ...grpc.CallOption) (*PullTransactionsQueryResp, error)
+	StandingOrder(ctx context.Context, in *StandingOrderReq, opts ...grpc.CallOption) (*StandingOrderResp, error)
+	B2BExpressCheckout(ctx context.Context, in *B2BExpressCheckoutReq, opts ...grpc.CallOption) (*B2BExpressCheckoutResp, error)
+	B2CAccountTopUp(ctx context.Context, in *B2CAccountTopUpReq, opts ...grpc.CallOption) (*B2CAccountTopUpResp, error)
+	B2Pochi(ctx context.Context, in *B2PochiReq, opts ...grpc.CallOption) (*B2PochiResp, error)
+	SIMSwap(ctx context.Context, in *SIMSwapReq, opts ...grpc.CallOption) (*SIMSwapResp, error)
 }
 
 type serviceClient struct {
@@ -173,6 +199,123 @@ func (c *serviceClient) BusinessPayBill(ctx context.Context, in *BusinessPayBill
 	return out, nil
 }
 
+func (c *serviceClient) BillManagerOptIn(ctx context.Context, in *BillManagerOptInReq, opts ...grpc.CallOption) (*BillManagerOptInResp, error) {
+	out := new(BillManagerOptInResp)
+	err := c.cc.Invoke(ctx, Service_BillManagerOptIn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) BillManagerUpdateOptIn(ctx context.Context, in *BillManagerUpdateOptInReq, opts ...grpc.CallOption) (*BillManagerUpdateOptInResp, error) {
+	out := new(BillManagerUpdateOptInResp)
+	err := c.cc.Invoke(ctx, Service_BillManagerUpdateOptIn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) BillManagerSingleInvoice(ctx context.Context, in *BillManagerSingleInvoiceReq, opts ...grpc.CallOption) (*BillManagerSingleInvoiceResp, error) {
+	out := new(BillManagerSingleInvoiceResp)
+	err := c.cc.Invoke(ctx, Service_BillManagerSingleInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) BillManagerBulkInvoice(ctx context.Context, in *BillManagerBulkInvoiceReq, opts ...grpc.CallOption) (*BillManagerBulkInvoiceResp, error) {
+	out := new(BillManagerBulkInvoiceResp)
+	err := c.cc.Invoke(ctx, Service_BillManagerBulkInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) BillManagerCancelInvoice(ctx context.Context, in *BillManagerCancelInvoiceReq, opts ...grpc.CallOption) (*BillManagerCancelInvoiceResp, error) {
+	out := new(BillManagerCancelInvoiceResp)
+	err := c.cc.Invoke(ctx, Service_BillManagerCancelInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) BillManagerReconcile(ctx context.Context, in *BillManagerReconcileReq, opts ...grpc.CallOption) (*BillManagerReconcileResp, error) {
+	out := new(BillManagerReconcileResp)
+	err := c.cc.Invoke(ctx, Service_BillManagerReconcile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) PullTransactionsRegister(ctx context.Context, in *PullTransactionsRegisterReq, opts ...grpc.CallOption) (*PullTransactionsRegisterResp, error) {
+	out := new(PullTransactionsRegisterResp)
+	err := c.cc.Invoke(ctx, Service_PullTransactionsRegister_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) PullTransactionsQuery(ctx context.Context, in *PullTransactionsQueryReq, opts ...grpc.CallOption) (*PullTransactionsQueryResp, error) {
+	out := new(PullTransactionsQueryResp)
+	err := c.cc.Invoke(ctx, Service_PullTransactionsQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) StandingOrder(ctx context.Context, in *StandingOrderReq, opts ...grpc.CallOption) (*StandingOrderResp, error) {
+	out := new(StandingOrderResp)
+	err := c.cc.Invoke(ctx, Service_StandingOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) B2BExpressCheckout(ctx context.Context, in *B2BExpressCheckoutReq, opts ...grpc.CallOption) (*B2BExpressCheckoutResp, error) {
+	out := new(B2BExpressCheckoutResp)
+	err := c.cc.Invoke(ctx, Service_B2BExpressCheckout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) B2CAccountTopUp(ctx context.Context, in *B2CAccountTopUpReq, opts ...grpc.CallOption) (*B2CAccountTopUpResp, error) {
+	out := new(B2CAccountTopUpResp)
+	err := c.cc.Invoke(ctx, Service_B2CAccountTopUp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) B2Pochi(ctx context.Context, in *B2PochiReq, opts ...grpc.CallOption) (*B2PochiResp, error) {
+	out := new(B2PochiResp)
+	err := c.cc.Invoke(ctx, Service_B2Pochi_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SIMSwap(ctx context.Context, in *SIMSwapReq, opts ...grpc.CallOption) (*SIMSwapResp, error) {
+	out := new(SIMSwapResp)
+	err := c.cc.Invoke(ctx, Service_SIMSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -189,6 +332,19 @@ type ServiceServer interface {
 	TransactionStatus(context.Context, *TransactionStatusReq) (*TransactionStatusResp, error)
 	RemitTax(context.Context, *RemitTaxReq) (*RemitTaxResp, error)
 	BusinessPayBill(context.Context, *BusinessPayBillReq) (*BusinessPayBillResp, error)
+	BillManagerOptIn(context.Context, *BillManagerOptInReq) (*BillManagerOptInResp, error)
+	BillManagerUpdateOptIn(context.Context, *BillManagerUpdateOptInReq) (*BillManagerUpdateOptInResp, error)
+	BillManagerSingleInvoice(context.Context, *BillManagerSingleInvoiceReq) (*BillManagerSingleInvoiceResp, error)
+	BillManagerBulkInvoice(context.Context, *BillManagerBulkInvoiceReq) (*BillManagerBulkInvoiceResp, error)
+	BillManagerCancelInvoice(context.Context, *BillManagerCancelInvoiceReq) (*BillManagerCancelInvoiceResp, error)
+	BillManagerReconcile(context.Context, *BillManagerReconcileReq) (*BillManagerReconcileResp, error)
+	PullTransactionsRegister(context.Context, *PullTransactionsRegisterReq) (*PullTransactionsRegisterResp, error)
+	PullTransactionsQuery(context.Context, *PullTransactionsQueryReq) (*PullTransactionsQueryResp, error)
+	StandingOrder(context.Context, *StandingOrderReq) (*StandingOrderResp, error)
+	B2BExpressCheckout(context.Context, *B2BExpressCheckoutReq) (*B2BExpressCheckoutResp, error)
+	B2CAccountTopUp(context.Context, *B2CAccountTopUpReq) (*B2CAccountTopUpResp, error)
+	B2Pochi(context.Context, *B2PochiReq) (*B2PochiResp, error)
+	SIMSwap(context.Context, *SIMSwapReq) (*SIMSwapResp, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -232,6 +388,45 @@ func (UnimplementedServiceServer) RemitTax(context.Context, *RemitTaxReq) (*Remi
 func (UnimplementedServiceServer) BusinessPayBill(context.Context, *BusinessPayBillReq) (*BusinessPayBillResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BusinessPayBill not implemented")
 }
+func (UnimplementedServiceServer) BillManagerOptIn(context.Context, *BillManagerOptInReq) (*BillManagerOptInResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BillManagerOptIn not implemented")
+}
+func (UnimplementedServiceServer) BillManagerUpdateOptIn(context.Context, *BillManagerUpdateOptInReq) (*BillManagerUpdateOptInResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BillManagerUpdateOptIn not implemented")
+}
+func (UnimplementedServiceServer) BillManagerSingleInvoice(context.Context, *BillManagerSingleInvoiceReq) (*BillManagerSingleInvoiceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BillManagerSingleInvoice not implemented")
+}
+func (UnimplementedServiceServer) BillManagerBulkInvoice(context.Context, *BillManagerBulkInvoiceReq) (*BillManagerBulkInvoiceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BillManagerBulkInvoice not implemented")
+}
+func (UnimplementedServiceServer) BillManagerCancelInvoice(context.Context, *BillManagerCancelInvoiceReq) (*BillManagerCancelInvoiceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BillManagerCancelInvoice not implemented")
+}
+func (UnimplementedServiceServer) BillManagerReconcile(context.Context, *BillManagerReconcileReq) (*BillManagerReconcileResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BillManagerReconcile not implemented")
+}
+func (UnimplementedServiceServer) PullTransactionsRegister(context.Context, *PullTransactionsRegisterReq) (*PullTransactionsRegisterResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullTransactionsRegister not implemented")
+}
+func (UnimplementedServiceServer) PullTransactionsQuery(context.Context, *PullTransactionsQueryReq) (*PullTransactionsQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullTransactionsQuery not implemented")
+}
+func (UnimplementedServiceServer) StandingOrder(context.Context, *StandingOrderReq) (*StandingOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StandingOrder not implemented")
+}
+func (UnimplementedServiceServer) B2BExpressCheckout(context.Context, *B2BExpressCheckoutReq) (*B2BExpressCheckoutResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method B2BExpressCheckout not implemented")
+}
+func (UnimplementedServiceServer) B2CAccountTopUp(context.Context, *B2CAccountTopUpReq) (*B2CAccountTopUpResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method B2CAccountTopUp not implemented")
+}
+func (UnimplementedServiceServer) B2Pochi(context.Context, *B2PochiReq) (*B2PochiResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method B2Pochi not implemented")
+}
+func (UnimplementedServiceServer) SIMSwap(context.Context, *SIMSwapReq) (*SIMSwapResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SIMSwap not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -461,6 +656,240 @@ func _Service_BusinessPayBill_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_BillManagerOptIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BillManagerOptInReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BillManagerOptIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_BillManagerOptIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BillManagerOptIn(ctx, req.(*BillManagerOptInReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_BillManagerUpdateOptIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BillManagerUpdateOptInReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BillManagerUpdateOptIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_BillManagerUpdateOptIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BillManagerUpdateOptIn(ctx, req.(*BillManagerUpdateOptInReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_BillManagerSingleInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BillManagerSingleInvoiceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BillManagerSingleInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_BillManagerSingleInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BillManagerSingleInvoice(ctx, req.(*BillManagerSingleInvoiceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_BillManagerBulkInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BillManagerBulkInvoiceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BillManagerBulkInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_BillManagerBulkInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BillManagerBulkInvoice(ctx, req.(*BillManagerBulkInvoiceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_BillManagerCancelInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BillManagerCancelInvoiceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BillManagerCancelInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_BillManagerCancelInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BillManagerCancelInvoice(ctx, req.(*BillManagerCancelInvoiceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_BillManagerReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BillManagerReconcileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BillManagerReconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_BillManagerReconcile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BillManagerReconcile(ctx, req.(*BillManagerReconcileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_PullTransactionsRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullTransactionsRegisterReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PullTransactionsRegister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_PullTransactionsRegister_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PullTransactionsRegister(ctx, req.(*PullTransactionsRegisterReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_PullTransactionsQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullTransactionsQueryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PullTransactionsQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_PullTransactionsQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PullTransactionsQuery(ctx, req.(*PullTransactionsQueryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_StandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StandingOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).StandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_StandingOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).StandingOrder(ctx, req.(*StandingOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_B2BExpressCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(B2BExpressCheckoutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).B2BExpressCheckout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_B2BExpressCheckout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).B2BExpressCheckout(ctx, req.(*B2BExpressCheckoutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_B2CAccountTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(B2CAccountTopUpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).B2CAccountTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_B2CAccountTopUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).B2CAccountTopUp(ctx, req.(*B2CAccountTopUpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_B2Pochi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(B2PochiReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).B2Pochi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_B2Pochi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).B2Pochi(ctx, req.(*B2PochiReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SIMSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SIMSwapReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SIMSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SIMSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SIMSwap(ctx, req.(*SIMSwapReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -516,6 +945,58 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BusinessPayBill",
 			Handler:    _Service_BusinessPayBill_Handler,
 		},
+		{
+			MethodName: "BillManagerOptIn",
+			Handler:    _Service_BillManagerOptIn_Handler,
+		},
+		{
+			MethodName: "BillManagerUpdateOptIn",
+			Handler:    _Service_BillManagerUpdateOptIn_Handler,
+		},
+		{
+			MethodName: "BillManagerSingleInvoice",
+			Handler:    _Service_BillManagerSingleInvoice_Handler,
+		},
+		{
+			MethodName: "BillManagerBulkInvoice",
+			Handler:    _Service_BillManagerBulkInvoice_Handler,
+		},
+		{
+			MethodName: "BillManagerCancelInvoice",
+			Handler:    _Service_BillManagerCancelInvoice_Handler,
+		},
+		{
+			MethodName: "BillManagerReconcile",
+			Handler:    _Service_BillManagerReconcile_Handler,
+		},
+		{
+			MethodName: "PullTransactionsRegister",
+			Handler:    _Service_PullTransactionsRegister_Handler,
+		},
+		{
+			MethodName: "PullTransactionsQuery",
+			Handler:    _Service_PullTransactionsQuery_Handler,
+		},
+		{
+			MethodName: "StandingOrder",
+			Handler:    _Service_StandingOrder_Handler,
+		},
+		{
+			MethodName: "B2BExpressCheckout",
+			Handler:    _Service_B2BExpressCheckout_Handler,
+		},
+		{
+			MethodName: "B2CAccountTopUp",
+			Handler:    _Service_B2CAccountTopUp_Handler,
+		},
+		{
+			MethodName: "B2Pochi",
+			Handler:    _Service_B2Pochi_Handler,
+		},
+		{
+			MethodName: "SIMSwap",
+			Handler:    _Service_SIMSwap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "grpc/overlay.proto",
@@ -131,43 +131,709 @@ func (x *AccountBalanceReq) GetResultURL() string {
 	return ""
 }
 
+type B2BExpressCheckoutReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrimaryShortCode  uint64 `protobuf:"varint,1,opt,name=primaryShortCode,proto3" json:"primaryShortCode,omitempty"`
+	ReceiverShortCode uint64 `protobuf:"varint,2,opt,name=receiverShortCode,proto3" json:"receiverShortCode,omitempty"`
+	Amount            uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	PaymentRef        string `protobuf:"bytes,4,opt,name=paymentRef,proto3" json:"paymentRef,omitempty"`
+	CallbackURL       string `protobuf:"bytes,5,opt,name=callbackURL,proto3" json:"callbackURL,omitempty"`
+	PartnerName       string `protobuf:"bytes,6,opt,name=partnerName,proto3" json:"partnerName,omitempty"`
+	RequestRefID      string `protobuf:"bytes,7,opt,name=requestRefID,proto3" json:"requestRefID,omitempty"`
+}
+
+func (x *B2BExpressCheckoutReq) Reset() {
+	*x = B2BExpressCheckoutReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_requests_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *B2BExpressCheckoutReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*B2BExpressCheckoutReq) ProtoMessage() {}
+
+func (x *B2BExpressCheckoutReq) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use B2BExpressCheckoutReq.ProtoReflect.Descriptor instead.
+func (*B2BExpressCheckoutReq) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *B2BExpressCheckoutReq) GetPrimaryShortCode() uint64 {
+	if x != nil {
+		return x.PrimaryShortCode
+	}
+	return 0
+}
+
+func (x *B2BExpressCheckoutReq) GetReceiverShortCode() uint64 {
+	if x != nil {
+		return x.ReceiverShortCode
+	}
+	return 0
+}
+
+func (x *B2BExpressCheckoutReq) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *B2BExpressCheckoutReq) GetPaymentRef() string {
+	if x != nil {
+		return x.PaymentRef
+	}
+	return ""
+}
+
+func (x *B2BExpressCheckoutReq) GetCallbackURL() string {
+	if x != nil {
+		return x.CallbackURL
+	}
+	return ""
+}
+
+func (x *B2BExpressCheckoutReq) GetPartnerName() string {
+	if x != nil {
+		return x.PartnerName
+	}
+	return ""
+}
+
+func (x *B2BExpressCheckoutReq) GetRequestRefID() string {
+	if x != nil {
+		return x.RequestRefID
+	}
+	return ""
+}
+
+type B2CAccountTopUpReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Initiator              string `protobuf:"bytes,1,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	InitiatorPassword      string `protobuf:"bytes,2,opt,name=initiatorPassword,proto3" json:"initiatorPassword,omitempty"`
+	SecurityCredential     string `protobuf:"bytes,3,opt,name=securityCredential,proto3" json:"securityCredential,omitempty"`
+	CommandID              string `protobuf:"bytes,4,opt,name=commandID,proto3" json:"commandID,omitempty"`
+	SenderIdentifierType   uint32 `protobuf:"varint,5,opt,name=senderIdentifierType,proto3" json:"senderIdentifierType,omitempty"`
+	RecieverIdentifierType uint32 `protobuf:"varint,6,opt,name=recieverIdentifierType,proto3" json:"recieverIdentifierType,omitempty"`
+	Amount                 uint64 `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	PartyA                 uint64 `protobuf:"varint,8,opt,name=partyA,proto3" json:"partyA,omitempty"`
+	PartyB                 uint64 `protobuf:"varint,9,opt,name=partyB,proto3" json:"partyB,omitempty"`
+	AccountReference       string `protobuf:"bytes,10,opt,name=accountReference,proto3" json:"accountReference,omitempty"`
+	QueueTimeOutURL        string `protobuf:"bytes,11,opt,name=queueTimeOutURL,proto3" json:"queueTimeOutURL,omitempty"`
+	ResultURL              string `protobuf:"bytes,12,opt,name=resultURL,proto3" json:"resultURL,omitempty"`
+	Remarks                string `protobuf:"bytes,13,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	Requester              uint64 `protobuf:"varint,14,opt,name=requester,proto3" json:"requester,omitempty"`
+}
+
+func (x *B2CAccountTopUpReq) Reset() {
+	*x = B2CAccountTopUpReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_requests_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *B2CAccountTopUpReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*B2CAccountTopUpReq) ProtoMessage() {}
+
+func (x *B2CAccountTopUpReq) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use B2CAccountTopUpReq.ProtoReflect.Descriptor instead.
+func (*B2CAccountTopUpReq) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *B2CAccountTopUpReq) GetInitiator() string {
+	if x != nil {
+		return x.Initiator
+	}
+	return ""
+}
+
+func (x *B2CAccountTopUpReq) GetInitiatorPassword() string {
+	if x != nil {
+		return x.InitiatorPassword
+	}
+	return ""
+}
+
+func (x *B2CAccountTopUpReq) GetSecurityCredential() string {
+	if x != nil {
+		return x.SecurityCredential
+	}
+	return ""
+}
+
+func (x *B2CAccountTopUpReq) GetCommandID() string {
+	if x != nil {
+		return x.CommandID
+	}
+	return ""
+}
+
+func (x *B2CAccountTopUpReq) GetSenderIdentifierType() uint32 {
+	if x != nil {
+		return x.SenderIdentifierType
+	}
+	return 0
+}
+
+func (x *B2CAccountTopUpReq) GetRecieverIdentifierType() uint32 {
+	if x != nil {
+		return x.RecieverIdentifierType
+	}
+	return 0
+}
+
+func (x *B2CAccountTopUpReq) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *B2CAccountTopUpReq) GetPartyA() uint64 {
+	if x != nil {
+		return x.PartyA
+	}
+	return 0
+}
+
+func (x *B2CAccountTopUpReq) GetPartyB() uint64 {
+	if x != nil {
+		return x.PartyB
+	}
+	return 0
+}
+
+func (x *B2CAccountTopUpReq) GetAccountReference() string {
+	if x != nil {
+		return x.AccountReference
+	}
+	return ""
+}
+
+func (x *B2CAccountTopUpReq) GetQueueTimeOutURL() string {
+	if x != nil {
+		return x.QueueTimeOutURL
+	}
+	return ""
+}
+
+func (x *B2CAccountTopUpReq) GetResultURL() string {
+	if x != nil {
+		return x.ResultURL
+	}
+	return ""
+}
+
+func (x *B2CAccountTopUpReq) GetRemarks() string {
+	if x != nil {
+		return x.Remarks
+	}
+	return ""
+}
+
+func (x *B2CAccountTopUpReq) GetRequester() uint64 {
+	if x != nil {
+		return x.Requester
+	}
+	return 0
+}
+
 type B2CPaymentReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OriginatorConversationID string `protobuf:"bytes,1,opt,name=originatorConversationID,proto3" json:"originatorConversationID,omitempty"`
-	CommandID                string `protobuf:"bytes,2,opt,name=commandID,proto3" json:"commandID,omitempty"`
-	PartyA                   uint64 `protobuf:"varint,3,opt,name=partyA,proto3" json:"partyA,omitempty"`
-	PartyB                   uint64 `protobuf:"varint,4,opt,name=partyB,proto3" json:"partyB,omitempty"`
-	Remarks                  string `protobuf:"bytes,5,opt,name=remarks,proto3" json:"remarks,omitempty"`
-	InitiatorName            string `protobuf:"bytes,6,opt,name=initiatorName,proto3" json:"initiatorName,omitempty"`
-	InitiatorPassword        string `protobuf:"bytes,7,opt,name=initiatorPassword,proto3" json:"initiatorPassword,omitempty"`
-	SecurityCredential       string `protobuf:"bytes,8,opt,name=securityCredential,proto3" json:"securityCredential,omitempty"`
-	QueueTimeOutURL          string `protobuf:"bytes,9,opt,name=queueTimeOutURL,proto3" json:"queueTimeOutURL,omitempty"`
-	ResultURL                string `protobuf:"bytes,10,opt,name=resultURL,proto3" json:"resultURL,omitempty"`
-	TransactionID            string `protobuf:"bytes,11,opt,name=transactionID,proto3" json:"transactionID,omitempty"`
-	Occasion                 string `protobuf:"bytes,12,opt,name=occasion,proto3" json:"occasion,omitempty"`
-	Amount                   uint64 `protobuf:"varint,13,opt,name=amount,proto3" json:"amount,omitempty"`
+	OriginatorConversationID string `protobuf:"bytes,1,opt,name=originatorConversationID,proto3" json:"originatorConversationID,omitempty"`
+	CommandID                string `protobuf:"bytes,2,opt,name=commandID,proto3" json:"commandID,omitempty"`
+	PartyA                   uint64 `protobuf:"varint,3,opt,name=partyA,proto3" json:"partyA,omitempty"`
+	PartyB                   uint64 `protobuf:"varint,4,opt,name=partyB,proto3" json:"partyB,omitempty"`
+	Remarks                  string `protobuf:"bytes,5,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	InitiatorName            string `protobuf:"bytes,6,opt,name=initiatorName,proto3" json:"initiatorName,omitempty"`
+	InitiatorPassword        string `protobuf:"bytes,7,opt,name=initiatorPassword,proto3" json:"initiatorPassword,omitempty"`
+	SecurityCredential       string `protobuf:"bytes,8,opt,name=securityCredential,proto3" json:"securityCredential,omitempty"`
+	QueueTimeOutURL          string `protobuf:"bytes,9,opt,name=queueTimeOutURL,proto3" json:"queueTimeOutURL,omitempty"`
+	ResultURL                string `protobuf:"bytes,10,opt,name=resultURL,proto3" json:"resultURL,omitempty"`
+	TransactionID            string `protobuf:"bytes,11,opt,name=transactionID,proto3" json:"transactionID,omitempty"`
+	Occasion                 string `protobuf:"bytes,12,opt,name=occasion,proto3" json:"occasion,omitempty"`
+	Amount                   uint64 `protobuf:"varint,13,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *B2CPaymentReq) Reset() {
+	*x = B2CPaymentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_requests_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *B2CPaymentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*B2CPaymentReq) ProtoMessage() {}
+
+func (x *B2CPaymentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use B2CPaymentReq.ProtoReflect.Descriptor instead.
+func (*B2CPaymentReq) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *B2CPaymentReq) GetOriginatorConversationID() string {
+	if x != nil {
+		return x.OriginatorConversationID
+	}
+	return ""
+}
+
+func (x *B2CPaymentReq) GetCommandID() string {
+	if x != nil {
+		return x.CommandID
+	}
+	return ""
+}
+
+func (x *B2CPaymentReq) GetPartyA() uint64 {
+	if x != nil {
+		return x.PartyA
+	}
+	return 0
+}
+
+func (x *B2CPaymentReq) GetPartyB() uint64 {
+	if x != nil {
+		return x.PartyB
+	}
+	return 0
+}
+
+func (x *B2CPaymentReq) GetRemarks() string {
+	if x != nil {
+		return x.Remarks
+	}
+	return ""
+}
+
+func (x *B2CPaymentReq) GetInitiatorName() string {
+	if x != nil {
+		return x.InitiatorName
+	}
+	return ""
+}
+
+func (x *B2CPaymentReq) GetInitiatorPassword() string {
+	if x != nil {
+		return x.InitiatorPassword
+	}
+	return ""
+}
+
+func (x *B2CPaymentReq) GetSecurityCredential() string {
+	if x != nil {
+		return x.SecurityCredential
+	}
+	return ""
+}
+
+func (x *B2CPaymentReq) GetQueueTimeOutURL() string {
+	if x != nil {
+		return x.QueueTimeOutURL
+	}
+	return ""
+}
+
+func (x *B2CPaymentReq) GetResultURL() string {
+	if x != nil {
+		return x.ResultURL
+	}
+	return ""
+}
+
+func (x *B2CPaymentReq) GetTransactionID() string {
+	if x != nil {
+		return x.TransactionID
+	}
+	return ""
+}
+
+func (x *B2CPaymentReq) GetOccasion() string {
+	if x != nil {
+		return x.Occasion
+	}
+	return ""
+}
+
+func (x *B2CPaymentReq) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type B2PochiReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginatorConversationID string `protobuf:"bytes,1,opt,name=originatorConversationID,proto3" json:"originatorConversationID,omitempty"`
+	InitiatorName            string `protobuf:"bytes,2,opt,name=initiatorName,proto3" json:"initiatorName,omitempty"`
+	InitiatorPassword        string `protobuf:"bytes,3,opt,name=initiatorPassword,proto3" json:"initiatorPassword,omitempty"`
+	SecurityCredential       string `protobuf:"bytes,4,opt,name=securityCredential,proto3" json:"securityCredential,omitempty"`
+	CommandID                string `protobuf:"bytes,5,opt,name=commandID,proto3" json:"commandID,omitempty"`
+	Amount                   uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	PartyA                   uint64 `protobuf:"varint,7,opt,name=partyA,proto3" json:"partyA,omitempty"`
+	PartyB                   uint64 `protobuf:"varint,8,opt,name=partyB,proto3" json:"partyB,omitempty"`
+	Remarks                  string `protobuf:"bytes,9,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	QueueTimeOutURL          string `protobuf:"bytes,10,opt,name=queueTimeOutURL,proto3" json:"queueTimeOutURL,omitempty"`
+	ResultURL                string `protobuf:"bytes,11,opt,name=resultURL,proto3" json:"resultURL,omitempty"`
+	Occasion                 string `protobuf:"bytes,12,opt,name=occasion,proto3" json:"occasion,omitempty"`
+}
+
+func (x *B2PochiReq) Reset() {
+	*x = B2PochiReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_requests_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *B2PochiReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*B2PochiReq) ProtoMessage() {}
+
+func (x *B2PochiReq) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use B2PochiReq.ProtoReflect.Descriptor instead.
+func (*B2PochiReq) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *B2PochiReq) GetOriginatorConversationID() string {
+	if x != nil {
+		return x.OriginatorConversationID
+	}
+	return ""
+}
+
+func (x *B2PochiReq) GetInitiatorName() string {
+	if x != nil {
+		return x.InitiatorName
+	}
+	return ""
+}
+
+func (x *B2PochiReq) GetInitiatorPassword() string {
+	if x != nil {
+		return x.InitiatorPassword
+	}
+	return ""
+}
+
+func (x *B2PochiReq) GetSecurityCredential() string {
+	if x != nil {
+		return x.SecurityCredential
+	}
+	return ""
+}
+
+func (x *B2PochiReq) GetCommandID() string {
+	if x != nil {
+		return x.CommandID
+	}
+	return ""
+}
+
+func (x *B2PochiReq) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *B2PochiReq) GetPartyA() uint64 {
+	if x != nil {
+		return x.PartyA
+	}
+	return 0
+}
+
+func (x *B2PochiReq) GetPartyB() uint64 {
+	if x != nil {
+		return x.PartyB
+	}
+	return 0
+}
+
+func (x *B2PochiReq) GetRemarks() string {
+	if x != nil {
+		return x.Remarks
+	}
+	return ""
+}
+
+func (x *B2PochiReq) GetQueueTimeOutURL() string {
+	if x != nil {
+		return x.QueueTimeOutURL
+	}
+	return ""
+}
+
+func (x *B2PochiReq) GetResultURL() string {
+	if x != nil {
+		return x.ResultURL
+	}
+	return ""
+}
+
+func (x *B2PochiReq) GetOccasion() string {
+	if x != nil {
+		return x.Occasion
+	}
+	return ""
+}
+
+type BillManagerBulkInvoiceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invoices []*BillManagerSingleInvoiceReq `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+}
+
+func (x *BillManagerBulkInvoiceReq) Reset() {
+	*x = BillManagerBulkInvoiceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_requests_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillManagerBulkInvoiceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillManagerBulkInvoiceReq) ProtoMessage() {}
+
+func (x *BillManagerBulkInvoiceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillManagerBulkInvoiceReq.ProtoReflect.Descriptor instead.
+func (*BillManagerBulkInvoiceReq) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BillManagerBulkInvoiceReq) GetInvoices() []*BillManagerSingleInvoiceReq {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+type BillManagerCancelInvoiceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalReferences []string `protobuf:"bytes,1,rep,name=externalReferences,proto3" json:"externalReferences,omitempty"`
+}
+
+func (x *BillManagerCancelInvoiceReq) Reset() {
+	*x = BillManagerCancelInvoiceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_requests_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillManagerCancelInvoiceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillManagerCancelInvoiceReq) ProtoMessage() {}
+
+func (x *BillManagerCancelInvoiceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillManagerCancelInvoiceReq.ProtoReflect.Descriptor instead.
+func (*BillManagerCancelInvoiceReq) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BillManagerCancelInvoiceReq) GetExternalReferences() []string {
+	if x != nil {
+		return x.ExternalReferences
+	}
+	return nil
+}
+
+type BillManagerInvoiceItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemName string `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	Amount   uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *BillManagerInvoiceItem) Reset() {
+	*x = BillManagerInvoiceItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_requests_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillManagerInvoiceItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillManagerInvoiceItem) ProtoMessage() {}
+
+func (x *BillManagerInvoiceItem) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillManagerInvoiceItem.ProtoReflect.Descriptor instead.
+func (*BillManagerInvoiceItem) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BillManagerInvoiceItem) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *BillManagerInvoiceItem) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type BillManagerOptInReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortCode       uint64 `protobuf:"varint,1,opt,name=shortCode,proto3" json:"shortCode,omitempty"`
+	Email           string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	OfficialContact string `protobuf:"bytes,3,opt,name=officialContact,proto3" json:"officialContact,omitempty"`
+	SendReminders   uint32 `protobuf:"varint,4,opt,name=sendReminders,proto3" json:"sendReminders,omitempty"`
+	Logo            string `protobuf:"bytes,5,opt,name=logo,proto3" json:"logo,omitempty"`
+	CallbackURL     string `protobuf:"bytes,6,opt,name=callbackURL,proto3" json:"callbackURL,omitempty"`
 }
 
-func (x *B2CPaymentReq) Reset() {
-	*x = B2CPaymentReq{}
+func (x *BillManagerOptInReq) Reset() {
+	*x = BillManagerOptInReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_requests_proto_msgTypes[1]
+		mi := &file_grpc_requests_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *B2CPaymentReq) String() string {
+func (x *BillManagerOptInReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*B2CPaymentReq) ProtoMessage() {}
+func (*BillManagerOptInReq) ProtoMessage() {}
 
-func (x *B2CPaymentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_requests_proto_msgTypes[1]
+func (x *BillManagerOptInReq) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -178,102 +844,354 @@ func (x *B2CPaymentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use B2CPaymentReq.ProtoReflect.Descriptor instead.
-func (*B2CPaymentReq) Descriptor() ([]byte, []int) {
-	return file_grpc_requests_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use BillManagerOptInReq.ProtoReflect.Descriptor instead.
+func (*BillManagerOptInReq) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *B2CPaymentReq) GetOriginatorConversationID() string {
+func (x *BillManagerOptInReq) GetShortCode() uint64 {
 	if x != nil {
-		return x.OriginatorConversationID
+		return x.ShortCode
+	}
+	return 0
+}
+
+func (x *BillManagerOptInReq) GetEmail() string {
+	if x != nil {
+		return x.Email
 	}
 	return ""
 }
 
-func (x *B2CPaymentReq) GetCommandID() string {
+func (x *BillManagerOptInReq) GetOfficialContact() string {
 	if x != nil {
-		return x.CommandID
+		return x.OfficialContact
 	}
 	return ""
 }
 
-func (x *B2CPaymentReq) GetPartyA() uint64 {
+func (x *BillManagerOptInReq) GetSendReminders() uint32 {
 	if x != nil {
-		return x.PartyA
+		return x.SendReminders
 	}
 	return 0
 }
 
-func (x *B2CPaymentReq) GetPartyB() uint64 {
+func (x *BillManagerOptInReq) GetLogo() string {
 	if x != nil {
-		return x.PartyB
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *BillManagerOptInReq) GetCallbackURL() string {
+	if x != nil {
+		return x.CallbackURL
+	}
+	return ""
+}
+
+type BillManagerReconcileReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentDate       string `protobuf:"bytes,1,opt,name=paymentDate,proto3" json:"paymentDate,omitempty"`
+	PaidAmount        uint64 `protobuf:"varint,2,opt,name=paidAmount,proto3" json:"paidAmount,omitempty"`
+	AccountReference  string `protobuf:"bytes,3,opt,name=accountReference,proto3" json:"accountReference,omitempty"`
+	TransactionID     string `protobuf:"bytes,4,opt,name=transactionID,proto3" json:"transactionID,omitempty"`
+	PhoneNumber       uint64 `protobuf:"varint,5,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	FullName          string `protobuf:"bytes,6,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	InvoiceName       string `protobuf:"bytes,7,opt,name=invoiceName,proto3" json:"invoiceName,omitempty"`
+	ExternalReference string `protobuf:"bytes,8,opt,name=externalReference,proto3" json:"externalReference,omitempty"`
+}
+
+func (x *BillManagerReconcileReq) Reset() {
+	*x = BillManagerReconcileReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_requests_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillManagerReconcileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillManagerReconcileReq) ProtoMessage() {}
+
+func (x *BillManagerReconcileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillManagerReconcileReq.ProtoReflect.Descriptor instead.
+func (*BillManagerReconcileReq) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BillManagerReconcileReq) GetPaymentDate() string {
+	if x != nil {
+		return x.PaymentDate
+	}
+	return ""
+}
+
+func (x *BillManagerReconcileReq) GetPaidAmount() uint64 {
+	if x != nil {
+		return x.PaidAmount
 	}
 	return 0
 }
 
-func (x *B2CPaymentReq) GetRemarks() string {
+func (x *BillManagerReconcileReq) GetAccountReference() string {
 	if x != nil {
-		return x.Remarks
+		return x.AccountReference
 	}
 	return ""
 }
 
-func (x *B2CPaymentReq) GetInitiatorName() string {
+func (x *BillManagerReconcileReq) GetTransactionID() string {
 	if x != nil {
-		return x.InitiatorName
+		return x.TransactionID
 	}
 	return ""
 }
 
-func (x *B2CPaymentReq) GetInitiatorPassword() string {
+func (x *BillManagerReconcileReq) GetPhoneNumber() uint64 {
 	if x != nil {
-		return x.InitiatorPassword
+		return x.PhoneNumber
+	}
+	return 0
+}
+
+func (x *BillManagerReconcileReq) GetFullName() string {
+	if x != nil {
+		return x.FullName
 	}
 	return ""
 }
 
-func (x *B2CPaymentReq) GetSecurityCredential() string {
+func (x *BillManagerReconcileReq) GetInvoiceName() string {
 	if x != nil {
-		return x.SecurityCredential
+		return x.InvoiceName
 	}
 	return ""
 }
 
-func (x *B2CPaymentReq) GetQueueTimeOutURL() string {
+func (x *BillManagerReconcileReq) GetExternalReference() string {
 	if x != nil {
-		return x.QueueTimeOutURL
+		return x.ExternalReference
 	}
 	return ""
 }
 
-func (x *B2CPaymentReq) GetResultURL() string {
+type BillManagerSingleInvoiceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalReference string                    `protobuf:"bytes,1,opt,name=externalReference,proto3" json:"externalReference,omitempty"`
+	BilledFullName    string                    `protobuf:"bytes,2,opt,name=billedFullName,proto3" json:"billedFullName,omitempty"`
+	BilledPhoneNumber uint64                    `protobuf:"varint,3,opt,name=billedPhoneNumber,proto3" json:"billedPhoneNumber,omitempty"`
+	BilledPeriod      string                    `protobuf:"bytes,4,opt,name=billedPeriod,proto3" json:"billedPeriod,omitempty"`
+	InvoiceName       string                    `protobuf:"bytes,5,opt,name=invoiceName,proto3" json:"invoiceName,omitempty"`
+	DueDate           string                    `protobuf:"bytes,6,opt,name=dueDate,proto3" json:"dueDate,omitempty"`
+	AccountReference  string                    `protobuf:"bytes,7,opt,name=accountReference,proto3" json:"accountReference,omitempty"`
+	Amount            uint64                    `protobuf:"varint,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	InvoiceItems      []*BillManagerInvoiceItem `protobuf:"bytes,9,rep,name=invoiceItems,proto3" json:"invoiceItems,omitempty"`
+}
+
+func (x *BillManagerSingleInvoiceReq) Reset() {
+	*x = BillManagerSingleInvoiceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_requests_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillManagerSingleInvoiceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillManagerSingleInvoiceReq) ProtoMessage() {}
+
+func (x *BillManagerSingleInvoiceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillManagerSingleInvoiceReq.ProtoReflect.Descriptor instead.
+func (*BillManagerSingleInvoiceReq) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BillManagerSingleInvoiceReq) GetExternalReference() string {
 	if x != nil {
-		return x.ResultURL
+		return x.ExternalReference
 	}
 	return ""
 }
 
-func (x *B2CPaymentReq) GetTransactionID() string {
+func (x *BillManagerSingleInvoiceReq) GetBilledFullName() string {
 	if x != nil {
-		return x.TransactionID
+		return x.BilledFullName
 	}
 	return ""
 }
 
-func (x *B2CPaymentReq) GetOccasion() string {
+func (x *BillManagerSingleInvoiceReq) GetBilledPhoneNumber() uint64 {
 	if x != nil {
-		return x.Occasion
+		return x.BilledPhoneNumber
+	}
+	return 0
+}
+
+func (x *BillManagerSingleInvoiceReq) GetBilledPeriod() string {
+	if x != nil {
+		return x.BilledPeriod
 	}
 	return ""
 }
 
-func (x *B2CPaymentReq) GetAmount() uint64 {
+func (x *BillManagerSingleInvoiceReq) GetInvoiceName() string {
+	if x != nil {
+		return x.InvoiceName
+	}
+	return ""
+}
+
+func (x *BillManagerSingleInvoiceReq) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *BillManagerSingleInvoiceReq) GetAccountReference() string {
+	if x != nil {
+		return x.AccountReference
+	}
+	return ""
+}
+
+func (x *BillManagerSingleInvoiceReq) GetAmount() uint64 {
 	if x != nil {
 		return x.Amount
 	}
 	return 0
 }
 
+func (x *BillManagerSingleInvoiceReq) GetInvoiceItems() []*BillManagerInvoiceItem {
+	if x != nil {
+		return x.InvoiceItems
+	}
+	return nil
+}
+
+type BillManagerUpdateOptInReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortCode       uint64 `protobuf:"varint,1,opt,name=shortCode,proto3" json:"shortCode,omitempty"`
+	Email           string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	OfficialContact string `protobuf:"bytes,3,opt,name=officialContact,proto3" json:"officialContact,omitempty"`
+	SendReminders   uint32 `protobuf:"varint,4,opt,name=sendReminders,proto3" json:"sendReminders,omitempty"`
+	Logo            string `protobuf:"bytes,5,opt,name=logo,proto3" json:"logo,omitempty"`
+	CallbackURL     string `protobuf:"bytes,6,opt,name=callbackURL,proto3" json:"callbackURL,omitempty"`
+}
+
+func (x *BillManagerUpdateOptInReq) Reset() {
+	*x = BillManagerUpdateOptInReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_requests_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillManagerUpdateOptInReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillManagerUpdateOptInReq) ProtoMessage() {}
+
+func (x *BillManagerUpdateOptInReq) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillManagerUpdateOptInReq.ProtoReflect.Descriptor instead.
+func (*BillManagerUpdateOptInReq) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BillManagerUpdateOptInReq) GetShortCode() uint64 {
+	if x != nil {
+		return x.ShortCode
+	}
+	return 0
+}
+
+func (x *BillManagerUpdateOptInReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BillManagerUpdateOptInReq) GetOfficialContact() string {
+	if x != nil {
+		return x.OfficialContact
+	}
+	return ""
+}
+
+func (x *BillManagerUpdateOptInReq) GetSendReminders() uint32 {
+	if x != nil {
+		return x.SendReminders
+	}
+	return 0
+}
+
+func (x *BillManagerUpdateOptInReq) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *BillManagerUpdateOptInReq) GetCallbackURL() string {
+	if x != nil {
+		return x.CallbackURL
+	}
+	return ""
+}
+
 type BusinessPayBillReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -298,7 +1216,7 @@ type BusinessPayBillReq struct {
 func (x *BusinessPayBillReq) Reset() {
 	*x = BusinessPayBillReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_requests_proto_msgTypes[2]
+		mi := &file_grpc_requests_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -311,7 +1229,7 @@ func (x *BusinessPayBillReq) String() string {
 func (*BusinessPayBillReq) ProtoMessage() {}
 
 func (x *BusinessPayBillReq) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_requests_proto_msgTypes[2]
+	mi := &file_grpc_requests_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,7 +1242,7 @@ func (x *BusinessPayBillReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BusinessPayBillReq.ProtoReflect.Descriptor instead.
 func (*BusinessPayBillReq) Descriptor() ([]byte, []int) {
-	return file_grpc_requests_proto_rawDescGZIP(), []int{2}
+	return file_grpc_requests_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *BusinessPayBillReq) GetInitiator() string {
@@ -439,7 +1357,7 @@ type C2BRegisterURLReq struct {
 func (x *C2BRegisterURLReq) Reset() {
 	*x = C2BRegisterURLReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_requests_proto_msgTypes[3]
+		mi := &file_grpc_requests_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -452,7 +1370,7 @@ func (x *C2BRegisterURLReq) String() string {
 func (*C2BRegisterURLReq) ProtoMessage() {}
 
 func (x *C2BRegisterURLReq) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_requests_proto_msgTypes[3]
+	mi := &file_grpc_requests_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +1383,7 @@ func (x *C2BRegisterURLReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2BRegisterURLReq.ProtoReflect.Descriptor instead.
 func (*C2BRegisterURLReq) Descriptor() ([]byte, []int) {
-	return file_grpc_requests_proto_rawDescGZIP(), []int{3}
+	return file_grpc_requests_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *C2BRegisterURLReq) GetValidationURL() string {
@@ -511,7 +1429,7 @@ type C2BSimulateReq struct {
 func (x *C2BSimulateReq) Reset() {
 	*x = C2BSimulateReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_requests_proto_msgTypes[4]
+		mi := &file_grpc_requests_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -524,7 +1442,7 @@ func (x *C2BSimulateReq) String() string {
 func (*C2BSimulateReq) ProtoMessage() {}
 
 func (x *C2BSimulateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_requests_proto_msgTypes[4]
+	mi := &file_grpc_requests_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +1455,7 @@ func (x *C2BSimulateReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2BSimulateReq.ProtoReflect.Descriptor instead.
 func (*C2BSimulateReq) Descriptor() ([]byte, []int) {
-	return file_grpc_requests_proto_rawDescGZIP(), []int{4}
+	return file_grpc_requests_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *C2BSimulateReq) GetCommandID() string {
@@ -590,7 +1508,7 @@ type ExpressQueryReq struct {
 func (x *ExpressQueryReq) Reset() {
 	*x = ExpressQueryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_requests_proto_msgTypes[5]
+		mi := &file_grpc_requests_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -603,7 +1521,7 @@ func (x *ExpressQueryReq) String() string {
 func (*ExpressQueryReq) ProtoMessage() {}
 
 func (x *ExpressQueryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_requests_proto_msgTypes[5]
+	mi := &file_grpc_requests_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,7 +1534,7 @@ func (x *ExpressQueryReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExpressQueryReq.ProtoReflect.Descriptor instead.
 func (*ExpressQueryReq) Descriptor() ([]byte, []int) {
-	return file_grpc_requests_proto_rawDescGZIP(), []int{5}
+	return file_grpc_requests_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ExpressQueryReq) GetPassKey() string {
@@ -676,7 +1594,7 @@ type ExpressSimulateReq struct {
 func (x *ExpressSimulateReq) Reset() {
 	*x = ExpressSimulateReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_requests_proto_msgTypes[6]
+		mi := &file_grpc_requests_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -689,7 +1607,7 @@ func (x *ExpressSimulateReq) String() string {
 func (*ExpressSimulateReq) ProtoMessage() {}
 
 func (x *ExpressSimulateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_requests_proto_msgTypes[6]
+	mi := &file_grpc_requests_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +1620,7 @@ func (x *ExpressSimulateReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExpressSimulateReq.ProtoReflect.Descriptor instead.
 func (*ExpressSimulateReq) Descriptor() ([]byte, []int) {
-	return file_grpc_requests_proto_rawDescGZIP(), []int{6}
+	return file_grpc_requests_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ExpressSimulateReq) GetPassKey() string {
@@ -805,7 +1723,7 @@ type GenerateQRReq struct {
 func (x *GenerateQRReq) Reset() {
 	*x = GenerateQRReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_requests_proto_msgTypes[7]
+		mi := &file_grpc_requests_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -818,7 +1736,7 @@ func (x *GenerateQRReq) String() string {
 func (*GenerateQRReq) ProtoMessage() {}
 
 func (x *GenerateQRReq) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_requests_proto_msgTypes[7]
+	mi := &file_grpc_requests_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,7 +1749,7 @@ func (x *GenerateQRReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateQRReq.ProtoReflect.Descriptor instead.
 func (*GenerateQRReq) Descriptor() ([]byte, []int) {
-	return file_grpc_requests_proto_rawDescGZIP(), []int{7}
+	return file_grpc_requests_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GenerateQRReq) GetMerchantName() string {
@@ -876,6 +1794,148 @@ func (x *GenerateQRReq) GetSize() string {
 	return ""
 }
 
+type PullTransactionsQueryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortCode   uint64 `protobuf:"varint,1,opt,name=shortCode,proto3" json:"shortCode,omitempty"`
+	StartDate   string `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate     string `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	OffSetValue uint64 `protobuf:"varint,4,opt,name=offSetValue,proto3" json:"offSetValue,omitempty"`
+}
+
+func (x *PullTransactionsQueryReq) Reset() {
+	*x = PullTransactionsQueryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_requests_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullTransactionsQueryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullTransactionsQueryReq) ProtoMessage() {}
+
+func (x *PullTransactionsQueryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullTransactionsQueryReq.ProtoReflect.Descriptor instead.
+func (*PullTransactionsQueryReq) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PullTransactionsQueryReq) GetShortCode() uint64 {
+	if x != nil {
+		return x.ShortCode
+	}
+	return 0
+}
+
+func (x *PullTransactionsQueryReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *PullTransactionsQueryReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *PullTransactionsQueryReq) GetOffSetValue() uint64 {
+	if x != nil {
+		return x.OffSetValue
+	}
+	return 0
+}
+
+type PullTransactionsRegisterReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortCode       uint64 `protobuf:"varint,1,opt,name=shortCode,proto3" json:"shortCode,omitempty"`
+	RequestType     string `protobuf:"bytes,2,opt,name=requestType,proto3" json:"requestType,omitempty"`
+	NominatedNumber string `protobuf:"bytes,3,opt,name=nominatedNumber,proto3" json:"nominatedNumber,omitempty"`
+	CallBackURL     string `protobuf:"bytes,4,opt,name=callBackURL,proto3" json:"callBackURL,omitempty"`
+}
+
+func (x *PullTransactionsRegisterReq) Reset() {
+	*x = PullTransactionsRegisterReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_requests_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullTransactionsRegisterReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullTransactionsRegisterReq) ProtoMessage() {}
+
+func (x *PullTransactionsRegisterReq) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullTransactionsRegisterReq.ProtoReflect.Descriptor instead.
+func (*PullTransactionsRegisterReq) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PullTransactionsRegisterReq) GetShortCode() uint64 {
+	if x != nil {
+		return x.ShortCode
+	}
+	return 0
+}
+
+func (x *PullTransactionsRegisterReq) GetRequestType() string {
+	if x != nil {
+		return x.RequestType
+	}
+	return ""
+}
+
+func (x *PullTransactionsRegisterReq) GetNominatedNumber() string {
+	if x != nil {
+		return x.NominatedNumber
+	}
+	return ""
+}
+
+func (x *PullTransactionsRegisterReq) GetCallBackURL() string {
+	if x != nil {
+		return x.CallBackURL
+	}
+	return ""
+}
+
 type RemitTaxReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -899,7 +1959,7 @@ type RemitTaxReq struct {
 func (x *RemitTaxReq) Reset() {
 	*x = RemitTaxReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_requests_proto_msgTypes[8]
+		mi := &file_grpc_requests_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -912,7 +1972,7 @@ func (x *RemitTaxReq) String() string {
 func (*RemitTaxReq) ProtoMessage() {}
 
 func (x *RemitTaxReq) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_requests_proto_msgTypes[8]
+	mi := &file_grpc_requests_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -925,7 +1985,7 @@ func (x *RemitTaxReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemitTaxReq.ProtoReflect.Descriptor instead.
 func (*RemitTaxReq) Descriptor() ([]byte, []int) {
-	return file_grpc_requests_proto_rawDescGZIP(), []int{8}
+	return file_grpc_requests_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RemitTaxReq) GetInitiatorName() string {
@@ -1041,7 +2101,7 @@ type ReverseReq struct {
 func (x *ReverseReq) Reset() {
 	*x = ReverseReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_requests_proto_msgTypes[9]
+		mi := &file_grpc_requests_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1054,7 +2114,7 @@ func (x *ReverseReq) String() string {
 func (*ReverseReq) ProtoMessage() {}
 
 func (x *ReverseReq) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_requests_proto_msgTypes[9]
+	mi := &file_grpc_requests_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,91 +2127,273 @@ func (x *ReverseReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReverseReq.ProtoReflect.Descriptor instead.
 func (*ReverseReq) Descriptor() ([]byte, []int) {
-	return file_grpc_requests_proto_rawDescGZIP(), []int{9}
+	return file_grpc_requests_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReverseReq) GetCommandID() string {
+	if x != nil {
+		return x.CommandID
+	}
+	return ""
+}
+
+func (x *ReverseReq) GetReceiverParty() uint64 {
+	if x != nil {
+		return x.ReceiverParty
+	}
+	return 0
+}
+
+func (x *ReverseReq) GetRecieverIdentifierType() uint32 {
+	if x != nil {
+		return x.RecieverIdentifierType
+	}
+	return 0
+}
+
+func (x *ReverseReq) GetRemarks() string {
+	if x != nil {
+		return x.Remarks
+	}
+	return ""
+}
+
+func (x *ReverseReq) GetInitiatorName() string {
+	if x != nil {
+		return x.InitiatorName
+	}
+	return ""
+}
+
+func (x *ReverseReq) GetInitiatorPassword() string {
+	if x != nil {
+		return x.InitiatorPassword
+	}
+	return ""
+}
+
+func (x *ReverseReq) GetSecurityCredential() string {
+	if x != nil {
+		return x.SecurityCredential
+	}
+	return ""
+}
+
+func (x *ReverseReq) GetQueueTimeOutURL() string {
+	if x != nil {
+		return x.QueueTimeOutURL
+	}
+	return ""
+}
+
+func (x *ReverseReq) GetResultURL() string {
+	if x != nil {
+		return x.ResultURL
+	}
+	return ""
+}
+
+func (x *ReverseReq) GetOccasion() string {
+	if x != nil {
+		return x.Occasion
+	}
+	return ""
+}
+
+func (x *ReverseReq) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReverseReq) GetTransactionID() string {
+	if x != nil {
+		return x.TransactionID
+	}
+	return ""
+}
+
+type SIMSwapReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerNumber uint64 `protobuf:"varint,1,opt,name=customerNumber,proto3" json:"customerNumber,omitempty"`
+}
+
+func (x *SIMSwapReq) Reset() {
+	*x = SIMSwapReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_requests_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SIMSwapReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SIMSwapReq) ProtoMessage() {}
+
+func (x *SIMSwapReq) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SIMSwapReq.ProtoReflect.Descriptor instead.
+func (*SIMSwapReq) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SIMSwapReq) GetCustomerNumber() uint64 {
+	if x != nil {
+		return x.CustomerNumber
+	}
+	return 0
+}
+
+type StandingOrderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StandingOrderName           string `protobuf:"bytes,1,opt,name=standingOrderName,proto3" json:"standingOrderName,omitempty"`
+	StartDate                   string `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate                     string `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	BusinessShortCode           uint64 `protobuf:"varint,4,opt,name=businessShortCode,proto3" json:"businessShortCode,omitempty"`
+	TransactionType             string `protobuf:"bytes,5,opt,name=transactionType,proto3" json:"transactionType,omitempty"`
+	ReceiverPartyIdentifierType uint32 `protobuf:"varint,6,opt,name=receiverPartyIdentifierType,proto3" json:"receiverPartyIdentifierType,omitempty"`
+	Amount                      uint64 `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	PartyA                      uint64 `protobuf:"varint,8,opt,name=partyA,proto3" json:"partyA,omitempty"`
+	CallBackURL                 string `protobuf:"bytes,9,opt,name=callBackURL,proto3" json:"callBackURL,omitempty"`
+	AccountReference            string `protobuf:"bytes,10,opt,name=accountReference,proto3" json:"accountReference,omitempty"`
+	TransactionDesc             string `protobuf:"bytes,11,opt,name=transactionDesc,proto3" json:"transactionDesc,omitempty"`
+	Frequency                   uint32 `protobuf:"varint,12,opt,name=frequency,proto3" json:"frequency,omitempty"`
+}
+
+func (x *StandingOrderReq) Reset() {
+	*x = StandingOrderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_requests_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StandingOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingOrderReq) ProtoMessage() {}
+
+func (x *StandingOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_requests_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingOrderReq.ProtoReflect.Descriptor instead.
+func (*StandingOrderReq) Descriptor() ([]byte, []int) {
+	return file_grpc_requests_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ReverseReq) GetCommandID() string {
+func (x *StandingOrderReq) GetStandingOrderName() string {
 	if x != nil {
-		return x.CommandID
+		return x.StandingOrderName
 	}
 	return ""
 }
 
-func (x *ReverseReq) GetReceiverParty() uint64 {
+func (x *StandingOrderReq) GetStartDate() string {
 	if x != nil {
-		return x.ReceiverParty
+		return x.StartDate
 	}
-	return 0
+	return ""
 }
 
-func (x *ReverseReq) GetRecieverIdentifierType() uint32 {
+func (x *StandingOrderReq) GetEndDate() string {
 	if x != nil {
-		return x.RecieverIdentifierType
+		return x.EndDate
 	}
-	return 0
+	return ""
 }
 
-func (x *ReverseReq) GetRemarks() string {
+func (x *StandingOrderReq) GetBusinessShortCode() uint64 {
 	if x != nil {
-		return x.Remarks
+		return x.BusinessShortCode
 	}
-	return ""
+	return 0
 }
 
-func (x *ReverseReq) GetInitiatorName() string {
+func (x *StandingOrderReq) GetTransactionType() string {
 	if x != nil {
-		return x.InitiatorName
+		return x.TransactionType
 	}
 	return ""
 }
 
-func (x *ReverseReq) GetInitiatorPassword() string {
+func (x *StandingOrderReq) GetReceiverPartyIdentifierType() uint32 {
 	if x != nil {
-		return x.InitiatorPassword
+		return x.ReceiverPartyIdentifierType
 	}
-	return ""
+	return 0
 }
 
-func (x *ReverseReq) GetSecurityCredential() string {
+func (x *StandingOrderReq) GetAmount() uint64 {
 	if x != nil {
-		return x.SecurityCredential
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *ReverseReq) GetQueueTimeOutURL() string {
+func (x *StandingOrderReq) GetPartyA() uint64 {
 	if x != nil {
-		return x.QueueTimeOutURL
+		return x.PartyA
 	}
-	return ""
+	return 0
 }
 
-func (x *ReverseReq) GetResultURL() string {
+func (x *StandingOrderReq) GetCallBackURL() string {
 	if x != nil {
-		return x.ResultURL
+		return x.CallBackURL
 	}
 	return ""
 }
 
-func (x *ReverseReq) GetOccasion() string {
+func (x *StandingOrderReq) GetAccountReference() string {
 	if x != nil {
-		return x.Occasion
+		return x.AccountReference
 	}
 	return ""
 }
 
-func (x *ReverseReq) GetAmount() uint64 {
+func (x *StandingOrderReq) GetTransactionDesc() string {
 	if x != nil {
-		return x.Amount
+		return x.TransactionDesc
 	}
-	return 0
+	return ""
 }
 
-func (x *ReverseReq) GetTransactionID() string {
+func (x *StandingOrderReq) GetFrequency() uint32 {
 	if x != nil {
-		return x.TransactionID
+		return x.Frequency
 	}
-	return ""
+	return 0
 }
 
 type TransactionStatusReq struct {
@@ -1159,23 +2401,24 @@ type TransactionStatusReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommandID          string `protobuf:"bytes,1,opt,name=commandID,proto3" json:"commandID,omitempty"`
-	PartyA             uint64 `protobuf:"varint,2,opt,name=partyA,proto3" json:"partyA,omitempty"`
-	IdentifierType     uint32 `protobuf:"varint,3,opt,name=identifierType,proto3" json:"identifierType,omitempty"`
-	Remarks            string `protobuf:"bytes,4,opt,name=remarks,proto3" json:"remarks,omitempty"`
-	InitiatorName      string `protobuf:"bytes,5,opt,name=initiatorName,proto3" json:"initiatorName,omitempty"`
-	InitiatorPassword  string `protobuf:"bytes,6,opt,name=initiatorPassword,proto3" json:"initiatorPassword,omitempty"`
-	SecurityCredential string `protobuf:"bytes,7,opt,name=securityCredential,proto3" json:"securityCredential,omitempty"`
-	QueueTimeOutURL    string `protobuf:"bytes,8,opt,name=queueTimeOutURL,proto3" json:"queueTimeOutURL,omitempty"`
-	ResultURL          string `protobuf:"bytes,9,opt,name=resultURL,proto3" json:"resultURL,omitempty"`
-	TransactionID      string `protobuf:"bytes,10,opt,name=transactionID,proto3" json:"transactionID,omitempty"`
-	Occasion           string `protobuf:"bytes,11,opt,name=occasion,proto3" json:"occasion,omitempty"`
+	CommandID                string `protobuf:"bytes,1,opt,name=commandID,proto3" json:"commandID,omitempty"`
+	PartyA                   uint64 `protobuf:"varint,2,opt,name=partyA,proto3" json:"partyA,omitempty"`
+	IdentifierType           uint32 `protobuf:"varint,3,opt,name=identifierType,proto3" json:"identifierType,omitempty"`
+	Remarks                  string `protobuf:"bytes,4,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	InitiatorName            string `protobuf:"bytes,5,opt,name=initiatorName,proto3" json:"initiatorName,omitempty"`
+	InitiatorPassword        string `protobuf:"bytes,6,opt,name=initiatorPassword,proto3" json:"initiatorPassword,omitempty"`
+	SecurityCredential       string `protobuf:"bytes,7,opt,name=securityCredential,proto3" json:"securityCredential,omitempty"`
+	QueueTimeOutURL          string `protobuf:"bytes,8,opt,name=queueTimeOutURL,proto3" json:"queueTimeOutURL,omitempty"`
+	ResultURL                string `protobuf:"bytes,9,opt,name=resultURL,proto3" json:"resultURL,omitempty"`
+	TransactionID            string `protobuf:"bytes,10,opt,name=transactionID,proto3" json:"transactionID,omitempty"`
+	Occasion                 string `protobuf:"bytes,11,opt,name=occasion,proto3" json:"occasion,omitempty"`
+	OriginatorConversationID string `protobuf:"bytes,12,opt,name=originatorConversationID,proto3" json:"originatorConversationID,omitempty"`
 }
 
 func (x *TransactionStatusReq) Reset() {
 	*x = TransactionStatusReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_requests_proto_msgTypes[10]
+		mi := &file_grpc_requests_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1188,7 +2431,7 @@ func (x *TransactionStatusReq) String() string {
 func (*TransactionStatusReq) ProtoMessage() {}
 
 func (x *TransactionStatusReq) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_requests_proto_msgTypes[10]
+	mi := &file_grpc_requests_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1201,7 +2444,7 @@ func (x *TransactionStatusReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionStatusReq.ProtoReflect.Descriptor instead.
 func (*TransactionStatusReq) Descriptor() ([]byte, []int) {
-	return file_grpc_requests_proto_rawDescGZIP(), []int{10}
+	return file_grpc_requests_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *TransactionStatusReq) GetCommandID() string {
@@ -1281,6 +2524,13 @@ func (x *TransactionStatusReq) GetOccasion() string {
 	return ""
 }
 
+func (x *TransactionStatusReq) GetOriginatorConversationID() string {
+	if x != nil {
+		return x.OriginatorConversationID
+	}
+	return ""
+}
+
 var File_grpc_requests_proto protoreflect.FileDescriptor
 
 var file_grpc_requests_proto_rawDesc = []byte{
@@ -1308,226 +2558,449 @@ var file_grpc_requests_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75,
 	0x74, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x52,
 	0x4c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55,
-	0x52, 0x4c, 0x22, 0xd9, 0x03, 0x0a, 0x0d, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x12, 0x3a, 0x0a, 0x18, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x52, 0x4c, 0x22, 0x91, 0x02, 0x0a, 0x15, 0x42, 0x32, 0x42, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2a, 0x0a, 0x10,
+	0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x66,
+	0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x66, 0x49, 0x44, 0x22, 0x8e, 0x04, 0x0a, 0x12, 0x42, 0x32, 0x43, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x14, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x72,
+	0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x72, 0x65, 0x63,
+	0x69, 0x65, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x41, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x41, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x12, 0x2a, 0x0a, 0x10, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x55, 0x52,
+	0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x52, 0x4c, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x22, 0xd9, 0x03, 0x0a, 0x0d, 0x42, 0x32, 0x43, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x3a, 0x0a, 0x18, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x42, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75,
+	0x74, 0x55, 0x52, 0x4c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xb0, 0x03, 0x0a, 0x0a, 0x42, 0x32, 0x50, 0x6f, 0x63, 0x68, 0x69, 0x52,
+	0x65, 0x71, 0x12, 0x3a, 0x0a, 0x18, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x24,
+	0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x41, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f,
+	0x75, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x63,
+	0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x63,
+	0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x67, 0x0a, 0x19, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x4a, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65,
+	0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x22,
+	0x4d, 0x0a, 0x1b, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2e,
+	0x0a, 0x12, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x4c,
+	0x0a, 0x16, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcf, 0x01, 0x0a,
+	0x13, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x49,
+	0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x66, 0x66, 0x69,
+	0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x6f, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x22, 0xbb,
+	0x02, 0x0a, 0x17, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x70, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c,
+	0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x94, 0x03, 0x0a,
+	0x1b, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x11,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x62, 0x69,
+	0x6c, 0x6c, 0x65, 0x64, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x62, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x62, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x68, 0x6f, 0x6e,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x62,
+	0x69, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x22, 0x0a, 0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x2a, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x19, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x49, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61,
+	0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x69,
+	0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x6f, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x22, 0x8e, 0x04, 0x0a, 0x12,
+	0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79, 0x42, 0x69, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2e,
+	0x0a, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x14,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x36, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x16, 0x72, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x42, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42,
+	0x12, 0x2a, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x55, 0x52, 0x4c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x55, 0x52, 0x4c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x22, 0xa5, 0x01, 0x0a,
+	0x11, 0x43, 0x32, 0x42, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x52,
+	0x65, 0x71, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x4c, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x0e, 0x43, 0x32, 0x42, 0x53, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x12, 0x24, 0x0a,
+	0x0d, 0x62, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x0f, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x61, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x62, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x53, 0x68, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x2c, 0x0a, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x22, 0xa2, 0x03,
+	0x0a, 0x12, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x2c,
+	0x0a, 0x11, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x62, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x41, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x41, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61,
+	0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x12, 0x2a, 0x0a, 0x10,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x73, 0x63, 0x22, 0xa1, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51,
+	0x52, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x72, 0x63,
+	0x68, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x66, 0x4e,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x66, 0x4e, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x78, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x78, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x63, 0x50, 0x49, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63,
+	0x50, 0x49, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x18, 0x50, 0x75, 0x6c, 0x6c, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x66, 0x66,
+	0x53, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x6f, 0x66, 0x66, 0x53, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x1b,
+	0x50, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6e,
+	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63,
+	0x6b, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c,
+	0x42, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x22, 0xf1, 0x03, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x69,
+	0x74, 0x54, 0x61, 0x78, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a,
+	0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x14, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a,
+	0x16, 0x72, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x72,
+	0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x41, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x12, 0x2a, 0x0a,
+	0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74,
+	0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x52, 0x4c,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x52,
+	0x4c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0xc8, 0x03, 0x0a, 0x0a,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x12, 0x36,
+	0x0a, 0x16, 0x72, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16,
+	0x72, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x34, 0x0a, 0x0a, 0x53, 0x49, 0x4d, 0x53, 0x77, 0x61,
+	0x70, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xd8, 0x03, 0x0a,
+	0x10, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x62, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x53, 0x68, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x40, 0x0a, 0x1b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x41, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x41, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b,
+	0x55, 0x52, 0x4c, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x73, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xd8, 0x03, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x12, 0x18,
-	0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x69, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c,
 	0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69,
 	0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2e, 0x0a, 0x12,
 	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
 	0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f,
 	0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65,
 	0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x55, 0x52, 0x4c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x55, 0x52, 0x4c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c,
 	0x74, 0x55, 0x52, 0x4c, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61,
 	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x63,
-	0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x63,
-	0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8e,
-	0x04, 0x0a, 0x12, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79, 0x42, 0x69,
-	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x12,
-	0x32, 0x0a, 0x14, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x16, 0x72, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x12, 0x16, 0x0a, 0x06, 0x70,
-	0x61, 0x72, 0x74, 0x79, 0x42, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x74, 0x79, 0x42, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x28, 0x0a, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x55,
-	0x52, 0x4c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x22,
-	0xa5, 0x01, 0x0a, 0x11, 0x43, 0x32, 0x42, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55,
-	0x52, 0x4c, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x4c, 0x12, 0x28, 0x0a, 0x0f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x4c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f,
-	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x0e, 0x43, 0x32, 0x42, 0x53,
-	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x69, 0x73,
-	0x64, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e,
-	0x12, 0x24, 0x0a, 0x0d, 0x62, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x66,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xc1, 0x01, 0x0a,
-	0x0f, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x70, 0x61, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x62, 0x75,
-	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x53,
-	0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
-	0x22, 0xa2, 0x03, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53, 0x69, 0x6d, 0x75,
-	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x73, 0x73, 0x4b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x73, 0x73, 0x4b, 0x65,
-	0x79, 0x12, 0x2c, 0x0a, 0x11, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x62, 0x75,
-	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
-	0x61, 0x72, 0x74, 0x79, 0x41, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x12, 0x20, 0x0a,
-	0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x12,
-	0x2a, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x65, 0x73, 0x63, 0x22, 0xa1, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x51, 0x52, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x63, 0x68,
-	0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d,
-	0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72,
-	0x65, 0x66, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x66, 0x4e,
-	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x78,
-	0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x78, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x50, 0x49, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x63, 0x50, 0x49, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xf1, 0x03, 0x0a, 0x0b, 0x52, 0x65,
-	0x6d, 0x69, 0x74, 0x54, 0x61, 0x78, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2e, 0x0a,
-	0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72,
-	0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x14, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x36, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x16, 0x72, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79,
-	0x42, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x12,
-	0x2a, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x71,
-	0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f,
-	0x75, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55,
-	0x52, 0x4c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x55, 0x52, 0x4c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0xc8, 0x03,
-	0x0a, 0x0a, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79,
-	0x12, 0x36, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x16, 0x72, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61,
-	0x72, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c,
-	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x1a,
-	0x0a, 0x08, 0x6f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x9c, 0x03, 0x0a, 0x14, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2e, 0x0a,
-	0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72,
-	0x69, 0x74, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x28, 0x0a,
-	0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x4f, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x55, 0x52, 0x4c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
-	0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
-	0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x5a, 0x06, 0x2e, 0x2f, 0x67, 0x72, 0x70,
-	0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x63,
+	0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x18, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x42, 0x08, 0x5a, 0x06, 0x2e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1542,26 +3015,42 @@ func file_grpc_requests_proto_rawDescGZIP() []byte {
 	return file_grpc_requests_proto_rawDescData
 }
 
-var file_grpc_requests_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_grpc_requests_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_grpc_requests_proto_goTypes = []interface{}{
-	(*AccountBalanceReq)(nil),    // 0: mpesaoverlay.grpc.AccountBalanceReq
-	(*B2CPaymentReq)(nil),        // 1: mpesaoverlay.grpc.B2CPaymentReq
-	(*BusinessPayBillReq)(nil),   // 2: mpesaoverlay.grpc.BusinessPayBillReq
-	(*C2BRegisterURLReq)(nil),    // 3: mpesaoverlay.grpc.C2BRegisterURLReq
-	(*C2BSimulateReq)(nil),       // 4: mpesaoverlay.grpc.C2BSimulateReq
-	(*ExpressQueryReq)(nil),      // 5: mpesaoverlay.grpc.ExpressQueryReq
-	(*ExpressSimulateReq)(nil),   // 6: mpesaoverlay.grpc.ExpressSimulateReq
-	(*GenerateQRReq)(nil),        // 7: mpesaoverlay.grpc.GenerateQRReq
-	(*RemitTaxReq)(nil),          // 8: mpesaoverlay.grpc.RemitTaxReq
-	(*ReverseReq)(nil),           // 9: mpesaoverlay.grpc.ReverseReq
-	(*TransactionStatusReq)(nil), // 10: mpesaoverlay.grpc.TransactionStatusReq
+	(*AccountBalanceReq)(nil),           // 0: mpesaoverlay.grpc.AccountBalanceReq
+	(*B2BExpressCheckoutReq)(nil),       // 1: mpesaoverlay.grpc.B2BExpressCheckoutReq
+	(*B2CAccountTopUpReq)(nil),          // 2: mpesaoverlay.grpc.B2CAccountTopUpReq
+	(*B2CPaymentReq)(nil),               // 3: mpesaoverlay.grpc.B2CPaymentReq
+	(*B2PochiReq)(nil),                  // 4: mpesaoverlay.grpc.B2PochiReq
+	(*BillManagerBulkInvoiceReq)(nil),   // 5: mpesaoverlay.grpc.BillManagerBulkInvoiceReq
+	(*BillManagerCancelInvoiceReq)(nil), // 6: mpesaoverlay.grpc.BillManagerCancelInvoiceReq
+	(*BillManagerInvoiceItem)(nil),      // 7: mpesaoverlay.grpc.BillManagerInvoiceItem
+	(*BillManagerOptInReq)(nil),         // 8: mpesaoverlay.grpc.BillManagerOptInReq
+	(*BillManagerReconcileReq)(nil),     // 9: mpesaoverlay.grpc.BillManagerReconcileReq
+	(*BillManagerSingleInvoiceReq)(nil), // 10: mpesaoverlay.grpc.BillManagerSingleInvoiceReq
+	(*BillManagerUpdateOptInReq)(nil),   // 11: mpesaoverlay.grpc.BillManagerUpdateOptInReq
+	(*BusinessPayBillReq)(nil),          // 12: mpesaoverlay.grpc.BusinessPayBillReq
+	(*C2BRegisterURLReq)(nil),           // 13: mpesaoverlay.grpc.C2BRegisterURLReq
+	(*C2BSimulateReq)(nil),              // 14: mpesaoverlay.grpc.C2BSimulateReq
+	(*ExpressQueryReq)(nil),             // 15: mpesaoverlay.grpc.ExpressQueryReq
+	(*ExpressSimulateReq)(nil),          // 16: mpesaoverlay.grpc.ExpressSimulateReq
+	(*GenerateQRReq)(nil),               // 17: mpesaoverlay.grpc.GenerateQRReq
+	(*PullTransactionsQueryReq)(nil),    // 18: mpesaoverlay.grpc.PullTransactionsQueryReq
+	(*PullTransactionsRegisterReq)(nil), // 19: mpesaoverlay.grpc.PullTransactionsRegisterReq
+	(*RemitTaxReq)(nil),                 // 20: mpesaoverlay.grpc.RemitTaxReq
+	(*ReverseReq)(nil),                  // 21: mpesaoverlay.grpc.ReverseReq
+	(*SIMSwapReq)(nil),                  // 22: mpesaoverlay.grpc.SIMSwapReq
+	(*StandingOrderReq)(nil),            // 23: mpesaoverlay.grpc.StandingOrderReq
+	(*TransactionStatusReq)(nil),        // 24: mpesaoverlay.grpc.TransactionStatusReq
 }
 var file_grpc_requests_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	10, // 0: mpesaoverlay.grpc.BillManagerBulkInvoiceReq.invoices:type_name -> mpesaoverlay.grpc.BillManagerSingleInvoiceReq
+	7,  // 1: mpesaoverlay.grpc.BillManagerSingleInvoiceReq.invoiceItems:type_name -> mpesaoverlay.grpc.BillManagerInvoiceItem
+	2,  // [2:2] is the sub-list for method output_type
+	2,  // [2:2] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_grpc_requests_proto_init() }
@@ -1583,7 +3072,7 @@ func file_grpc_requests_proto_init() {
 			}
 		}
 		file_grpc_requests_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*B2CPaymentReq); i {
+			switch v := v.(*B2BExpressCheckoutReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1595,7 +3084,7 @@ func file_grpc_requests_proto_init() {
 			}
 		}
 		file_grpc_requests_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BusinessPayBillReq); i {
+			switch v := v.(*B2CAccountTopUpReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1607,7 +3096,7 @@ func file_grpc_requests_proto_init() {
 			}
 		}
 		file_grpc_requests_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2BRegisterURLReq); i {
+			switch v := v.(*B2CPaymentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1619,7 +3108,7 @@ func file_grpc_requests_proto_init() {
 			}
 		}
 		file_grpc_requests_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2BSimulateReq); i {
+			switch v := v.(*B2PochiReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1631,7 +3120,7 @@ func file_grpc_requests_proto_init() {
 			}
 		}
 		file_grpc_requests_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExpressQueryReq); i {
+			switch v := v.(*BillManagerBulkInvoiceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1643,7 +3132,7 @@ func file_grpc_requests_proto_init() {
 			}
 		}
 		file_grpc_requests_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExpressSimulateReq); i {
+			switch v := v.(*BillManagerCancelInvoiceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1655,7 +3144,7 @@ func file_grpc_requests_proto_init() {
 			}
 		}
 		file_grpc_requests_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateQRReq); i {
+			switch v := v.(*BillManagerInvoiceItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1667,7 +3156,7 @@ func file_grpc_requests_proto_init() {
 			}
 		}
 		file_grpc_requests_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemitTaxReq); i {
+			switch v := v.(*BillManagerOptInReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1679,7 +3168,7 @@ func file_grpc_requests_proto_init() {
 			}
 		}
 		file_grpc_requests_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReverseReq); i {
+			switch v := v.(*BillManagerReconcileReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1691,6 +3180,174 @@ func file_grpc_requests_proto_init() {
 			}
 		}
 		file_grpc_requests_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BillManagerSingleInvoiceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_requests_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BillManagerUpdateOptInReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_requests_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BusinessPayBillReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_requests_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2BRegisterURLReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_requests_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2BSimulateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_requests_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpressQueryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_requests_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpressSimulateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_requests_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateQRReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_requests_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullTransactionsQueryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_requests_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullTransactionsRegisterReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_requests_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemitTaxReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_requests_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_requests_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SIMSwapReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_requests_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandingOrderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_requests_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransactionStatusReq); i {
 			case 0:
 				return &v.state
@@ -1709,7 +3366,7 @@ func file_grpc_requests_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_grpc_requests_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
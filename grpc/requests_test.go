@@ -589,16 +589,17 @@ func TestEmpty(t *testing.T) {
 
 func TestTransactionStatusReq(t *testing.T) {
 	req := grpc.TransactionStatusReq{
-		InitiatorName:     "testapi",
-		InitiatorPassword: "Safaricom999!*!",
-		CommandID:         "TransactionStatusQuery",
-		IdentifierType:    1,
-		TransactionID:     "RI704KI9RW",
-		PartyA:            254759764065,
-		QueueTimeOutURL:   "https://example.com/timeout",
-		ResultURL:         "https://example.com/result",
-		Remarks:           "test",
-		Occasion:          "test",
+		InitiatorName:            "testapi",
+		InitiatorPassword:        "Safaricom999!*!",
+		CommandID:                "TransactionStatusQuery",
+		IdentifierType:           1,
+		TransactionID:            "RI704KI9RW",
+		PartyA:                   254759764065,
+		QueueTimeOutURL:          "https://example.com/timeout",
+		ResultURL:                "https://example.com/result",
+		Remarks:                  "test",
+		Occasion:                 "test",
+		OriginatorConversationID: "feb5e3f2-fbbc-4745-844c-ee37b546f627",
 	}
 
 	val := req.String()
@@ -649,6 +650,10 @@ func TestTransactionStatusReq(t *testing.T) {
 	assert.NotEmpty(t, val2)
 	assert.Equal(t, req.Occasion, val2)
 
+	val2 = req.GetOriginatorConversationID()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.OriginatorConversationID, val2)
+
 	val9, val10 := req.Descriptor()
 	assert.NotEmpty(t, val9)
 	assert.NotEmpty(t, val10)
@@ -745,3 +750,746 @@ func TestBusinessPayBillReq(t *testing.T) {
 	val = req.String()
 	assert.Empty(t, val)
 }
+
+func TestBillManagerBulkInvoiceReq(t *testing.T) {
+	req := grpc.BillManagerBulkInvoiceReq{
+		Invoices: []*grpc.BillManagerSingleInvoiceReq{{ExternalReference: "INV-2024-03-001", Amount: 15000}},
+	}
+
+	val := req.String()
+	assert.NotEmpty(t, val)
+
+	req.ProtoMessage()
+
+	val1 := req.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := req.GetInvoices()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.Invoices, val2)
+
+	val9, val10 := req.Descriptor()
+	assert.NotEmpty(t, val9)
+	assert.NotEmpty(t, val10)
+
+	req.Reset()
+
+	val = req.String()
+	assert.Empty(t, val)
+}
+
+func TestBillManagerCancelInvoiceReq(t *testing.T) {
+	req := grpc.BillManagerCancelInvoiceReq{
+		ExternalReferences: []string{"INV-2024-03-001", "INV-2024-03-002"},
+	}
+
+	val := req.String()
+	assert.NotEmpty(t, val)
+
+	req.ProtoMessage()
+
+	val1 := req.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := req.GetExternalReferences()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.ExternalReferences, val2)
+
+	val9, val10 := req.Descriptor()
+	assert.NotEmpty(t, val9)
+	assert.NotEmpty(t, val10)
+
+	req.Reset()
+
+	val = req.String()
+	assert.Empty(t, val)
+}
+
+func TestBillManagerInvoiceItem(t *testing.T) {
+	req := grpc.BillManagerInvoiceItem{
+		ItemName: "Water",
+		Amount:   500,
+	}
+
+	val := req.String()
+	assert.NotEmpty(t, val)
+
+	req.ProtoMessage()
+
+	val1 := req.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := req.GetItemName()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.ItemName, val2)
+
+	val23 := req.GetAmount()
+	assert.NotEmpty(t, val23)
+	assert.Equal(t, req.Amount, val23)
+
+	val9, val10 := req.Descriptor()
+	assert.NotEmpty(t, val9)
+	assert.NotEmpty(t, val10)
+
+	req.Reset()
+
+	val = req.String()
+	assert.Empty(t, val)
+}
+
+func TestBillManagerOptInReq(t *testing.T) {
+	req := grpc.BillManagerOptInReq{
+		ShortCode:       600984,
+		Email:           "billing@example.com",
+		OfficialContact: "254712345678",
+		SendReminders:   1,
+		Logo:            "https://example.com/logo.png",
+		CallbackURL:     "https://example.com/billmanager",
+	}
+
+	val := req.String()
+	assert.NotEmpty(t, val)
+
+	req.ProtoMessage()
+
+	val1 := req.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := req.GetShortCode()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.ShortCode, val2)
+
+	val23 := req.GetEmail()
+	assert.NotEmpty(t, val23)
+	assert.Equal(t, req.Email, val23)
+
+	val23 = req.GetOfficialContact()
+	assert.NotEmpty(t, val23)
+	assert.Equal(t, req.OfficialContact, val23)
+
+	val24 := req.GetSendReminders()
+	assert.NotEmpty(t, val24)
+	assert.Equal(t, req.SendReminders, val24)
+
+	val23 = req.GetLogo()
+	assert.NotEmpty(t, val23)
+	assert.Equal(t, req.Logo, val23)
+
+	val23 = req.GetCallbackURL()
+	assert.NotEmpty(t, val23)
+	assert.Equal(t, req.CallbackURL, val23)
+
+	val9, val10 := req.Descriptor()
+	assert.NotEmpty(t, val9)
+	assert.NotEmpty(t, val10)
+
+	req.Reset()
+
+	val = req.String()
+	assert.Empty(t, val)
+}
+
+func TestBillManagerReconcileReq(t *testing.T) {
+	req := grpc.BillManagerReconcileReq{
+		PaymentDate:       "2024-03-05",
+		PaidAmount:        15000,
+		AccountReference:  "A1",
+		TransactionID:     "SC54HJ8K9L",
+		PhoneNumber:       254712345678,
+		FullName:          "John Doe",
+		InvoiceName:       "Rent",
+		ExternalReference: "INV-2024-03-001",
+	}
+
+	val := req.String()
+	assert.NotEmpty(t, val)
+
+	req.ProtoMessage()
+
+	val1 := req.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := req.GetPaymentDate()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.PaymentDate, val2)
+
+	val23 := req.GetPaidAmount()
+	assert.NotEmpty(t, val23)
+	assert.Equal(t, req.PaidAmount, val23)
+
+	val2 = req.GetAccountReference()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.AccountReference, val2)
+
+	val2 = req.GetTransactionID()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.TransactionID, val2)
+
+	val23 = req.GetPhoneNumber()
+	assert.NotEmpty(t, val23)
+	assert.Equal(t, req.PhoneNumber, val23)
+
+	val2 = req.GetFullName()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.FullName, val2)
+
+	val2 = req.GetInvoiceName()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.InvoiceName, val2)
+
+	val2 = req.GetExternalReference()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.ExternalReference, val2)
+
+	val9, val10 := req.Descriptor()
+	assert.NotEmpty(t, val9)
+	assert.NotEmpty(t, val10)
+
+	req.Reset()
+
+	val = req.String()
+	assert.Empty(t, val)
+}
+
+func TestBillManagerSingleInvoiceReq(t *testing.T) {
+	req := grpc.BillManagerSingleInvoiceReq{
+		ExternalReference: "INV-2024-03-001",
+		BilledFullName:    "John Doe",
+		BilledPhoneNumber: 254712345678,
+		BilledPeriod:      "March 2024",
+		InvoiceName:       "Rent",
+		DueDate:           "2024-03-10 00:00:00",
+		AccountReference:  "A1",
+		Amount:            15000,
+		InvoiceItems:      []*grpc.BillManagerInvoiceItem{{ItemName: "Water", Amount: 500}},
+	}
+
+	val := req.String()
+	assert.NotEmpty(t, val)
+
+	req.ProtoMessage()
+
+	val1 := req.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := req.GetExternalReference()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.ExternalReference, val2)
+
+	val2 = req.GetBilledFullName()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.BilledFullName, val2)
+
+	val23 := req.GetBilledPhoneNumber()
+	assert.NotEmpty(t, val23)
+	assert.Equal(t, req.BilledPhoneNumber, val23)
+
+	val2 = req.GetBilledPeriod()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.BilledPeriod, val2)
+
+	val2 = req.GetInvoiceName()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.InvoiceName, val2)
+
+	val2 = req.GetDueDate()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.DueDate, val2)
+
+	val2 = req.GetAccountReference()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.AccountReference, val2)
+
+	val23 = req.GetAmount()
+	assert.NotEmpty(t, val23)
+	assert.Equal(t, req.Amount, val23)
+
+	val24 := req.GetInvoiceItems()
+	assert.NotEmpty(t, val24)
+	assert.Equal(t, req.InvoiceItems, val24)
+
+	val9, val10 := req.Descriptor()
+	assert.NotEmpty(t, val9)
+	assert.NotEmpty(t, val10)
+
+	req.Reset()
+
+	val = req.String()
+	assert.Empty(t, val)
+}
+
+func TestBillManagerUpdateOptInReq(t *testing.T) {
+	req := grpc.BillManagerUpdateOptInReq{
+		ShortCode:       600984,
+		Email:           "billing@example.com",
+		OfficialContact: "254712345678",
+		SendReminders:   1,
+		Logo:            "https://example.com/logo.png",
+		CallbackURL:     "https://example.com/billmanager",
+	}
+
+	val := req.String()
+	assert.NotEmpty(t, val)
+
+	req.ProtoMessage()
+
+	val1 := req.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := req.GetShortCode()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.ShortCode, val2)
+
+	val23 := req.GetEmail()
+	assert.NotEmpty(t, val23)
+	assert.Equal(t, req.Email, val23)
+
+	val23 = req.GetOfficialContact()
+	assert.NotEmpty(t, val23)
+	assert.Equal(t, req.OfficialContact, val23)
+
+	val24 := req.GetSendReminders()
+	assert.NotEmpty(t, val24)
+	assert.Equal(t, req.SendReminders, val24)
+
+	val23 = req.GetLogo()
+	assert.NotEmpty(t, val23)
+	assert.Equal(t, req.Logo, val23)
+
+	val23 = req.GetCallbackURL()
+	assert.NotEmpty(t, val23)
+	assert.Equal(t, req.CallbackURL, val23)
+
+	val9, val10 := req.Descriptor()
+	assert.NotEmpty(t, val9)
+	assert.NotEmpty(t, val10)
+
+	req.Reset()
+
+	val = req.String()
+	assert.Empty(t, val)
+}
+
+func TestPullTransactionsRegisterReq(t *testing.T) {
+	req := grpc.PullTransactionsRegisterReq{
+		ShortCode:       600984,
+		RequestType:     "Pull",
+		NominatedNumber: "254712345678",
+		CallBackURL:     "https://example.com/pull",
+	}
+
+	val := req.String()
+	assert.NotEmpty(t, val)
+
+	req.ProtoMessage()
+
+	val1 := req.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := req.GetShortCode()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.ShortCode, val2)
+
+	val3 := req.GetRequestType()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.RequestType, val3)
+
+	val3 = req.GetNominatedNumber()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.NominatedNumber, val3)
+
+	val3 = req.GetCallBackURL()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.CallBackURL, val3)
+
+	val4, val5 := req.Descriptor()
+	assert.NotEmpty(t, val4)
+	assert.NotEmpty(t, val5)
+
+	req.Reset()
+
+	val = req.String()
+	assert.Empty(t, val)
+}
+
+func TestPullTransactionsQueryReq(t *testing.T) {
+	req := grpc.PullTransactionsQueryReq{
+		ShortCode:   600984,
+		StartDate:   "2024-03-01 00:00:00",
+		EndDate:     "2024-03-02 00:00:00",
+		OffSetValue: 100,
+	}
+
+	val := req.String()
+	assert.NotEmpty(t, val)
+
+	req.ProtoMessage()
+
+	val1 := req.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := req.GetShortCode()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.ShortCode, val2)
+
+	val3 := req.GetStartDate()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.StartDate, val3)
+
+	val3 = req.GetEndDate()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.EndDate, val3)
+
+	val2 = req.GetOffSetValue()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.OffSetValue, val2)
+
+	val4, val5 := req.Descriptor()
+	assert.NotEmpty(t, val4)
+	assert.NotEmpty(t, val5)
+
+	req.Reset()
+
+	val = req.String()
+	assert.Empty(t, val)
+}
+
+func TestStandingOrderReq(t *testing.T) {
+	req := grpc.StandingOrderReq{
+		StandingOrderName:           "Gym Membership",
+		StartDate:                   "20240401",
+		EndDate:                     "20250401",
+		BusinessShortCode:           174379,
+		TransactionType:             "Standing Order Customer Pay Bill",
+		ReceiverPartyIdentifierType: 4,
+		Amount:                      1500,
+		PartyA:                      254708374149,
+		CallBackURL:                 "https://example.com/ratiba",
+		AccountReference:            "GYM001",
+		TransactionDesc:             "Membership",
+		Frequency:                   4,
+	}
+
+	val := req.String()
+	assert.NotEmpty(t, val)
+
+	req.ProtoMessage()
+
+	val1 := req.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := req.GetStandingOrderName()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.StandingOrderName, val2)
+
+	val2 = req.GetStartDate()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.StartDate, val2)
+
+	val2 = req.GetEndDate()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.EndDate, val2)
+
+	val3 := req.GetBusinessShortCode()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.BusinessShortCode, val3)
+
+	val2 = req.GetTransactionType()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.TransactionType, val2)
+
+	val4 := req.GetReceiverPartyIdentifierType()
+	assert.NotEmpty(t, val4)
+	assert.Equal(t, req.ReceiverPartyIdentifierType, val4)
+
+	val3 = req.GetAmount()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.Amount, val3)
+
+	val3 = req.GetPartyA()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.PartyA, val3)
+
+	val2 = req.GetCallBackURL()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.CallBackURL, val2)
+
+	val2 = req.GetAccountReference()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.AccountReference, val2)
+
+	val2 = req.GetTransactionDesc()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.TransactionDesc, val2)
+
+	val4 = req.GetFrequency()
+	assert.NotEmpty(t, val4)
+	assert.Equal(t, req.Frequency, val4)
+
+	val5, val6 := req.Descriptor()
+	assert.NotEmpty(t, val5)
+	assert.NotEmpty(t, val6)
+
+	req.Reset()
+
+	val = req.String()
+	assert.Empty(t, val)
+}
+
+func TestB2BExpressCheckoutReq(t *testing.T) {
+	req := grpc.B2BExpressCheckoutReq{
+		PrimaryShortCode:  600992,
+		ReceiverShortCode: 600984,
+		Amount:            100,
+		PaymentRef:        "INV001",
+		CallbackURL:       "https://example.com/b2bexpress",
+		PartnerName:       "Vendor",
+		RequestRefID:      "01HRZ8Y4M2B2X0E6Q5N8J3K7TA",
+	}
+
+	val := req.String()
+	assert.NotEmpty(t, val)
+
+	req.ProtoMessage()
+
+	val1 := req.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := req.GetPrimaryShortCode()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.PrimaryShortCode, val2)
+
+	val2 = req.GetReceiverShortCode()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.ReceiverShortCode, val2)
+
+	val2 = req.GetAmount()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.Amount, val2)
+
+	val3 := req.GetPaymentRef()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.PaymentRef, val3)
+
+	val3 = req.GetCallbackURL()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.CallbackURL, val3)
+
+	val3 = req.GetPartnerName()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.PartnerName, val3)
+
+	val3 = req.GetRequestRefID()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.RequestRefID, val3)
+
+	val4, val5 := req.Descriptor()
+	assert.NotEmpty(t, val4)
+	assert.NotEmpty(t, val5)
+
+	req.Reset()
+
+	val = req.String()
+	assert.Empty(t, val)
+}
+
+func TestB2CAccountTopUpReq(t *testing.T) {
+	req := grpc.B2CAccountTopUpReq{
+		Initiator:              "testapi",
+		InitiatorPassword:      "Safaricom999!*!",
+		SecurityCredential:     "credential",
+		CommandID:              "BusinessPayToBulk",
+		SenderIdentifierType:   4,
+		RecieverIdentifierType: 4,
+		Amount:                 239,
+		PartyA:                 600979,
+		PartyB:                 600000,
+		AccountReference:       "353353",
+		QueueTimeOutURL:        "https://example.com/timeout",
+		ResultURL:              "https://example.com/result",
+		Remarks:                "OK",
+		Requester:              254708374149,
+	}
+
+	val := req.String()
+	assert.NotEmpty(t, val)
+
+	req.ProtoMessage()
+
+	val1 := req.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := req.GetInitiator()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.Initiator, val2)
+
+	val2 = req.GetInitiatorPassword()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.InitiatorPassword, val2)
+
+	val2 = req.GetSecurityCredential()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.SecurityCredential, val2)
+
+	val2 = req.GetCommandID()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.CommandID, val2)
+
+	val3 := req.GetSenderIdentifierType()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.SenderIdentifierType, val3)
+
+	val3 = req.GetRecieverIdentifierType()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.RecieverIdentifierType, val3)
+
+	val4 := req.GetAmount()
+	assert.NotEmpty(t, val4)
+	assert.Equal(t, req.Amount, val4)
+
+	val4 = req.GetPartyA()
+	assert.NotEmpty(t, val4)
+	assert.Equal(t, req.PartyA, val4)
+
+	val4 = req.GetPartyB()
+	assert.NotEmpty(t, val4)
+	assert.Equal(t, req.PartyB, val4)
+
+	val2 = req.GetAccountReference()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.AccountReference, val2)
+
+	val2 = req.GetQueueTimeOutURL()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.QueueTimeOutURL, val2)
+
+	val2 = req.GetResultURL()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.ResultURL, val2)
+
+	val2 = req.GetRemarks()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.Remarks, val2)
+
+	val4 = req.GetRequester()
+	assert.NotEmpty(t, val4)
+	assert.Equal(t, req.Requester, val4)
+
+	val5, val6 := req.Descriptor()
+	assert.NotEmpty(t, val5)
+	assert.NotEmpty(t, val6)
+
+	req.Reset()
+
+	val = req.String()
+	assert.Empty(t, val)
+}
+
+func TestB2PochiReq(t *testing.T) {
+	req := grpc.B2PochiReq{
+		OriginatorConversationID: "01HS0G4D3N2M6P1R9V5Q8K7T2W",
+		InitiatorName:            "testapi",
+		InitiatorPassword:        "Safaricom999!*!",
+		SecurityCredential:       "credential",
+		CommandID:                "BusinessPayToPochi",
+		Amount:                   10,
+		PartyA:                   600996,
+		PartyB:                   254728762287,
+		Remarks:                  "here are my remarks",
+		QueueTimeOutURL:          "https://example.com/timeout",
+		ResultURL:                "https://example.com/result",
+		Occasion:                 "Christmas",
+	}
+
+	val := req.String()
+	assert.NotEmpty(t, val)
+
+	req.ProtoMessage()
+
+	val1 := req.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := req.GetOriginatorConversationID()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.OriginatorConversationID, val2)
+
+	val2 = req.GetInitiatorName()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.InitiatorName, val2)
+
+	val2 = req.GetInitiatorPassword()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.InitiatorPassword, val2)
+
+	val2 = req.GetSecurityCredential()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.SecurityCredential, val2)
+
+	val2 = req.GetCommandID()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.CommandID, val2)
+
+	val3 := req.GetAmount()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.Amount, val3)
+
+	val3 = req.GetPartyA()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.PartyA, val3)
+
+	val3 = req.GetPartyB()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, req.PartyB, val3)
+
+	val2 = req.GetRemarks()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.Remarks, val2)
+
+	val2 = req.GetQueueTimeOutURL()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.QueueTimeOutURL, val2)
+
+	val2 = req.GetResultURL()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.ResultURL, val2)
+
+	val2 = req.GetOccasion()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.Occasion, val2)
+
+	val4, val5 := req.Descriptor()
+	assert.NotEmpty(t, val4)
+	assert.NotEmpty(t, val5)
+
+	req.Reset()
+
+	val = req.String()
+	assert.Empty(t, val)
+}
+
+func TestSIMSwapReq(t *testing.T) {
+	req := grpc.SIMSwapReq{
+		CustomerNumber: 254722000000,
+	}
+
+	val := req.String()
+	assert.NotEmpty(t, val)
+
+	req.ProtoMessage()
+
+	val1 := req.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := req.GetCustomerNumber()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, req.CustomerNumber, val2)
+
+	val3, val4 := req.Descriptor()
+	assert.NotEmpty(t, val3)
+	assert.NotEmpty(t, val4)
+
+	req.Reset()
+
+	val = req.String()
+	assert.Empty(t, val)
+}
@@ -67,16 +67,18 @@ func (x *AccountBalanceResp) GetValidResp() *ValidResp {
 	return nil
 }
 
-type B2CPaymentResp struct {
+type B2BExpressCheckoutResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ValidResp *ValidResp `protobuf:"bytes,1,opt,name=validResp,proto3" json:"validResp,omitempty"`
+	Code         string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Status       string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	RequestRefID string `protobuf:"bytes,3,opt,name=requestRefID,proto3" json:"requestRefID,omitempty"`
 }
 
-func (x *B2CPaymentResp) Reset() {
-	*x = B2CPaymentResp{}
+func (x *B2BExpressCheckoutResp) Reset() {
+	*x = B2BExpressCheckoutResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_grpc_responses_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -84,13 +86,13 @@ func (x *B2CPaymentResp) Reset() {
 	}
 }
 
-func (x *B2CPaymentResp) String() string {
+func (x *B2BExpressCheckoutResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*B2CPaymentResp) ProtoMessage() {}
+func (*B2BExpressCheckoutResp) ProtoMessage() {}
 
-func (x *B2CPaymentResp) ProtoReflect() protoreflect.Message {
+func (x *B2BExpressCheckoutResp) ProtoReflect() protoreflect.Message {
 	mi := &file_grpc_responses_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -102,19 +104,33 @@ func (x *B2CPaymentResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use B2CPaymentResp.ProtoReflect.Descriptor instead.
-func (*B2CPaymentResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use B2BExpressCheckoutResp.ProtoReflect.Descriptor instead.
+func (*B2BExpressCheckoutResp) Descriptor() ([]byte, []int) {
 	return file_grpc_responses_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *B2CPaymentResp) GetValidResp() *ValidResp {
+func (x *B2BExpressCheckoutResp) GetCode() string {
 	if x != nil {
-		return x.ValidResp
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-type BusinessPayBillResp struct {
+func (x *B2BExpressCheckoutResp) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *B2BExpressCheckoutResp) GetRequestRefID() string {
+	if x != nil {
+		return x.RequestRefID
+	}
+	return ""
+}
+
+type B2CAccountTopUpResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -122,8 +138,8 @@ type BusinessPayBillResp struct {
 	ValidResp *ValidResp `protobuf:"bytes,1,opt,name=validResp,proto3" json:"validResp,omitempty"`
 }
 
-func (x *BusinessPayBillResp) Reset() {
-	*x = BusinessPayBillResp{}
+func (x *B2CAccountTopUpResp) Reset() {
+	*x = B2CAccountTopUpResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_grpc_responses_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -131,13 +147,13 @@ func (x *BusinessPayBillResp) Reset() {
 	}
 }
 
-func (x *BusinessPayBillResp) String() string {
+func (x *B2CAccountTopUpResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BusinessPayBillResp) ProtoMessage() {}
+func (*B2CAccountTopUpResp) ProtoMessage() {}
 
-func (x *BusinessPayBillResp) ProtoReflect() protoreflect.Message {
+func (x *B2CAccountTopUpResp) ProtoReflect() protoreflect.Message {
 	mi := &file_grpc_responses_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -149,19 +165,19 @@ func (x *BusinessPayBillResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BusinessPayBillResp.ProtoReflect.Descriptor instead.
-func (*BusinessPayBillResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use B2CAccountTopUpResp.ProtoReflect.Descriptor instead.
+func (*B2CAccountTopUpResp) Descriptor() ([]byte, []int) {
 	return file_grpc_responses_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *BusinessPayBillResp) GetValidResp() *ValidResp {
+func (x *B2CAccountTopUpResp) GetValidResp() *ValidResp {
 	if x != nil {
 		return x.ValidResp
 	}
 	return nil
 }
 
-type C2BRegisterURLResp struct {
+type B2CPaymentResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -169,8 +185,8 @@ type C2BRegisterURLResp struct {
 	ValidResp *ValidResp `protobuf:"bytes,1,opt,name=validResp,proto3" json:"validResp,omitempty"`
 }
 
-func (x *C2BRegisterURLResp) Reset() {
-	*x = C2BRegisterURLResp{}
+func (x *B2CPaymentResp) Reset() {
+	*x = B2CPaymentResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_grpc_responses_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -178,13 +194,13 @@ func (x *C2BRegisterURLResp) Reset() {
 	}
 }
 
-func (x *C2BRegisterURLResp) String() string {
+func (x *B2CPaymentResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*C2BRegisterURLResp) ProtoMessage() {}
+func (*B2CPaymentResp) ProtoMessage() {}
 
-func (x *C2BRegisterURLResp) ProtoReflect() protoreflect.Message {
+func (x *B2CPaymentResp) ProtoReflect() protoreflect.Message {
 	mi := &file_grpc_responses_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -196,19 +212,19 @@ func (x *C2BRegisterURLResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use C2BRegisterURLResp.ProtoReflect.Descriptor instead.
-func (*C2BRegisterURLResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use B2CPaymentResp.ProtoReflect.Descriptor instead.
+func (*B2CPaymentResp) Descriptor() ([]byte, []int) {
 	return file_grpc_responses_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *C2BRegisterURLResp) GetValidResp() *ValidResp {
+func (x *B2CPaymentResp) GetValidResp() *ValidResp {
 	if x != nil {
 		return x.ValidResp
 	}
 	return nil
 }
 
-type C2BSimulateResp struct {
+type B2PochiResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -216,8 +232,8 @@ type C2BSimulateResp struct {
 	ValidResp *ValidResp `protobuf:"bytes,1,opt,name=validResp,proto3" json:"validResp,omitempty"`
 }
 
-func (x *C2BSimulateResp) Reset() {
-	*x = C2BSimulateResp{}
+func (x *B2PochiResp) Reset() {
+	*x = B2PochiResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_grpc_responses_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -225,13 +241,13 @@ func (x *C2BSimulateResp) Reset() {
 	}
 }
 
-func (x *C2BSimulateResp) String() string {
+func (x *B2PochiResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*C2BSimulateResp) ProtoMessage() {}
+func (*B2PochiResp) ProtoMessage() {}
 
-func (x *C2BSimulateResp) ProtoReflect() protoreflect.Message {
+func (x *B2PochiResp) ProtoReflect() protoreflect.Message {
 	mi := &file_grpc_responses_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -243,34 +259,28 @@ func (x *C2BSimulateResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use C2BSimulateResp.ProtoReflect.Descriptor instead.
-func (*C2BSimulateResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use B2PochiResp.ProtoReflect.Descriptor instead.
+func (*B2PochiResp) Descriptor() ([]byte, []int) {
 	return file_grpc_responses_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *C2BSimulateResp) GetValidResp() *ValidResp {
+func (x *B2PochiResp) GetValidResp() *ValidResp {
 	if x != nil {
 		return x.ValidResp
 	}
 	return nil
 }
 
-type ExpressQueryResp struct {
+type BillManagerBulkInvoiceResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ResponseDescription string `protobuf:"bytes,1,opt,name=responseDescription,proto3" json:"responseDescription,omitempty"`
-	ResponseCode        string `protobuf:"bytes,2,opt,name=responseCode,proto3" json:"responseCode,omitempty"`
-	MerchantRequestID   string `protobuf:"bytes,3,opt,name=merchantRequestID,proto3" json:"merchantRequestID,omitempty"`
-	CheckoutRequestID   string `protobuf:"bytes,4,opt,name=checkoutRequestID,proto3" json:"checkoutRequestID,omitempty"`
-	CustomerMessage     string `protobuf:"bytes,5,opt,name=customerMessage,proto3" json:"customerMessage,omitempty"`
-	ResultCode          string `protobuf:"bytes,6,opt,name=resultCode,proto3" json:"resultCode,omitempty"`
-	ResultDesc          string `protobuf:"bytes,7,opt,name=resultDesc,proto3" json:"resultDesc,omitempty"`
+	BillManagerResp *BillManagerResp `protobuf:"bytes,1,opt,name=billManagerResp,proto3" json:"billManagerResp,omitempty"`
 }
 
-func (x *ExpressQueryResp) Reset() {
-	*x = ExpressQueryResp{}
+func (x *BillManagerBulkInvoiceResp) Reset() {
+	*x = BillManagerBulkInvoiceResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_grpc_responses_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -278,13 +288,13 @@ func (x *ExpressQueryResp) Reset() {
 	}
 }
 
-func (x *ExpressQueryResp) String() string {
+func (x *BillManagerBulkInvoiceResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpressQueryResp) ProtoMessage() {}
+func (*BillManagerBulkInvoiceResp) ProtoMessage() {}
 
-func (x *ExpressQueryResp) ProtoReflect() protoreflect.Message {
+func (x *BillManagerBulkInvoiceResp) ProtoReflect() protoreflect.Message {
 	mi := &file_grpc_responses_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -296,89 +306,91 @@ func (x *ExpressQueryResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpressQueryResp.ProtoReflect.Descriptor instead.
-func (*ExpressQueryResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use BillManagerBulkInvoiceResp.ProtoReflect.Descriptor instead.
+func (*BillManagerBulkInvoiceResp) Descriptor() ([]byte, []int) {
 	return file_grpc_responses_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ExpressQueryResp) GetResponseDescription() string {
+func (x *BillManagerBulkInvoiceResp) GetBillManagerResp() *BillManagerResp {
 	if x != nil {
-		return x.ResponseDescription
+		return x.BillManagerResp
 	}
-	return ""
+	return nil
 }
 
-func (x *ExpressQueryResp) GetResponseCode() string {
-	if x != nil {
-		return x.ResponseCode
-	}
-	return ""
+type BillManagerCancelInvoiceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillManagerResp *BillManagerResp `protobuf:"bytes,1,opt,name=billManagerResp,proto3" json:"billManagerResp,omitempty"`
 }
 
-func (x *ExpressQueryResp) GetMerchantRequestID() string {
-	if x != nil {
-		return x.MerchantRequestID
+func (x *BillManagerCancelInvoiceResp) Reset() {
+	*x = BillManagerCancelInvoiceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ExpressQueryResp) GetCheckoutRequestID() string {
-	if x != nil {
-		return x.CheckoutRequestID
-	}
-	return ""
+func (x *BillManagerCancelInvoiceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ExpressQueryResp) GetCustomerMessage() string {
-	if x != nil {
-		return x.CustomerMessage
+func (*BillManagerCancelInvoiceResp) ProtoMessage() {}
+
+func (x *BillManagerCancelInvoiceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ExpressQueryResp) GetResultCode() string {
-	if x != nil {
-		return x.ResultCode
-	}
-	return ""
+// Deprecated: Use BillManagerCancelInvoiceResp.ProtoReflect.Descriptor instead.
+func (*BillManagerCancelInvoiceResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ExpressQueryResp) GetResultDesc() string {
+func (x *BillManagerCancelInvoiceResp) GetBillManagerResp() *BillManagerResp {
 	if x != nil {
-		return x.ResultDesc
+		return x.BillManagerResp
 	}
-	return ""
+	return nil
 }
 
-type ExpressSimulateResp struct {
+type BillManagerOptInResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ResponseDescription string `protobuf:"bytes,1,opt,name=responseDescription,proto3" json:"responseDescription,omitempty"`
-	ResponseCode        string `protobuf:"bytes,2,opt,name=responseCode,proto3" json:"responseCode,omitempty"`
-	MerchantRequestID   string `protobuf:"bytes,3,opt,name=merchantRequestID,proto3" json:"merchantRequestID,omitempty"`
-	CheckoutRequestID   string `protobuf:"bytes,4,opt,name=checkoutRequestID,proto3" json:"checkoutRequestID,omitempty"`
-	CustomerMessage     string `protobuf:"bytes,5,opt,name=customerMessage,proto3" json:"customerMessage,omitempty"`
+	AppKey          string           `protobuf:"bytes,1,opt,name=appKey,proto3" json:"appKey,omitempty"`
+	BillManagerResp *BillManagerResp `protobuf:"bytes,2,opt,name=billManagerResp,proto3" json:"billManagerResp,omitempty"`
 }
 
-func (x *ExpressSimulateResp) Reset() {
-	*x = ExpressSimulateResp{}
+func (x *BillManagerOptInResp) Reset() {
+	*x = BillManagerOptInResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_responses_proto_msgTypes[6]
+		mi := &file_grpc_responses_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExpressSimulateResp) String() string {
+func (x *BillManagerOptInResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpressSimulateResp) ProtoMessage() {}
+func (*BillManagerOptInResp) ProtoMessage() {}
 
-func (x *ExpressSimulateResp) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_responses_proto_msgTypes[6]
+func (x *BillManagerOptInResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -389,74 +401,99 @@ func (x *ExpressSimulateResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpressSimulateResp.ProtoReflect.Descriptor instead.
-func (*ExpressSimulateResp) Descriptor() ([]byte, []int) {
-	return file_grpc_responses_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BillManagerOptInResp.ProtoReflect.Descriptor instead.
+func (*BillManagerOptInResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ExpressSimulateResp) GetResponseDescription() string {
+func (x *BillManagerOptInResp) GetAppKey() string {
 	if x != nil {
-		return x.ResponseDescription
+		return x.AppKey
 	}
 	return ""
 }
 
-func (x *ExpressSimulateResp) GetResponseCode() string {
+func (x *BillManagerOptInResp) GetBillManagerResp() *BillManagerResp {
 	if x != nil {
-		return x.ResponseCode
+		return x.BillManagerResp
 	}
-	return ""
+	return nil
 }
 
-func (x *ExpressSimulateResp) GetMerchantRequestID() string {
-	if x != nil {
-		return x.MerchantRequestID
+type BillManagerReconcileResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillManagerResp *BillManagerResp `protobuf:"bytes,1,opt,name=billManagerResp,proto3" json:"billManagerResp,omitempty"`
+}
+
+func (x *BillManagerReconcileResp) Reset() {
+	*x = BillManagerReconcileResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ExpressSimulateResp) GetCheckoutRequestID() string {
-	if x != nil {
-		return x.CheckoutRequestID
+func (x *BillManagerReconcileResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillManagerReconcileResp) ProtoMessage() {}
+
+func (x *BillManagerReconcileResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ExpressSimulateResp) GetCustomerMessage() string {
+// Deprecated: Use BillManagerReconcileResp.ProtoReflect.Descriptor instead.
+func (*BillManagerReconcileResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BillManagerReconcileResp) GetBillManagerResp() *BillManagerResp {
 	if x != nil {
-		return x.CustomerMessage
+		return x.BillManagerResp
 	}
-	return ""
+	return nil
 }
 
-type GenerateQRResp struct {
+type BillManagerResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ResponseDescription string `protobuf:"bytes,1,opt,name=responseDescription,proto3" json:"responseDescription,omitempty"`
-	ResponseCode        string `protobuf:"bytes,2,opt,name=responseCode,proto3" json:"responseCode,omitempty"`
-	RequestID           string `protobuf:"bytes,3,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	QRCode              string `protobuf:"bytes,4,opt,name=qRCode,proto3" json:"qRCode,omitempty"`
+	ResponseMessage string `protobuf:"bytes,1,opt,name=responseMessage,proto3" json:"responseMessage,omitempty"`
+	ResponseCode    string `protobuf:"bytes,2,opt,name=responseCode,proto3" json:"responseCode,omitempty"`
+	StatusMessage   string `protobuf:"bytes,3,opt,name=statusMessage,proto3" json:"statusMessage,omitempty"`
 }
 
-func (x *GenerateQRResp) Reset() {
-	*x = GenerateQRResp{}
+func (x *BillManagerResp) Reset() {
+	*x = BillManagerResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_responses_proto_msgTypes[7]
+		mi := &file_grpc_responses_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateQRResp) String() string {
+func (x *BillManagerResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateQRResp) ProtoMessage() {}
+func (*BillManagerResp) ProtoMessage() {}
 
-func (x *GenerateQRResp) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_responses_proto_msgTypes[7]
+func (x *BillManagerResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,64 +504,57 @@ func (x *GenerateQRResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateQRResp.ProtoReflect.Descriptor instead.
-func (*GenerateQRResp) Descriptor() ([]byte, []int) {
-	return file_grpc_responses_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BillManagerResp.ProtoReflect.Descriptor instead.
+func (*BillManagerResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GenerateQRResp) GetResponseDescription() string {
+func (x *BillManagerResp) GetResponseMessage() string {
 	if x != nil {
-		return x.ResponseDescription
+		return x.ResponseMessage
 	}
 	return ""
 }
 
-func (x *GenerateQRResp) GetResponseCode() string {
+func (x *BillManagerResp) GetResponseCode() string {
 	if x != nil {
 		return x.ResponseCode
 	}
 	return ""
 }
 
-func (x *GenerateQRResp) GetRequestID() string {
-	if x != nil {
-		return x.RequestID
-	}
-	return ""
-}
-
-func (x *GenerateQRResp) GetQRCode() string {
+func (x *BillManagerResp) GetStatusMessage() string {
 	if x != nil {
-		return x.QRCode
+		return x.StatusMessage
 	}
 	return ""
 }
 
-type RemitTaxResp struct {
+type BillManagerSingleInvoiceResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ValidResp *ValidResp `protobuf:"bytes,1,opt,name=validResp,proto3" json:"validResp,omitempty"`
+	BillManagerResp *BillManagerResp `protobuf:"bytes,1,opt,name=billManagerResp,proto3" json:"billManagerResp,omitempty"`
 }
 
-func (x *RemitTaxResp) Reset() {
-	*x = RemitTaxResp{}
+func (x *BillManagerSingleInvoiceResp) Reset() {
+	*x = BillManagerSingleInvoiceResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_responses_proto_msgTypes[8]
+		mi := &file_grpc_responses_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemitTaxResp) String() string {
+func (x *BillManagerSingleInvoiceResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemitTaxResp) ProtoMessage() {}
+func (*BillManagerSingleInvoiceResp) ProtoMessage() {}
 
-func (x *RemitTaxResp) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_responses_proto_msgTypes[8]
+func (x *BillManagerSingleInvoiceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,45 +565,882 @@ func (x *RemitTaxResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemitTaxResp.ProtoReflect.Descriptor instead.
-func (*RemitTaxResp) Descriptor() ([]byte, []int) {
-	return file_grpc_responses_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use BillManagerSingleInvoiceResp.ProtoReflect.Descriptor instead.
+func (*BillManagerSingleInvoiceResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RemitTaxResp) GetValidResp() *ValidResp {
+func (x *BillManagerSingleInvoiceResp) GetBillManagerResp() *BillManagerResp {
 	if x != nil {
-		return x.ValidResp
+		return x.BillManagerResp
 	}
 	return nil
 }
 
-type RespError struct {
+type BillManagerUpdateOptInResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID string `protobuf:"bytes,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Code      string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	BillManagerResp *BillManagerResp `protobuf:"bytes,1,opt,name=billManagerResp,proto3" json:"billManagerResp,omitempty"`
 }
 
-func (x *RespError) Reset() {
-	*x = RespError{}
+func (x *BillManagerUpdateOptInResp) Reset() {
+	*x = BillManagerUpdateOptInResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_responses_proto_msgTypes[9]
+		mi := &file_grpc_responses_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillManagerUpdateOptInResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillManagerUpdateOptInResp) ProtoMessage() {}
+
+func (x *BillManagerUpdateOptInResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillManagerUpdateOptInResp.ProtoReflect.Descriptor instead.
+func (*BillManagerUpdateOptInResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BillManagerUpdateOptInResp) GetBillManagerResp() *BillManagerResp {
+	if x != nil {
+		return x.BillManagerResp
+	}
+	return nil
+}
+
+type BusinessPayBillResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidResp *ValidResp `protobuf:"bytes,1,opt,name=validResp,proto3" json:"validResp,omitempty"`
+}
+
+func (x *BusinessPayBillResp) Reset() {
+	*x = BusinessPayBillResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BusinessPayBillResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusinessPayBillResp) ProtoMessage() {}
+
+func (x *BusinessPayBillResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusinessPayBillResp.ProtoReflect.Descriptor instead.
+func (*BusinessPayBillResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BusinessPayBillResp) GetValidResp() *ValidResp {
+	if x != nil {
+		return x.ValidResp
+	}
+	return nil
+}
+
+type C2BRegisterURLResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidResp *ValidResp `protobuf:"bytes,1,opt,name=validResp,proto3" json:"validResp,omitempty"`
+}
+
+func (x *C2BRegisterURLResp) Reset() {
+	*x = C2BRegisterURLResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2BRegisterURLResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2BRegisterURLResp) ProtoMessage() {}
+
+func (x *C2BRegisterURLResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2BRegisterURLResp.ProtoReflect.Descriptor instead.
+func (*C2BRegisterURLResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *C2BRegisterURLResp) GetValidResp() *ValidResp {
+	if x != nil {
+		return x.ValidResp
+	}
+	return nil
+}
+
+type C2BSimulateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidResp *ValidResp `protobuf:"bytes,1,opt,name=validResp,proto3" json:"validResp,omitempty"`
+}
+
+func (x *C2BSimulateResp) Reset() {
+	*x = C2BSimulateResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2BSimulateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2BSimulateResp) ProtoMessage() {}
+
+func (x *C2BSimulateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2BSimulateResp.ProtoReflect.Descriptor instead.
+func (*C2BSimulateResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *C2BSimulateResp) GetValidResp() *ValidResp {
+	if x != nil {
+		return x.ValidResp
+	}
+	return nil
+}
+
+type ExpressQueryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResponseDescription string `protobuf:"bytes,1,opt,name=responseDescription,proto3" json:"responseDescription,omitempty"`
+	ResponseCode        string `protobuf:"bytes,2,opt,name=responseCode,proto3" json:"responseCode,omitempty"`
+	MerchantRequestID   string `protobuf:"bytes,3,opt,name=merchantRequestID,proto3" json:"merchantRequestID,omitempty"`
+	CheckoutRequestID   string `protobuf:"bytes,4,opt,name=checkoutRequestID,proto3" json:"checkoutRequestID,omitempty"`
+	CustomerMessage     string `protobuf:"bytes,5,opt,name=customerMessage,proto3" json:"customerMessage,omitempty"`
+	ResultCode          string `protobuf:"bytes,6,opt,name=resultCode,proto3" json:"resultCode,omitempty"`
+	ResultDesc          string `protobuf:"bytes,7,opt,name=resultDesc,proto3" json:"resultDesc,omitempty"`
+}
+
+func (x *ExpressQueryResp) Reset() {
+	*x = ExpressQueryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpressQueryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpressQueryResp) ProtoMessage() {}
+
+func (x *ExpressQueryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpressQueryResp.ProtoReflect.Descriptor instead.
+func (*ExpressQueryResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExpressQueryResp) GetResponseDescription() string {
+	if x != nil {
+		return x.ResponseDescription
+	}
+	return ""
+}
+
+func (x *ExpressQueryResp) GetResponseCode() string {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return ""
+}
+
+func (x *ExpressQueryResp) GetMerchantRequestID() string {
+	if x != nil {
+		return x.MerchantRequestID
+	}
+	return ""
+}
+
+func (x *ExpressQueryResp) GetCheckoutRequestID() string {
+	if x != nil {
+		return x.CheckoutRequestID
+	}
+	return ""
+}
+
+func (x *ExpressQueryResp) GetCustomerMessage() string {
+	if x != nil {
+		return x.CustomerMessage
+	}
+	return ""
+}
+
+func (x *ExpressQueryResp) GetResultCode() string {
+	if x != nil {
+		return x.ResultCode
+	}
+	return ""
+}
+
+func (x *ExpressQueryResp) GetResultDesc() string {
+	if x != nil {
+		return x.ResultDesc
+	}
+	return ""
+}
+
+type ExpressSimulateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResponseDescription string `protobuf:"bytes,1,opt,name=responseDescription,proto3" json:"responseDescription,omitempty"`
+	ResponseCode        string `protobuf:"bytes,2,opt,name=responseCode,proto3" json:"responseCode,omitempty"`
+	MerchantRequestID   string `protobuf:"bytes,3,opt,name=merchantRequestID,proto3" json:"merchantRequestID,omitempty"`
+	CheckoutRequestID   string `protobuf:"bytes,4,opt,name=checkoutRequestID,proto3" json:"checkoutRequestID,omitempty"`
+	CustomerMessage     string `protobuf:"bytes,5,opt,name=customerMessage,proto3" json:"customerMessage,omitempty"`
+}
+
+func (x *ExpressSimulateResp) Reset() {
+	*x = ExpressSimulateResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpressSimulateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpressSimulateResp) ProtoMessage() {}
+
+func (x *ExpressSimulateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpressSimulateResp.ProtoReflect.Descriptor instead.
+func (*ExpressSimulateResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExpressSimulateResp) GetResponseDescription() string {
+	if x != nil {
+		return x.ResponseDescription
+	}
+	return ""
+}
+
+func (x *ExpressSimulateResp) GetResponseCode() string {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return ""
+}
+
+func (x *ExpressSimulateResp) GetMerchantRequestID() string {
+	if x != nil {
+		return x.MerchantRequestID
+	}
+	return ""
+}
+
+func (x *ExpressSimulateResp) GetCheckoutRequestID() string {
+	if x != nil {
+		return x.CheckoutRequestID
+	}
+	return ""
+}
+
+func (x *ExpressSimulateResp) GetCustomerMessage() string {
+	if x != nil {
+		return x.CustomerMessage
+	}
+	return ""
+}
+
+type GenerateQRResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResponseDescription string `protobuf:"bytes,1,opt,name=responseDescription,proto3" json:"responseDescription,omitempty"`
+	ResponseCode        string `protobuf:"bytes,2,opt,name=responseCode,proto3" json:"responseCode,omitempty"`
+	RequestID           string `protobuf:"bytes,3,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	QRCode              string `protobuf:"bytes,4,opt,name=qRCode,proto3" json:"qRCode,omitempty"`
+}
+
+func (x *GenerateQRResp) Reset() {
+	*x = GenerateQRResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateQRResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateQRResp) ProtoMessage() {}
+
+func (x *GenerateQRResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateQRResp.ProtoReflect.Descriptor instead.
+func (*GenerateQRResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GenerateQRResp) GetResponseDescription() string {
+	if x != nil {
+		return x.ResponseDescription
+	}
+	return ""
+}
+
+func (x *GenerateQRResp) GetResponseCode() string {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return ""
+}
+
+func (x *GenerateQRResp) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+func (x *GenerateQRResp) GetQRCode() string {
+	if x != nil {
+		return x.QRCode
+	}
+	return ""
+}
+
+type PullTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionID    string `protobuf:"bytes,1,opt,name=transactionID,proto3" json:"transactionID,omitempty"`
+	TransactionDate  string `protobuf:"bytes,2,opt,name=transactionDate,proto3" json:"transactionDate,omitempty"`
+	Msisdn           string `protobuf:"bytes,3,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
+	Sender           string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	TransactionType  string `protobuf:"bytes,5,opt,name=transactionType,proto3" json:"transactionType,omitempty"`
+	BillReference    string `protobuf:"bytes,6,opt,name=billReference,proto3" json:"billReference,omitempty"`
+	Amount           string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	OrganizationName string `protobuf:"bytes,8,opt,name=organizationName,proto3" json:"organizationName,omitempty"`
+}
+
+func (x *PullTransaction) Reset() {
+	*x = PullTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullTransaction) ProtoMessage() {}
+
+func (x *PullTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullTransaction.ProtoReflect.Descriptor instead.
+func (*PullTransaction) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PullTransaction) GetTransactionID() string {
+	if x != nil {
+		return x.TransactionID
+	}
+	return ""
+}
+
+func (x *PullTransaction) GetTransactionDate() string {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return ""
+}
+
+func (x *PullTransaction) GetMsisdn() string {
+	if x != nil {
+		return x.Msisdn
+	}
+	return ""
+}
+
+func (x *PullTransaction) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *PullTransaction) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *PullTransaction) GetBillReference() string {
+	if x != nil {
+		return x.BillReference
+	}
+	return ""
+}
+
+func (x *PullTransaction) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PullTransaction) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+type PullTransactionsQueryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResponseRefID   string             `protobuf:"bytes,1,opt,name=responseRefID,proto3" json:"responseRefID,omitempty"`
+	ResponseCode    string             `protobuf:"bytes,2,opt,name=responseCode,proto3" json:"responseCode,omitempty"`
+	ResponseMessage string             `protobuf:"bytes,3,opt,name=responseMessage,proto3" json:"responseMessage,omitempty"`
+	Transactions    []*PullTransaction `protobuf:"bytes,4,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *PullTransactionsQueryResp) Reset() {
+	*x = PullTransactionsQueryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullTransactionsQueryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullTransactionsQueryResp) ProtoMessage() {}
+
+func (x *PullTransactionsQueryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullTransactionsQueryResp.ProtoReflect.Descriptor instead.
+func (*PullTransactionsQueryResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PullTransactionsQueryResp) GetResponseRefID() string {
+	if x != nil {
+		return x.ResponseRefID
+	}
+	return ""
+}
+
+func (x *PullTransactionsQueryResp) GetResponseCode() string {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return ""
+}
+
+func (x *PullTransactionsQueryResp) GetResponseMessage() string {
+	if x != nil {
+		return x.ResponseMessage
+	}
+	return ""
+}
+
+func (x *PullTransactionsQueryResp) GetTransactions() []*PullTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type PullTransactionsRegisterResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResponseRefID   string `protobuf:"bytes,1,opt,name=responseRefID,proto3" json:"responseRefID,omitempty"`
+	ResponseCode    string `protobuf:"bytes,2,opt,name=responseCode,proto3" json:"responseCode,omitempty"`
+	ResponseMessage string `protobuf:"bytes,3,opt,name=responseMessage,proto3" json:"responseMessage,omitempty"`
+	ShortCode       string `protobuf:"bytes,4,opt,name=shortCode,proto3" json:"shortCode,omitempty"`
+}
+
+func (x *PullTransactionsRegisterResp) Reset() {
+	*x = PullTransactionsRegisterResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullTransactionsRegisterResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullTransactionsRegisterResp) ProtoMessage() {}
+
+func (x *PullTransactionsRegisterResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullTransactionsRegisterResp.ProtoReflect.Descriptor instead.
+func (*PullTransactionsRegisterResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PullTransactionsRegisterResp) GetResponseRefID() string {
+	if x != nil {
+		return x.ResponseRefID
+	}
+	return ""
+}
+
+func (x *PullTransactionsRegisterResp) GetResponseCode() string {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return ""
+}
+
+func (x *PullTransactionsRegisterResp) GetResponseMessage() string {
+	if x != nil {
+		return x.ResponseMessage
+	}
+	return ""
+}
+
+func (x *PullTransactionsRegisterResp) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+type RemitTaxResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidResp *ValidResp `protobuf:"bytes,1,opt,name=validResp,proto3" json:"validResp,omitempty"`
+}
+
+func (x *RemitTaxResp) Reset() {
+	*x = RemitTaxResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemitTaxResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemitTaxResp) ProtoMessage() {}
+
+func (x *RemitTaxResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemitTaxResp.ProtoReflect.Descriptor instead.
+func (*RemitTaxResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RemitTaxResp) GetValidResp() *ValidResp {
+	if x != nil {
+		return x.ValidResp
+	}
+	return nil
+}
+
+type RespError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestID string `protobuf:"bytes,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Code      string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RespError) Reset() {
+	*x = RespError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespError) ProtoMessage() {}
+
+func (x *RespError) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespError.ProtoReflect.Descriptor instead.
+func (*RespError) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RespError) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+func (x *RespError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RespError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ReverseResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidResp *ValidResp `protobuf:"bytes,1,opt,name=validResp,proto3" json:"validResp,omitempty"`
+}
+
+func (x *ReverseResp) Reset() {
+	*x = ReverseResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseResp) ProtoMessage() {}
+
+func (x *ReverseResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseResp.ProtoReflect.Descriptor instead.
+func (*ReverseResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReverseResp) GetValidResp() *ValidResp {
+	if x != nil {
+		return x.ValidResp
+	}
+	return nil
+}
+
+type SIMSwapResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResponseRefID       string `protobuf:"bytes,1,opt,name=responseRefID,proto3" json:"responseRefID,omitempty"`
+	ResponseCode        string `protobuf:"bytes,2,opt,name=responseCode,proto3" json:"responseCode,omitempty"`
+	ResponseDescription string `protobuf:"bytes,3,opt,name=responseDescription,proto3" json:"responseDescription,omitempty"`
+	CustomerNumber      string `protobuf:"bytes,4,opt,name=customerNumber,proto3" json:"customerNumber,omitempty"`
+	LastSwapDate        string `protobuf:"bytes,5,opt,name=lastSwapDate,proto3" json:"lastSwapDate,omitempty"`
+}
+
+func (x *SIMSwapResp) Reset() {
+	*x = SIMSwapResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_responses_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RespError) String() string {
+func (x *SIMSwapResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespError) ProtoMessage() {}
+func (*SIMSwapResp) ProtoMessage() {}
 
-func (x *RespError) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_responses_proto_msgTypes[9]
+func (x *SIMSwapResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,57 +1451,74 @@ func (x *RespError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespError.ProtoReflect.Descriptor instead.
-func (*RespError) Descriptor() ([]byte, []int) {
-	return file_grpc_responses_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SIMSwapResp.ProtoReflect.Descriptor instead.
+func (*SIMSwapResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *RespError) GetRequestID() string {
+func (x *SIMSwapResp) GetResponseRefID() string {
 	if x != nil {
-		return x.RequestID
+		return x.ResponseRefID
 	}
 	return ""
 }
 
-func (x *RespError) GetCode() string {
+func (x *SIMSwapResp) GetResponseCode() string {
 	if x != nil {
-		return x.Code
+		return x.ResponseCode
 	}
 	return ""
 }
 
-func (x *RespError) GetMessage() string {
+func (x *SIMSwapResp) GetResponseDescription() string {
 	if x != nil {
-		return x.Message
+		return x.ResponseDescription
 	}
 	return ""
 }
 
-type ReverseResp struct {
+func (x *SIMSwapResp) GetCustomerNumber() string {
+	if x != nil {
+		return x.CustomerNumber
+	}
+	return ""
+}
+
+func (x *SIMSwapResp) GetLastSwapDate() string {
+	if x != nil {
+		return x.LastSwapDate
+	}
+	return ""
+}
+
+type StandingOrderResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ValidResp *ValidResp `protobuf:"bytes,1,opt,name=validResp,proto3" json:"validResp,omitempty"`
+	ResponseRefID       string `protobuf:"bytes,1,opt,name=responseRefID,proto3" json:"responseRefID,omitempty"`
+	ResponseCode        string `protobuf:"bytes,2,opt,name=responseCode,proto3" json:"responseCode,omitempty"`
+	ResponseDescription string `protobuf:"bytes,3,opt,name=responseDescription,proto3" json:"responseDescription,omitempty"`
+	ResultDesc          string `protobuf:"bytes,4,opt,name=resultDesc,proto3" json:"resultDesc,omitempty"`
 }
 
-func (x *ReverseResp) Reset() {
-	*x = ReverseResp{}
+func (x *StandingOrderResp) Reset() {
+	*x = StandingOrderResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_responses_proto_msgTypes[10]
+		mi := &file_grpc_responses_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReverseResp) String() string {
+func (x *StandingOrderResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReverseResp) ProtoMessage() {}
+func (*StandingOrderResp) ProtoMessage() {}
 
-func (x *ReverseResp) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_responses_proto_msgTypes[10]
+func (x *StandingOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_responses_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -645,16 +1529,37 @@ func (x *ReverseResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReverseResp.ProtoReflect.Descriptor instead.
-func (*ReverseResp) Descriptor() ([]byte, []int) {
-	return file_grpc_responses_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use StandingOrderResp.ProtoReflect.Descriptor instead.
+func (*StandingOrderResp) Descriptor() ([]byte, []int) {
+	return file_grpc_responses_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ReverseResp) GetValidResp() *ValidResp {
+func (x *StandingOrderResp) GetResponseRefID() string {
 	if x != nil {
-		return x.ValidResp
+		return x.ResponseRefID
 	}
-	return nil
+	return ""
+}
+
+func (x *StandingOrderResp) GetResponseCode() string {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return ""
+}
+
+func (x *StandingOrderResp) GetResponseDescription() string {
+	if x != nil {
+		return x.ResponseDescription
+	}
+	return ""
+}
+
+func (x *StandingOrderResp) GetResultDesc() string {
+	if x != nil {
+		return x.ResultDesc
+	}
+	return ""
 }
 
 type TokenResp struct {
@@ -669,7 +1574,7 @@ type TokenResp struct {
 func (x *TokenResp) Reset() {
 	*x = TokenResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_responses_proto_msgTypes[11]
+		mi := &file_grpc_responses_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -682,7 +1587,7 @@ func (x *TokenResp) String() string {
 func (*TokenResp) ProtoMessage() {}
 
 func (x *TokenResp) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_responses_proto_msgTypes[11]
+	mi := &file_grpc_responses_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,7 +1600,7 @@ func (x *TokenResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenResp.ProtoReflect.Descriptor instead.
 func (*TokenResp) Descriptor() ([]byte, []int) {
-	return file_grpc_responses_proto_rawDescGZIP(), []int{11}
+	return file_grpc_responses_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *TokenResp) GetAccessToken() string {
@@ -723,7 +1628,7 @@ type TransactionStatusResp struct {
 func (x *TransactionStatusResp) Reset() {
 	*x = TransactionStatusResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_responses_proto_msgTypes[12]
+		mi := &file_grpc_responses_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -736,7 +1641,7 @@ func (x *TransactionStatusResp) String() string {
 func (*TransactionStatusResp) ProtoMessage() {}
 
 func (x *TransactionStatusResp) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_responses_proto_msgTypes[12]
+	mi := &file_grpc_responses_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,7 +1654,7 @@ func (x *TransactionStatusResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionStatusResp.ProtoReflect.Descriptor instead.
 func (*TransactionStatusResp) Descriptor() ([]byte, []int) {
-	return file_grpc_responses_proto_rawDescGZIP(), []int{12}
+	return file_grpc_responses_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *TransactionStatusResp) GetValidResp() *ValidResp {
@@ -773,7 +1678,7 @@ type ValidResp struct {
 func (x *ValidResp) Reset() {
 	*x = ValidResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_responses_proto_msgTypes[13]
+		mi := &file_grpc_responses_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -786,7 +1691,7 @@ func (x *ValidResp) String() string {
 func (*ValidResp) ProtoMessage() {}
 
 func (x *ValidResp) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_responses_proto_msgTypes[13]
+	mi := &file_grpc_responses_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,7 +1704,7 @@ func (x *ValidResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidResp.ProtoReflect.Descriptor instead.
 func (*ValidResp) Descriptor() ([]byte, []int) {
-	return file_grpc_responses_proto_rawDescGZIP(), []int{13}
+	return file_grpc_responses_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ValidResp) GetOriginatorConversationID() string {
@@ -840,28 +1745,114 @@ var file_grpc_responses_proto_rawDesc = []byte{
 	0x3a, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61,
 	0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4c, 0x0a, 0x0e, 0x42,
-	0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x68, 0x0a, 0x16, 0x42,
+	0x32, 0x42, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x66, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x66, 0x49, 0x44, 0x22, 0x51, 0x0a, 0x13, 0x42, 0x32, 0x43, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x52, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4c, 0x0a, 0x0e, 0x42, 0x32, 0x43, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x49, 0x0a, 0x0b, 0x42, 0x32, 0x50, 0x6f, 0x63, 0x68,
+	0x69, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x6a, 0x0a, 0x1a, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x42, 0x75, 0x6c, 0x6b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x4c, 0x0a, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c,
+	0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0f, 0x62, 0x69,
+	0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x6c, 0x0a,
+	0x1c, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a,
+	0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76,
+	0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0f, 0x62, 0x69, 0x6c, 0x6c,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7c, 0x0a, 0x14, 0x42,
+	0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x49, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x4c, 0x0a, 0x0f, 0x62,
+	0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x68, 0x0a, 0x18, 0x42, 0x69, 0x6c,
+	0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x52, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6c, 0x0a, 0x1c, 0x42,
+	0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x0f, 0x62,
+	0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x6a, 0x0a, 0x1a, 0x42, 0x69, 0x6c,
+	0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70,
+	0x74, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x52, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x51, 0x0a, 0x13, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x50, 0x61, 0x79, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x52, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x50, 0x0a, 0x12, 0x43, 0x32, 0x42, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a,
+	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x52,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4d, 0x0a, 0x0f, 0x43, 0x32,
+	0x42, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a,
 	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e,
 	0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x52, 0x09,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x51, 0x0a, 0x13, 0x42, 0x75, 0x73,
-	0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x3a, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c,
-	0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x50, 0x0a, 0x12,
-	0x43, 0x32, 0x42, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x3a, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65,
-	0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4d,
-	0x0a, 0x0f, 0x43, 0x32, 0x42, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x3a, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72,
-	0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0xae, 0x02,
-	0x0a, 0x10, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0xae, 0x02, 0x0a, 0x10, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x30,
+	0x0a, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x73, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x73, 0x63, 0x22, 0xf1, 0x01, 0x0a, 0x13, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
 	0x73, 0x70, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65,
 	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
@@ -875,75 +1866,124 @@ var file_grpc_responses_proto_rawDesc = []byte{
 	0x09, 0x52, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
 	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x73, 0x63, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x73, 0x63, 0x22, 0xf1,
-	0x01, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9c,
+	0x01, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x52, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x71, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xa5, 0x02,
+	0x0a, 0x0f, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x62,
+	0x69, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x62, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd7, 0x01, 0x0a, 0x19, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x65, 0x66, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x66, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a,
+	0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xb0, 0x01, 0x0a, 0x1c, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x66, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x65, 0x66, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0x4a, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x54, 0x61, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x3a, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65,
+	0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x57,
+	0x0a, 0x09, 0x52, 0x65, 0x73, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x49, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0xd5, 0x01, 0x0a, 0x0b, 0x53, 0x49, 0x4d, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65,
+	0x66, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x65, 0x66, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x11,
-	0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51,
-	0x52, 0x52, 0x65, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x52, 0x43,
-	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x71, 0x52, 0x43, 0x6f, 0x64,
-	0x65, 0x22, 0x4a, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x54, 0x61, 0x78, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x3a, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72,
-	0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x57, 0x0a,
-	0x09, 0x52, 0x65, 0x73, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x49, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
-	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x45, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20,
-	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x53, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x3a, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72,
-	0x6c, 0x61, 0x79, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0xc5, 0x01,
-	0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x18, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
-	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
-	0x30, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x13,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x44, 0x61, 0x74, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x11, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x66, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x65, 0x66, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x73, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x73, 0x63, 0x22, 0x45, 0x0a, 0x09,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x22, 0x53, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x52, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0xc5, 0x01, 0x0a, 0x09, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x18, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x08, 0x5a, 0x06, 0x2e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x42, 0x08, 0x5a, 0x06, 0x2e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -958,37 +1998,61 @@ func file_grpc_responses_proto_rawDescGZIP() []byte {
 	return file_grpc_responses_proto_rawDescData
 }
 
-var file_grpc_responses_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_grpc_responses_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_grpc_responses_proto_goTypes = []interface{}{
-	(*AccountBalanceResp)(nil),    // 0: mpesaoverlay.grpc.AccountBalanceResp
-	(*B2CPaymentResp)(nil),        // 1: mpesaoverlay.grpc.B2CPaymentResp
-	(*BusinessPayBillResp)(nil),   // 2: mpesaoverlay.grpc.BusinessPayBillResp
-	(*C2BRegisterURLResp)(nil),    // 3: mpesaoverlay.grpc.C2BRegisterURLResp
-	(*C2BSimulateResp)(nil),       // 4: mpesaoverlay.grpc.C2BSimulateResp
-	(*ExpressQueryResp)(nil),      // 5: mpesaoverlay.grpc.ExpressQueryResp
-	(*ExpressSimulateResp)(nil),   // 6: mpesaoverlay.grpc.ExpressSimulateResp
-	(*GenerateQRResp)(nil),        // 7: mpesaoverlay.grpc.GenerateQRResp
-	(*RemitTaxResp)(nil),          // 8: mpesaoverlay.grpc.RemitTaxResp
-	(*RespError)(nil),             // 9: mpesaoverlay.grpc.RespError
-	(*ReverseResp)(nil),           // 10: mpesaoverlay.grpc.ReverseResp
-	(*TokenResp)(nil),             // 11: mpesaoverlay.grpc.TokenResp
-	(*TransactionStatusResp)(nil), // 12: mpesaoverlay.grpc.TransactionStatusResp
-	(*ValidResp)(nil),             // 13: mpesaoverlay.grpc.ValidResp
+	(*AccountBalanceResp)(nil),           // 0: mpesaoverlay.grpc.AccountBalanceResp
+	(*B2BExpressCheckoutResp)(nil),       // 1: mpesaoverlay.grpc.B2BExpressCheckoutResp
+	(*B2CAccountTopUpResp)(nil),          // 2: mpesaoverlay.grpc.B2CAccountTopUpResp
+	(*B2CPaymentResp)(nil),               // 3: mpesaoverlay.grpc.B2CPaymentResp
+	(*B2PochiResp)(nil),                  // 4: mpesaoverlay.grpc.B2PochiResp
+	(*BillManagerBulkInvoiceResp)(nil),   // 5: mpesaoverlay.grpc.BillManagerBulkInvoiceResp
+	(*BillManagerCancelInvoiceResp)(nil), // 6: mpesaoverlay.grpc.BillManagerCancelInvoiceResp
+	(*BillManagerOptInResp)(nil),         // 7: mpesaoverlay.grpc.BillManagerOptInResp
+	(*BillManagerReconcileResp)(nil),     // 8: mpesaoverlay.grpc.BillManagerReconcileResp
+	(*BillManagerResp)(nil),              // 9: mpesaoverlay.grpc.BillManagerResp
+	(*BillManagerSingleInvoiceResp)(nil), // 10: mpesaoverlay.grpc.BillManagerSingleInvoiceResp
+	(*BillManagerUpdateOptInResp)(nil),   // 11: mpesaoverlay.grpc.BillManagerUpdateOptInResp
+	(*BusinessPayBillResp)(nil),          // 12: mpesaoverlay.grpc.BusinessPayBillResp
+	(*C2BRegisterURLResp)(nil),           // 13: mpesaoverlay.grpc.C2BRegisterURLResp
+	(*C2BSimulateResp)(nil),              // 14: mpesaoverlay.grpc.C2BSimulateResp
+	(*ExpressQueryResp)(nil),             // 15: mpesaoverlay.grpc.ExpressQueryResp
+	(*ExpressSimulateResp)(nil),          // 16: mpesaoverlay.grpc.ExpressSimulateResp
+	(*GenerateQRResp)(nil),               // 17: mpesaoverlay.grpc.GenerateQRResp
+	(*PullTransaction)(nil),              // 18: mpesaoverlay.grpc.PullTransaction
+	(*PullTransactionsQueryResp)(nil),    // 19: mpesaoverlay.grpc.PullTransactionsQueryResp
+	(*PullTransactionsRegisterResp)(nil), // 20: mpesaoverlay.grpc.PullTransactionsRegisterResp
+	(*RemitTaxResp)(nil),                 // 21: mpesaoverlay.grpc.RemitTaxResp
+	(*RespError)(nil),                    // 22: mpesaoverlay.grpc.RespError
+	(*ReverseResp)(nil),                  // 23: mpesaoverlay.grpc.ReverseResp
+	(*SIMSwapResp)(nil),                  // 24: mpesaoverlay.grpc.SIMSwapResp
+	(*StandingOrderResp)(nil),            // 25: mpesaoverlay.grpc.StandingOrderResp
+	(*TokenResp)(nil),                    // 26: mpesaoverlay.grpc.TokenResp
+	(*TransactionStatusResp)(nil),        // 27: mpesaoverlay.grpc.TransactionStatusResp
+	(*ValidResp)(nil),                    // 28: mpesaoverlay.grpc.ValidResp
 }
 var file_grpc_responses_proto_depIdxs = []int32{
-	13, // 0: mpesaoverlay.grpc.AccountBalanceResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
-	13, // 1: mpesaoverlay.grpc.B2CPaymentResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
-	13, // 2: mpesaoverlay.grpc.BusinessPayBillResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
-	13, // 3: mpesaoverlay.grpc.C2BRegisterURLResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
-	13, // 4: mpesaoverlay.grpc.C2BSimulateResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
-	13, // 5: mpesaoverlay.grpc.RemitTaxResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
-	13, // 6: mpesaoverlay.grpc.ReverseResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
-	13, // 7: mpesaoverlay.grpc.TransactionStatusResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	28, // 0: mpesaoverlay.grpc.AccountBalanceResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
+	28, // 1: mpesaoverlay.grpc.B2CAccountTopUpResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
+	28, // 2: mpesaoverlay.grpc.B2CPaymentResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
+	28, // 3: mpesaoverlay.grpc.B2PochiResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
+	9,  // 4: mpesaoverlay.grpc.BillManagerBulkInvoiceResp.billManagerResp:type_name -> mpesaoverlay.grpc.BillManagerResp
+	9,  // 5: mpesaoverlay.grpc.BillManagerCancelInvoiceResp.billManagerResp:type_name -> mpesaoverlay.grpc.BillManagerResp
+	9,  // 6: mpesaoverlay.grpc.BillManagerOptInResp.billManagerResp:type_name -> mpesaoverlay.grpc.BillManagerResp
+	9,  // 7: mpesaoverlay.grpc.BillManagerReconcileResp.billManagerResp:type_name -> mpesaoverlay.grpc.BillManagerResp
+	9,  // 8: mpesaoverlay.grpc.BillManagerSingleInvoiceResp.billManagerResp:type_name -> mpesaoverlay.grpc.BillManagerResp
+	9,  // 9: mpesaoverlay.grpc.BillManagerUpdateOptInResp.billManagerResp:type_name -> mpesaoverlay.grpc.BillManagerResp
+	28, // 10: mpesaoverlay.grpc.BusinessPayBillResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
+	28, // 11: mpesaoverlay.grpc.C2BRegisterURLResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
+	28, // 12: mpesaoverlay.grpc.C2BSimulateResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
+	18, // 13: mpesaoverlay.grpc.PullTransactionsQueryResp.transactions:type_name -> mpesaoverlay.grpc.PullTransaction
+	28, // 14: mpesaoverlay.grpc.RemitTaxResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
+	28, // 15: mpesaoverlay.grpc.ReverseResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
+	28, // 16: mpesaoverlay.grpc.TransactionStatusResp.validResp:type_name -> mpesaoverlay.grpc.ValidResp
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_grpc_responses_proto_init() }
@@ -1010,7 +2074,7 @@ func file_grpc_responses_proto_init() {
 			}
 		}
 		file_grpc_responses_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*B2CPaymentResp); i {
+			switch v := v.(*B2BExpressCheckoutResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1022,7 +2086,7 @@ func file_grpc_responses_proto_init() {
 			}
 		}
 		file_grpc_responses_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BusinessPayBillResp); i {
+			switch v := v.(*B2CAccountTopUpResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1034,7 +2098,7 @@ func file_grpc_responses_proto_init() {
 			}
 		}
 		file_grpc_responses_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2BRegisterURLResp); i {
+			switch v := v.(*B2CPaymentResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1046,7 +2110,7 @@ func file_grpc_responses_proto_init() {
 			}
 		}
 		file_grpc_responses_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2BSimulateResp); i {
+			switch v := v.(*B2PochiResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1058,7 +2122,7 @@ func file_grpc_responses_proto_init() {
 			}
 		}
 		file_grpc_responses_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExpressQueryResp); i {
+			switch v := v.(*BillManagerBulkInvoiceResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1070,7 +2134,7 @@ func file_grpc_responses_proto_init() {
 			}
 		}
 		file_grpc_responses_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExpressSimulateResp); i {
+			switch v := v.(*BillManagerCancelInvoiceResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1082,7 +2146,7 @@ func file_grpc_responses_proto_init() {
 			}
 		}
 		file_grpc_responses_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateQRResp); i {
+			switch v := v.(*BillManagerOptInResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1094,7 +2158,7 @@ func file_grpc_responses_proto_init() {
 			}
 		}
 		file_grpc_responses_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemitTaxResp); i {
+			switch v := v.(*BillManagerReconcileResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1106,7 +2170,7 @@ func file_grpc_responses_proto_init() {
 			}
 		}
 		file_grpc_responses_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespError); i {
+			switch v := v.(*BillManagerResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1118,7 +2182,7 @@ func file_grpc_responses_proto_init() {
 			}
 		}
 		file_grpc_responses_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReverseResp); i {
+			switch v := v.(*BillManagerSingleInvoiceResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1130,7 +2194,7 @@ func file_grpc_responses_proto_init() {
 			}
 		}
 		file_grpc_responses_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenResp); i {
+			switch v := v.(*BillManagerUpdateOptInResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1142,7 +2206,7 @@ func file_grpc_responses_proto_init() {
 			}
 		}
 		file_grpc_responses_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionStatusResp); i {
+			switch v := v.(*BusinessPayBillResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1154,6 +2218,186 @@ func file_grpc_responses_proto_init() {
 			}
 		}
 		file_grpc_responses_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2BRegisterURLResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2BSimulateResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpressQueryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpressSimulateResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateQRResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullTransactionsQueryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullTransactionsRegisterResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemitTaxResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SIMSwapResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandingOrderResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_responses_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ValidResp); i {
 			case 0:
 				return &v.state
@@ -1172,7 +2416,7 @@ func file_grpc_responses_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_grpc_responses_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
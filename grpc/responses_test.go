@@ -20,6 +20,12 @@ var validGRPCResp = &grpc.ValidResp{
 	ResponseCode:             "0",
 }
 
+var billManagerGRPCResp = &grpc.BillManagerResp{
+	ResponseMessage: "Success",
+	ResponseCode:    "200",
+	StatusMessage:   "Invoice sent successfully",
+}
+
 func TestAccountBalanceResp(t *testing.T) {
 	resp := grpc.AccountBalanceResp{
 		ValidResp: validGRPCResp,
@@ -479,3 +485,527 @@ func TestBusinessPayBillResp(t *testing.T) {
 	val = resp.String()
 	assert.Empty(t, val)
 }
+
+func TestBillManagerResp(t *testing.T) {
+	resp := grpc.BillManagerResp{
+		ResponseMessage: "Success",
+		ResponseCode:    "200",
+		StatusMessage:   "Invoice sent successfully",
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetResponseMessage()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResponseMessage, val2)
+
+	val2 = resp.GetResponseCode()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResponseCode, val2)
+
+	val2 = resp.GetStatusMessage()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.StatusMessage, val2)
+
+	val3, val4 := resp.Descriptor()
+	assert.NotEmpty(t, val3)
+	assert.NotEmpty(t, val4)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
+
+func TestBillManagerOptInResp(t *testing.T) {
+	resp := grpc.BillManagerOptInResp{
+		AppKey:          "AG_2376487236_126732989KJ",
+		BillManagerResp: billManagerGRPCResp,
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetBillManagerResp()
+	assert.NotEmpty(t, val2)
+
+	val5 := resp.GetAppKey()
+	assert.NotEmpty(t, val5)
+	assert.Equal(t, resp.AppKey, val5)
+
+	val3, val4 := resp.Descriptor()
+	assert.NotEmpty(t, val3)
+	assert.NotEmpty(t, val4)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
+
+func TestBillManagerUpdateOptInResp(t *testing.T) {
+	resp := grpc.BillManagerUpdateOptInResp{
+		BillManagerResp: billManagerGRPCResp,
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetBillManagerResp()
+	assert.NotEmpty(t, val2)
+
+	val3, val4 := resp.Descriptor()
+	assert.NotEmpty(t, val3)
+	assert.NotEmpty(t, val4)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
+
+func TestBillManagerSingleInvoiceResp(t *testing.T) {
+	resp := grpc.BillManagerSingleInvoiceResp{
+		BillManagerResp: billManagerGRPCResp,
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetBillManagerResp()
+	assert.NotEmpty(t, val2)
+
+	val3, val4 := resp.Descriptor()
+	assert.NotEmpty(t, val3)
+	assert.NotEmpty(t, val4)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
+
+func TestBillManagerBulkInvoiceResp(t *testing.T) {
+	resp := grpc.BillManagerBulkInvoiceResp{
+		BillManagerResp: billManagerGRPCResp,
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetBillManagerResp()
+	assert.NotEmpty(t, val2)
+
+	val3, val4 := resp.Descriptor()
+	assert.NotEmpty(t, val3)
+	assert.NotEmpty(t, val4)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
+
+func TestBillManagerCancelInvoiceResp(t *testing.T) {
+	resp := grpc.BillManagerCancelInvoiceResp{
+		BillManagerResp: billManagerGRPCResp,
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetBillManagerResp()
+	assert.NotEmpty(t, val2)
+
+	val3, val4 := resp.Descriptor()
+	assert.NotEmpty(t, val3)
+	assert.NotEmpty(t, val4)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
+
+func TestBillManagerReconcileResp(t *testing.T) {
+	resp := grpc.BillManagerReconcileResp{
+		BillManagerResp: billManagerGRPCResp,
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetBillManagerResp()
+	assert.NotEmpty(t, val2)
+
+	val3, val4 := resp.Descriptor()
+	assert.NotEmpty(t, val3)
+	assert.NotEmpty(t, val4)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
+
+func TestPullTransactionsRegisterResp(t *testing.T) {
+	resp := grpc.PullTransactionsRegisterResp{
+		ResponseRefID:   "1a2b3c4d",
+		ResponseCode:    "1000",
+		ResponseMessage: "Success",
+		ShortCode:       "600984",
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetResponseRefID()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResponseRefID, val2)
+
+	val2 = resp.GetResponseCode()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResponseCode, val2)
+
+	val2 = resp.GetResponseMessage()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResponseMessage, val2)
+
+	val2 = resp.GetShortCode()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ShortCode, val2)
+
+	val3, val4 := resp.Descriptor()
+	assert.NotEmpty(t, val3)
+	assert.NotEmpty(t, val4)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
+
+func TestPullTransaction(t *testing.T) {
+	resp := grpc.PullTransaction{
+		TransactionID:    "RJB53MYR1N",
+		TransactionDate:  "2024-03-01T10:00:00+03:00",
+		Msisdn:           "254712345678",
+		Sender:           "John Doe",
+		TransactionType:  "c2b-pay-bill-debit",
+		BillReference:    "A1",
+		Amount:           "100.00",
+		OrganizationName: "Example Ltd",
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetTransactionID()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.TransactionID, val2)
+
+	val2 = resp.GetTransactionDate()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.TransactionDate, val2)
+
+	val2 = resp.GetMsisdn()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.Msisdn, val2)
+
+	val2 = resp.GetSender()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.Sender, val2)
+
+	val2 = resp.GetTransactionType()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.TransactionType, val2)
+
+	val2 = resp.GetBillReference()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.BillReference, val2)
+
+	val3 := resp.GetAmount()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, resp.Amount, val3)
+
+	val2 = resp.GetOrganizationName()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.OrganizationName, val2)
+
+	val4, val5 := resp.Descriptor()
+	assert.NotEmpty(t, val4)
+	assert.NotEmpty(t, val5)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
+
+func TestPullTransactionsQueryResp(t *testing.T) {
+	resp := grpc.PullTransactionsQueryResp{
+		ResponseRefID:   "1a2b3c4d",
+		ResponseCode:    "1000",
+		ResponseMessage: "Success",
+		Transactions:    []*grpc.PullTransaction{{TransactionID: "RJB53MYR1N", Amount: "100.00"}},
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetResponseRefID()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResponseRefID, val2)
+
+	val2 = resp.GetResponseCode()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResponseCode, val2)
+
+	val2 = resp.GetResponseMessage()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResponseMessage, val2)
+
+	val3 := resp.GetTransactions()
+	assert.NotEmpty(t, val3)
+	assert.Equal(t, resp.Transactions, val3)
+
+	val4, val5 := resp.Descriptor()
+	assert.NotEmpty(t, val4)
+	assert.NotEmpty(t, val5)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
+
+func TestStandingOrderResp(t *testing.T) {
+	resp := grpc.StandingOrderResp{
+		ResponseRefID:       "4dd9b5d9-d738-42ba-9326-2cc99e966000",
+		ResponseCode:        "200",
+		ResponseDescription: "Request accepted for processing",
+		ResultDesc:          "The service request is processed successfully.",
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetResponseRefID()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResponseRefID, val2)
+
+	val2 = resp.GetResponseCode()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResponseCode, val2)
+
+	val2 = resp.GetResponseDescription()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResponseDescription, val2)
+
+	val2 = resp.GetResultDesc()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResultDesc, val2)
+
+	val3, val4 := resp.Descriptor()
+	assert.NotEmpty(t, val3)
+	assert.NotEmpty(t, val4)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
+
+func TestB2BExpressCheckoutResp(t *testing.T) {
+	resp := grpc.B2BExpressCheckoutResp{
+		Code:         "0",
+		Status:       "USSD Initiated Successfully",
+		RequestRefID: "01HRZ8Y4M2B2X0E6Q5N8J3K7TA",
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetCode()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.Code, val2)
+
+	val2 = resp.GetStatus()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.Status, val2)
+
+	val2 = resp.GetRequestRefID()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.RequestRefID, val2)
+
+	val3, val4 := resp.Descriptor()
+	assert.NotEmpty(t, val3)
+	assert.NotEmpty(t, val4)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
+
+func TestB2CAccountTopUpResp(t *testing.T) {
+	resp := grpc.B2CAccountTopUpResp{
+		ValidResp: &grpc.ValidResp{ResponseCode: "0"},
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetValidResp()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ValidResp, val2)
+
+	val3, val4 := resp.Descriptor()
+	assert.NotEmpty(t, val3)
+	assert.NotEmpty(t, val4)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
+
+func TestB2PochiResp(t *testing.T) {
+	resp := grpc.B2PochiResp{
+		ValidResp: &grpc.ValidResp{ResponseCode: "0"},
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetValidResp()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ValidResp, val2)
+
+	val3, val4 := resp.Descriptor()
+	assert.NotEmpty(t, val3)
+	assert.NotEmpty(t, val4)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
+
+func TestSIMSwapResp(t *testing.T) {
+	resp := grpc.SIMSwapResp{
+		ResponseRefID:       "0b7d3f51-4dc4-45e6-8f2f-6f5b0c3c2a10",
+		ResponseCode:        "200",
+		ResponseDescription: "Success",
+		CustomerNumber:      "254722000000",
+		LastSwapDate:        "2024-01-15T10:30:00+03:00",
+	}
+
+	val := resp.String()
+	assert.NotEmpty(t, val)
+
+	resp.ProtoMessage()
+
+	val1 := resp.ProtoReflect()
+	assert.NotEmpty(t, val1)
+
+	val2 := resp.GetResponseRefID()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResponseRefID, val2)
+
+	val2 = resp.GetResponseCode()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResponseCode, val2)
+
+	val2 = resp.GetResponseDescription()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.ResponseDescription, val2)
+
+	val2 = resp.GetCustomerNumber()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.CustomerNumber, val2)
+
+	val2 = resp.GetLastSwapDate()
+	assert.NotEmpty(t, val2)
+	assert.Equal(t, resp.LastSwapDate, val2)
+
+	val3, val4 := resp.Descriptor()
+	assert.NotEmpty(t, val3)
+	assert.NotEmpty(t, val4)
+
+	resp.Reset()
+
+	val = resp.String()
+	assert.Empty(t, val)
+}
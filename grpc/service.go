@@ -7,34 +7,62 @@
 package grpc
 
 import (
+	"context"
+
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 )
 
 // Service is the interface that provides methods for the MpesaOverlay SDK.
 type Service interface {
-	Token() (mpesa.TokenResp, error)
+	Token(ctx context.Context) (mpesa.TokenResp, error)
+
+	ExpressQuery(ctx context.Context, eqReq mpesa.ExpressQueryReq) (mpesa.ExpressQueryResp, error)
+
+	ExpressSimulate(ctx context.Context, eReq mpesa.ExpressSimulateReq) (mpesa.ExpressSimulateResp, error)
+
+	B2CPayment(ctx context.Context, b2cReq mpesa.B2CPaymentReq) (mpesa.B2CPaymentResp, error)
+
+	AccountBalance(ctx context.Context, abReq mpesa.AccountBalanceReq) (mpesa.AccountBalanceResp, error)
+
+	C2BRegisterURL(ctx context.Context, c2bReq mpesa.C2BRegisterURLReq) (mpesa.C2BRegisterURLResp, error)
+
+	C2BSimulate(ctx context.Context, c2bReq mpesa.C2BSimulateReq) (mpesa.C2BSimulateResp, error)
+
+	GenerateQR(ctx context.Context, qReq mpesa.GenerateQRReq) (mpesa.GenerateQRResp, error)
+
+	Reverse(ctx context.Context, rReq mpesa.ReverseReq) (mpesa.ReverseResp, error)
+
+	TransactionStatus(ctx context.Context, tReq mpesa.TransactionStatusReq) (mpesa.TransactionStatusResp, error)
 
-	ExpressQuery(eqReq mpesa.ExpressQueryReq) (mpesa.ExpressQueryResp, error)
+	RemitTax(ctx context.Context, rReq mpesa.RemitTaxReq) (mpesa.RemitTaxResp, error)
 
-	ExpressSimulate(eReq mpesa.ExpressSimulateReq) (mpesa.ExpressSimulateResp, error)
+	BusinessPayBill(ctx context.Context, bpbReq mpesa.BusinessPayBillReq) (mpesa.BusinessPayBillResp, error)
 
-	B2CPayment(b2cReq mpesa.B2CPaymentReq) (mpesa.B2CPaymentResp, error)
+	BillManagerOptIn(ctx context.Context, oReq mpesa.BillManagerOptInReq) (mpesa.BillManagerOptInResp, error)
 
-	AccountBalance(abReq mpesa.AccountBalanceReq) (mpesa.AccountBalanceResp, error)
+	BillManagerUpdateOptIn(ctx context.Context, uReq mpesa.BillManagerUpdateOptInReq) (mpesa.BillManagerUpdateOptInResp, error)
 
-	C2BRegisterURL(c2bReq mpesa.C2BRegisterURLReq) (mpesa.C2BRegisterURLResp, error)
+	BillManagerSingleInvoice(ctx context.Context, iReq mpesa.BillManagerSingleInvoiceReq) (mpesa.BillManagerSingleInvoiceResp, error)
 
-	C2BSimulate(c2bReq mpesa.C2BSimulateReq) (mpesa.C2BSimulateResp, error)
+	BillManagerBulkInvoice(ctx context.Context, bReq mpesa.BillManagerBulkInvoiceReq) (mpesa.BillManagerBulkInvoiceResp, error)
 
-	GenerateQR(qReq mpesa.GenerateQRReq) (mpesa.GenerateQRResp, error)
+	BillManagerCancelInvoice(ctx context.Context, cReq mpesa.BillManagerCancelInvoiceReq) (mpesa.BillManagerCancelInvoiceResp, error)
 
-	Reverse(rReq mpesa.ReverseReq) (mpesa.ReverseResp, error)
+	BillManagerReconcile(ctx context.Context, rReq mpesa.BillManagerReconcileReq) (mpesa.BillManagerReconcileResp, error)
 
-	TransactionStatus(tReq mpesa.TransactionStatusReq) (mpesa.TransactionStatusResp, error)
+	PullTransactionsRegister(ctx context.Context, prReq mpesa.PullTransactionsRegisterReq) (mpesa.PullTransactionsRegisterResp, error)
 
-	RemitTax(rReq mpesa.RemitTaxReq) (mpesa.RemitTaxResp, error)
+	PullTransactionsQuery(ctx context.Context, pqReq mpesa.PullTransactionsQueryReq) (mpesa.PullTransactionsQueryResp, error)
 
-	BusinessPayBill(bpbReq mpesa.BusinessPayBillReq) (mpesa.BusinessPayBillResp, error)
+	StandingOrder(ctx context.Context, soReq mpesa.StandingOrderReq) (mpesa.StandingOrderResp, error)
+
+	B2BExpressCheckout(ctx context.Context, b2bReq mpesa.B2BExpressCheckoutReq) (mpesa.B2BExpressCheckoutResp, error)
+
+	B2CAccountTopUp(ctx context.Context, topUpReq mpesa.B2CAccountTopUpReq) (mpesa.B2CAccountTopUpResp, error)
+
+	B2Pochi(ctx context.Context, pochiReq mpesa.B2PochiReq) (mpesa.B2PochiResp, error)
+
+	SIMSwap(ctx context.Context, ssReq mpesa.SIMSwapReq) (mpesa.SIMSwapResp, error)
 }
 
 // service implements the Service interface.
@@ -49,50 +77,102 @@ func NewService(sdk mpesa.SDK) Service {
 	return &service{sdk: sdk}
 }
 
-func (s *service) Token() (mpesa.TokenResp, error) {
-	return s.sdk.Token()
+func (s *service) Token(ctx context.Context) (mpesa.TokenResp, error) {
+	return s.sdk.Token(ctx)
+}
+
+func (s *service) ExpressQuery(ctx context.Context, eqReq mpesa.ExpressQueryReq) (mpesa.ExpressQueryResp, error) {
+	return s.sdk.ExpressQuery(ctx, eqReq)
+}
+
+func (s *service) ExpressSimulate(ctx context.Context, eReq mpesa.ExpressSimulateReq) (mpesa.ExpressSimulateResp, error) {
+	return s.sdk.ExpressSimulate(ctx, eReq)
+}
+
+func (s *service) B2CPayment(ctx context.Context, b2cReq mpesa.B2CPaymentReq) (mpesa.B2CPaymentResp, error) {
+	return s.sdk.B2CPayment(ctx, b2cReq)
+}
+
+func (s *service) AccountBalance(ctx context.Context, abReq mpesa.AccountBalanceReq) (mpesa.AccountBalanceResp, error) {
+	return s.sdk.AccountBalance(ctx, abReq)
+}
+
+func (s *service) C2BRegisterURL(ctx context.Context, c2bReq mpesa.C2BRegisterURLReq) (mpesa.C2BRegisterURLResp, error) {
+	return s.sdk.C2BRegisterURL(ctx, c2bReq)
+}
+
+func (s *service) C2BSimulate(ctx context.Context, c2bReq mpesa.C2BSimulateReq) (mpesa.C2BSimulateResp, error) {
+	return s.sdk.C2BSimulate(ctx, c2bReq)
+}
+
+func (s *service) GenerateQR(ctx context.Context, qReq mpesa.GenerateQRReq) (mpesa.GenerateQRResp, error) {
+	return s.sdk.GenerateQR(ctx, qReq)
+}
+
+func (s *service) Reverse(ctx context.Context, rReq mpesa.ReverseReq) (mpesa.ReverseResp, error) {
+	return s.sdk.Reverse(ctx, rReq)
+}
+
+func (s *service) TransactionStatus(ctx context.Context, tReq mpesa.TransactionStatusReq) (mpesa.TransactionStatusResp, error) {
+	return s.sdk.TransactionStatus(ctx, tReq)
+}
+
+func (s *service) RemitTax(ctx context.Context, rReq mpesa.RemitTaxReq) (mpesa.RemitTaxResp, error) {
+	return s.sdk.RemitTax(ctx, rReq)
+}
+
+func (s *service) BusinessPayBill(ctx context.Context, bpbReq mpesa.BusinessPayBillReq) (mpesa.BusinessPayBillResp, error) {
+	return s.sdk.BusinessPayBill(ctx, bpbReq)
+}
+
+func (s *service) BillManagerOptIn(ctx context.Context, oReq mpesa.BillManagerOptInReq) (mpesa.BillManagerOptInResp, error) {
+	return s.sdk.BillManagerOptIn(ctx, oReq)
+}
+
+func (s *service) BillManagerUpdateOptIn(ctx context.Context, uReq mpesa.BillManagerUpdateOptInReq) (mpesa.BillManagerUpdateOptInResp, error) {
+	return s.sdk.BillManagerUpdateOptIn(ctx, uReq)
 }
 
-func (s *service) ExpressQuery(eqReq mpesa.ExpressQueryReq) (mpesa.ExpressQueryResp, error) {
-	return s.sdk.ExpressQuery(eqReq)
+func (s *service) BillManagerSingleInvoice(ctx context.Context, iReq mpesa.BillManagerSingleInvoiceReq) (mpesa.BillManagerSingleInvoiceResp, error) {
+	return s.sdk.BillManagerSingleInvoice(ctx, iReq)
 }
 
-func (s *service) ExpressSimulate(eReq mpesa.ExpressSimulateReq) (mpesa.ExpressSimulateResp, error) {
-	return s.sdk.ExpressSimulate(eReq)
+func (s *service) BillManagerBulkInvoice(ctx context.Context, bReq mpesa.BillManagerBulkInvoiceReq) (mpesa.BillManagerBulkInvoiceResp, error) {
+	return s.sdk.BillManagerBulkInvoice(ctx, bReq)
 }
 
-func (s *service) B2CPayment(b2cReq mpesa.B2CPaymentReq) (mpesa.B2CPaymentResp, error) {
-	return s.sdk.B2CPayment(b2cReq)
+func (s *service) BillManagerCancelInvoice(ctx context.Context, cReq mpesa.BillManagerCancelInvoiceReq) (mpesa.BillManagerCancelInvoiceResp, error) {
+	return s.sdk.BillManagerCancelInvoice(ctx, cReq)
 }
 
-func (s *service) AccountBalance(abReq mpesa.AccountBalanceReq) (mpesa.AccountBalanceResp, error) {
-	return s.sdk.AccountBalance(abReq)
+func (s *service) BillManagerReconcile(ctx context.Context, rReq mpesa.BillManagerReconcileReq) (mpesa.BillManagerReconcileResp, error) {
+	return s.sdk.BillManagerReconcile(ctx, rReq)
 }
 
-func (s *service) C2BRegisterURL(c2bReq mpesa.C2BRegisterURLReq) (mpesa.C2BRegisterURLResp, error) {
-	return s.sdk.C2BRegisterURL(c2bReq)
+func (s *service) PullTransactionsRegister(ctx context.Context, prReq mpesa.PullTransactionsRegisterReq) (mpesa.PullTransactionsRegisterResp, error) {
+	return s.sdk.PullTransactionsRegister(ctx, prReq)
 }
 
-func (s *service) C2BSimulate(c2bReq mpesa.C2BSimulateReq) (mpesa.C2BSimulateResp, error) {
-	return s.sdk.C2BSimulate(c2bReq)
+func (s *service) PullTransactionsQuery(ctx context.Context, pqReq mpesa.PullTransactionsQueryReq) (mpesa.PullTransactionsQueryResp, error) {
+	return s.sdk.PullTransactionsQuery(ctx, pqReq)
 }
 
-func (s *service) GenerateQR(qReq mpesa.GenerateQRReq) (mpesa.GenerateQRResp, error) {
-	return s.sdk.GenerateQR(qReq)
+func (s *service) StandingOrder(ctx context.Context, soReq mpesa.StandingOrderReq) (mpesa.StandingOrderResp, error) {
+	return s.sdk.StandingOrder(ctx, soReq)
 }
 
-func (s *service) Reverse(rReq mpesa.ReverseReq) (mpesa.ReverseResp, error) {
-	return s.sdk.Reverse(rReq)
+func (s *service) B2BExpressCheckout(ctx context.Context, b2bReq mpesa.B2BExpressCheckoutReq) (mpesa.B2BExpressCheckoutResp, error) {
+	return s.sdk.B2BExpressCheckout(ctx, b2bReq)
 }
 
-func (s *service) TransactionStatus(tReq mpesa.TransactionStatusReq) (mpesa.TransactionStatusResp, error) {
-	return s.sdk.TransactionStatus(tReq)
+func (s *service) B2CAccountTopUp(ctx context.Context, topUpReq mpesa.B2CAccountTopUpReq) (mpesa.B2CAccountTopUpResp, error) {
+	return s.sdk.B2CAccountTopUp(ctx, topUpReq)
 }
 
-func (s *service) RemitTax(rReq mpesa.RemitTaxReq) (mpesa.RemitTaxResp, error) {
-	return s.sdk.RemitTax(rReq)
+func (s *service) B2Pochi(ctx context.Context, pochiReq mpesa.B2PochiReq) (mpesa.B2PochiResp, error) {
+	return s.sdk.B2Pochi(ctx, pochiReq)
 }
 
-func (s *service) BusinessPayBill(bpbReq mpesa.BusinessPayBillReq) (mpesa.BusinessPayBillResp, error) {
-	return s.sdk.BusinessPayBill(bpbReq)
+func (s *service) SIMSwap(ctx context.Context, ssReq mpesa.SIMSwapReq) (mpesa.SIMSwapResp, error) {
+	return s.sdk.SIMSwap(ctx, ssReq)
 }
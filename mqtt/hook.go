@@ -8,10 +8,13 @@ package mqtt
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"strings"
 
 	"github.com/0x6flab/mpesaoverlay"
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 	mqtt "github.com/mochi-mqtt/server/v2"
 	"github.com/mochi-mqtt/server/v2/packets"
 	"go.uber.org/zap"
@@ -97,7 +100,7 @@ func (h *Hook) OnPublished(cl *mqtt.Client, pk packets.Packet) {
 		zap.String("topic", pk.TopicName),
 	)
 
-	h.handleMessages(pk)
+	h.handleMessages(context.Background(), pk)
 }
 
 func (h *Hook) OnSubscribed(cl *mqtt.Client, pk packets.Packet, _ []byte) {
@@ -119,20 +122,27 @@ func (h *Hook) OnUnsubscribed(cl *mqtt.Client, pk packets.Packet) {
 }
 
 // handleMessages handles the inbound MQTT messages.
-func (h *Hook) handleMessages(pk packets.Packet) {
+func (h *Hook) handleMessages(ctx context.Context, pk packets.Packet) {
 	switch pk.TopicName {
 	case "mpesa/token":
 		h.logger.Info("handling token")
-		resp, _ := h.Token(pk)
+		resp, err := h.Token(ctx, pk)
+		if err != nil {
+			h.logger.Error("failed to handle token", zap.Error(err))
+			h.publishError("mpesa/token", err)
+
+			return
+		}
 		h.logger.Info("token", zap.Any("resp", resp))
 
 		h.publish("mpesa/token", resp)
 
 	case "mpesa/express/query":
 		h.logger.Info("handling express query")
-		resp, err := h.ExpressQuery(pk)
+		resp, err := h.ExpressQuery(ctx, pk)
 		if err != nil {
 			h.logger.Error("failed to handle express query", zap.Error(err))
+			h.publishError("mpesa/express/query", err)
 
 			return
 		}
@@ -140,29 +150,65 @@ func (h *Hook) handleMessages(pk packets.Packet) {
 
 	case "mpesa/express/simulate":
 		h.logger.Info("handling express simulate")
-		resp, err := h.ExpressSimulate(pk)
+		resp, err := h.ExpressSimulate(ctx, pk)
 		if err != nil {
 			h.logger.Error("failed to handle express simulate", zap.Error(err))
+			h.publishError("mpesa/express/simulate", err)
 
 			return
 		}
 		h.publish("mpesa/express/simulate", resp)
 
+	case "mpesa/express/standingorder":
+		h.logger.Info("handling standing order")
+		resp, err := h.StandingOrder(ctx, pk)
+		if err != nil {
+			h.logger.Error("failed to handle standing order", zap.Error(err))
+			h.publishError("mpesa/express/standingorder", err)
+
+			return
+		}
+		h.publish("mpesa/express/standingorder", resp)
+
 	case "mpesa/b2c/payment":
 		h.logger.Info("handling b2c payment")
-		resp, err := h.B2CPayment(pk)
+		resp, err := h.B2CPayment(ctx, pk)
 		if err != nil {
 			h.logger.Error("failed to handle b2c payment", zap.Error(err))
+			h.publishError("mpesa/b2c/payment", err)
 
 			return
 		}
 		h.publish("mpesa/b2c/payment", resp)
 
+	case "mpesa/b2c/topup":
+		h.logger.Info("handling b2c account top up")
+		resp, err := h.B2CAccountTopUp(ctx, pk)
+		if err != nil {
+			h.logger.Error("failed to handle b2c account top up", zap.Error(err))
+			h.publishError("mpesa/b2c/topup", err)
+
+			return
+		}
+		h.publish("mpesa/b2c/topup", resp)
+
+	case "mpesa/b2c/pochi":
+		h.logger.Info("handling b2pochi")
+		resp, err := h.B2Pochi(ctx, pk)
+		if err != nil {
+			h.logger.Error("failed to handle b2pochi", zap.Error(err))
+			h.publishError("mpesa/b2c/pochi", err)
+
+			return
+		}
+		h.publish("mpesa/b2c/pochi", resp)
+
 	case "mpesa/account/balance":
 		h.logger.Info("handling account balance")
-		resp, err := h.AccountBalance(pk)
+		resp, err := h.AccountBalance(ctx, pk)
 		if err != nil {
 			h.logger.Error("failed to handle account balance", zap.Error(err))
+			h.publishError("mpesa/account/balance", err)
 
 			return
 		}
@@ -170,9 +216,10 @@ func (h *Hook) handleMessages(pk packets.Packet) {
 
 	case "mpesa/c2b/register":
 		h.logger.Info("handling c2b register")
-		resp, err := h.C2BRegisterURL(pk)
+		resp, err := h.C2BRegisterURL(ctx, pk)
 		if err != nil {
 			h.logger.Error("failed to handle c2b register", zap.Error(err))
+			h.publishError("mpesa/c2b/register", err)
 
 			return
 		}
@@ -180,9 +227,10 @@ func (h *Hook) handleMessages(pk packets.Packet) {
 
 	case "mpesa/c2b/simulate":
 		h.logger.Info("handling c2b simulate")
-		resp, err := h.C2BSimulate(pk)
+		resp, err := h.C2BSimulate(ctx, pk)
 		if err != nil {
 			h.logger.Error("failed to handle c2b simulate", zap.Error(err))
+			h.publishError("mpesa/c2b/simulate", err)
 
 			return
 		}
@@ -190,9 +238,10 @@ func (h *Hook) handleMessages(pk packets.Packet) {
 
 	case "mpesa/generate/qr":
 		h.logger.Info("handling generate qr")
-		resp, err := h.GenerateQR(pk)
+		resp, err := h.GenerateQR(ctx, pk)
 		if err != nil {
 			h.logger.Error("failed to handle generate qr", zap.Error(err))
+			h.publishError("mpesa/generate/qr", err)
 
 			return
 		}
@@ -200,9 +249,10 @@ func (h *Hook) handleMessages(pk packets.Packet) {
 
 	case "mpesa/reverse":
 		h.logger.Info("handling reverse")
-		resp, err := h.Reverse(pk)
+		resp, err := h.Reverse(ctx, pk)
 		if err != nil {
 			h.logger.Error("failed to handle reverse", zap.Error(err))
+			h.publishError("mpesa/reverse", err)
 
 			return
 		}
@@ -210,19 +260,32 @@ func (h *Hook) handleMessages(pk packets.Packet) {
 
 	case "mpesa/transaction/status":
 		h.logger.Info("handling transaction status")
-		resp, err := h.TransactionStatus(pk)
+		resp, err := h.TransactionStatus(ctx, pk)
 		if err != nil {
 			h.logger.Error("failed to handle transaction status", zap.Error(err))
+			h.publishError("mpesa/transaction/status", err)
 
 			return
 		}
 		h.publish("mpesa/transaction/status", resp)
 
+	case "mpesa/simswap":
+		h.logger.Info("handling sim swap")
+		resp, err := h.SIMSwap(ctx, pk)
+		if err != nil {
+			h.logger.Error("failed to handle sim swap", zap.Error(err))
+			h.publishError("mpesa/simswap", err)
+
+			return
+		}
+		h.publish("mpesa/simswap", resp)
+
 	case "mpesa/remit/tax":
 		h.logger.Info("handling remit tax")
-		resp, err := h.RemitTax(pk)
+		resp, err := h.RemitTax(ctx, pk)
 		if err != nil {
 			h.logger.Error("failed to handle remit tax", zap.Error(err))
+			h.publishError("mpesa/remit/tax", err)
 
 			return
 		}
@@ -230,14 +293,92 @@ func (h *Hook) handleMessages(pk packets.Packet) {
 
 	case "mpesa/b2b/payment":
 		h.logger.Info("handling b2b payment")
-		resp, err := h.BusinessPayBill(pk)
+		resp, err := h.BusinessPayBill(ctx, pk)
 		if err != nil {
 			h.logger.Error("failed to handle b2b payment", zap.Error(err))
+			h.publishError("mpesa/b2b/payment", err)
 
 			return
 		}
 		h.publish("mpesa/b2b/payment", resp)
 
+	case "mpesa/b2b/expresscheckout":
+		h.logger.Info("handling b2b express checkout")
+		resp, err := h.B2BExpressCheckout(ctx, pk)
+		if err != nil {
+			h.logger.Error("failed to handle b2b express checkout", zap.Error(err))
+			h.publishError("mpesa/b2b/expresscheckout", err)
+
+			return
+		}
+		h.publish("mpesa/b2b/expresscheckout", resp)
+
+	case "mpesa/billmanager/optin":
+		h.logger.Info("handling bill manager opt in")
+		resp, err := h.BillManagerOptIn(ctx, pk)
+		if err != nil {
+			h.logger.Error("failed to handle bill manager opt in", zap.Error(err))
+			h.publishError("mpesa/billmanager/optin", err)
+
+			return
+		}
+		h.publish("mpesa/billmanager/optin", resp)
+
+	case "mpesa/billmanager/optin/update":
+		h.logger.Info("handling bill manager opt in update")
+		resp, err := h.BillManagerUpdateOptIn(ctx, pk)
+		if err != nil {
+			h.logger.Error("failed to handle bill manager opt in update", zap.Error(err))
+			h.publishError("mpesa/billmanager/optin/update", err)
+
+			return
+		}
+		h.publish("mpesa/billmanager/optin/update", resp)
+
+	case "mpesa/billmanager/invoice":
+		h.logger.Info("handling bill manager invoice")
+		resp, err := h.BillManagerSingleInvoice(ctx, pk)
+		if err != nil {
+			h.logger.Error("failed to handle bill manager invoice", zap.Error(err))
+			h.publishError("mpesa/billmanager/invoice", err)
+
+			return
+		}
+		h.publish("mpesa/billmanager/invoice", resp)
+
+	case "mpesa/billmanager/invoice/bulk":
+		h.logger.Info("handling bill manager bulk invoice")
+		resp, err := h.BillManagerBulkInvoice(ctx, pk)
+		if err != nil {
+			h.logger.Error("failed to handle bill manager bulk invoice", zap.Error(err))
+			h.publishError("mpesa/billmanager/invoice/bulk", err)
+
+			return
+		}
+		h.publish("mpesa/billmanager/invoice/bulk", resp)
+
+	case "mpesa/billmanager/invoice/cancel":
+		h.logger.Info("handling bill manager invoice cancellation")
+		resp, err := h.BillManagerCancelInvoice(ctx, pk)
+		if err != nil {
+			h.logger.Error("failed to handle bill manager invoice cancellation", zap.Error(err))
+			h.publishError("mpesa/billmanager/invoice/cancel", err)
+
+			return
+		}
+		h.publish("mpesa/billmanager/invoice/cancel", resp)
+
+	case "mpesa/billmanager/reconcile":
+		h.logger.Info("handling bill manager reconciliation")
+		resp, err := h.BillManagerReconcile(ctx, pk)
+		if err != nil {
+			h.logger.Error("failed to handle bill manager reconciliation", zap.Error(err))
+			h.publishError("mpesa/billmanager/reconcile", err)
+
+			return
+		}
+		h.publish("mpesa/billmanager/reconcile", resp)
+
 	default:
 		switch strings.HasSuffix(pk.TopicName, "/response") {
 		case true:
@@ -248,6 +389,47 @@ func (h *Hook) handleMessages(pk packets.Packet) {
 	}
 }
 
+// ErrorResp is published to the response topic when a request fails.
+// The Daraja fields are set when Daraja rejected the request.
+type ErrorResp struct {
+	Error        string `json:"Error"`
+	StatusCode   int    `json:"StatusCode,omitempty"`
+	RequestID    string `json:"RequestID,omitempty"`
+	ErrorCode    string `json:"ErrorCode,omitempty"`
+	ErrorMessage string `json:"ErrorMessage,omitempty"`
+	Retryable    bool   `json:"Retryable"`
+	Auth         bool   `json:"Auth"`
+}
+
+// newErrorResp returns the error response for err.
+func newErrorResp(err error) ErrorResp {
+	resp := ErrorResp{Error: err.Error()}
+
+	var (
+		darajaErr    *mpesa.Error
+		rejectionErr *mpesa.RejectionError
+	)
+	switch {
+	case errors.As(err, &darajaErr):
+		resp.StatusCode = darajaErr.StatusCode
+		resp.RequestID = darajaErr.RequestID
+		resp.ErrorCode = darajaErr.Code
+		resp.ErrorMessage = darajaErr.Message
+		resp.Retryable = darajaErr.IsRetryable()
+		resp.Auth = darajaErr.IsAuth()
+	case errors.As(err, &rejectionErr):
+		resp.ErrorCode = rejectionErr.ResponseCode
+		resp.ErrorMessage = rejectionErr.ResponseDescription
+	}
+
+	return resp
+}
+
+// publishError publishes the error response for a failed request.
+func (h *Hook) publishError(topic string, err error) {
+	h.publish(topic, newErrorResp(err))
+}
+
 // publish publishes the response to the MQTT broker.
 func (h *Hook) publish(topic string, payload interface{}) {
 	data, err := json.Marshal(payload)
@@ -7,6 +7,7 @@
 package mqtt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -30,6 +31,10 @@ var (
 		ResponseDescription:      "Accept the service request successfully.",
 		ResponseCode:             "0",
 	}
+	billManagerResp = mpesa.BillManagerResp{
+		ResponseMessage: "Success",
+		ResponseCode:    "200",
+	}
 )
 
 func TestSetServer(_ *testing.T) {
@@ -290,6 +295,20 @@ func TestHandleMessages(_ *testing.T) {
 			payload: invalidPayload,
 			mockErr: errInvalidJSON,
 		},
+		{
+			name:  "handle mpesa/express/standingorder success",
+			topic: "mpesa/express/standingorder",
+			payload: []byte(`{
+				"StandingOrderName": "Gym Membership"
+			}`),
+			mockErr: nil,
+		},
+		{
+			name:    "handle mpesa/express/standingorder failure",
+			topic:   "mpesa/express/standingorder",
+			payload: invalidPayload,
+			mockErr: errInvalidJSON,
+		},
 		{
 			name:  "handle mpesa/b2c/payment success",
 			topic: "mpesa/b2c/payment",
@@ -304,6 +323,34 @@ func TestHandleMessages(_ *testing.T) {
 			payload: invalidPayload,
 			mockErr: errInvalidJSON,
 		},
+		{
+			name:  "handle mpesa/b2c/topup success",
+			topic: "mpesa/b2c/topup",
+			payload: []byte(`{
+				"PartyA": 600996
+			}`),
+			mockErr: nil,
+		},
+		{
+			name:    "handle mpesa/b2c/topup failure",
+			topic:   "mpesa/b2c/topup",
+			payload: invalidPayload,
+			mockErr: errInvalidJSON,
+		},
+		{
+			name:  "handle mpesa/b2c/pochi success",
+			topic: "mpesa/b2c/pochi",
+			payload: []byte(`{
+				"PartyA": 600996
+			}`),
+			mockErr: nil,
+		},
+		{
+			name:    "handle mpesa/b2c/pochi failure",
+			topic:   "mpesa/b2c/pochi",
+			payload: invalidPayload,
+			mockErr: errInvalidJSON,
+		},
 		{
 			name:  "handle mpesa/account/balance success",
 			topic: "mpesa/account/balance",
@@ -388,6 +435,20 @@ func TestHandleMessages(_ *testing.T) {
 			payload: invalidPayload,
 			mockErr: errInvalidJSON,
 		},
+		{
+			name:  "handle mpesa/simswap success",
+			topic: "mpesa/simswap",
+			payload: []byte(`{
+				"customerNumber": "254722000000"
+			}`),
+			mockErr: nil,
+		},
+		{
+			name:    "handle mpesa/simswap failure",
+			topic:   "mpesa/simswap",
+			payload: invalidPayload,
+			mockErr: errInvalidJSON,
+		},
 		{
 			name:  "handle mpesa/remit/tax success",
 			topic: "mpesa/remit/tax",
@@ -416,11 +477,109 @@ func TestHandleMessages(_ *testing.T) {
 			payload: invalidPayload,
 			mockErr: errInvalidJSON,
 		},
+		{
+			name:  "handle mpesa/b2b/expresscheckout success",
+			topic: "mpesa/b2b/expresscheckout",
+			payload: []byte(`{
+				"primaryShortCode": "600992"
+			}`),
+			mockErr: nil,
+		},
+		{
+			name:    "handle mpesa/b2b/expresscheckout failure",
+			topic:   "mpesa/b2b/expresscheckout",
+			payload: invalidPayload,
+			mockErr: errInvalidJSON,
+		},
+		{
+			name:  "handle mpesa/billmanager/optin success",
+			topic: "mpesa/billmanager/optin",
+			payload: []byte(`{
+				"shortcode": "600984"
+			}`),
+			mockErr: nil,
+		},
+		{
+			name:    "handle mpesa/billmanager/optin failure",
+			topic:   "mpesa/billmanager/optin",
+			payload: invalidPayload,
+			mockErr: errInvalidJSON,
+		},
+		{
+			name:  "handle mpesa/billmanager/optin/update success",
+			topic: "mpesa/billmanager/optin/update",
+			payload: []byte(`{
+				"shortcode": "600984"
+			}`),
+			mockErr: nil,
+		},
+		{
+			name:    "handle mpesa/billmanager/optin/update failure",
+			topic:   "mpesa/billmanager/optin/update",
+			payload: invalidPayload,
+			mockErr: errInvalidJSON,
+		},
+		{
+			name:  "handle mpesa/billmanager/invoice success",
+			topic: "mpesa/billmanager/invoice",
+			payload: []byte(`{
+				"externalReference": "INV-2024-03-001"
+			}`),
+			mockErr: nil,
+		},
+		{
+			name:    "handle mpesa/billmanager/invoice failure",
+			topic:   "mpesa/billmanager/invoice",
+			payload: invalidPayload,
+			mockErr: errInvalidJSON,
+		},
+		{
+			name:  "handle mpesa/billmanager/invoice/bulk success",
+			topic: "mpesa/billmanager/invoice/bulk",
+			payload: []byte(`{
+				"invoices": [{"externalReference": "INV-2024-03-001"}]
+			}`),
+			mockErr: nil,
+		},
+		{
+			name:    "handle mpesa/billmanager/invoice/bulk failure",
+			topic:   "mpesa/billmanager/invoice/bulk",
+			payload: invalidPayload,
+			mockErr: errInvalidJSON,
+		},
+		{
+			name:  "handle mpesa/billmanager/invoice/cancel success",
+			topic: "mpesa/billmanager/invoice/cancel",
+			payload: []byte(`{
+				"externalReferences": ["INV-2024-03-001"]
+			}`),
+			mockErr: nil,
+		},
+		{
+			name:    "handle mpesa/billmanager/invoice/cancel failure",
+			topic:   "mpesa/billmanager/invoice/cancel",
+			payload: invalidPayload,
+			mockErr: errInvalidJSON,
+		},
+		{
+			name:  "handle mpesa/billmanager/reconcile success",
+			topic: "mpesa/billmanager/reconcile",
+			payload: []byte(`{
+				"transactionId": "SC54HJ8K9L"
+			}`),
+			mockErr: nil,
+		},
+		{
+			name:    "handle mpesa/billmanager/reconcile failure",
+			topic:   "mpesa/billmanager/reconcile",
+			payload: invalidPayload,
+			mockErr: errInvalidJSON,
+		},
 	}
 
 	for _, c := range cases {
-		call1 := mockSDK.On("Token").Return(mpesa.TokenResp{}, c.mockErr)
-		call2 := mockSDK.On("ExpressQuery", mock.Anything).Return(mpesa.ExpressQueryResp{
+		call1 := mockSDK.On("Token", mock.Anything).Return(mpesa.TokenResp{}, c.mockErr)
+		call2 := mockSDK.On("ExpressQuery", mock.Anything, mock.Anything).Return(mpesa.ExpressQueryResp{
 			ResponseDescription: "The service request has been accepted successsfully",
 			ResponseCode:        "0",
 			MerchantRequestID:   "92643-47073138-2",
@@ -429,45 +588,83 @@ func TestHandleMessages(_ *testing.T) {
 			ResultCode:          "1032",
 			ResultDesc:          "Request cancelled by user",
 		}, c.mockErr)
-		call3 := mockSDK.On("ExpressSimulate", mock.Anything).Return(mpesa.ExpressSimulateResp{
+		call3 := mockSDK.On("ExpressSimulate", mock.Anything, mock.Anything).Return(mpesa.ExpressSimulateResp{
 			ResponseDescription: "Success. Request accepted for processing",
 			ResponseCode:        "0",
 			MerchantRequestID:   "27260-79456854-2",
 			CheckoutRequestID:   "ws_CO_07092023004130971712345678",
 			CustomerMessage:     "Success. Request accepted for processing",
 		}, c.mockErr)
-		call4 := mockSDK.On("B2CPayment", mock.Anything).Return(mpesa.B2CPaymentResp{
+		call4 := mockSDK.On("B2CPayment", mock.Anything, mock.Anything).Return(mpesa.B2CPaymentResp{
 			ValidResp: validResp,
 		}, c.mockErr)
-		call5 := mockSDK.On("AccountBalance", mock.Anything).Return(mpesa.AccountBalanceResp{
+		call5 := mockSDK.On("AccountBalance", mock.Anything, mock.Anything).Return(mpesa.AccountBalanceResp{
 			ValidResp: validResp,
 		}, c.mockErr)
-		call6 := mockSDK.On("C2BRegisterURL", mock.Anything).Return(mpesa.C2BRegisterURLResp{
+		call6 := mockSDK.On("C2BRegisterURL", mock.Anything, mock.Anything).Return(mpesa.C2BRegisterURLResp{
 			ValidResp: validResp,
 		}, c.mockErr)
-		call7 := mockSDK.On("C2BSimulate", mock.Anything).Return(mpesa.C2BSimulateResp{
+		call7 := mockSDK.On("C2BSimulate", mock.Anything, mock.Anything).Return(mpesa.C2BSimulateResp{
 			ValidResp: validResp,
 		}, c.mockErr)
-		call8 := mockSDK.On("GenerateQR", mock.Anything).Return(mpesa.GenerateQRResp{
+		call8 := mockSDK.On("GenerateQR", mock.Anything, mock.Anything).Return(mpesa.GenerateQRResp{
 			ResponseDescription: "The service request is processed successfully.",
 			ResponseCode:        "00",
 			RequestID:           "QRCode:...",
 			QRCode:              "qr_code",
 		}, c.mockErr)
-		call9 := mockSDK.On("Reverse", mock.Anything).Return(mpesa.ReverseResp{
+		call9 := mockSDK.On("Reverse", mock.Anything, mock.Anything).Return(mpesa.ReverseResp{
+			ValidResp: validResp,
+		}, c.mockErr)
+		call10 := mockSDK.On("TransactionStatus", mock.Anything, mock.Anything).Return(mpesa.TransactionStatusResp{
 			ValidResp: validResp,
 		}, c.mockErr)
-		call10 := mockSDK.On("TransactionStatus", mock.Anything).Return(mpesa.TransactionStatusResp{
+		call11 := mockSDK.On("RemitTax", mock.Anything, mock.Anything).Return(mpesa.RemitTaxResp{
 			ValidResp: validResp,
 		}, c.mockErr)
-		call11 := mockSDK.On("RemitTax", mock.Anything).Return(mpesa.RemitTaxResp{
+		call12 := mockSDK.On("BusinessPayBill", mock.Anything, mock.Anything).Return(mpesa.BusinessPayBillResp{
+			ValidResp: validResp,
+		}, c.mockErr)
+		call13 := mockSDK.On("BillManagerOptIn", mock.Anything, mock.Anything).Return(mpesa.BillManagerOptInResp{
+			BillManagerResp: billManagerResp,
+		}, c.mockErr)
+		call14 := mockSDK.On("BillManagerUpdateOptIn", mock.Anything, mock.Anything).Return(mpesa.BillManagerUpdateOptInResp{
+			BillManagerResp: billManagerResp,
+		}, c.mockErr)
+		call15 := mockSDK.On("BillManagerSingleInvoice", mock.Anything, mock.Anything).Return(mpesa.BillManagerSingleInvoiceResp{
+			BillManagerResp: billManagerResp,
+		}, c.mockErr)
+		call16 := mockSDK.On("BillManagerBulkInvoice", mock.Anything, mock.Anything).Return(mpesa.BillManagerBulkInvoiceResp{
+			BillManagerResp: billManagerResp,
+		}, c.mockErr)
+		call17 := mockSDK.On("BillManagerCancelInvoice", mock.Anything, mock.Anything).Return(mpesa.BillManagerCancelInvoiceResp{
+			BillManagerResp: billManagerResp,
+		}, c.mockErr)
+		call18 := mockSDK.On("BillManagerReconcile", mock.Anything, mock.Anything).Return(mpesa.BillManagerReconcileResp{
+			BillManagerResp: billManagerResp,
+		}, c.mockErr)
+		call19 := mockSDK.On("StandingOrder", mock.Anything, mock.Anything).Return(mpesa.StandingOrderResp{
+			ResponseRefID:       "4dd9b5d9-d738-42ba-9326-2cc99e966000",
+			ResponseCode:        "200",
+			ResponseDescription: "Request accepted for processing",
+		}, c.mockErr)
+		call20 := mockSDK.On("B2BExpressCheckout", mock.Anything, mock.Anything).Return(mpesa.B2BExpressCheckoutResp{
+			Code:   "0",
+			Status: "USSD Initiated Successfully",
+		}, c.mockErr)
+		call21 := mockSDK.On("B2CAccountTopUp", mock.Anything, mock.Anything).Return(mpesa.B2CAccountTopUpResp{
 			ValidResp: validResp,
 		}, c.mockErr)
-		call12 := mockSDK.On("BusinessPayBill", mock.Anything).Return(mpesa.BusinessPayBillResp{
+		call22 := mockSDK.On("B2Pochi", mock.Anything, mock.Anything).Return(mpesa.B2PochiResp{
 			ValidResp: validResp,
 		}, c.mockErr)
+		call23 := mockSDK.On("SIMSwap", mock.Anything, mock.Anything).Return(mpesa.SIMSwapResp{
+			ResponseCode:        "200",
+			ResponseDescription: "Success",
+			CustomerNumber:      "254722000000",
+		}, c.mockErr)
 
-		hook.handleMessages(packets.Packet{
+		hook.handleMessages(context.Background(), packets.Packet{
 			TopicName: c.topic,
 			Payload:   c.payload,
 		})
@@ -484,5 +681,66 @@ func TestHandleMessages(_ *testing.T) {
 		call10.Unset()
 		call11.Unset()
 		call12.Unset()
+		call13.Unset()
+		call14.Unset()
+		call15.Unset()
+		call16.Unset()
+		call17.Unset()
+		call18.Unset()
+		call19.Unset()
+		call20.Unset()
+		call21.Unset()
+		call22.Unset()
+		call23.Unset()
+	}
+}
+
+func TestNewErrorResp(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want ErrorResp
+	}{
+		{
+			name: "sdk error",
+			err:  errMock,
+			want: ErrorResp{Error: errMock.Error()},
+		},
+		{
+			name: "daraja spike arrest",
+			err:  mpesa.NewError(429, []byte(`{"requestId":"11728-2929992-1","errorCode":"500.003.02","errorMessage":"Error Occurred: Spike Arrest Violation"}`)),
+			want: ErrorResp{
+				StatusCode:   429,
+				RequestID:    "11728-2929992-1",
+				ErrorCode:    "500.003.02",
+				ErrorMessage: "Error Occurred: Spike Arrest Violation",
+				Retryable:    true,
+			},
+		},
+		{
+			name: "daraja invalid access token",
+			err:  mpesa.NewError(401, []byte(`{"requestId":"11728-2929992-1","errorCode":"404.001.03","errorMessage":"Invalid Access Token"}`)),
+			want: ErrorResp{
+				StatusCode:   401,
+				RequestID:    "11728-2929992-1",
+				ErrorCode:    "404.001.03",
+				ErrorMessage: "Invalid Access Token",
+				Auth:         true,
+			},
+		},
+		{
+			name: "strict mode rejection",
+			err:  &mpesa.RejectionError{Op: mpesa.OpB2CPayment, ResponseCode: "1", ResponseDescription: "Rejected"},
+			want: ErrorResp{
+				ErrorCode:    "1",
+				ErrorMessage: "Rejected",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		got := newErrorResp(c.err)
+		c.want.Error = c.err.Error()
+		assert.Equal(t, c.want, got, "%s: newErrorResp() = %v, want %v", c.name, got, c.want)
 	}
 }
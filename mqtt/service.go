@@ -7,6 +7,7 @@
 package mqtt
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
@@ -15,29 +16,51 @@ import (
 
 // Service is the interface that provides methods for the MpesaOverlay SDK.
 type Service interface {
-	Token(pk packets.Packet) (mpesa.TokenResp, error)
+	Token(ctx context.Context, pk packets.Packet) (mpesa.TokenResp, error)
 
-	ExpressQuery(pk packets.Packet) (mpesa.ExpressQueryResp, error)
+	ExpressQuery(ctx context.Context, pk packets.Packet) (mpesa.ExpressQueryResp, error)
 
-	ExpressSimulate(pk packets.Packet) (mpesa.ExpressSimulateResp, error)
+	ExpressSimulate(ctx context.Context, pk packets.Packet) (mpesa.ExpressSimulateResp, error)
 
-	B2CPayment(pk packets.Packet) (mpesa.B2CPaymentResp, error)
+	StandingOrder(ctx context.Context, pk packets.Packet) (mpesa.StandingOrderResp, error)
 
-	AccountBalance(pk packets.Packet) (mpesa.AccountBalanceResp, error)
+	B2CPayment(ctx context.Context, pk packets.Packet) (mpesa.B2CPaymentResp, error)
 
-	C2BRegisterURL(pk packets.Packet) (mpesa.C2BRegisterURLResp, error)
+	B2CAccountTopUp(ctx context.Context, pk packets.Packet) (mpesa.B2CAccountTopUpResp, error)
 
-	C2BSimulate(pk packets.Packet) (mpesa.C2BSimulateResp, error)
+	B2Pochi(ctx context.Context, pk packets.Packet) (mpesa.B2PochiResp, error)
 
-	GenerateQR(pk packets.Packet) (mpesa.GenerateQRResp, error)
+	AccountBalance(ctx context.Context, pk packets.Packet) (mpesa.AccountBalanceResp, error)
 
-	Reverse(pk packets.Packet) (mpesa.ReverseResp, error)
+	C2BRegisterURL(ctx context.Context, pk packets.Packet) (mpesa.C2BRegisterURLResp, error)
 
-	TransactionStatus(pk packets.Packet) (mpesa.TransactionStatusResp, error)
+	C2BSimulate(ctx context.Context, pk packets.Packet) (mpesa.C2BSimulateResp, error)
 
-	RemitTax(pk packets.Packet) (mpesa.RemitTaxResp, error)
+	GenerateQR(ctx context.Context, pk packets.Packet) (mpesa.GenerateQRResp, error)
 
-	BusinessPayBill(pk packets.Packet) (mpesa.BusinessPayBillResp, error)
+	Reverse(ctx context.Context, pk packets.Packet) (mpesa.ReverseResp, error)
+
+	TransactionStatus(ctx context.Context, pk packets.Packet) (mpesa.TransactionStatusResp, error)
+
+	SIMSwap(ctx context.Context, pk packets.Packet) (mpesa.SIMSwapResp, error)
+
+	RemitTax(ctx context.Context, pk packets.Packet) (mpesa.RemitTaxResp, error)
+
+	BusinessPayBill(ctx context.Context, pk packets.Packet) (mpesa.BusinessPayBillResp, error)
+
+	B2BExpressCheckout(ctx context.Context, pk packets.Packet) (mpesa.B2BExpressCheckoutResp, error)
+
+	BillManagerOptIn(ctx context.Context, pk packets.Packet) (mpesa.BillManagerOptInResp, error)
+
+	BillManagerUpdateOptIn(ctx context.Context, pk packets.Packet) (mpesa.BillManagerUpdateOptInResp, error)
+
+	BillManagerSingleInvoice(ctx context.Context, pk packets.Packet) (mpesa.BillManagerSingleInvoiceResp, error)
+
+	BillManagerBulkInvoice(ctx context.Context, pk packets.Packet) (mpesa.BillManagerBulkInvoiceResp, error)
+
+	BillManagerCancelInvoice(ctx context.Context, pk packets.Packet) (mpesa.BillManagerCancelInvoiceResp, error)
+
+	BillManagerReconcile(ctx context.Context, pk packets.Packet) (mpesa.BillManagerReconcileResp, error)
 }
 
 // service implements the Service interface.
@@ -52,105 +75,204 @@ func NewService(sdk mpesa.SDK) Service {
 	return &service{sdk: sdk}
 }
 
-func (s *service) Token(_ packets.Packet) (mpesa.TokenResp, error) {
-	return s.sdk.Token()
+func (s *service) Token(ctx context.Context, _ packets.Packet) (mpesa.TokenResp, error) {
+	return s.sdk.Token(ctx)
 }
 
-func (s *service) ExpressQuery(pk packets.Packet) (mpesa.ExpressQueryResp, error) {
+func (s *service) ExpressQuery(ctx context.Context, pk packets.Packet) (mpesa.ExpressQueryResp, error) {
 	var req mpesa.ExpressQueryReq
 	if err := json.Unmarshal(pk.Payload, &req); err != nil {
 		return mpesa.ExpressQueryResp{}, err
 	}
 
-	return s.sdk.ExpressQuery(req)
+	return s.sdk.ExpressQuery(ctx, req)
 }
 
-func (s *service) ExpressSimulate(pk packets.Packet) (mpesa.ExpressSimulateResp, error) {
+func (s *service) ExpressSimulate(ctx context.Context, pk packets.Packet) (mpesa.ExpressSimulateResp, error) {
 	var req mpesa.ExpressSimulateReq
 	if err := json.Unmarshal(pk.Payload, &req); err != nil {
 		return mpesa.ExpressSimulateResp{}, err
 	}
 
-	return s.sdk.ExpressSimulate(req)
+	return s.sdk.ExpressSimulate(ctx, req)
+}
+
+func (s *service) StandingOrder(ctx context.Context, pk packets.Packet) (mpesa.StandingOrderResp, error) {
+	var req mpesa.StandingOrderReq
+	if err := json.Unmarshal(pk.Payload, &req); err != nil {
+		return mpesa.StandingOrderResp{}, err
+	}
+
+	return s.sdk.StandingOrder(ctx, req)
 }
 
-func (s *service) B2CPayment(pk packets.Packet) (mpesa.B2CPaymentResp, error) {
+func (s *service) B2CPayment(ctx context.Context, pk packets.Packet) (mpesa.B2CPaymentResp, error) {
 	var req mpesa.B2CPaymentReq
 	if err := json.Unmarshal(pk.Payload, &req); err != nil {
 		return mpesa.B2CPaymentResp{}, err
 	}
 
-	return s.sdk.B2CPayment(req)
+	return s.sdk.B2CPayment(ctx, req)
 }
 
-func (s *service) AccountBalance(pk packets.Packet) (mpesa.AccountBalanceResp, error) {
+func (s *service) B2CAccountTopUp(ctx context.Context, pk packets.Packet) (mpesa.B2CAccountTopUpResp, error) {
+	var req mpesa.B2CAccountTopUpReq
+	if err := json.Unmarshal(pk.Payload, &req); err != nil {
+		return mpesa.B2CAccountTopUpResp{}, err
+	}
+
+	return s.sdk.B2CAccountTopUp(ctx, req)
+}
+
+func (s *service) B2Pochi(ctx context.Context, pk packets.Packet) (mpesa.B2PochiResp, error) {
+	var req mpesa.B2PochiReq
+	if err := json.Unmarshal(pk.Payload, &req); err != nil {
+		return mpesa.B2PochiResp{}, err
+	}
+
+	return s.sdk.B2Pochi(ctx, req)
+}
+
+func (s *service) AccountBalance(ctx context.Context, pk packets.Packet) (mpesa.AccountBalanceResp, error) {
 	var req mpesa.AccountBalanceReq
 	if err := json.Unmarshal(pk.Payload, &req); err != nil {
 		return mpesa.AccountBalanceResp{}, err
 	}
 
-	return s.sdk.AccountBalance(req)
+	return s.sdk.AccountBalance(ctx, req)
 }
 
-func (s *service) C2BRegisterURL(pk packets.Packet) (mpesa.C2BRegisterURLResp, error) {
+func (s *service) C2BRegisterURL(ctx context.Context, pk packets.Packet) (mpesa.C2BRegisterURLResp, error) {
 	var req mpesa.C2BRegisterURLReq
 	if err := json.Unmarshal(pk.Payload, &req); err != nil {
 		return mpesa.C2BRegisterURLResp{}, err
 	}
 
-	return s.sdk.C2BRegisterURL(req)
+	return s.sdk.C2BRegisterURL(ctx, req)
 }
 
-func (s *service) C2BSimulate(pk packets.Packet) (mpesa.C2BSimulateResp, error) {
+func (s *service) C2BSimulate(ctx context.Context, pk packets.Packet) (mpesa.C2BSimulateResp, error) {
 	var req mpesa.C2BSimulateReq
 	if err := json.Unmarshal(pk.Payload, &req); err != nil {
 		return mpesa.C2BSimulateResp{}, err
 	}
 
-	return s.sdk.C2BSimulate(req)
+	return s.sdk.C2BSimulate(ctx, req)
 }
 
-func (s *service) GenerateQR(pk packets.Packet) (mpesa.GenerateQRResp, error) {
+func (s *service) GenerateQR(ctx context.Context, pk packets.Packet) (mpesa.GenerateQRResp, error) {
 	var req mpesa.GenerateQRReq
 	if err := json.Unmarshal(pk.Payload, &req); err != nil {
 		return mpesa.GenerateQRResp{}, err
 	}
 
-	return s.sdk.GenerateQR(req)
+	return s.sdk.GenerateQR(ctx, req)
 }
 
-func (s *service) Reverse(pk packets.Packet) (mpesa.ReverseResp, error) {
+func (s *service) Reverse(ctx context.Context, pk packets.Packet) (mpesa.ReverseResp, error) {
 	var req mpesa.ReverseReq
 	if err := json.Unmarshal(pk.Payload, &req); err != nil {
 		return mpesa.ReverseResp{}, err
 	}
 
-	return s.sdk.Reverse(req)
+	return s.sdk.Reverse(ctx, req)
 }
 
-func (s *service) TransactionStatus(pk packets.Packet) (mpesa.TransactionStatusResp, error) {
+func (s *service) TransactionStatus(ctx context.Context, pk packets.Packet) (mpesa.TransactionStatusResp, error) {
 	var req mpesa.TransactionStatusReq
 	if err := json.Unmarshal(pk.Payload, &req); err != nil {
 		return mpesa.TransactionStatusResp{}, err
 	}
 
-	return s.sdk.TransactionStatus(req)
+	return s.sdk.TransactionStatus(ctx, req)
+}
+
+func (s *service) SIMSwap(ctx context.Context, pk packets.Packet) (mpesa.SIMSwapResp, error) {
+	var req mpesa.SIMSwapReq
+	if err := json.Unmarshal(pk.Payload, &req); err != nil {
+		return mpesa.SIMSwapResp{}, err
+	}
+
+	return s.sdk.SIMSwap(ctx, req)
 }
 
-func (s *service) RemitTax(pk packets.Packet) (mpesa.RemitTaxResp, error) {
+func (s *service) RemitTax(ctx context.Context, pk packets.Packet) (mpesa.RemitTaxResp, error) {
 	var req mpesa.RemitTaxReq
 	if err := json.Unmarshal(pk.Payload, &req); err != nil {
 		return mpesa.RemitTaxResp{}, err
 	}
 
-	return s.sdk.RemitTax(req)
+	return s.sdk.RemitTax(ctx, req)
 }
 
-func (s *service) BusinessPayBill(pk packets.Packet) (mpesa.BusinessPayBillResp, error) {
+func (s *service) BusinessPayBill(ctx context.Context, pk packets.Packet) (mpesa.BusinessPayBillResp, error) {
 	var req mpesa.BusinessPayBillReq
 	if err := json.Unmarshal(pk.Payload, &req); err != nil {
 		return mpesa.BusinessPayBillResp{}, err
 	}
 
-	return s.sdk.BusinessPayBill(req)
+	return s.sdk.BusinessPayBill(ctx, req)
+}
+
+func (s *service) B2BExpressCheckout(ctx context.Context, pk packets.Packet) (mpesa.B2BExpressCheckoutResp, error) {
+	var req mpesa.B2BExpressCheckoutReq
+	if err := json.Unmarshal(pk.Payload, &req); err != nil {
+		return mpesa.B2BExpressCheckoutResp{}, err
+	}
+
+	return s.sdk.B2BExpressCheckout(ctx, req)
+}
+
+func (s *service) BillManagerOptIn(ctx context.Context, pk packets.Packet) (mpesa.BillManagerOptInResp, error) {
+	var req mpesa.BillManagerOptInReq
+	if err := json.Unmarshal(pk.Payload, &req); err != nil {
+		return mpesa.BillManagerOptInResp{}, err
+	}
+
+	return s.sdk.BillManagerOptIn(ctx, req)
+}
+
+func (s *service) BillManagerUpdateOptIn(ctx context.Context, pk packets.Packet) (mpesa.BillManagerUpdateOptInResp, error) {
+	var req mpesa.BillManagerUpdateOptInReq
+	if err := json.Unmarshal(pk.Payload, &req); err != nil {
+		return mpesa.BillManagerUpdateOptInResp{}, err
+	}
+
+	return s.sdk.BillManagerUpdateOptIn(ctx, req)
+}
+
+func (s *service) BillManagerSingleInvoice(ctx context.Context, pk packets.Packet) (mpesa.BillManagerSingleInvoiceResp, error) {
+	var req mpesa.BillManagerSingleInvoiceReq
+	if err := json.Unmarshal(pk.Payload, &req); err != nil {
+		return mpesa.BillManagerSingleInvoiceResp{}, err
+	}
+
+	return s.sdk.BillManagerSingleInvoice(ctx, req)
+}
+
+func (s *service) BillManagerBulkInvoice(ctx context.Context, pk packets.Packet) (mpesa.BillManagerBulkInvoiceResp, error) {
+	var req mpesa.BillManagerBulkInvoiceReq
+	if err := json.Unmarshal(pk.Payload, &req); err != nil {
+		return mpesa.BillManagerBulkInvoiceResp{}, err
+	}
+
+	return s.sdk.BillManagerBulkInvoice(ctx, req)
+}
+
+func (s *service) BillManagerCancelInvoice(ctx context.Context, pk packets.Packet) (mpesa.BillManagerCancelInvoiceResp, error) {
+	var req mpesa.BillManagerCancelInvoiceReq
+	if err := json.Unmarshal(pk.Payload, &req); err != nil {
+		return mpesa.BillManagerCancelInvoiceResp{}, err
+	}
+
+	return s.sdk.BillManagerCancelInvoice(ctx, req)
+}
+
+func (s *service) BillManagerReconcile(ctx context.Context, pk packets.Packet) (mpesa.BillManagerReconcileResp, error) {
+	var req mpesa.BillManagerReconcileReq
+	if err := json.Unmarshal(pk.Payload, &req); err != nil {
+		return mpesa.BillManagerReconcileResp{}, err
+	}
+
+	return s.sdk.BillManagerReconcile(ctx, req)
 }
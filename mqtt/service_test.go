@@ -7,6 +7,7 @@
 package mqtt_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -31,6 +32,10 @@ var (
 		ResponseDescription:      "Accept the service request successfully.",
 		ResponseCode:             "0",
 	}
+	billManagerResp = mpesa.BillManagerResp{
+		ResponseMessage: "Success",
+		ResponseCode:    "200",
+	}
 )
 
 func TestToken(t *testing.T) {
@@ -59,14 +64,14 @@ func TestToken(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("Token").Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("Token", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.Token(mockPacket)
+		resp, err := s.Token(context.Background(), mockPacket)
 
 		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
 		assert.Equal(t, tc.expectedErr, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 
-		call.Parent.AssertCalled(t, "Token")
+		call.Parent.AssertCalled(t, "Token", mock.Anything)
 		call.Unset()
 	}
 }
@@ -112,9 +117,9 @@ func TestAccountBalance(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("AccountBalance", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("AccountBalance", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.AccountBalance(tc.packet)
+		resp, err := s.AccountBalance(context.Background(), tc.packet)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -167,9 +172,9 @@ func TestC2BRegisterURL(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("C2BRegisterURL", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("C2BRegisterURL", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.C2BRegisterURL(tc.packet)
+		resp, err := s.C2BRegisterURL(context.Background(), tc.packet)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -222,9 +227,9 @@ func TestC2BSimulate(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("C2BSimulate", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("C2BSimulate", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.C2BSimulate(tc.packet)
+		resp, err := s.C2BSimulate(context.Background(), tc.packet)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -280,9 +285,9 @@ func TestGenerateQR(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("GenerateQR", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("GenerateQR", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.GenerateQR(tc.packet)
+		resp, err := s.GenerateQR(context.Background(), tc.packet)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -341,9 +346,9 @@ func TestExpressQuery(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("ExpressQuery", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("ExpressQuery", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.ExpressQuery(tc.packet)
+		resp, err := s.ExpressQuery(context.Background(), tc.packet)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -396,9 +401,9 @@ func TestReverse(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("Reverse", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("Reverse", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.Reverse(tc.packet)
+		resp, err := s.Reverse(context.Background(), tc.packet)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -455,9 +460,9 @@ func TestExpressSimulate(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("ExpressSimulate", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("ExpressSimulate", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.ExpressSimulate(tc.packet)
+		resp, err := s.ExpressSimulate(context.Background(), tc.packet)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -510,9 +515,9 @@ func TestRemitTax(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("RemitTax", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("RemitTax", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.RemitTax(tc.packet)
+		resp, err := s.RemitTax(context.Background(), tc.packet)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -565,9 +570,9 @@ func TestTransactionStatus(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("TransactionStatus", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("TransactionStatus", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.TransactionStatus(tc.packet)
+		resp, err := s.TransactionStatus(context.Background(), tc.packet)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -620,9 +625,9 @@ func TestB2CPayment(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("B2CPayment", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("B2CPayment", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.B2CPayment(tc.packet)
+		resp, err := s.B2CPayment(context.Background(), tc.packet)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -675,9 +680,620 @@ func TestBusinessPayBill(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("BusinessPayBill", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("BusinessPayBill", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.BusinessPayBill(context.Background(), tc.packet)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestBillManagerOptIn(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := mqtt.NewService(mockSDK)
+
+	cases := []struct {
+		name         string
+		packet       packets.Packet
+		expectedResp mpesa.BillManagerOptInResp
+		expectedErr  error
+	}{
+		{
+			name: "BillManagerOptIn success",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"shortcode": "600984"
+				}`),
+			},
+			expectedResp: mpesa.BillManagerOptInResp{
+				AppKey:          "AG_2376487236_126732989KJ",
+				BillManagerResp: billManagerResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "BillManagerOptIn error",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"shortcode": "600984"
+				}`),
+			},
+			expectedResp: mpesa.BillManagerOptInResp{},
+			expectedErr:  errMock,
+		},
+		{
+			name:         "BillManagerOptIn invalid payload",
+			packet:       invalidPacket,
+			expectedResp: mpesa.BillManagerOptInResp{},
+			expectedErr:  errInvalidJSON,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("BillManagerOptIn", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.BillManagerOptIn(context.Background(), tc.packet)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestBillManagerUpdateOptIn(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := mqtt.NewService(mockSDK)
+
+	cases := []struct {
+		name         string
+		packet       packets.Packet
+		expectedResp mpesa.BillManagerUpdateOptInResp
+		expectedErr  error
+	}{
+		{
+			name: "BillManagerUpdateOptIn success",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"shortcode": "600984"
+				}`),
+			},
+			expectedResp: mpesa.BillManagerUpdateOptInResp{
+				BillManagerResp: billManagerResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "BillManagerUpdateOptIn error",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"shortcode": "600984"
+				}`),
+			},
+			expectedResp: mpesa.BillManagerUpdateOptInResp{},
+			expectedErr:  errMock,
+		},
+		{
+			name:         "BillManagerUpdateOptIn invalid payload",
+			packet:       invalidPacket,
+			expectedResp: mpesa.BillManagerUpdateOptInResp{},
+			expectedErr:  errInvalidJSON,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("BillManagerUpdateOptIn", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.BillManagerUpdateOptIn(context.Background(), tc.packet)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestBillManagerSingleInvoice(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := mqtt.NewService(mockSDK)
+
+	cases := []struct {
+		name         string
+		packet       packets.Packet
+		expectedResp mpesa.BillManagerSingleInvoiceResp
+		expectedErr  error
+	}{
+		{
+			name: "BillManagerSingleInvoice success",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"externalReference": "INV-2024-03-001"
+				}`),
+			},
+			expectedResp: mpesa.BillManagerSingleInvoiceResp{
+				BillManagerResp: billManagerResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "BillManagerSingleInvoice error",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"externalReference": "INV-2024-03-001"
+				}`),
+			},
+			expectedResp: mpesa.BillManagerSingleInvoiceResp{},
+			expectedErr:  errMock,
+		},
+		{
+			name:         "BillManagerSingleInvoice invalid payload",
+			packet:       invalidPacket,
+			expectedResp: mpesa.BillManagerSingleInvoiceResp{},
+			expectedErr:  errInvalidJSON,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("BillManagerSingleInvoice", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.BillManagerSingleInvoice(context.Background(), tc.packet)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestBillManagerBulkInvoice(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := mqtt.NewService(mockSDK)
+
+	cases := []struct {
+		name         string
+		packet       packets.Packet
+		expectedResp mpesa.BillManagerBulkInvoiceResp
+		expectedErr  error
+	}{
+		{
+			name: "BillManagerBulkInvoice success",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"invoices": [{"externalReference": "INV-2024-03-001"}]
+				}`),
+			},
+			expectedResp: mpesa.BillManagerBulkInvoiceResp{
+				BillManagerResp: billManagerResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "BillManagerBulkInvoice error",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"invoices": [{"externalReference": "INV-2024-03-001"}]
+				}`),
+			},
+			expectedResp: mpesa.BillManagerBulkInvoiceResp{},
+			expectedErr:  errMock,
+		},
+		{
+			name:         "BillManagerBulkInvoice invalid payload",
+			packet:       invalidPacket,
+			expectedResp: mpesa.BillManagerBulkInvoiceResp{},
+			expectedErr:  errInvalidJSON,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("BillManagerBulkInvoice", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.BillManagerBulkInvoice(context.Background(), tc.packet)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestBillManagerCancelInvoice(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := mqtt.NewService(mockSDK)
+
+	cases := []struct {
+		name         string
+		packet       packets.Packet
+		expectedResp mpesa.BillManagerCancelInvoiceResp
+		expectedErr  error
+	}{
+		{
+			name: "BillManagerCancelInvoice success",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"externalReferences": ["INV-2024-03-001"]
+				}`),
+			},
+			expectedResp: mpesa.BillManagerCancelInvoiceResp{
+				BillManagerResp: billManagerResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "BillManagerCancelInvoice error",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"externalReferences": ["INV-2024-03-001"]
+				}`),
+			},
+			expectedResp: mpesa.BillManagerCancelInvoiceResp{},
+			expectedErr:  errMock,
+		},
+		{
+			name:         "BillManagerCancelInvoice invalid payload",
+			packet:       invalidPacket,
+			expectedResp: mpesa.BillManagerCancelInvoiceResp{},
+			expectedErr:  errInvalidJSON,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("BillManagerCancelInvoice", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.BillManagerCancelInvoice(context.Background(), tc.packet)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestBillManagerReconcile(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := mqtt.NewService(mockSDK)
+
+	cases := []struct {
+		name         string
+		packet       packets.Packet
+		expectedResp mpesa.BillManagerReconcileResp
+		expectedErr  error
+	}{
+		{
+			name: "BillManagerReconcile success",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"transactionId": "SC54HJ8K9L"
+				}`),
+			},
+			expectedResp: mpesa.BillManagerReconcileResp{
+				BillManagerResp: billManagerResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "BillManagerReconcile error",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"transactionId": "SC54HJ8K9L"
+				}`),
+			},
+			expectedResp: mpesa.BillManagerReconcileResp{},
+			expectedErr:  errMock,
+		},
+		{
+			name:         "BillManagerReconcile invalid payload",
+			packet:       invalidPacket,
+			expectedResp: mpesa.BillManagerReconcileResp{},
+			expectedErr:  errInvalidJSON,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("BillManagerReconcile", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.BillManagerReconcile(context.Background(), tc.packet)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestStandingOrder(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := mqtt.NewService(mockSDK)
+
+	cases := []struct {
+		name         string
+		packet       packets.Packet
+		expectedResp mpesa.StandingOrderResp
+		expectedErr  error
+	}{
+		{
+			name: "StandingOrder success",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"StandingOrderName": "Gym Membership"
+				}`),
+			},
+			expectedResp: mpesa.StandingOrderResp{
+				ResponseRefID:       "4dd9b5d9-d738-42ba-9326-2cc99e966000",
+				ResponseCode:        "200",
+				ResponseDescription: "Request accepted for processing",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "StandingOrder error",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"StandingOrderName": "Gym Membership"
+				}`),
+			},
+			expectedResp: mpesa.StandingOrderResp{},
+			expectedErr:  errMock,
+		},
+		{
+			name:         "StandingOrder invalid payload",
+			packet:       invalidPacket,
+			expectedResp: mpesa.StandingOrderResp{},
+			expectedErr:  errInvalidJSON,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("StandingOrder", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.StandingOrder(context.Background(), tc.packet)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestB2BExpressCheckout(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := mqtt.NewService(mockSDK)
+
+	cases := []struct {
+		name         string
+		packet       packets.Packet
+		expectedResp mpesa.B2BExpressCheckoutResp
+		expectedErr  error
+	}{
+		{
+			name: "B2BExpressCheckout success",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"primaryShortCode": "600992"
+				}`),
+			},
+			expectedResp: mpesa.B2BExpressCheckoutResp{
+				Code:   "0",
+				Status: "USSD Initiated Successfully",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "B2BExpressCheckout error",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"primaryShortCode": "600992"
+				}`),
+			},
+			expectedResp: mpesa.B2BExpressCheckoutResp{},
+			expectedErr:  errMock,
+		},
+		{
+			name:         "B2BExpressCheckout invalid payload",
+			packet:       invalidPacket,
+			expectedResp: mpesa.B2BExpressCheckoutResp{},
+			expectedErr:  errInvalidJSON,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("B2BExpressCheckout", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.B2BExpressCheckout(context.Background(), tc.packet)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestB2CAccountTopUp(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := mqtt.NewService(mockSDK)
+
+	cases := []struct {
+		name         string
+		packet       packets.Packet
+		expectedResp mpesa.B2CAccountTopUpResp
+		expectedErr  error
+	}{
+		{
+			name: "B2CAccountTopUp success",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"PartyA": 600996
+				}`),
+			},
+			expectedResp: mpesa.B2CAccountTopUpResp{
+				ValidResp: validResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "B2CAccountTopUp error",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"PartyA": 600996
+				}`),
+			},
+			expectedResp: mpesa.B2CAccountTopUpResp{},
+			expectedErr:  errMock,
+		},
+		{
+			name:         "B2CAccountTopUp invalid payload",
+			packet:       invalidPacket,
+			expectedResp: mpesa.B2CAccountTopUpResp{},
+			expectedErr:  errInvalidJSON,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("B2CAccountTopUp", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.B2CAccountTopUp(context.Background(), tc.packet)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestB2Pochi(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := mqtt.NewService(mockSDK)
+
+	cases := []struct {
+		name         string
+		packet       packets.Packet
+		expectedResp mpesa.B2PochiResp
+		expectedErr  error
+	}{
+		{
+			name: "B2Pochi success",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"PartyA": 600996
+				}`),
+			},
+			expectedResp: mpesa.B2PochiResp{
+				ValidResp: validResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "B2Pochi error",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"PartyA": 600996
+				}`),
+			},
+			expectedResp: mpesa.B2PochiResp{},
+			expectedErr:  errMock,
+		},
+		{
+			name:         "B2Pochi invalid payload",
+			packet:       invalidPacket,
+			expectedResp: mpesa.B2PochiResp{},
+			expectedErr:  errInvalidJSON,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("B2Pochi", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.B2Pochi(context.Background(), tc.packet)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestSIMSwap(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := mqtt.NewService(mockSDK)
+
+	cases := []struct {
+		name         string
+		packet       packets.Packet
+		expectedResp mpesa.SIMSwapResp
+		expectedErr  error
+	}{
+		{
+			name: "SIMSwap success",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"customerNumber": "254722000000"
+				}`),
+			},
+			expectedResp: mpesa.SIMSwapResp{
+				ResponseCode:        "200",
+				ResponseDescription: "Success",
+				CustomerNumber:      "254722000000",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "SIMSwap error",
+			packet: packets.Packet{
+				Payload: []byte(`{
+					"customerNumber": "254722000000"
+				}`),
+			},
+			expectedResp: mpesa.SIMSwapResp{},
+			expectedErr:  errMock,
+		},
+		{
+			name:         "SIMSwap invalid payload",
+			packet:       invalidPacket,
+			expectedResp: mpesa.SIMSwapResp{},
+			expectedErr:  errInvalidJSON,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("SIMSwap", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.BusinessPayBill(tc.packet)
+		resp, err := s.SIMSwap(context.Background(), tc.packet)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
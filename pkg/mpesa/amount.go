@@ -0,0 +1,97 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// amountScale is the number of minor units in a major unit of currency.
+const amountScale = 100
+
+// errInvalidAmount indicates that an amount is not a decimal with at most two fractional digits.
+var errInvalidAmount = errors.New("invalid amount")
+
+// Amount is an exact amount of money in minor units, such as cents, so that
+// balances can be added and compared without floating point errors.
+type Amount int64
+
+// ParseAmount parses a decimal amount such as 700000.00 or -1540.5.
+func ParseAmount(s string) (Amount, error) {
+	value := strings.TrimSpace(s)
+
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(strings.TrimPrefix(value, "-"), "+")
+
+	whole, fraction, _ := strings.Cut(value, ".")
+	if whole == "" || len(fraction) > 2 || strings.ContainsAny(whole+fraction, "+-") {
+		return 0, fmt.Errorf("%w: %q", errInvalidAmount, s)
+	}
+	fraction += strings.Repeat("0", 2-len(fraction))
+
+	major, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || major > math.MaxInt64/amountScale-1 {
+		return 0, fmt.Errorf("%w: %q", errInvalidAmount, s)
+	}
+	minor, err := strconv.ParseInt(fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", errInvalidAmount, s)
+	}
+
+	amount := Amount(major*amountScale + minor)
+	if negative {
+		amount = -amount
+	}
+
+	return amount, nil
+}
+
+// String returns the amount with two fractional digits, such as 700000.00.
+func (a Amount) String() string {
+	sign := ""
+	minor := int64(a)
+	if minor < 0 {
+		sign = "-"
+		minor = -minor
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, minor/amountScale, minor%amountScale)
+}
+
+// Float64 returns the amount in major units. It may not be exact.
+func (a Amount) Float64() float64 {
+	return float64(a) / amountScale
+}
+
+// MarshalJSON encodes the amount as a JSON number with two fractional digits
+// so that it decodes back to the same amount.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON decodes an amount sent as either a JSON number or a string.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	value := string(data)
+	if value == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		value = unquoted
+	}
+
+	amount, err := ParseAmount(value)
+	if err != nil {
+		return err
+	}
+	*a = amount
+
+	return nil
+}
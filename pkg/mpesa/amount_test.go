@@ -0,0 +1,90 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAmount(t *testing.T) {
+	testCases := []struct {
+		value       string
+		expected    Amount
+		str         string
+		expectedErr error
+	}{
+		{value: "700000.00", expected: 70000000, str: "700000.00"},
+		{value: "6186.83", expected: 618683, str: "6186.83"},
+		{value: "-1540.00", expected: -154000, str: "-1540.00"},
+		{value: "-0.5", expected: -50, str: "-0.50"},
+		{value: "10", expected: 1000, str: "10.00"},
+		{value: "+3.1", expected: 310, str: "3.10"},
+		{value: " 0.01 ", expected: 1, str: "0.01"},
+		{value: "0.1", expected: 10, str: "0.10"},
+		{value: "", expectedErr: errInvalidAmount},
+		{value: "-", expectedErr: errInvalidAmount},
+		{value: ".50", expectedErr: errInvalidAmount},
+		{value: "1.005", expectedErr: errInvalidAmount},
+		{value: "1.2.3", expectedErr: errInvalidAmount},
+		{value: "--1", expectedErr: errInvalidAmount},
+		{value: "1e3", expectedErr: errInvalidAmount},
+		{value: "99999999999999999999", expectedErr: errInvalidAmount},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			amount, err := ParseAmount(tc.value)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, tc.expected, amount)
+			assert.Equal(t, tc.str, amount.String())
+		})
+	}
+
+	a, err := ParseAmount("0.10")
+	assert.NoError(t, err)
+	b, err := ParseAmount("0.20")
+	assert.NoError(t, err)
+	assert.Equal(t, "0.30", (a + b).String())
+	assert.Equal(t, 0.3, (a + b).Float64())
+}
+
+func TestAmountJSON(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Amount Amount `json:"amount"`
+	}{Amount: -154050})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount":-1540.50}`, string(data))
+
+	testCases := []struct {
+		data        string
+		expected    Amount
+		expectedErr error
+	}{
+		{data: `700000.00`, expected: 70000000},
+		{data: `"6186.83"`, expected: 618683},
+		{data: `10`, expected: 1000},
+		{data: `null`},
+		{data: `"ten"`, expectedErr: errInvalidAmount},
+		{data: `1.005`, expectedErr: errInvalidAmount},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.data, func(t *testing.T) {
+			var amount Amount
+			err := json.Unmarshal([]byte(tc.data), &amount)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expected, amount)
+		})
+	}
+}
@@ -7,19 +7,21 @@
 package mpesa
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
-	"io"
 	"net/http"
 )
 
 var errFailedToGetToken = errors.New("failed to get token")
 
-func (sdk mSDK) Token() (TokenResp, error) {
-	url := fmt.Sprintf("%s/%s", sdk.baseURL, authEndpoint)
+func (sdk mSDK) Token(ctx context.Context) (TokenResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpToken)
+	defer cancel()
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	url := sdk.url(OpToken)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return TokenResp{}, err
 	}
@@ -28,19 +30,20 @@ func (sdk mSDK) Token() (TokenResp, error) {
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Cache-Control", "no-cache")
 
-	resp, err := sdk.client.Do(req)
+	statusCode, body, retries, err := sdk.doWithRetry(OpToken, req, "")
 	if err != nil {
-		return TokenResp{}, err
+		return TokenResp{}, withRetries(OpToken, retries, err)
 	}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return TokenResp{}, err
-	}
-	defer resp.Body.Close()
+	if statusCode != http.StatusOK {
+		tokenErr := newError(statusCode, body, errFailedToGetToken)
+		if (statusCode == http.StatusBadRequest || statusCode == http.StatusUnauthorized) &&
+			(tokenErr.kind == nil || tokenErr.kind == ErrInvalidAccessToken) {
+			// The token endpoint rejects bad app credentials without a specific code.
+			tokenErr.kind = ErrInvalidCredentials
+		}
 
-	if resp.StatusCode != http.StatusOK {
-		return TokenResp{}, errFailedToGetToken
+		return TokenResp{}, withRetries(OpToken, retries, tokenErr)
 	}
 
 	var tr TokenResp
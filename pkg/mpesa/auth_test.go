@@ -7,6 +7,7 @@
 package mpesa
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -84,7 +85,7 @@ func TestToken(t *testing.T) {
 				client:    server.Client(),
 			}
 
-			tokenResp, err := sdk.Token()
+			tokenResp, err := sdk.Token(context.Background())
 			if errors.Is(err, tc.expectedErr) == false {
 				t.Errorf("Expected error '%v', got '%v'", tc.expectedErr, err)
 			}
@@ -97,3 +98,33 @@ func TestToken(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenWithCancelledContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if err := json.NewEncoder(w).Encode(validToken); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	sdk := mSDK{
+		baseURL:   server.URL,
+		appKey:    appKey,
+		appSecret: appSecret,
+		client:    server.Client(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tokenResp, err := sdk.Token(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected error '%v', got '%v'", context.Canceled, err)
+	}
+	if tokenResp.AccessToken != "" {
+		t.Errorf("Expected empty token, got '%s'", tokenResp.AccessToken)
+	}
+}
@@ -0,0 +1,316 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// defaultResultRetention is how long a result nobody waits for is kept.
+const defaultResultRetention = 10 * time.Minute
+
+var (
+	// ErrAwaitTimeout indicates that no result arrived before the context was done.
+	ErrAwaitTimeout = errors.New("timed out waiting for result")
+
+	// errMissingResultID indicates that the ID to await is empty.
+	errMissingResultID = errors.New("missing conversation id")
+)
+
+// ResultBackend delivers the results received by the callback endpoints to
+// the callers waiting for them. The in-memory backend only reaches waiters of
+// the same process; a shared backend, for example on top of Redis or NATS,
+// lets a result that lands on one replica wake a waiter on another.
+type ResultBackend interface {
+	// Publish delivers the result to the waiters of its
+	// OriginatorConversationID and of its ConversationID. If nobody is
+	// waiting yet, the result must be kept for a later Wait.
+	Publish(ctx context.Context, result AsyncResult) error
+
+	// Wait blocks until a result with the given OriginatorConversationID or
+	// ConversationID is published or ctx is done, in which case it returns
+	// the error of ctx.
+	Wait(ctx context.Context, id string) (AsyncResult, error)
+}
+
+// storedResult is a published result that nobody has waited for yet.
+type storedResult struct {
+	result      AsyncResult
+	publishedAt time.Time
+}
+
+type memoryResultBackend struct {
+	mu        sync.Mutex
+	retention time.Duration
+	results   map[string]*storedResult
+	waiters   map[string][]chan AsyncResult
+}
+
+// NewMemoryResultBackend returns a ResultBackend that keeps results in
+// memory. Results nobody waits for are dropped after retention, which
+// defaults to 10 minutes when it is zero.
+func NewMemoryResultBackend(retention time.Duration) ResultBackend {
+	if retention <= 0 {
+		retention = defaultResultRetention
+	}
+
+	return &memoryResultBackend{
+		retention: retention,
+		results:   make(map[string]*storedResult),
+		waiters:   make(map[string][]chan AsyncResult),
+	}
+}
+
+func (b *memoryResultBackend) Publish(_ context.Context, result AsyncResult) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.expire()
+
+	ids := resultIDs(result)
+
+	var delivered bool
+	for _, id := range ids {
+		for _, ch := range b.waiters[id] {
+			ch <- result
+			delivered = true
+		}
+		delete(b.waiters, id)
+	}
+	if delivered {
+		return nil
+	}
+
+	stored := &storedResult{result: result, publishedAt: time.Now()}
+	for _, id := range ids {
+		b.results[id] = stored
+	}
+
+	return nil
+}
+
+func (b *memoryResultBackend) Wait(ctx context.Context, id string) (AsyncResult, error) {
+	b.mu.Lock()
+	if stored, ok := b.results[id]; ok {
+		for _, id := range resultIDs(stored.result) {
+			delete(b.results, id)
+		}
+		b.mu.Unlock()
+
+		return stored.result, nil
+	}
+
+	ch := make(chan AsyncResult, 1)
+	b.waiters[id] = append(b.waiters[id], ch)
+	b.mu.Unlock()
+
+	select {
+	case result := <-ch:
+		return result, nil
+	case <-ctx.Done():
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		// The result may have been delivered while the lock was released.
+		select {
+		case result := <-ch:
+			return result, nil
+		default:
+		}
+		b.removeWaiter(id, ch)
+
+		return AsyncResult{}, ctx.Err()
+	}
+}
+
+// expire drops the results that nobody waited for within the retention.
+func (b *memoryResultBackend) expire() {
+	deadline := time.Now().Add(-b.retention)
+	for id, stored := range b.results {
+		if stored.publishedAt.Before(deadline) {
+			delete(b.results, id)
+		}
+	}
+}
+
+func (b *memoryResultBackend) removeWaiter(id string, ch chan AsyncResult) {
+	waiters := b.waiters[id]
+	for i, waiter := range waiters {
+		if waiter == ch {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(b.waiters, id)
+
+		return
+	}
+	b.waiters[id] = waiters
+}
+
+// resultIDs returns the IDs a result can be awaited with.
+func resultIDs(result AsyncResult) []string {
+	ids := make([]string, 0, 2)
+	if result.OriginatorConversationID != "" {
+		ids = append(ids, result.OriginatorConversationID)
+	}
+	if result.ConversationID != "" && result.ConversationID != result.OriginatorConversationID {
+		ids = append(ids, result.ConversationID)
+	}
+
+	return ids
+}
+
+// Correlator turns asynchronous operations into blocking calls. It serves the
+// ResultURL and QueueTimeOutURL of the requests, publishes every result it
+// receives to its backend and lets callers await the result of a request by
+// the IDs Daraja acknowledged it with.
+type Correlator struct {
+	backend  ResultBackend
+	handlers ResultHandlers
+}
+
+// NewCorrelator returns a correlator that uses the given backend, or an
+// in-memory backend if it is nil.
+func NewCorrelator(backend ResultBackend) *Correlator {
+	if backend == nil {
+		backend = NewMemoryResultBackend(0)
+	}
+
+	c := &Correlator{backend: backend}
+	c.handlers = newResultHandlers(backend.Publish)
+
+	return c
+}
+
+// Handlers returns the handlers to serve at the ResultURL and QueueTimeOutURL
+// of the requests whose results are awaited. They accept the results of every
+// asynchronous operation.
+func (c *Correlator) Handlers() ResultHandlers {
+	return c.handlers
+}
+
+// Track returns the ID the result of an acknowledged request is awaited
+// with: the OriginatorConversationID, or the ConversationID if Daraja did not
+// return one. Results that arrive before Await is called are kept by the
+// backend, so Track can be called right after the request returns.
+func (c *Correlator) Track(resp ValidResp) (string, error) {
+	switch {
+	case resp.OriginatorConversationID != "":
+		return resp.OriginatorConversationID, nil
+	case resp.ConversationID != "":
+		return resp.ConversationID, nil
+	default:
+		return "", errMissingResultID
+	}
+}
+
+// Await blocks until the result of the request with the given
+// OriginatorConversationID or ConversationID arrives. It returns
+// ErrAwaitTimeout if ctx is done first. A result posted to the
+// QueueTimeOutURL is returned with TimedOut set.
+func (c *Correlator) Await(ctx context.Context, id string) (AsyncResult, error) {
+	if id == "" {
+		return AsyncResult{}, errMissingResultID
+	}
+
+	result, err := c.backend.Wait(ctx, id)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+			return AsyncResult{}, errors.Join(ErrAwaitTimeout, err)
+		}
+
+		return AsyncResult{}, err
+	}
+
+	return result, nil
+}
+
+// AwaitB2CPayment awaits the result of a B2CPayment.
+func (c *Correlator) AwaitB2CPayment(ctx context.Context, id string) (B2CResult, error) {
+	result, err := c.Await(ctx, id)
+	if err != nil {
+		return B2CResult{}, err
+	}
+
+	return newB2CResult(result)
+}
+
+// AwaitBusinessPayBill awaits the result of a BusinessPayBill.
+func (c *Correlator) AwaitBusinessPayBill(ctx context.Context, id string) (BusinessPayBillResult, error) {
+	result, err := c.Await(ctx, id)
+	if err != nil {
+		return BusinessPayBillResult{}, err
+	}
+
+	return newBusinessPayBillResult(result)
+}
+
+// AwaitB2CAccountTopUp awaits the result of a B2CAccountTopUp.
+func (c *Correlator) AwaitB2CAccountTopUp(ctx context.Context, id string) (B2CAccountTopUpResult, error) {
+	result, err := c.Await(ctx, id)
+	if err != nil {
+		return B2CAccountTopUpResult{}, err
+	}
+
+	return newB2CAccountTopUpResult(result)
+}
+
+// AwaitB2Pochi awaits the result of a B2Pochi.
+func (c *Correlator) AwaitB2Pochi(ctx context.Context, id string) (B2PochiResult, error) {
+	result, err := c.Await(ctx, id)
+	if err != nil {
+		return B2PochiResult{}, err
+	}
+
+	return newB2PochiResult(result)
+}
+
+// AwaitRemitTax awaits the result of a RemitTax.
+func (c *Correlator) AwaitRemitTax(ctx context.Context, id string) (RemitTaxResult, error) {
+	result, err := c.Await(ctx, id)
+	if err != nil {
+		return RemitTaxResult{}, err
+	}
+
+	return newRemitTaxResult(result)
+}
+
+// AwaitReverse awaits the result of a Reverse.
+func (c *Correlator) AwaitReverse(ctx context.Context, id string) (ReverseResult, error) {
+	result, err := c.Await(ctx, id)
+	if err != nil {
+		return ReverseResult{}, err
+	}
+
+	return newReverseResult(result)
+}
+
+// AwaitAccountBalance awaits the result of an AccountBalance.
+func (c *Correlator) AwaitAccountBalance(ctx context.Context, id string) (AccountBalanceResult, error) {
+	result, err := c.Await(ctx, id)
+	if err != nil {
+		return AccountBalanceResult{}, err
+	}
+
+	return newAccountBalanceResult(result)
+}
+
+// AwaitTransactionStatus awaits the result of a TransactionStatus.
+func (c *Correlator) AwaitTransactionStatus(ctx context.Context, id string) (TransactionStatusResult, error) {
+	result, err := c.Await(ctx, id)
+	if err != nil {
+		return TransactionStatusResult{}, err
+	}
+
+	return newTransactionStatusResult(result)
+}
@@ -0,0 +1,225 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func postResult(t *testing.T, handler http.Handler, body string) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/result", strings.NewReader(body)))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestCorrelatorAwait(t *testing.T) {
+	ack := ValidResp{
+		OriginatorConversationID: "10571-7910404-1",
+		ConversationID:           "AG_20191219_00004e48cf7e3533f581",
+		ResponseCode:             "0",
+	}
+
+	testCases := []struct {
+		name    string
+		id      func(c *Correlator) string
+		early   bool
+		timeout bool
+	}{
+		{
+			name: "by originator conversation id",
+			id: func(c *Correlator) string {
+				id, err := c.Track(ack)
+				require.NoError(t, err)
+
+				return id
+			},
+		},
+		{
+			name: "by conversation id",
+			id:   func(*Correlator) string { return ack.ConversationID },
+		},
+		{
+			name:  "result before await",
+			id:    func(*Correlator) string { return ack.OriginatorConversationID },
+			early: true,
+		},
+		{
+			name:    "queue timeout",
+			id:      func(*Correlator) string { return ack.OriginatorConversationID },
+			timeout: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewCorrelator(nil)
+			handler := c.Handlers().Result
+			if tc.timeout {
+				handler = c.Handlers().QueueTimeOut
+			}
+
+			if tc.early {
+				postResult(t, handler, b2cResult)
+			}
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				if !tc.early {
+					time.Sleep(20 * time.Millisecond)
+					postResult(t, handler, b2cResult)
+				}
+			}()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			res, err := c.AwaitB2CPayment(ctx, tc.id(c))
+			require.NoError(t, err)
+			assert.True(t, res.Matches(ack))
+			assert.Equal(t, tc.timeout, res.TimedOut)
+			assert.Equal(t, Amount(1000), res.TransactionAmount)
+
+			wg.Wait()
+		})
+	}
+}
+
+func TestCorrelatorAwaitTimeout(t *testing.T) {
+	c := NewCorrelator(nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := c.Await(ctx, "10571-7910404-1")
+	assert.ErrorIs(t, err, ErrAwaitTimeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = c.Await(context.Background(), "")
+	assert.ErrorIs(t, err, errMissingResultID)
+
+	_, err = c.Track(ValidResp{})
+	assert.ErrorIs(t, err, errMissingResultID)
+
+	id, err := c.Track(ValidResp{ConversationID: "AG_20191219_00004e48cf7e3533f581"})
+	require.NoError(t, err)
+	assert.Equal(t, "AG_20191219_00004e48cf7e3533f581", id)
+}
+
+func TestCorrelatorTypedResults(t *testing.T) {
+	c := NewCorrelator(nil)
+	ctx := context.Background()
+
+	postResult(t, c.Handlers().Result, accountBalanceResult)
+	balance, err := c.AwaitAccountBalance(ctx, "16917-22577599-3")
+	require.NoError(t, err)
+	assert.Len(t, balance.Balances, 5)
+
+	postResult(t, c.Handlers().Result, transactionStatusResult)
+	status, err := c.AwaitTransactionStatus(ctx, "AG_20200120_0000657265d5fa9ae5c0")
+	require.NoError(t, err)
+	assert.Equal(t, "OAK41HAY6Q", status.ReceiptNo)
+
+	postResult(t, c.Handlers().Result, reverseResult)
+	reversal, err := c.AwaitReverse(ctx, "8521-4298025-1")
+	require.NoError(t, err)
+	assert.Equal(t, "MJ551H6X5D", reversal.OriginalTransactionID)
+
+	postResult(t, c.Handlers().Result, businessPayBillResult)
+	payBill, err := c.AwaitBusinessPayBill(ctx, "626f6ddf-ab37-4650-b882-b1de92ec9aa4")
+	require.NoError(t, err)
+	assert.Equal(t, "19008", payBill.BillReferenceNumber)
+
+	postResult(t, c.Handlers().Result, businessPayBillResult)
+	tax, err := c.AwaitRemitTax(ctx, "12345677dfdf89099B3")
+	require.NoError(t, err)
+	assert.Equal(t, Amount(19000), tax.Amount)
+}
+
+func TestMemoryResultBackend(t *testing.T) {
+	result := AsyncResult{OriginatorConversationID: "10571-7910404-1", ConversationID: "AG_20191219_00004e48cf7e3533f581"}
+
+	t.Run("result is consumed once by either id", func(t *testing.T) {
+		backend := NewMemoryResultBackend(0)
+		require.NoError(t, backend.Publish(context.Background(), result))
+
+		got, err := backend.Wait(context.Background(), result.ConversationID)
+		require.NoError(t, err)
+		assert.Equal(t, result, got)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = backend.Wait(ctx, result.OriginatorConversationID)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("every waiter is woken", func(t *testing.T) {
+		backend := NewMemoryResultBackend(0)
+
+		var wg sync.WaitGroup
+		for _, id := range []string{result.OriginatorConversationID, result.OriginatorConversationID, result.ConversationID} {
+			wg.Add(1)
+			go func(id string) {
+				defer wg.Done()
+
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+
+				got, err := backend.Wait(ctx, id)
+				assert.NoError(t, err)
+				assert.Equal(t, result, got)
+			}(id)
+		}
+
+		mb := backend.(*memoryResultBackend)
+		require.Eventually(t, func() bool {
+			mb.mu.Lock()
+			defer mb.mu.Unlock()
+
+			return len(mb.waiters[result.OriginatorConversationID]) == 2 && len(mb.waiters[result.ConversationID]) == 1
+		}, time.Second, time.Millisecond)
+
+		require.NoError(t, backend.Publish(context.Background(), result))
+		wg.Wait()
+		assert.Empty(t, mb.results)
+	})
+
+	t.Run("cancelled waiter is removed", func(t *testing.T) {
+		backend := NewMemoryResultBackend(0)
+		mb := backend.(*memoryResultBackend)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := backend.Wait(ctx, result.ConversationID)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Empty(t, mb.waiters)
+	})
+
+	t.Run("unclaimed results expire", func(t *testing.T) {
+		backend := NewMemoryResultBackend(time.Millisecond)
+		mb := backend.(*memoryResultBackend)
+
+		require.NoError(t, backend.Publish(context.Background(), result))
+		time.Sleep(5 * time.Millisecond)
+		require.NoError(t, backend.Publish(context.Background(), AsyncResult{ConversationID: "AG_20200120_0000657265d5fa9ae5c0"}))
+
+		assert.Len(t, mb.results, 1)
+	})
+}
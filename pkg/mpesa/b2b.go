@@ -8,20 +8,27 @@ package mpesa
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 )
 
-func (sdk mSDK) BusinessPayBill(bpbReq BusinessPayBillReq) (BusinessPayBillResp, error) {
+func (sdk mSDK) BusinessPayBill(ctx context.Context, bpbReq BusinessPayBillReq) (BusinessPayBillResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpBusinessPayBill)
+	defer cancel()
+
+	bpbReq = bpbReq.withIdentifierTypes()
+
 	if err := bpbReq.Validate(); err != nil {
 		return BusinessPayBillResp{}, err
 	}
 
-	var err error
-	bpbReq.SecurityCredential, err = sdk.generateSecurityCredential(bpbReq.InitiatorPassword)
-	if err != nil {
-		return BusinessPayBillResp{}, err
+	if bpbReq.SecurityCredential == "" {
+		var err error
+		bpbReq.SecurityCredential, err = sdk.generateSecurityCredential(ctx, bpbReq.InitiatorPassword)
+		if err != nil {
+			return BusinessPayBillResp{}, err
+		}
 	}
 
 	data, err := json.Marshal(bpbReq)
@@ -29,14 +36,14 @@ func (sdk mSDK) BusinessPayBill(bpbReq BusinessPayBillReq) (BusinessPayBillResp,
 		return BusinessPayBillResp{}, err
 	}
 
-	url := fmt.Sprintf("%s/%s", sdk.baseURL, b2bEndpoint)
+	url := sdk.url(OpBusinessPayBill)
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
 	if err != nil {
 		return BusinessPayBillResp{}, err
 	}
 
-	resp, err := sdk.sendRequest(req)
+	resp, err := sdk.sendRequest(OpBusinessPayBill, req)
 	if err != nil {
 		return BusinessPayBillResp{}, err
 	}
@@ -46,5 +53,26 @@ func (sdk mSDK) BusinessPayBill(bpbReq BusinessPayBillReq) (BusinessPayBillResp,
 		return BusinessPayBillResp{}, err
 	}
 
+	if err := sdk.checkResponseCode(OpBusinessPayBill, b2cr.ResponseCode, b2cr.ResponseDescription, resp); err != nil {
+		return BusinessPayBillResp{}, err
+	}
+
 	return b2cr, nil
 }
+
+// withIdentifierTypes sets the identifier types that are not set to the ones
+// of the CommandID.
+func (r BusinessPayBillReq) withIdentifierTypes() BusinessPayBillReq {
+	parties, ok := b2bCommands[r.CommandID]
+	if !ok {
+		return r
+	}
+	if r.SenderIdentifierType == 0 {
+		r.SenderIdentifierType = parties.sender
+	}
+	if r.RecieverIdentifierType == 0 {
+		r.RecieverIdentifierType = parties.receiver
+	}
+
+	return r
+}
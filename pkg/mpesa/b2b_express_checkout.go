@@ -0,0 +1,58 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/oklog/ulid/v2"
+)
+
+func (sdk mSDK) B2BExpressCheckout(ctx context.Context, b2bReq B2BExpressCheckoutReq) (B2BExpressCheckoutResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpB2BExpressCheckout)
+	defer cancel()
+
+	if err := b2bReq.Validate(); err != nil {
+		return B2BExpressCheckoutResp{}, err
+	}
+
+	if b2bReq.RequestRefID == "" {
+		b2bReq.RequestRefID = ulid.Make().String()
+	}
+
+	data, err := json.Marshal(b2bReq)
+	if err != nil {
+		return B2BExpressCheckoutResp{}, err
+	}
+
+	url := sdk.url(OpB2BExpressCheckout)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return B2BExpressCheckoutResp{}, err
+	}
+
+	resp, err := sdk.sendRequest(OpB2BExpressCheckout, req)
+	if err != nil {
+		return B2BExpressCheckoutResp{}, err
+	}
+
+	var b2br B2BExpressCheckoutResp
+	if err := json.Unmarshal(resp, &b2br); err != nil {
+		return B2BExpressCheckoutResp{}, err
+	}
+
+	if err := sdk.checkResponseCode(OpB2BExpressCheckout, b2br.Code, b2br.Status, resp); err != nil {
+		return B2BExpressCheckoutResp{}, err
+	}
+	b2br.RequestRefID = b2bReq.RequestRefID
+
+	return b2br, nil
+}
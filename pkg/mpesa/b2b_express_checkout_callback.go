@@ -0,0 +1,114 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// B2BExpressCheckoutCallback is the outcome of a B2B Express Checkout that
+// Safaricom posts to the CallbackURL of the B2BExpressCheckout request once
+// the merchant has paid or cancelled the USSD push.
+type B2BExpressCheckoutCallback struct {
+	RequestID        string     // The RequestRefID of the B2BExpressCheckout request.
+	ResultCode       ResultCode // The outcome of the payment. 0 means that it was paid.
+	ResultDesc       string     // The description of the outcome.
+	ResultType       string     // The type of the result.
+	Amount           Amount     // The amount of the payment.
+	ConversationID   string     // The unique identifier of the transaction. Only set on success.
+	TransactionID    string     // The M-PESA transaction ID. Only set on success.
+	Status           string     // The status of the payment, e.g. SUCCESS.
+	PaymentReference string     // The paymentRef of the request. Only set on failure.
+}
+
+type b2bExpressCheckoutCallbackBody struct {
+	RequestID        string      `json:"requestId"`
+	ResultCode       ResultCode  `json:"resultCode"`
+	ResultDesc       string      `json:"resultDesc"`
+	ResultType       interface{} `json:"resultType"`
+	Amount           json.Number `json:"amount"`
+	ConversationID   string      `json:"conversationID"`
+	TransactionID    string      `json:"transactionId"`
+	Status           string      `json:"status"`
+	PaymentReference string      `json:"paymentReference"`
+}
+
+// ParseB2BExpressCheckoutCallback parses the body of a B2B Express Checkout callback.
+func ParseB2BExpressCheckoutCallback(data []byte) (B2BExpressCheckoutCallback, error) {
+	var body b2bExpressCheckoutCallbackBody
+	if err := decodeCallback(data, &body); err != nil {
+		return B2BExpressCheckoutCallback{}, err
+	}
+	if body.RequestID == "" || body.ResultCode == "" {
+		return B2BExpressCheckoutCallback{}, fmt.Errorf("%w: missing requestId or resultCode", errInvalidCallback)
+	}
+
+	cb := B2BExpressCheckoutCallback{
+		RequestID:        body.RequestID,
+		ResultCode:       body.ResultCode,
+		ResultDesc:       body.ResultDesc,
+		ConversationID:   body.ConversationID,
+		TransactionID:    body.TransactionID,
+		Status:           body.Status,
+		PaymentReference: body.PaymentReference,
+	}
+	if body.ResultType != nil {
+		cb.ResultType = fmt.Sprint(body.ResultType)
+	}
+	if body.Amount != "" {
+		var err error
+		if cb.Amount, err = ParseAmount(body.Amount.String()); err != nil {
+			return B2BExpressCheckoutCallback{}, fmt.Errorf("%w: amount: %w", errInvalidCallback, err)
+		}
+	}
+
+	return cb, nil
+}
+
+// B2BExpressCheckoutCallbackFunc handles the outcome of a B2B Express
+// Checkout. If it returns an error the callback is not acknowledged.
+type B2BExpressCheckoutCallbackFunc func(ctx context.Context, cb B2BExpressCheckoutCallback) error
+
+type b2bExpressCheckoutCallbackHandler struct {
+	fn B2BExpressCheckoutCallbackFunc
+}
+
+// NewB2BExpressCheckoutCallbackHandler returns an http.Handler that serves the
+// CallbackURL of B2BExpressCheckout requests. It parses every callback,
+// passes it to fn and acknowledges it to Safaricom.
+func NewB2BExpressCheckoutCallbackHandler(fn B2BExpressCheckoutCallbackFunc) (http.Handler, error) {
+	if fn == nil {
+		return nil, errMissingCallbackHandler
+	}
+
+	return b2bExpressCheckoutCallbackHandler{fn: fn}, nil
+}
+
+func (h b2bExpressCheckoutCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	data, ok := readCallback(w, r)
+	if !ok {
+		return
+	}
+
+	cb, err := ParseB2BExpressCheckoutCallback(data)
+	if err != nil {
+		writeCallbackResp(w, http.StatusBadRequest, callbackFailed)
+
+		return
+	}
+
+	if err := h.fn(r.Context(), cb); err != nil {
+		writeCallbackResp(w, http.StatusInternalServerError, callbackFailed)
+
+		return
+	}
+
+	writeCallbackResp(w, http.StatusOK, callbackAccepted)
+}
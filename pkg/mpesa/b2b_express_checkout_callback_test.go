@@ -0,0 +1,149 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testB2BExpressCheckoutCallback = `{
+	"resultCode": "0",
+	"resultDesc": "The service request is processed successfully.",
+	"amount": "71.0",
+	"requestId": "404e1aec-19e0-4ce3-973d-bd92e94c8021",
+	"resultType": "0",
+	"conversationID": "AG_20230426_2010434680d9f5a73766",
+	"transactionId": "RDQ01NFT1Q",
+	"status": "SUCCESS"
+}`
+
+func TestParseB2BExpressCheckoutCallback(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        string
+		expected    B2BExpressCheckoutCallback
+		expectedErr error
+	}{
+		{
+			name: "paid",
+			data: testB2BExpressCheckoutCallback,
+			expected: B2BExpressCheckoutCallback{
+				RequestID:      "404e1aec-19e0-4ce3-973d-bd92e94c8021",
+				ResultCode:     "0",
+				ResultDesc:     "The service request is processed successfully.",
+				ResultType:     "0",
+				Amount:         7100,
+				ConversationID: "AG_20230426_2010434680d9f5a73766",
+				TransactionID:  "RDQ01NFT1Q",
+				Status:         "SUCCESS",
+			},
+		},
+		{
+			name: "cancelled",
+			data: `{"resultCode":4001,"resultDesc":"User cancelled transaction","requestId":"c2a9ba32","amount":71,"paymentReference":"MAndbubry3hi"}`,
+			expected: B2BExpressCheckoutCallback{
+				RequestID:        "c2a9ba32",
+				ResultCode:       "4001",
+				ResultDesc:       "User cancelled transaction",
+				Amount:           7100,
+				PaymentReference: "MAndbubry3hi",
+			},
+		},
+		{
+			name:        "missing request id",
+			data:        `{"resultCode":"0"}`,
+			expectedErr: errInvalidCallback,
+		},
+		{
+			name:        "missing result code",
+			data:        `{"requestId":"c2a9ba32"}`,
+			expectedErr: errInvalidCallback,
+		},
+		{
+			name:        "invalid amount",
+			data:        `{"resultCode":"0","requestId":"c2a9ba32","amount":"ten"}`,
+			expectedErr: errInvalidCallback,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cb, err := ParseB2BExpressCheckoutCallback([]byte(tc.data))
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, cb)
+		})
+	}
+}
+
+func TestB2BExpressCheckoutCallbackHandler(t *testing.T) {
+	testCases := []struct {
+		name           string
+		body           string
+		fnErr          error
+		expectedStatus int
+		expectedResp   CallbackResp
+	}{
+		{
+			name:           "accepted",
+			body:           testB2BExpressCheckoutCallback,
+			expectedStatus: http.StatusOK,
+			expectedResp:   callbackAccepted,
+		},
+		{
+			name:           "invalid callback",
+			body:           `{"status":"SUCCESS"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   callbackFailed,
+		},
+		{
+			name:           "handler error",
+			body:           testB2BExpressCheckoutCallback,
+			fnErr:          errInvalidCallback,
+			expectedStatus: http.StatusInternalServerError,
+			expectedResp:   callbackFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got B2BExpressCheckoutCallback
+			handler, err := NewB2BExpressCheckoutCallbackHandler(func(_ context.Context, cb B2BExpressCheckoutCallback) error {
+				got = cb
+
+				return tc.fnErr
+			})
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/b2bexpress", strings.NewReader(tc.body)))
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedStatus != http.StatusBadRequest {
+				assert.Equal(t, "RDQ01NFT1Q", got.TransactionID)
+			}
+
+			var resp CallbackResp
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, tc.expectedResp, resp)
+		})
+	}
+
+	_, err := NewB2BExpressCheckoutCallbackHandler(nil)
+	assert.ErrorIs(t, err, errMissingCallbackHandler)
+}
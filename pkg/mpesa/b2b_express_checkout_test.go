@@ -0,0 +1,139 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testB2BExpressCheckoutReq = B2BExpressCheckoutReq{
+	PrimaryShortCode:  600992,
+	ReceiverShortCode: 600984,
+	Amount:            100,
+	PaymentRef:        "INV001",
+	CallbackURL:       "https://example.com/b2bexpress",
+	PartnerName:       "Vendor",
+}
+
+func TestB2BExpressCheckout(t *testing.T) {
+	testCases := []struct {
+		name         string
+		req          B2BExpressCheckoutReq
+		expectedRef  string
+		expectedResp B2BExpressCheckoutResp
+	}{
+		{
+			name: "generated request ref id",
+			req:  testB2BExpressCheckoutReq,
+			expectedResp: B2BExpressCheckoutResp{
+				Code:   "0",
+				Status: "USSD Initiated Successfully",
+			},
+		},
+		{
+			name:        "given request ref id",
+			req:         with(testB2BExpressCheckoutReq, func(r *B2BExpressCheckoutReq) { r.RequestRefID = "ref" }),
+			expectedRef: "ref",
+			expectedResp: B2BExpressCheckoutResp{
+				Code:   "0",
+				Status: "USSD Initiated Successfully",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var sentRef string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/"+b2bExpressCheckoutEndpoint, r.URL.Path)
+
+				var req map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, "600992", req["primaryShortCode"])
+				assert.Equal(t, "600984", req["receiverShortCode"])
+				assert.Equal(t, "100", req["amount"])
+				assert.Equal(t, "INV001", req["paymentRef"])
+				assert.Equal(t, "https://example.com/b2bexpress", req["callbackUrl"])
+				assert.Equal(t, "Vendor", req["partnerName"])
+				assert.NotEmpty(t, req["RequestRefID"])
+				sentRef = req["RequestRefID"]
+				if tc.expectedRef != "" {
+					assert.Equal(t, tc.expectedRef, req["RequestRefID"])
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"code":"0","status":"USSD Initiated Successfully"}`))
+			}))
+			defer server.Close()
+
+			sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+			sdk.strict = true
+
+			resp, err := sdk.B2BExpressCheckout(context.Background(), tc.req)
+			require.NoError(t, err)
+			assert.Equal(t, sentRef, resp.RequestRefID, "expected the sent RequestRefID to be returned")
+			tc.expectedResp.RequestRefID = sentRef
+			assert.Equal(t, tc.expectedResp, resp)
+		})
+	}
+}
+
+func TestB2BExpressCheckoutValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		req         B2BExpressCheckoutReq
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			req:  testB2BExpressCheckoutReq,
+		},
+		{
+			name:        "invalid primary shortcode",
+			req:         with(testB2BExpressCheckoutReq, func(r *B2BExpressCheckoutReq) { r.PrimaryShortCode = 1 }),
+			expectedErr: errInvalidShortCode,
+		},
+		{
+			name:        "invalid receiver shortcode",
+			req:         with(testB2BExpressCheckoutReq, func(r *B2BExpressCheckoutReq) { r.ReceiverShortCode = 0 }),
+			expectedErr: errInvalidShortCode,
+		},
+		{
+			name:        "without amount",
+			req:         with(testB2BExpressCheckoutReq, func(r *B2BExpressCheckoutReq) { r.Amount = 0 }),
+			expectedErr: errInvalidAmount,
+		},
+		{
+			name:        "without payment reference",
+			req:         with(testB2BExpressCheckoutReq, func(r *B2BExpressCheckoutReq) { r.PaymentRef = "" }),
+			expectedErr: errInvalidPaymentRef,
+		},
+		{
+			name:        "invalid callback url",
+			req:         with(testB2BExpressCheckoutReq, func(r *B2BExpressCheckoutReq) { r.CallbackURL = invalidURL }),
+			expectedErr: errInvalidURL,
+		},
+		{
+			name:        "without partner name",
+			req:         with(testB2BExpressCheckoutReq, func(r *B2BExpressCheckoutReq) { r.PartnerName = "" }),
+			expectedErr: errInvalidPartnerName,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.req.Validate(), tc.expectedErr)
+		})
+	}
+}
@@ -7,6 +7,7 @@
 package mpesa
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -257,11 +258,11 @@ func TestBusinessPayBill(t *testing.T) {
 				baseURL:   server.URL,
 				appKey:    appKey,
 				appSecret: appSecret,
-				certFile:  sandboxCertificate,
+				cert:      testCertificate(t),
 				client:    server.Client(),
 			}
 
-			response, err := sdk.BusinessPayBill(tc.request)
+			response, err := sdk.BusinessPayBill(context.Background(), tc.request)
 			assert.ErrorIs(t, err, tc.expectedErr, "%s: Expected error '%v', got '%v'", tc.name, tc.expectedErr, err)
 
 			if !reflect.DeepEqual(response, tc.expectedResponse) {
@@ -270,3 +271,55 @@ func TestBusinessPayBill(t *testing.T) {
 		})
 	}
 }
+
+func TestBusinessPayBillCommands(t *testing.T) {
+	req := BusinessPayBillReq{
+		Initiator:         initiatorName,
+		InitiatorPassword: initiatorPassword,
+		Amount:            10,
+		PartyA:            600986,
+		PartyB:            600992,
+		QueueTimeOutURL:   "https://example.com/timeout",
+		ResultURL:         "https://example.com/result",
+		Remarks:           "test",
+	}
+
+	testCases := []struct {
+		commandID        string
+		senderType       uint8
+		receiverType     uint8
+		expectedSender   uint8
+		expectedReceiver uint8
+		expectedErr      error
+	}{
+		{commandID: "BusinessPayBill", expectedSender: 4, expectedReceiver: 4},
+		{commandID: "BusinessBuyGoods", expectedSender: 4, expectedReceiver: 2},
+		{commandID: "BusinessToBusinessTransfer", expectedSender: 4, expectedReceiver: 4},
+		{commandID: "MerchantToMerchantTransfer", expectedSender: 2, expectedReceiver: 2},
+		{commandID: "MerchantTransferFromMerchantToWorking", expectedSender: 2, expectedReceiver: 4},
+		{commandID: "MerchantServicesMMFAccountTransfer", expectedSender: 2, expectedReceiver: 4},
+		{commandID: "AgencyFloatAdvance", expectedSender: 4, expectedReceiver: 4},
+		{commandID: "BusinessBuyGoods", senderType: 4, receiverType: 2, expectedSender: 4, expectedReceiver: 2},
+		{commandID: "BusinessBuyGoods", receiverType: 4, expectedErr: errInvalidIdentifierType},
+		{commandID: "MerchantToMerchantTransfer", senderType: 4, expectedErr: errInvalidIdentifierType},
+		{commandID: "BusinessPayment", expectedErr: errInvalidCommandID},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.commandID, func(t *testing.T) {
+			req := with(req, func(r *BusinessPayBillReq) {
+				r.CommandID = tc.commandID
+				r.SenderIdentifierType = tc.senderType
+				r.RecieverIdentifierType = tc.receiverType
+			})
+			assert.ErrorIs(t, req.Validate(), tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			req = req.withIdentifierTypes()
+			assert.Equal(t, tc.expectedSender, req.SenderIdentifierType)
+			assert.Equal(t, tc.expectedReceiver, req.RecieverIdentifierType)
+		})
+	}
+}
@@ -8,22 +8,27 @@ package mpesa
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 
 	"github.com/oklog/ulid/v2"
 )
 
-func (sdk mSDK) B2CPayment(b2cReq B2CPaymentReq) (B2CPaymentResp, error) {
+func (sdk mSDK) B2CPayment(ctx context.Context, b2cReq B2CPaymentReq) (B2CPaymentResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpB2CPayment)
+	defer cancel()
+
 	if err := b2cReq.Validate(); err != nil {
 		return B2CPaymentResp{}, err
 	}
 
-	var err error
-	b2cReq.SecurityCredential, err = sdk.generateSecurityCredential(b2cReq.InitiatorPassword)
-	if err != nil {
-		return B2CPaymentResp{}, err
+	if b2cReq.SecurityCredential == "" {
+		var err error
+		b2cReq.SecurityCredential, err = sdk.generateSecurityCredential(ctx, b2cReq.InitiatorPassword)
+		if err != nil {
+			return B2CPaymentResp{}, err
+		}
 	}
 
 	if b2cReq.OriginatorConversationID == "" {
@@ -35,14 +40,14 @@ func (sdk mSDK) B2CPayment(b2cReq B2CPaymentReq) (B2CPaymentResp, error) {
 		return B2CPaymentResp{}, err
 	}
 
-	url := fmt.Sprintf("%s/%s", sdk.baseURL, b2cEndpoint)
+	url := sdk.url(OpB2CPayment)
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
 	if err != nil {
 		return B2CPaymentResp{}, err
 	}
 
-	resp, err := sdk.sendRequest(req)
+	resp, err := sdk.sendRequest(OpB2CPayment, req)
 	if err != nil {
 		return B2CPaymentResp{}, err
 	}
@@ -52,5 +57,123 @@ func (sdk mSDK) B2CPayment(b2cReq B2CPaymentReq) (B2CPaymentResp, error) {
 		return B2CPaymentResp{}, err
 	}
 
+	if err := sdk.checkResponseCode(OpB2CPayment, b2cr.ResponseCode, b2cr.ResponseDescription, resp); err != nil {
+		return B2CPaymentResp{}, err
+	}
+
+	// The v3 endpoint deduplicates by the OriginatorConversationID of the
+	// request, so it identifies the payment even when Daraja does not echo it.
+	if sdk.b2cVersion == B2CV3 && b2cr.OriginatorConversationID == "" {
+		b2cr.OriginatorConversationID = b2cReq.OriginatorConversationID
+	}
+
 	return b2cr, nil
 }
+
+func (sdk mSDK) B2CAccountTopUp(ctx context.Context, topUpReq B2CAccountTopUpReq) (B2CAccountTopUpResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpB2CAccountTopUp)
+	defer cancel()
+
+	if topUpReq.CommandID == "" {
+		topUpReq.CommandID = b2cAccountTopUpCommand
+	}
+	if topUpReq.SenderIdentifierType == 0 {
+		topUpReq.SenderIdentifierType = shortCodeIdentifier
+	}
+	if topUpReq.RecieverIdentifierType == 0 {
+		topUpReq.RecieverIdentifierType = shortCodeIdentifier
+	}
+
+	if err := topUpReq.Validate(); err != nil {
+		return B2CAccountTopUpResp{}, err
+	}
+
+	if topUpReq.SecurityCredential == "" {
+		var err error
+		topUpReq.SecurityCredential, err = sdk.generateSecurityCredential(ctx, topUpReq.InitiatorPassword)
+		if err != nil {
+			return B2CAccountTopUpResp{}, err
+		}
+	}
+
+	data, err := json.Marshal(topUpReq)
+	if err != nil {
+		return B2CAccountTopUpResp{}, err
+	}
+
+	url := sdk.url(OpB2CAccountTopUp)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return B2CAccountTopUpResp{}, err
+	}
+
+	resp, err := sdk.sendRequest(OpB2CAccountTopUp, req)
+	if err != nil {
+		return B2CAccountTopUpResp{}, err
+	}
+
+	var topUpResp B2CAccountTopUpResp
+	if err := json.Unmarshal(resp, &topUpResp); err != nil {
+		return B2CAccountTopUpResp{}, err
+	}
+
+	if err := sdk.checkResponseCode(OpB2CAccountTopUp, topUpResp.ResponseCode, topUpResp.ResponseDescription, resp); err != nil {
+		return B2CAccountTopUpResp{}, err
+	}
+
+	return topUpResp, nil
+}
+
+func (sdk mSDK) B2Pochi(ctx context.Context, pochiReq B2PochiReq) (B2PochiResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpB2Pochi)
+	defer cancel()
+
+	if pochiReq.CommandID == "" {
+		pochiReq.CommandID = b2PochiCommand
+	}
+
+	if err := pochiReq.Validate(); err != nil {
+		return B2PochiResp{}, err
+	}
+
+	if pochiReq.SecurityCredential == "" {
+		var err error
+		pochiReq.SecurityCredential, err = sdk.generateSecurityCredential(ctx, pochiReq.InitiatorPassword)
+		if err != nil {
+			return B2PochiResp{}, err
+		}
+	}
+
+	if pochiReq.OriginatorConversationID == "" {
+		pochiReq.OriginatorConversationID = ulid.Make().String()
+	}
+
+	data, err := json.Marshal(pochiReq)
+	if err != nil {
+		return B2PochiResp{}, err
+	}
+
+	url := sdk.url(OpB2Pochi)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return B2PochiResp{}, err
+	}
+
+	resp, err := sdk.sendRequest(OpB2Pochi, req)
+	if err != nil {
+		return B2PochiResp{}, err
+	}
+
+	var pochiResp B2PochiResp
+	if err := json.Unmarshal(resp, &pochiResp); err != nil {
+		return B2PochiResp{}, err
+	}
+
+	if err := sdk.checkResponseCode(OpB2Pochi, pochiResp.ResponseCode, pochiResp.ResponseDescription, resp); err != nil {
+		return B2PochiResp{}, err
+	}
+
+	return pochiResp, nil
+}
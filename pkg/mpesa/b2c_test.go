@@ -7,6 +7,7 @@
 package mpesa
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -31,6 +33,30 @@ var (
 	invalidString             = strings.Repeat("a", 256)
 	initiatorName             = "testapi"
 	initiatorPassword         = "Safaricom999!*!"
+
+	testB2CAccountTopUpReq = B2CAccountTopUpReq{
+		Initiator:          initiatorName,
+		SecurityCredential: "pre-encrypted-credential",
+		Amount:             239,
+		PartyA:             600979,
+		PartyB:             600000,
+		AccountReference:   "353353",
+		Requester:          254708374149,
+		Remarks:            "OK",
+		QueueTimeOutURL:    "https://example.com/timeout",
+		ResultURL:          "https://example.com/result",
+	}
+	testB2PochiReq = B2PochiReq{
+		InitiatorName:      initiatorName,
+		SecurityCredential: "pre-encrypted-credential",
+		Amount:             10,
+		PartyA:             600996,
+		PartyB:             254712345678,
+		Remarks:            "test",
+		QueueTimeOutURL:    "https://example.com/timeout",
+		ResultURL:          "https://example.com/result",
+		Occasion:           "test",
+	}
 )
 
 func TestB2CPayment(t *testing.T) {
@@ -234,11 +260,11 @@ func TestB2CPayment(t *testing.T) {
 				baseURL:   server.URL,
 				appKey:    appKey,
 				appSecret: appSecret,
-				certFile:  sandboxCertificate,
+				cert:      testCertificate(t),
 				client:    server.Client(),
 			}
 
-			response, err := sdk.B2CPayment(tc.request)
+			response, err := sdk.B2CPayment(context.Background(), tc.request)
 			assert.ErrorIs(t, err, tc.expectedErr, "%s: Expected error '%v', got '%v'", tc.name, tc.expectedErr, err)
 
 			if !reflect.DeepEqual(response, tc.expectedResponse) {
@@ -247,3 +273,222 @@ func TestB2CPayment(t *testing.T) {
 		})
 	}
 }
+
+func TestB2CAccountTopUp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+b2bEndpoint, r.URL.Path)
+
+		var req B2CAccountTopUpReq
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "BusinessPayToBulk", req.CommandID)
+		assert.Equal(t, uint8(4), req.SenderIdentifierType)
+		assert.Equal(t, uint8(4), req.RecieverIdentifierType)
+		assert.Equal(t, "pre-encrypted-credential", req.SecurityCredential)
+
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(B2CAccountTopUpResp{ValidResp: validResp}))
+	}))
+	defer server.Close()
+
+	sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+	sdk.strict = true
+
+	resp, err := sdk.B2CAccountTopUp(context.Background(), testB2CAccountTopUpReq)
+	require.NoError(t, err)
+	assert.Equal(t, B2CAccountTopUpResp{ValidResp: validResp}, resp)
+}
+
+func TestB2CAccountTopUpValidate(t *testing.T) {
+	valid := with(testB2CAccountTopUpReq, func(r *B2CAccountTopUpReq) {
+		r.CommandID = "BusinessPayToBulk"
+		r.SenderIdentifierType = 4
+		r.RecieverIdentifierType = 4
+	})
+
+	testCases := []struct {
+		name        string
+		req         B2CAccountTopUpReq
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			req:  valid,
+		},
+		{
+			name:        "invalid command id",
+			req:         with(valid, func(r *B2CAccountTopUpReq) { r.CommandID = "BusinessPayBill" }),
+			expectedErr: errInvalidCommandID,
+		},
+		{
+			name:        "invalid party A",
+			req:         with(valid, func(r *B2CAccountTopUpReq) { r.PartyA = invalidShortCode }),
+			expectedErr: errInvalidShortCode,
+		},
+		{
+			name:        "invalid party B",
+			req:         with(valid, func(r *B2CAccountTopUpReq) { r.PartyB = invalidShortCode }),
+			expectedErr: errInvalidShortCode,
+		},
+		{
+			name:        "till number sender",
+			req:         with(valid, func(r *B2CAccountTopUpReq) { r.SenderIdentifierType = 2 }),
+			expectedErr: errInvalidIdentifierType,
+		},
+		{
+			name:        "without amount",
+			req:         with(valid, func(r *B2CAccountTopUpReq) { r.Amount = 0 }),
+			expectedErr: errInvalidAmount,
+		},
+		{
+			name:        "invalid account reference",
+			req:         with(valid, func(r *B2CAccountTopUpReq) { r.AccountReference = invalidString }),
+			expectedErr: errInvalidAccountReference,
+		},
+		{
+			name:        "invalid result url",
+			req:         with(valid, func(r *B2CAccountTopUpReq) { r.ResultURL = invalidURL }),
+			expectedErr: errInvalidURL,
+		},
+		{
+			name:        "invalid remarks",
+			req:         with(valid, func(r *B2CAccountTopUpReq) { r.Remarks = invalidString }),
+			expectedErr: errInvalidRemarks,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.req.Validate(), tc.expectedErr)
+		})
+	}
+}
+
+func TestB2Pochi(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+b2cEndpoint, r.URL.Path)
+
+		var req B2PochiReq
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "BusinessPayToPochi", req.CommandID)
+		assert.NotEmpty(t, req.OriginatorConversationID)
+
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(B2PochiResp{ValidResp: validResp}))
+	}))
+	defer server.Close()
+
+	sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+	sdk.strict = true
+
+	resp, err := sdk.B2Pochi(context.Background(), testB2PochiReq)
+	require.NoError(t, err)
+	assert.Equal(t, B2PochiResp{ValidResp: validResp}, resp)
+}
+
+func TestB2PochiValidate(t *testing.T) {
+	valid := with(testB2PochiReq, func(r *B2PochiReq) { r.CommandID = "BusinessPayToPochi" })
+
+	testCases := []struct {
+		name        string
+		req         B2PochiReq
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			req:  valid,
+		},
+		{
+			name:        "invalid command id",
+			req:         with(valid, func(r *B2PochiReq) { r.CommandID = "BusinessPayment" }),
+			expectedErr: errInvalidCommandID,
+		},
+		{
+			name:        "invalid party A",
+			req:         with(valid, func(r *B2PochiReq) { r.PartyA = invalidShortCode }),
+			expectedErr: errInvalidShortCode,
+		},
+		{
+			name:        "invalid party B",
+			req:         with(valid, func(r *B2PochiReq) { r.PartyB = invalidPhoneNumber }),
+			expectedErr: errInvalidPhoneNumber,
+		},
+		{
+			name:        "without amount",
+			req:         with(valid, func(r *B2PochiReq) { r.Amount = 0 }),
+			expectedErr: errInvalidAmount,
+		},
+		{
+			name:        "invalid queue timeout url",
+			req:         with(valid, func(r *B2PochiReq) { r.QueueTimeOutURL = invalidURL }),
+			expectedErr: errInvalidURL,
+		},
+		{
+			name:        "invalid occasion",
+			req:         with(valid, func(r *B2PochiReq) { r.Occasion = invalidString }),
+			expectedErr: errInvalidOccasion,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.req.Validate(), tc.expectedErr)
+		})
+	}
+}
+
+func TestB2CPaymentV3(t *testing.T) {
+	testCases := []struct {
+		name       string
+		b2cVersion B2CVersion
+		path       string
+		response   string
+		expectedID string
+	}{
+		{
+			name:       "v1 ignores the originator conversation id",
+			b2cVersion: B2CV1,
+			path:       b2cEndpoint,
+			response:   `{"ConversationID":"AG_20230907_2010325b025970fde878","OriginatorCoversationID":"29115-34620561-1","ResponseCode":"0","ResponseDescription":"Accept the service request successfully."}`,
+			expectedID: "29115-34620561-1",
+		},
+		{
+			name:       "v3 returns the originator conversation id",
+			b2cVersion: B2CV3,
+			path:       b2cV3Endpoint,
+			response:   `{"ConversationID":"AG_20230907_2010325b025970fde878","OriginatorConversationID":"feb5e3f2-fbbc-4745-844c-ee37b546f627","ResponseCode":"0","ResponseDescription":"Accept the service request successfully."}`,
+			expectedID: "feb5e3f2-fbbc-4745-844c-ee37b546f627",
+		},
+		{
+			name:       "v3 without the originator conversation id in the response",
+			b2cVersion: B2CV3,
+			path:       b2cV3Endpoint,
+			response:   `{"ConversationID":"AG_20230907_2010325b025970fde878","ResponseCode":"0","ResponseDescription":"Accept the service request successfully."}`,
+			expectedID: "feb5e3f2-fbbc-4745-844c-ee37b546f627",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/"+tc.path, r.URL.Path)
+
+				var req B2CPaymentReq
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, testB2CPaymentReq.OriginatorConversationID, req.OriginatorConversationID)
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+			sdk.endpoints, _ = Config{B2CVersion: tc.b2cVersion}.endpoints()
+			sdk.b2cVersion = tc.b2cVersion
+
+			resp, err := sdk.B2CPayment(context.Background(), testB2CPaymentReq)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedID, resp.OriginatorConversationID)
+			assert.Equal(t, "AG_20230907_2010325b025970fde878", resp.ConversationID)
+		})
+	}
+}
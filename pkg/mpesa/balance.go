@@ -8,20 +8,31 @@ package mpesa
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
-func (sdk mSDK) AccountBalance(abReq AccountBalanceReq) (AccountBalanceResp, error) {
+// errInvalidAccountBalance indicates that an account balance could not be parsed.
+var errInvalidAccountBalance = errors.New("invalid account balance")
+
+func (sdk mSDK) AccountBalance(ctx context.Context, abReq AccountBalanceReq) (AccountBalanceResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpAccountBalance)
+	defer cancel()
+
 	if err := abReq.Validate(); err != nil {
 		return AccountBalanceResp{}, err
 	}
 
-	var err error
-	abReq.SecurityCredential, err = sdk.generateSecurityCredential(abReq.InitiatorPassword)
-	if err != nil {
-		return AccountBalanceResp{}, err
+	if abReq.SecurityCredential == "" {
+		var err error
+		abReq.SecurityCredential, err = sdk.generateSecurityCredential(ctx, abReq.InitiatorPassword)
+		if err != nil {
+			return AccountBalanceResp{}, err
+		}
 	}
 
 	data, err := json.Marshal(abReq)
@@ -29,14 +40,14 @@ func (sdk mSDK) AccountBalance(abReq AccountBalanceReq) (AccountBalanceResp, err
 		return AccountBalanceResp{}, err
 	}
 
-	url := fmt.Sprintf("%s/%s", sdk.baseURL, accbalanceEndpoint)
+	url := sdk.url(OpAccountBalance)
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
 	if err != nil {
 		return AccountBalanceResp{}, err
 	}
 
-	resp, err := sdk.sendRequest(req)
+	resp, err := sdk.sendRequest(OpAccountBalance, req)
 	if err != nil {
 		return AccountBalanceResp{}, err
 	}
@@ -46,5 +57,52 @@ func (sdk mSDK) AccountBalance(abReq AccountBalanceReq) (AccountBalanceResp, err
 		return AccountBalanceResp{}, err
 	}
 
+	if err := sdk.checkResponseCode(OpAccountBalance, abr.ResponseCode, abr.ResponseDescription, resp); err != nil {
+		return AccountBalanceResp{}, err
+	}
+
 	return abr, nil
 }
+
+// AccountBalance is the balance of one of the accounts of an organization.
+type AccountBalance struct {
+	Name      string // The name of the account, such as Working Account or Utility Account.
+	Currency  string // The currency of the account, such as KES.
+	Current   Amount // The current balance of the account.
+	Available Amount // The balance that can be transacted.
+	Reserved  Amount // The amount held by pending transactions.
+	Uncleared Amount // The amount not yet cleared.
+}
+
+// ParseAccountBalances parses the AccountBalance result parameter, which
+// lists the accounts separated by & and the fields of each account separated
+// by |, for example Working Account|KES|700000.00|700000.00|0.00|0.00.
+func ParseAccountBalances(s string) ([]AccountBalance, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	accounts := strings.Split(s, "&")
+	balances := make([]AccountBalance, 0, len(accounts))
+	for _, account := range accounts {
+		fields := strings.Split(account, "|")
+		if len(fields) != 6 {
+			return nil, fmt.Errorf("%w: %q has %d fields, expected 6", errInvalidAccountBalance, account, len(fields))
+		}
+
+		balance := AccountBalance{
+			Name:     strings.TrimSpace(fields[0]),
+			Currency: strings.TrimSpace(fields[1]),
+		}
+		for i, amount := range []*Amount{&balance.Current, &balance.Available, &balance.Reserved, &balance.Uncleared} {
+			value, err := ParseAmount(fields[i+2])
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %w", errInvalidAccountBalance, balance.Name, err)
+			}
+			*amount = value
+		}
+		balances = append(balances, balance)
+	}
+
+	return balances, nil
+}
@@ -7,6 +7,7 @@
 package mpesa
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -180,11 +181,11 @@ func TestAccountBalance(t *testing.T) {
 				baseURL:   server.URL,
 				appKey:    appKey,
 				appSecret: appSecret,
-				certFile:  sandboxCertificate,
+				cert:      testCertificate(t),
 				client:    server.Client(),
 			}
 
-			response, err := sdk.AccountBalance(tc.request)
+			response, err := sdk.AccountBalance(context.Background(), tc.request)
 			assert.ErrorIs(t, err, tc.expectedErr, "%s: Expected error '%v', got '%v'", tc.name, tc.expectedErr, err)
 
 			if !reflect.DeepEqual(response, tc.expectedResponse) {
@@ -193,3 +194,66 @@ func TestAccountBalance(t *testing.T) {
 		})
 	}
 }
+
+func TestParseAccountBalances(t *testing.T) {
+	testCases := []struct {
+		name        string
+		balance     string
+		expected    []AccountBalance
+		expectedErr error
+	}{
+		{
+			name:    "sandbox",
+			balance: "Working Account|KES|700000.00|700000.00|0.00|0.00&Float Account|KES|0.00|0.00|0.00|0.00&Utility Account|KES|228037.00|228037.00|0.00|0.00&Charges Paid Account|KES|-1540.00|-1540.00|0.00|0.00&Organization Settlement Account|KES|0.00|0.00|0.00|0.00",
+			expected: []AccountBalance{
+				{Name: "Working Account", Currency: "KES", Current: 70000000, Available: 70000000},
+				{Name: "Float Account", Currency: "KES"},
+				{Name: "Utility Account", Currency: "KES", Current: 22803700, Available: 22803700},
+				{Name: "Charges Paid Account", Currency: "KES", Current: -154000, Available: -154000},
+				{Name: "Organization Settlement Account", Currency: "KES"},
+			},
+		},
+		{
+			name:    "reserved funds",
+			balance: "Working Account|KES|346768.83|6186.83|340582.00|0.00",
+			expected: []AccountBalance{
+				{Name: "Working Account", Currency: "KES", Current: 34676883, Available: 618683, Reserved: 34058200},
+			},
+		},
+		{
+			name:    "uncleared funds",
+			balance: "Merchant Account|KES|1250.5|1000|0.00|250.50",
+			expected: []AccountBalance{
+				{Name: "Merchant Account", Currency: "KES", Current: 125050, Available: 100000, Uncleared: 25050},
+			},
+		},
+		{
+			name:     "empty",
+			balance:  "",
+			expected: nil,
+		},
+		{
+			name:        "missing fields",
+			balance:     "Working Account|KES|700000.00",
+			expectedErr: errInvalidAccountBalance,
+		},
+		{
+			name:        "invalid amount",
+			balance:     "Working Account|KES|700000.00|seven|0.00|0.00",
+			expectedErr: errInvalidAmount,
+		},
+		{
+			name:        "too many fractional digits",
+			balance:     "Working Account|KES|700000.001|700000.00|0.00|0.00",
+			expectedErr: errInvalidAmount,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			balances, err := ParseAccountBalances(tc.balance)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expected, balances)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+func (sdk mSDK) BillManagerOptIn(ctx context.Context, oReq BillManagerOptInReq) (BillManagerOptInResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpBillManagerOptIn)
+	defer cancel()
+
+	if err := oReq.Validate(); err != nil {
+		return BillManagerOptInResp{}, err
+	}
+
+	var or BillManagerOptInResp
+	if err := sdk.sendBillManagerRequest(ctx, OpBillManagerOptIn, oReq, &or, &or.BillManagerResp); err != nil {
+		return BillManagerOptInResp{}, err
+	}
+
+	return or, nil
+}
+
+func (sdk mSDK) BillManagerUpdateOptIn(ctx context.Context, uReq BillManagerUpdateOptInReq) (BillManagerUpdateOptInResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpBillManagerUpdateOptIn)
+	defer cancel()
+
+	if err := uReq.Validate(); err != nil {
+		return BillManagerUpdateOptInResp{}, err
+	}
+
+	var ur BillManagerUpdateOptInResp
+	if err := sdk.sendBillManagerRequest(ctx, OpBillManagerUpdateOptIn, uReq, &ur, &ur.BillManagerResp); err != nil {
+		return BillManagerUpdateOptInResp{}, err
+	}
+
+	return ur, nil
+}
+
+func (sdk mSDK) BillManagerSingleInvoice(ctx context.Context, iReq BillManagerSingleInvoiceReq) (BillManagerSingleInvoiceResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpBillManagerSingleInvoice)
+	defer cancel()
+
+	if err := iReq.Validate(); err != nil {
+		return BillManagerSingleInvoiceResp{}, err
+	}
+
+	var ir BillManagerSingleInvoiceResp
+	if err := sdk.sendBillManagerRequest(ctx, OpBillManagerSingleInvoice, iReq, &ir, &ir.BillManagerResp); err != nil {
+		return BillManagerSingleInvoiceResp{}, err
+	}
+
+	return ir, nil
+}
+
+func (sdk mSDK) BillManagerBulkInvoice(ctx context.Context, bReq BillManagerBulkInvoiceReq) (BillManagerBulkInvoiceResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpBillManagerBulkInvoice)
+	defer cancel()
+
+	if err := bReq.Validate(); err != nil {
+		return BillManagerBulkInvoiceResp{}, err
+	}
+
+	// Daraja expects the invoices as a JSON array.
+	var br BillManagerBulkInvoiceResp
+	if err := sdk.sendBillManagerRequest(ctx, OpBillManagerBulkInvoice, bReq.Invoices, &br, &br.BillManagerResp); err != nil {
+		return BillManagerBulkInvoiceResp{}, err
+	}
+
+	return br, nil
+}
+
+func (sdk mSDK) BillManagerCancelInvoice(ctx context.Context, cReq BillManagerCancelInvoiceReq) (BillManagerCancelInvoiceResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpBillManagerCancelInvoice)
+	defer cancel()
+
+	if err := cReq.Validate(); err != nil {
+		return BillManagerCancelInvoiceResp{}, err
+	}
+
+	// Daraja expects a JSON array with one object per invoice.
+	type invoice struct {
+		ExternalReference string `json:"externalReference"`
+	}
+	invoices := make([]invoice, len(cReq.ExternalReferences))
+	for i, reference := range cReq.ExternalReferences {
+		invoices[i] = invoice{ExternalReference: reference}
+	}
+
+	var cr BillManagerCancelInvoiceResp
+	if err := sdk.sendBillManagerRequest(ctx, OpBillManagerCancelInvoice, invoices, &cr, &cr.BillManagerResp); err != nil {
+		return BillManagerCancelInvoiceResp{}, err
+	}
+
+	return cr, nil
+}
+
+func (sdk mSDK) BillManagerReconcile(ctx context.Context, rReq BillManagerReconcileReq) (BillManagerReconcileResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpBillManagerReconcile)
+	defer cancel()
+
+	if err := rReq.Validate(); err != nil {
+		return BillManagerReconcileResp{}, err
+	}
+
+	var rr BillManagerReconcileResp
+	if err := sdk.sendBillManagerRequest(ctx, OpBillManagerReconcile, rReq, &rr, &rr.BillManagerResp); err != nil {
+		return BillManagerReconcileResp{}, err
+	}
+
+	return rr, nil
+}
+
+// sendBillManagerRequest posts payload to the endpoint of a Bill Manager
+// operation, decodes the response into resp and checks the response code
+// of common, which must be the BillManagerResp embedded in resp.
+func (sdk mSDK) sendBillManagerRequest(ctx context.Context, op Operation, payload, resp interface{}, common *BillManagerResp) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := sdk.url(op)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	body, err := sdk.sendRequest(op, req)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, resp); err != nil {
+		return err
+	}
+
+	return sdk.checkResponseCode(op, common.ResponseCode, common.ResponseMessage, body)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var (
+	billManagerAccepted = BillManagerResp{ResponseMessage: "Success", ResponseCode: acceptedBillManagerResponseCode}
+	billManagerFailed   = BillManagerResp{ResponseMessage: "Rejected", ResponseCode: "500"}
+)
+
+// BillManagerPayment is the notification of an invoice payment that Bill
+// Manager posts to the callback URL of the opt-in. Acknowledge it with
+// BillManagerReconcile so that the customer receives an e-receipt.
+type BillManagerPayment struct {
+	TransactionID    string    // The M-PESA transaction ID of the payment.
+	PaidAmount       Amount    // The amount paid.
+	MSISDN           string    // The phone number that made the payment.
+	DateCreated      time.Time // The date of the payment.
+	AccountReference string    // The account number the payment was made to.
+	ShortCode        uint64    // The shortcode that received the payment.
+}
+
+type billManagerPaymentBody struct {
+	TransactionID    string      `json:"transactionId"`
+	PaidAmount       json.Number `json:"paidAmount"`
+	MSISDN           interface{} `json:"msisdn"`
+	DateCreated      string      `json:"dateCreated"`
+	AccountReference string      `json:"accountReference"`
+	ShortCode        json.Number `json:"shortCode"`
+}
+
+// ParseBillManagerPayment parses the body of a Bill Manager payment notification.
+func ParseBillManagerPayment(data []byte) (BillManagerPayment, error) {
+	var body billManagerPaymentBody
+	if err := decodeCallback(data, &body); err != nil {
+		return BillManagerPayment{}, err
+	}
+	if body.TransactionID == "" {
+		return BillManagerPayment{}, fmt.Errorf("%w: missing transactionId", errInvalidCallback)
+	}
+
+	payment := BillManagerPayment{
+		TransactionID:    body.TransactionID,
+		AccountReference: body.AccountReference,
+	}
+	if body.MSISDN != nil {
+		payment.MSISDN = fmt.Sprint(body.MSISDN)
+	}
+
+	var err error
+	if body.PaidAmount != "" {
+		if payment.PaidAmount, err = ParseAmount(body.PaidAmount.String()); err != nil {
+			return BillManagerPayment{}, fmt.Errorf("%w: paidAmount: %w", errInvalidCallback, err)
+		}
+	}
+	if body.ShortCode != "" {
+		if payment.ShortCode, err = strconv.ParseUint(body.ShortCode.String(), 10, 64); err != nil {
+			return BillManagerPayment{}, fmt.Errorf("%w: shortCode: %w", errInvalidCallback, err)
+		}
+	}
+	if body.DateCreated != "" {
+		if payment.DateCreated, err = time.ParseInLocation(billManagerPaymentDate, body.DateCreated, darajaLocation); err != nil {
+			return BillManagerPayment{}, fmt.Errorf("%w: dateCreated: %w", errInvalidCallback, err)
+		}
+	}
+
+	return payment, nil
+}
+
+// BillManagerPaymentFunc handles a Bill Manager payment notification. If it
+// returns an error the notification is not acknowledged.
+type BillManagerPaymentFunc func(ctx context.Context, payment BillManagerPayment) error
+
+type billManagerPaymentHandler struct {
+	fn BillManagerPaymentFunc
+}
+
+// NewBillManagerPaymentHandler returns an http.Handler that serves the
+// callback URL of a Bill Manager opt-in. It parses every payment
+// notification, passes it to fn and acknowledges it to Safaricom.
+func NewBillManagerPaymentHandler(fn BillManagerPaymentFunc) (http.Handler, error) {
+	if fn == nil {
+		return nil, errMissingCallbackHandler
+	}
+
+	return billManagerPaymentHandler{fn: fn}, nil
+}
+
+func (h billManagerPaymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	data, ok := readCallback(w, r)
+	if !ok {
+		return
+	}
+
+	payment, err := ParseBillManagerPayment(data)
+	if err != nil {
+		writeCallbackResp(w, http.StatusBadRequest, billManagerFailed)
+
+		return
+	}
+
+	if err := h.fn(r.Context(), payment); err != nil {
+		writeCallbackResp(w, http.StatusInternalServerError, billManagerFailed)
+
+		return
+	}
+
+	writeCallbackResp(w, http.StatusOK, billManagerAccepted)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBillManagerPaymentHandler(t *testing.T) {
+	testCases := []struct {
+		name            string
+		body            string
+		fnErr           error
+		expectedStatus  int
+		expectedPayment BillManagerPayment
+	}{
+		{
+			name:           "payment with string values",
+			body:           `{"transactionId":"RJB53MYR1N","paidAmount":"5000","msisdn":"254710119383","dateCreated":"2021-09-15","accountReference":"LGHJIO789","shortCode":"718003"}`,
+			expectedStatus: http.StatusOK,
+			expectedPayment: BillManagerPayment{
+				TransactionID:    "RJB53MYR1N",
+				PaidAmount:       500000,
+				MSISDN:           "254710119383",
+				DateCreated:      time.Date(2021, 9, 15, 0, 0, 0, 0, darajaLocation),
+				AccountReference: "LGHJIO789",
+				ShortCode:        718003,
+			},
+		},
+		{
+			name:           "payment with number values",
+			body:           `{"transactionId":"RJB53MYR1N","paidAmount":5000.5,"msisdn":254710119383,"accountReference":"LGHJIO789","shortCode":718003}`,
+			expectedStatus: http.StatusOK,
+			expectedPayment: BillManagerPayment{
+				TransactionID:    "RJB53MYR1N",
+				PaidAmount:       500050,
+				MSISDN:           "254710119383",
+				AccountReference: "LGHJIO789",
+				ShortCode:        718003,
+			},
+		},
+		{
+			name:           "missing transaction id",
+			body:           `{"paidAmount":"5000"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid date",
+			body:           `{"transactionId":"RJB53MYR1N","dateCreated":"15/09/2021"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "handler error",
+			body:           `{"transactionId":"RJB53MYR1N","paidAmount":"5000"}`,
+			fnErr:          errInvalidCallback,
+			expectedStatus: http.StatusInternalServerError,
+			expectedPayment: BillManagerPayment{
+				TransactionID: "RJB53MYR1N",
+				PaidAmount:    500000,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got BillManagerPayment
+			handler, err := NewBillManagerPaymentHandler(func(_ context.Context, payment BillManagerPayment) error {
+				got = payment
+
+				return tc.fnErr
+			})
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/billmanager", strings.NewReader(tc.body)))
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Equal(t, tc.expectedPayment, got)
+
+			var resp BillManagerResp
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, billManagerAccepted, resp)
+			} else {
+				assert.Equal(t, billManagerFailed, resp)
+			}
+		})
+	}
+
+	_, err := NewBillManagerPaymentHandler(nil)
+	assert.ErrorIs(t, err, errMissingCallbackHandler)
+}
@@ -0,0 +1,362 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testBillManagerOptInReq = BillManagerOptInReq{
+		ShortCode:       600984,
+		Email:           "billing@example.com",
+		OfficialContact: "254712345678",
+		SendReminders:   1,
+		CallbackURL:     "https://example.com/billmanager",
+	}
+
+	testBillManagerInvoiceReq = BillManagerSingleInvoiceReq{
+		ExternalReference: "INV-2024-03-001",
+		BilledFullName:    "John Doe",
+		BilledPhoneNumber: 254712345678,
+		BilledPeriod:      "March 2024",
+		InvoiceName:       "Rent",
+		DueDate:           "2024-03-10 00:00:00.00",
+		AccountReference:  "A1",
+		Amount:            15000,
+		InvoiceItems: []BillManagerInvoiceItem{
+			{ItemName: "Water", Amount: 500},
+		},
+	}
+
+	testBillManagerReconcileReq = BillManagerReconcileReq{
+		PaymentDate:       "2024-03-05",
+		PaidAmount:        15000,
+		AccountReference:  "A1",
+		TransactionID:     "SC54HJ8K9L",
+		PhoneNumber:       254712345678,
+		FullName:          "John Doe",
+		InvoiceName:       "Rent",
+		ExternalReference: "INV-2024-03-001",
+	}
+)
+
+func TestBillManager(t *testing.T) {
+	secondInvoice := testBillManagerInvoiceReq
+	secondInvoice.ExternalReference = "INV-2024-03-002"
+
+	testCases := []struct {
+		name         string
+		endpoint     string
+		call         func(sdk SDK) (interface{}, error)
+		response     string
+		expectedBody string
+		expectedResp interface{}
+	}{
+		{
+			name:     "opt in",
+			endpoint: billManagerOptInEndpoint,
+			call: func(sdk SDK) (interface{}, error) {
+				return sdk.BillManagerOptIn(context.Background(), testBillManagerOptInReq)
+			},
+			response:     `{"app_key":"AG_2376487236_126732989KJ","resmsg":"Success","rescode":"200"}`,
+			expectedBody: `{"shortcode":"600984","email":"billing@example.com","officialContact":"254712345678","sendReminders":"1","callbackurl":"https://example.com/billmanager"}`,
+			expectedResp: BillManagerOptInResp{
+				AppKey:          "AG_2376487236_126732989KJ",
+				BillManagerResp: BillManagerResp{ResponseMessage: "Success", ResponseCode: "200"},
+			},
+		},
+		{
+			name:     "update opt in",
+			endpoint: billManagerUpdateOptInEndpoint,
+			call: func(sdk SDK) (interface{}, error) {
+				return sdk.BillManagerUpdateOptIn(context.Background(), BillManagerUpdateOptInReq(testBillManagerOptInReq))
+			},
+			response:     `{"resmsg":"Optin details updated successfully","rescode":"200"}`,
+			expectedBody: `{"shortcode":"600984","email":"billing@example.com","officialContact":"254712345678","sendReminders":"1","callbackurl":"https://example.com/billmanager"}`,
+			expectedResp: BillManagerUpdateOptInResp{
+				BillManagerResp: BillManagerResp{ResponseMessage: "Optin details updated successfully", ResponseCode: "200"},
+			},
+		},
+		{
+			name:     "single invoice",
+			endpoint: billManagerSingleInvoiceEndpoint,
+			call: func(sdk SDK) (interface{}, error) {
+				return sdk.BillManagerSingleInvoice(context.Background(), testBillManagerInvoiceReq)
+			},
+			response:     `{"Status_Message":"Invoice sent successfully","resmsg":"Success","rescode":"200"}`,
+			expectedBody: `{"externalReference":"INV-2024-03-001","billedFullName":"John Doe","billedPhoneNumber":"254712345678","billedPeriod":"March 2024","invoiceName":"Rent","dueDate":"2024-03-10 00:00:00.00","accountReference":"A1","amount":"15000","invoiceItems":[{"itemName":"Water","amount":"500"}]}`,
+			expectedResp: BillManagerSingleInvoiceResp{
+				BillManagerResp: BillManagerResp{ResponseMessage: "Success", ResponseCode: "200", StatusMessage: "Invoice sent successfully"},
+			},
+		},
+		{
+			name:     "bulk invoice",
+			endpoint: billManagerBulkInvoiceEndpoint,
+			call: func(sdk SDK) (interface{}, error) {
+				return sdk.BillManagerBulkInvoice(context.Background(), BillManagerBulkInvoiceReq{
+					Invoices: []BillManagerSingleInvoiceReq{testBillManagerInvoiceReq, secondInvoice},
+				})
+			},
+			response: `{"Status_Message":"Invoice sent successfully","resmsg":"Success","rescode":"200"}`,
+			expectedBody: `[` +
+				`{"externalReference":"INV-2024-03-001","billedFullName":"John Doe","billedPhoneNumber":"254712345678","billedPeriod":"March 2024","invoiceName":"Rent","dueDate":"2024-03-10 00:00:00.00","accountReference":"A1","amount":"15000","invoiceItems":[{"itemName":"Water","amount":"500"}]},` +
+				`{"externalReference":"INV-2024-03-002","billedFullName":"John Doe","billedPhoneNumber":"254712345678","billedPeriod":"March 2024","invoiceName":"Rent","dueDate":"2024-03-10 00:00:00.00","accountReference":"A1","amount":"15000","invoiceItems":[{"itemName":"Water","amount":"500"}]}` +
+				`]`,
+			expectedResp: BillManagerBulkInvoiceResp{
+				BillManagerResp: BillManagerResp{ResponseMessage: "Success", ResponseCode: "200", StatusMessage: "Invoice sent successfully"},
+			},
+		},
+		{
+			name:     "cancel invoice",
+			endpoint: billManagerCancelInvoiceEndpoint,
+			call: func(sdk SDK) (interface{}, error) {
+				return sdk.BillManagerCancelInvoice(context.Background(), BillManagerCancelInvoiceReq{
+					ExternalReferences: []string{"INV-2024-03-001", "INV-2024-03-002"},
+				})
+			},
+			response:     `{"Status_Message":"2 Invoices cancelled.","resmsg":"Success","rescode":"200","errors":[]}`,
+			expectedBody: `[{"externalReference":"INV-2024-03-001"},{"externalReference":"INV-2024-03-002"}]`,
+			expectedResp: BillManagerCancelInvoiceResp{
+				BillManagerResp: BillManagerResp{ResponseMessage: "Success", ResponseCode: "200", StatusMessage: "2 Invoices cancelled."},
+			},
+		},
+		{
+			name:     "reconcile",
+			endpoint: billManagerReconcileEndpoint,
+			call: func(sdk SDK) (interface{}, error) {
+				return sdk.BillManagerReconcile(context.Background(), testBillManagerReconcileReq)
+			},
+			response:     `{"resmsg":"Success","rescode":"200"}`,
+			expectedBody: `{"paymentDate":"2024-03-05","paidAmount":"15000","accountReference":"A1","transactionId":"SC54HJ8K9L","phoneNumber":"254712345678","fullName":"John Doe","invoiceName":"Rent","externalReference":"INV-2024-03-001"}`,
+			expectedResp: BillManagerReconcileResp{
+				BillManagerResp: BillManagerResp{ResponseMessage: "Success", ResponseCode: "200"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/"+tc.endpoint, r.URL.Path)
+
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.JSONEq(t, tc.expectedBody, string(body))
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+			sdk.strict = true
+
+			resp, err := tc.call(sdk)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResp, resp)
+		})
+	}
+}
+
+func TestBillManagerErrors(t *testing.T) {
+	t.Run("daraja error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"resmsg":"Shortcode already opted in","rescode":"400"}`))
+		}))
+		defer server.Close()
+
+		sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+
+		_, err := sdk.BillManagerOptIn(context.Background(), testBillManagerOptInReq)
+		assert.ErrorIs(t, err, errFailedToSendReq)
+
+		var darajaErr *Error
+		require.ErrorAs(t, err, &darajaErr)
+		assert.Equal(t, "400", darajaErr.Code)
+		assert.Equal(t, "Shortcode already opted in", darajaErr.Message)
+	})
+
+	t.Run("rejected in strict mode", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"Status_Message":"Invoice already exists","resmsg":"Failed","rescode":"409"}`))
+		}))
+		defer server.Close()
+
+		sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+
+		resp, err := sdk.BillManagerSingleInvoice(context.Background(), testBillManagerInvoiceReq)
+		require.NoError(t, err)
+		assert.Equal(t, "409", resp.ResponseCode)
+
+		sdk.strict = true
+		_, err = sdk.BillManagerSingleInvoice(context.Background(), testBillManagerInvoiceReq)
+		assert.ErrorIs(t, err, ErrRejected)
+
+		var rejection *RejectionError
+		require.ErrorAs(t, err, &rejection)
+		assert.Equal(t, OpBillManagerSingleInvoice, rejection.Op)
+		assert.Equal(t, "409", rejection.ResponseCode)
+		assert.Equal(t, "Failed", rejection.ResponseDescription)
+	})
+}
+
+func TestBillManagerValidate(t *testing.T) {
+	tooManyInvoices := make([]BillManagerSingleInvoiceReq, maxBillManagerInvoices+1)
+	duplicateInvoices := []BillManagerSingleInvoiceReq{testBillManagerInvoiceReq, testBillManagerInvoiceReq}
+
+	testCases := []struct {
+		name        string
+		req         interface{ Validate() error }
+		expectedErr error
+	}{
+		{
+			name: "opt in",
+			req:  testBillManagerOptInReq,
+		},
+		{
+			name:        "opt in with invalid shortcode",
+			req:         with(testBillManagerOptInReq, func(r *BillManagerOptInReq) { r.ShortCode = 1 }),
+			expectedErr: errInvalidShortCode,
+		},
+		{
+			name:        "opt in with invalid email",
+			req:         with(testBillManagerOptInReq, func(r *BillManagerOptInReq) { r.Email = "billing" }),
+			expectedErr: errInvalidEmail,
+		},
+		{
+			name:        "opt in without official contact",
+			req:         with(testBillManagerOptInReq, func(r *BillManagerOptInReq) { r.OfficialContact = "" }),
+			expectedErr: errInvalidPhoneNumber,
+		},
+		{
+			name:        "opt in with invalid send reminders",
+			req:         with(testBillManagerOptInReq, func(r *BillManagerOptInReq) { r.SendReminders = 2 }),
+			expectedErr: errInvalidSendReminders,
+		},
+		{
+			name:        "update opt in with invalid callback url",
+			req:         with(BillManagerUpdateOptInReq(testBillManagerOptInReq), func(r *BillManagerUpdateOptInReq) { r.CallbackURL = invalidURL }),
+			expectedErr: errInvalidURL,
+		},
+		{
+			name: "invoice",
+			req:  testBillManagerInvoiceReq,
+		},
+		{
+			name:        "invoice without external reference",
+			req:         with(testBillManagerInvoiceReq, func(r *BillManagerSingleInvoiceReq) { r.ExternalReference = "" }),
+			expectedErr: errInvalidExternalReference,
+		},
+		{
+			name:        "invoice without billed name",
+			req:         with(testBillManagerInvoiceReq, func(r *BillManagerSingleInvoiceReq) { r.BilledFullName = "" }),
+			expectedErr: errInvalidInvoice,
+		},
+		{
+			name:        "invoice with invalid phone number",
+			req:         with(testBillManagerInvoiceReq, func(r *BillManagerSingleInvoiceReq) { r.BilledPhoneNumber = 712345678 }),
+			expectedErr: errInvalidPhoneNumber,
+		},
+		{
+			name:        "invoice with invalid due date",
+			req:         with(testBillManagerInvoiceReq, func(r *BillManagerSingleInvoiceReq) { r.DueDate = "10/03/2024" }),
+			expectedErr: errInvalidDate,
+		},
+		{
+			name:        "invoice without amount",
+			req:         with(testBillManagerInvoiceReq, func(r *BillManagerSingleInvoiceReq) { r.Amount = 0 }),
+			expectedErr: errInvalidAmount,
+		},
+		{
+			name: "invoice with invalid item",
+			req: with(testBillManagerInvoiceReq, func(r *BillManagerSingleInvoiceReq) {
+				r.InvoiceItems = []BillManagerInvoiceItem{{ItemName: "Water"}}
+			}),
+			expectedErr: errInvalidAmount,
+		},
+		{
+			name:        "bulk invoice without invoices",
+			req:         BillManagerBulkInvoiceReq{},
+			expectedErr: errInvalidInvoiceCount,
+		},
+		{
+			name:        "bulk invoice with too many invoices",
+			req:         BillManagerBulkInvoiceReq{Invoices: tooManyInvoices},
+			expectedErr: errInvalidInvoiceCount,
+		},
+		{
+			name:        "bulk invoice with duplicate invoices",
+			req:         BillManagerBulkInvoiceReq{Invoices: duplicateInvoices},
+			expectedErr: errInvalidExternalReference,
+		},
+		{
+			name:        "bulk invoice with invalid invoice",
+			req:         BillManagerBulkInvoiceReq{Invoices: []BillManagerSingleInvoiceReq{{}}},
+			expectedErr: errInvalidExternalReference,
+		},
+		{
+			name:        "cancel invoice without references",
+			req:         BillManagerCancelInvoiceReq{},
+			expectedErr: errInvalidInvoiceCount,
+		},
+		{
+			name:        "cancel invoice with empty reference",
+			req:         BillManagerCancelInvoiceReq{ExternalReferences: []string{""}},
+			expectedErr: errInvalidExternalReference,
+		},
+		{
+			name: "reconcile",
+			req:  testBillManagerReconcileReq,
+		},
+		{
+			name:        "reconcile with invalid payment date",
+			req:         with(testBillManagerReconcileReq, func(r *BillManagerReconcileReq) { r.PaymentDate = "05-03-2024" }),
+			expectedErr: errInvalidDate,
+		},
+		{
+			name:        "reconcile without transaction id",
+			req:         with(testBillManagerReconcileReq, func(r *BillManagerReconcileReq) { r.TransactionID = "" }),
+			expectedErr: errInvalidTransactionID,
+		},
+		{
+			name:        "reconcile without external reference",
+			req:         with(testBillManagerReconcileReq, func(r *BillManagerReconcileReq) { r.ExternalReference = "" }),
+			expectedErr: errInvalidExternalReference,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.req.Validate()
+			if tc.expectedErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+// with returns a copy of req changed by fn.
+func with[T any](req T, fn func(*T)) T {
+	fn(&req)
+
+	return req
+}
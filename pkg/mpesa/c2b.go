@@ -8,12 +8,15 @@ package mpesa
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 )
 
-func (sdk mSDK) C2BRegisterURL(c2bReq C2BRegisterURLReq) (C2BRegisterURLResp, error) {
+func (sdk mSDK) C2BRegisterURL(ctx context.Context, c2bReq C2BRegisterURLReq) (C2BRegisterURLResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpC2BRegisterURL)
+	defer cancel()
+
 	if err := c2bReq.Validate(); err != nil {
 		return C2BRegisterURLResp{}, err
 	}
@@ -23,14 +26,14 @@ func (sdk mSDK) C2BRegisterURL(c2bReq C2BRegisterURLReq) (C2BRegisterURLResp, er
 		return C2BRegisterURLResp{}, err
 	}
 
-	url := fmt.Sprintf("%s/%s", sdk.baseURL, c2bRegisterURLEndpoint)
+	url := sdk.c2bURL(OpC2BRegisterURL, c2bReq.ShortCode)
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
 	if err != nil {
 		return C2BRegisterURLResp{}, err
 	}
 
-	resp, err := sdk.sendRequest(req)
+	resp, err := sdk.sendRequest(OpC2BRegisterURL, req)
 	if err != nil {
 		return C2BRegisterURLResp{}, err
 	}
@@ -40,10 +43,17 @@ func (sdk mSDK) C2BRegisterURL(c2bReq C2BRegisterURLReq) (C2BRegisterURLResp, er
 		return C2BRegisterURLResp{}, err
 	}
 
+	if err := sdk.checkResponseCode(OpC2BRegisterURL, c2br.ResponseCode, c2br.ResponseDescription, resp); err != nil {
+		return C2BRegisterURLResp{}, err
+	}
+
 	return c2br, nil
 }
 
-func (sdk mSDK) C2BSimulate(c2bReq C2BSimulateReq) (C2BSimulateResp, error) {
+func (sdk mSDK) C2BSimulate(ctx context.Context, c2bReq C2BSimulateReq) (C2BSimulateResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpC2BSimulate)
+	defer cancel()
+
 	if err := c2bReq.Validate(); err != nil {
 		return C2BSimulateResp{}, err
 	}
@@ -53,14 +63,14 @@ func (sdk mSDK) C2BSimulate(c2bReq C2BSimulateReq) (C2BSimulateResp, error) {
 		return C2BSimulateResp{}, err
 	}
 
-	url := fmt.Sprintf("%s/%s", sdk.baseURL, c2bSimulateEndpoint)
+	url := sdk.c2bURL(OpC2BSimulate, c2bReq.ShortCode)
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
 	if err != nil {
 		return C2BSimulateResp{}, err
 	}
 
-	resp, err := sdk.sendRequest(req)
+	resp, err := sdk.sendRequest(OpC2BSimulate, req)
 	if err != nil {
 		return C2BSimulateResp{}, err
 	}
@@ -70,5 +80,9 @@ func (sdk mSDK) C2BSimulate(c2bReq C2BSimulateReq) (C2BSimulateResp, error) {
 		return C2BSimulateResp{}, err
 	}
 
+	if err := sdk.checkResponseCode(OpC2BSimulate, c2bsr.ResponseCode, c2bsr.ResponseDescription, resp); err != nil {
+		return C2BSimulateResp{}, err
+	}
+
 	return c2bsr, nil
 }
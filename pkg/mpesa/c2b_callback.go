@@ -0,0 +1,307 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// The response types of C2BRegisterURLReq. They decide what happens to a
+// payment when the validation URL does not answer in time.
+const (
+	C2BResponseCompleted = "Completed"
+	C2BResponseCancelled = "Cancelled"
+)
+
+// defaultC2BValidationTimeout leaves a margin within the time Safaricom waits
+// for the validation URL to answer.
+const defaultC2BValidationTimeout = 5 * time.Second
+
+// C2BResultCode is the answer to a C2B validation request. A validator
+// rejects a payment by returning one of the codes other than C2BAccepted,
+// which implement error, optionally wrapped.
+type C2BResultCode string
+
+// C2B validation result codes.
+const (
+	C2BAccepted             C2BResultCode = "0"        // The payment is accepted.
+	C2BInvalidMSISDN        C2BResultCode = "C2B00011" // The phone number is not allowed to pay.
+	C2BInvalidAccountNumber C2BResultCode = "C2B00012" // The account number (BillRefNumber) is unknown.
+	C2BInvalidAmount        C2BResultCode = "C2B00013" // The amount is not allowed.
+	C2BInvalidKYCDetails    C2BResultCode = "C2B00014" // The customer details do not match.
+	C2BInvalidShortcode     C2BResultCode = "C2B00015" // The shortcode is not expected.
+	C2BOtherError           C2BResultCode = "C2B00016" // The payment is rejected for another reason.
+)
+
+var c2bResultDescriptions = map[C2BResultCode]string{
+	C2BAccepted:             "Accepted",
+	C2BInvalidMSISDN:        "Invalid MSISDN",
+	C2BInvalidAccountNumber: "Invalid Account Number",
+	C2BInvalidAmount:        "Invalid Amount",
+	C2BInvalidKYCDetails:    "Invalid KYC Details",
+	C2BInvalidShortcode:     "Invalid Shortcode",
+	C2BOtherError:           "Other Error",
+}
+
+// Description returns the description of the code.
+func (c C2BResultCode) Description() string {
+	if desc, ok := c2bResultDescriptions[c]; ok {
+		return desc
+	}
+
+	return string(c)
+}
+
+func (c C2BResultCode) Error() string {
+	return fmt.Sprintf("c2b validation failed: %s: %s", string(c), c.Description())
+}
+
+// C2BCallback is a customer payment that Safaricom posts to the validation
+// and confirmation URLs registered with C2BRegisterURL.
+type C2BCallback struct {
+	TransactionType   string       // The type of the transaction, such as Pay Bill or Buy Goods.
+	TransID           string       // The unique M-PESA transaction ID.
+	TransTime         time.Time    // The time of the transaction.
+	TransAmount       Amount       // The amount paid.
+	BusinessShortCode string       // The shortcode that receives the payment.
+	BillRefNumber     string       // The account number entered by the customer for Pay Bill payments.
+	InvoiceNumber     string       // The invoice number, if any.
+	OrgAccountBalance Amount       // The balance of the shortcode after the payment. Only set on confirmation.
+	ThirdPartyTransID string       // The transaction ID returned by the validation URL, if any.
+	MSISDN            string       // The phone number that paid. It may be masked or hashed, see MatchMSISDN.
+	MSISDNFormat      MSISDNFormat // Whether the MSISDN is the full phone number, masked or hashed.
+	FirstName         string       // The first name of the customer.
+	MiddleName        string       // The middle name of the customer.
+	LastName          string       // The last name of the customer.
+}
+
+type c2bCallbackBody struct {
+	TransactionType   string `json:"TransactionType"`
+	TransType         string `json:"TransType"`
+	TransID           string `json:"TransID"`
+	TransTime         string `json:"TransTime"`
+	TransAmount       string `json:"TransAmount"`
+	BusinessShortCode string `json:"BusinessShortCode"`
+	BillRefNumber     string `json:"BillRefNumber"`
+	InvoiceNumber     string `json:"InvoiceNumber"`
+	OrgAccountBalance string `json:"OrgAccountBalance"`
+	ThirdPartyTransID string `json:"ThirdPartyTransID"`
+	MSISDN            string `json:"MSISDN"`
+	FirstName         string `json:"FirstName"`
+	MiddleName        string `json:"MiddleName"`
+	LastName          string `json:"LastName"`
+}
+
+// ParseC2BCallback parses the body of a C2B validation or confirmation request.
+func ParseC2BCallback(data []byte) (C2BCallback, error) {
+	var body c2bCallbackBody
+	if err := decodeCallback(data, &body); err != nil {
+		return C2BCallback{}, err
+	}
+	if body.TransID == "" {
+		return C2BCallback{}, fmt.Errorf("%w: missing TransID", errInvalidCallback)
+	}
+
+	cb := C2BCallback{
+		TransactionType:   body.TransactionType,
+		TransID:           body.TransID,
+		BusinessShortCode: body.BusinessShortCode,
+		BillRefNumber:     body.BillRefNumber,
+		InvoiceNumber:     body.InvoiceNumber,
+		ThirdPartyTransID: body.ThirdPartyTransID,
+		MSISDN:            body.MSISDN,
+		MSISDNFormat:      ParseMSISDNFormat(body.MSISDN),
+		FirstName:         body.FirstName,
+		MiddleName:        body.MiddleName,
+		LastName:          body.LastName,
+	}
+	if cb.TransactionType == "" {
+		cb.TransactionType = body.TransType
+	}
+
+	var err error
+	if body.TransTime != "" {
+		if cb.TransTime, err = parseDarajaTime(body.TransTime); err != nil {
+			return C2BCallback{}, fmt.Errorf("%w: TransTime: %w", errInvalidCallback, err)
+		}
+	}
+	if body.TransAmount != "" {
+		if cb.TransAmount, err = ParseAmount(body.TransAmount); err != nil {
+			return C2BCallback{}, fmt.Errorf("%w: TransAmount: %w", errInvalidCallback, err)
+		}
+	}
+	if body.OrgAccountBalance != "" {
+		if cb.OrgAccountBalance, err = ParseAmount(body.OrgAccountBalance); err != nil {
+			return C2BCallback{}, fmt.Errorf("%w: OrgAccountBalance: %w", errInvalidCallback, err)
+		}
+	}
+
+	return cb, nil
+}
+
+// C2BValidatorFunc decides whether a C2B payment is accepted. It accepts the
+// payment by returning nil and rejects it by returning a C2BResultCode. Any
+// other error rejects the payment with C2BOtherError.
+type C2BValidatorFunc func(ctx context.Context, cb C2BCallback) error
+
+// C2BCallbackFunc handles a confirmed C2B payment. If it returns an error
+// the confirmation is not acknowledged.
+type C2BCallbackFunc func(ctx context.Context, cb C2BCallback) error
+
+// C2BValidationConfig configures the handler of the C2B validation URL.
+type C2BValidationConfig struct {
+	// Validate decides whether a payment is accepted.
+	Validate C2BValidatorFunc
+
+	// ResponseType is the response type registered with C2BRegisterURL. The
+	// payment is accepted if it is Completed, or rejected if it is Cancelled,
+	// when Validate does not return within Timeout.
+	ResponseType string
+
+	// Timeout is the time Validate has to decide. It defaults to 5 seconds.
+	Timeout time.Duration
+}
+
+type c2bValidationHandler struct {
+	validate C2BValidatorFunc
+	fallback C2BResultCode
+	timeout  time.Duration
+}
+
+// NewC2BValidationHandler returns an http.Handler that serves the C2B
+// validation URL. It parses every validation request, asks the validator
+// whether the payment is accepted and answers Safaricom with the result.
+func NewC2BValidationHandler(conf C2BValidationConfig) (http.Handler, error) {
+	if conf.Validate == nil {
+		return nil, errMissingCallbackHandler
+	}
+
+	h := c2bValidationHandler{
+		validate: conf.Validate,
+		timeout:  conf.Timeout,
+	}
+
+	switch {
+	case strings.EqualFold(conf.ResponseType, C2BResponseCompleted):
+		h.fallback = C2BAccepted
+	case strings.EqualFold(conf.ResponseType, C2BResponseCancelled):
+		h.fallback = C2BOtherError
+	default:
+		return nil, errInvalidResponseType
+	}
+
+	switch {
+	case h.timeout < 0:
+		return nil, errInvalidTimeout
+	case h.timeout == 0:
+		h.timeout = defaultC2BValidationTimeout
+	}
+
+	return h, nil
+}
+
+func (h c2bValidationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	data, ok := readCallback(w, r)
+	if !ok {
+		return
+	}
+
+	cb, err := ParseC2BCallback(data)
+	if err != nil {
+		writeCallbackResp(w, http.StatusBadRequest, c2bValidationResp(C2BOtherError))
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	result := make(chan C2BResultCode, 1)
+	go func() {
+		// net/http only recovers panics on the handler goroutine, so a
+		// panicking validator rejects the payment instead of crashing.
+		defer func() {
+			if r := recover(); r != nil {
+				result <- C2BOtherError
+			}
+		}()
+
+		result <- c2bResultCode(h.validate(ctx, cb))
+	}()
+
+	code := h.fallback
+	select {
+	case code = <-result:
+	case <-ctx.Done():
+	}
+
+	writeCallbackResp(w, http.StatusOK, c2bValidationResp(code))
+}
+
+// c2bResultCode returns the result code for the outcome of a validator.
+func c2bResultCode(err error) C2BResultCode {
+	if err == nil {
+		return C2BAccepted
+	}
+
+	var code C2BResultCode
+	if errors.As(err, &code) && code != C2BAccepted {
+		return code
+	}
+
+	return C2BOtherError
+}
+
+func c2bValidationResp(code C2BResultCode) CallbackResp {
+	if code == C2BAccepted {
+		return callbackAccepted
+	}
+
+	return CallbackResp{ResultCode: string(code), ResultDesc: callbackFailed.ResultDesc}
+}
+
+type c2bConfirmationHandler struct {
+	fn C2BCallbackFunc
+}
+
+// NewC2BConfirmationHandler returns an http.Handler that serves the C2B
+// confirmation URL. It parses every completed payment, passes it to fn and
+// acknowledges it to Safaricom.
+func NewC2BConfirmationHandler(fn C2BCallbackFunc) (http.Handler, error) {
+	if fn == nil {
+		return nil, errMissingCallbackHandler
+	}
+
+	return c2bConfirmationHandler{fn: fn}, nil
+}
+
+func (h c2bConfirmationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	data, ok := readCallback(w, r)
+	if !ok {
+		return
+	}
+
+	cb, err := ParseC2BCallback(data)
+	if err != nil {
+		writeCallbackResp(w, http.StatusBadRequest, callbackFailed)
+
+		return
+	}
+
+	if err := h.fn(r.Context(), cb); err != nil {
+		writeCallbackResp(w, http.StatusInternalServerError, callbackFailed)
+
+		return
+	}
+
+	writeCallbackResp(w, http.StatusOK, callbackAccepted)
+}
@@ -0,0 +1,317 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const c2bConfirmation = `{
+	"TransactionType": "Pay Bill",
+	"TransID": "RKTQDM7W6S",
+	"TransTime": "20191122063845",
+	"TransAmount": "10.00",
+	"BusinessShortCode": "600638",
+	"BillRefNumber": "invoice008",
+	"InvoiceNumber": "",
+	"OrgAccountBalance": "49197.00",
+	"ThirdPartyTransID": "",
+	"MSISDN": "2547*****149",
+	"FirstName": "John",
+	"MiddleName": "",
+	"LastName": "Doe"
+}`
+
+func TestParseC2BCallback(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        string
+		expected    C2BCallback
+		expectedErr error
+	}{
+		{
+			name: "confirmation",
+			data: c2bConfirmation,
+			expected: C2BCallback{
+				TransactionType:   "Pay Bill",
+				TransID:           "RKTQDM7W6S",
+				TransTime:         time.Date(2019, time.November, 22, 6, 38, 45, 0, darajaLocation),
+				TransAmount:       1000,
+				BusinessShortCode: "600638",
+				BillRefNumber:     "invoice008",
+				OrgAccountBalance: 4919700,
+				MSISDN:            "2547*****149",
+				MSISDNFormat:      MSISDNMasked,
+				FirstName:         "John",
+				LastName:          "Doe",
+			},
+		},
+		{
+			name: "validation with trans type",
+			data: `{"TransType":"Buy Goods","TransID":"RKTQDM7W6S","TransAmount":"5","BusinessShortCode":"600638","MSISDN":"254708374149"}`,
+			expected: C2BCallback{
+				TransactionType:   "Buy Goods",
+				TransID:           "RKTQDM7W6S",
+				TransAmount:       500,
+				BusinessShortCode: "600638",
+				MSISDN:            "254708374149",
+				MSISDNFormat:      MSISDNPlain,
+			},
+		},
+		{
+			name: "confirmation with hashed msisdn",
+			data: `{"TransactionType":"Pay Bill","TransID":"RKTQDM7W6S","TransAmount":"10","BusinessShortCode":"600638","MSISDN":"` + HashMSISDN(254708374149) + `"}`,
+			expected: C2BCallback{
+				TransactionType:   "Pay Bill",
+				TransID:           "RKTQDM7W6S",
+				TransAmount:       1000,
+				BusinessShortCode: "600638",
+				MSISDN:            HashMSISDN(254708374149),
+				MSISDNFormat:      MSISDNHashed,
+			},
+		},
+		{
+			name:        "missing transaction id",
+			data:        `{"TransAmount":"10"}`,
+			expectedErr: errInvalidCallback,
+		},
+		{
+			name:        "invalid amount",
+			data:        `{"TransID":"RKTQDM7W6S","TransAmount":"ten"}`,
+			expectedErr: errInvalidCallback,
+		},
+		{
+			name:        "invalid json",
+			data:        `[`,
+			expectedErr: errInvalidCallback,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cb, err := ParseC2BCallback([]byte(tc.data))
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr == nil {
+				assert.Equal(t, tc.expected, cb)
+			}
+		})
+	}
+}
+
+func TestNewC2BValidationHandler(t *testing.T) {
+	validate := func(context.Context, C2BCallback) error { return nil }
+
+	testCases := []struct {
+		name        string
+		conf        C2BValidationConfig
+		expectedErr error
+	}{
+		{
+			name: "completed",
+			conf: C2BValidationConfig{Validate: validate, ResponseType: C2BResponseCompleted},
+		},
+		{
+			name: "lower case cancelled",
+			conf: C2BValidationConfig{Validate: validate, ResponseType: "cancelled"},
+		},
+		{
+			name:        "missing validator",
+			conf:        C2BValidationConfig{ResponseType: C2BResponseCompleted},
+			expectedErr: errMissingCallbackHandler,
+		},
+		{
+			name:        "invalid response type",
+			conf:        C2BValidationConfig{Validate: validate, ResponseType: "Pending"},
+			expectedErr: errInvalidResponseType,
+		},
+		{
+			name:        "negative timeout",
+			conf:        C2BValidationConfig{Validate: validate, ResponseType: C2BResponseCompleted, Timeout: -time.Second},
+			expectedErr: errInvalidTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewC2BValidationHandler(tc.conf)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestC2BValidationHandler(t *testing.T) {
+	testCases := []struct {
+		name           string
+		responseType   string
+		body           string
+		validate       C2BValidatorFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:           "accepted",
+			responseType:   C2BResponseCancelled,
+			body:           c2bConfirmation,
+			validate:       func(context.Context, C2BCallback) error { return nil },
+			expectedStatus: http.StatusOK,
+			expectedCode:   "0",
+		},
+		{
+			name:         "invalid account number",
+			responseType: C2BResponseCompleted,
+			body:         c2bConfirmation,
+			validate: func(_ context.Context, cb C2BCallback) error {
+				return fmt.Errorf("unknown account %s: %w", cb.BillRefNumber, C2BInvalidAccountNumber)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCode:   "C2B00012",
+		},
+		{
+			name:           "invalid msisdn",
+			responseType:   C2BResponseCompleted,
+			body:           c2bConfirmation,
+			validate:       func(context.Context, C2BCallback) error { return C2BInvalidMSISDN },
+			expectedStatus: http.StatusOK,
+			expectedCode:   "C2B00011",
+		},
+		{
+			name:           "other error",
+			responseType:   C2BResponseCompleted,
+			body:           c2bConfirmation,
+			validate:       func(context.Context, C2BCallback) error { return errors.New("database unavailable") },
+			expectedStatus: http.StatusOK,
+			expectedCode:   "C2B00016",
+		},
+		{
+			name:           "panicking validator",
+			responseType:   C2BResponseCompleted,
+			body:           c2bConfirmation,
+			validate:       func(context.Context, C2BCallback) error { panic("nil map") },
+			expectedStatus: http.StatusOK,
+			expectedCode:   "C2B00016",
+		},
+		{
+			name:         "slow validator with completed response type",
+			responseType: C2BResponseCompleted,
+			body:         c2bConfirmation,
+			validate: func(ctx context.Context, _ C2BCallback) error {
+				<-ctx.Done()
+
+				return C2BInvalidAmount
+			},
+			expectedStatus: http.StatusOK,
+			expectedCode:   "0",
+		},
+		{
+			name:         "slow validator with cancelled response type",
+			responseType: C2BResponseCancelled,
+			body:         c2bConfirmation,
+			validate: func(ctx context.Context, _ C2BCallback) error {
+				<-ctx.Done()
+
+				return nil
+			},
+			expectedStatus: http.StatusOK,
+			expectedCode:   "C2B00016",
+		},
+		{
+			name:           "invalid payload",
+			responseType:   C2BResponseCompleted,
+			body:           `{}`,
+			validate:       func(context.Context, C2BCallback) error { return nil },
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "C2B00016",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, err := NewC2BValidationHandler(C2BValidationConfig{
+				Validate:     tc.validate,
+				ResponseType: tc.responseType,
+				Timeout:      50 * time.Millisecond,
+			})
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/validation", strings.NewReader(tc.body)))
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var resp CallbackResp
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, tc.expectedCode, resp.ResultCode)
+		})
+	}
+}
+
+func TestC2BConfirmationHandler(t *testing.T) {
+	_, err := NewC2BConfirmationHandler(nil)
+	assert.ErrorIs(t, err, errMissingCallbackHandler)
+
+	testCases := []struct {
+		name           string
+		method         string
+		body           string
+		handlerErr     error
+		expectedStatus int
+		expectedResp   CallbackResp
+	}{
+		{
+			name:           "success",
+			method:         http.MethodPost,
+			body:           c2bConfirmation,
+			expectedStatus: http.StatusOK,
+			expectedResp:   callbackAccepted,
+		},
+		{
+			name:           "handler error",
+			method:         http.MethodPost,
+			body:           c2bConfirmation,
+			handlerErr:     errors.New("handler error"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedResp:   callbackFailed,
+		},
+		{
+			name:           "invalid method",
+			method:         http.MethodPut,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedResp:   callbackFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, err := NewC2BConfirmationHandler(func(_ context.Context, cb C2BCallback) error {
+				assert.Equal(t, "RKTQDM7W6S", cb.TransID)
+
+				return tc.handlerErr
+			})
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tc.method, "/confirmation", strings.NewReader(tc.body)))
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var resp CallbackResp
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, tc.expectedResp, resp)
+		})
+	}
+}
@@ -7,6 +7,7 @@
 package mpesa
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -128,11 +129,10 @@ func TestC2BRegisterURL(t *testing.T) {
 				baseURL:   server.URL,
 				appKey:    appKey,
 				appSecret: appSecret,
-				certFile:  sandboxCertificate,
 				client:    server.Client(),
 			}
 
-			response, err := sdk.C2BRegisterURL(tc.request)
+			response, err := sdk.C2BRegisterURL(context.Background(), tc.request)
 			assert.ErrorIs(t, err, tc.expectedErr, "%s: Expected error '%v', got '%v'", tc.name, tc.expectedErr, err)
 
 			if !reflect.DeepEqual(response, tc.expectedResponse) {
@@ -232,11 +232,10 @@ func TestC2BSimulate(t *testing.T) {
 				baseURL:   server.URL,
 				appKey:    appKey,
 				appSecret: appSecret,
-				certFile:  sandboxCertificate,
 				client:    server.Client(),
 			}
 
-			response, err := sdk.C2BSimulate(tc.request)
+			response, err := sdk.C2BSimulate(context.Background(), tc.request)
 			assert.ErrorIs(t, err, tc.expectedErr, "%s: Expected error '%v', got '%v'", tc.name, tc.expectedErr, err)
 
 			if !reflect.DeepEqual(response, tc.expectedResponse) {
@@ -0,0 +1,212 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// maxCallbackSize is the largest callback body that is read.
+	maxCallbackSize = 1 << 20
+
+	// darajaTimeLayout is the layout of the timestamps sent by Daraja.
+	darajaTimeLayout = "20060102150405"
+)
+
+var (
+	// errInvalidCallback indicates that a callback payload could not be parsed.
+	errInvalidCallback = errors.New("invalid callback payload")
+
+	// errMissingCallbackHandler indicates that no function was given to handle callbacks.
+	errMissingCallbackHandler = errors.New("missing callback handler function")
+)
+
+// darajaLocation is the time zone of the timestamps sent by Daraja.
+var darajaLocation = time.FixedZone("EAT", 3*60*60)
+
+// CallbackResp is the acknowledgement sent back to Safaricom for a callback.
+type CallbackResp struct {
+	ResultCode string `json:"ResultCode"`
+	ResultDesc string `json:"ResultDesc"`
+}
+
+var (
+	callbackAccepted = CallbackResp{ResultCode: "0", ResultDesc: "Accepted"}
+	callbackFailed   = CallbackResp{ResultCode: "1", ResultDesc: "Rejected"}
+)
+
+// CallbackItem is a name and value pair of the metadata sent with a callback.
+// Numbers are decoded as json.Number so that no precision is lost.
+type CallbackItem struct {
+	Name  string      `json:"Name"`
+	Value interface{} `json:"Value,omitempty"`
+}
+
+// ExpressCallback is the result of an STK push that Safaricom posts to the
+// CallBackURL of the ExpressSimulate request.
+type ExpressCallback struct {
+	MerchantRequestID  string         // The MerchantRequestID returned by ExpressSimulate.
+	CheckoutRequestID  string         // The CheckoutRequestID returned by ExpressSimulate.
+	ResultCode         ResultCode     // The outcome of the transaction.
+	ResultDesc         string         // The description of the outcome.
+	Amount             Amount         // The amount paid. Only set on success.
+	MpesaReceiptNumber string         // The M-PESA receipt number. Only set on success.
+	TransactionDate    time.Time      // The time of the transaction. Only set on success.
+	PhoneNumber        uint64         // The phone number that paid. Only set on success.
+	Items              []CallbackItem // The callback metadata as sent by Safaricom.
+}
+
+type expressCallbackBody struct {
+	Body struct {
+		STKCallback *struct {
+			MerchantRequestID string     `json:"MerchantRequestID"`
+			CheckoutRequestID string     `json:"CheckoutRequestID"`
+			ResultCode        ResultCode `json:"ResultCode"`
+			ResultDesc        string     `json:"ResultDesc"`
+			CallbackMetadata  struct {
+				Item []CallbackItem `json:"Item"`
+			} `json:"CallbackMetadata"`
+		} `json:"stkCallback"`
+	} `json:"Body"`
+}
+
+// ParseExpressCallback parses the body of an STK push callback.
+func ParseExpressCallback(data []byte) (ExpressCallback, error) {
+	var body expressCallbackBody
+	if err := decodeCallback(data, &body); err != nil {
+		return ExpressCallback{}, err
+	}
+	stk := body.Body.STKCallback
+	if stk == nil || stk.CheckoutRequestID == "" {
+		return ExpressCallback{}, fmt.Errorf("%w: missing stkCallback", errInvalidCallback)
+	}
+
+	cb := ExpressCallback{
+		MerchantRequestID: stk.MerchantRequestID,
+		CheckoutRequestID: stk.CheckoutRequestID,
+		ResultCode:        stk.ResultCode,
+		ResultDesc:        stk.ResultDesc,
+		Items:             stk.CallbackMetadata.Item,
+	}
+
+	var err error
+	for _, item := range cb.Items {
+		if item.Value == nil {
+			continue
+		}
+		value := fmt.Sprint(item.Value)
+
+		switch item.Name {
+		case "Amount":
+			cb.Amount, err = ParseAmount(value)
+		case "MpesaReceiptNumber":
+			cb.MpesaReceiptNumber = value
+		case "TransactionDate":
+			cb.TransactionDate, err = parseDarajaTime(value)
+		case "PhoneNumber":
+			cb.PhoneNumber, err = strconv.ParseUint(value, 10, 64)
+		}
+		if err != nil {
+			return ExpressCallback{}, fmt.Errorf("%w: %s: %w", errInvalidCallback, item.Name, err)
+		}
+	}
+
+	return cb, nil
+}
+
+// ExpressCallbackFunc handles the result of an STK push. If it returns an
+// error the callback is not acknowledged.
+type ExpressCallbackFunc func(ctx context.Context, cb ExpressCallback) error
+
+type expressCallbackHandler struct {
+	fn ExpressCallbackFunc
+}
+
+// NewExpressCallbackHandler returns an http.Handler that serves the
+// CallBackURL of ExpressSimulate requests. It parses every STK push callback,
+// passes it to fn and acknowledges it to Safaricom.
+func NewExpressCallbackHandler(fn ExpressCallbackFunc) (http.Handler, error) {
+	if fn == nil {
+		return nil, errMissingCallbackHandler
+	}
+
+	return expressCallbackHandler{fn: fn}, nil
+}
+
+func (h expressCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	data, ok := readCallback(w, r)
+	if !ok {
+		return
+	}
+
+	cb, err := ParseExpressCallback(data)
+	if err != nil {
+		writeCallbackResp(w, http.StatusBadRequest, callbackFailed)
+
+		return
+	}
+
+	if err := h.fn(r.Context(), cb); err != nil {
+		writeCallbackResp(w, http.StatusInternalServerError, callbackFailed)
+
+		return
+	}
+
+	writeCallbackResp(w, http.StatusOK, callbackAccepted)
+}
+
+// readCallback reads the body of a callback request. It answers the request
+// and returns false if the body cannot be read.
+func readCallback(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeCallbackResp(w, http.StatusMethodNotAllowed, callbackFailed)
+
+		return nil, false
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxCallbackSize))
+	if err != nil {
+		writeCallbackResp(w, http.StatusBadRequest, callbackFailed)
+
+		return nil, false
+	}
+
+	return data, true
+}
+
+// decodeCallback decodes a callback body keeping numbers as json.Number.
+func decodeCallback(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("%w: %w", errInvalidCallback, err)
+	}
+
+	return nil
+}
+
+// writeCallbackResp acknowledges a callback.
+func writeCallbackResp(w http.ResponseWriter, statusCode int, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// parseDarajaTime parses a timestamp such as 20191219102115 sent by Daraja.
+func parseDarajaTime(value string) (time.Time, error) {
+	return time.ParseInLocation(darajaTimeLayout, value, darajaLocation)
+}
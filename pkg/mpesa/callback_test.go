@@ -0,0 +1,209 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	successfulExpressCallback = `{
+		"Body": {
+			"stkCallback": {
+				"MerchantRequestID": "29115-34620561-1",
+				"CheckoutRequestID": "ws_CO_191220191020363925",
+				"ResultCode": 0,
+				"ResultDesc": "The service request is processed successfully.",
+				"CallbackMetadata": {
+					"Item": [
+						{"Name": "Amount", "Value": 1.00},
+						{"Name": "MpesaReceiptNumber", "Value": "NLJ7RT61SV"},
+						{"Name": "TransactionDate", "Value": 20191219102115},
+						{"Name": "PhoneNumber", "Value": 254708374149}
+					]
+				}
+			}
+		}
+	}`
+	cancelledExpressCallback = `{
+		"Body": {
+			"stkCallback": {
+				"MerchantRequestID": "29115-34620561-1",
+				"CheckoutRequestID": "ws_CO_191220191020363925",
+				"ResultCode": 1032,
+				"ResultDesc": "Request cancelled by user."
+			}
+		}
+	}`
+)
+
+func TestParseExpressCallback(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        string
+		expected    ExpressCallback
+		expectedErr error
+	}{
+		{
+			name: "success",
+			data: successfulExpressCallback,
+			expected: ExpressCallback{
+				MerchantRequestID:  "29115-34620561-1",
+				CheckoutRequestID:  "ws_CO_191220191020363925",
+				ResultCode:         ResultCodeSuccess,
+				ResultDesc:         "The service request is processed successfully.",
+				Amount:             100,
+				MpesaReceiptNumber: "NLJ7RT61SV",
+				TransactionDate:    time.Date(2019, time.December, 19, 7, 21, 15, 0, time.UTC),
+				PhoneNumber:        254708374149,
+			},
+		},
+		{
+			name: "cancelled",
+			data: cancelledExpressCallback,
+			expected: ExpressCallback{
+				MerchantRequestID: "29115-34620561-1",
+				CheckoutRequestID: "ws_CO_191220191020363925",
+				ResultCode:        ResultCodeCancelledByUser,
+				ResultDesc:        "Request cancelled by user.",
+			},
+		},
+		{
+			name: "item without value",
+			data: `{"Body":{"stkCallback":{"CheckoutRequestID":"ws_CO_191220191020363925","ResultCode":0,"CallbackMetadata":{"Item":[{"Name":"Balance"},{"Name":"Amount","Value":10}]}}}}`,
+			expected: ExpressCallback{
+				CheckoutRequestID: "ws_CO_191220191020363925",
+				ResultCode:        ResultCodeSuccess,
+				Amount:            1000,
+			},
+		},
+		{
+			name:        "invalid json",
+			data:        `{"Body":`,
+			expectedErr: errInvalidCallback,
+		},
+		{
+			name:        "missing stk callback",
+			data:        `{"Body":{}}`,
+			expectedErr: errInvalidCallback,
+		},
+		{
+			name:        "invalid amount",
+			data:        `{"Body":{"stkCallback":{"CheckoutRequestID":"ws_CO_191220191020363925","ResultCode":0,"CallbackMetadata":{"Item":[{"Name":"Amount","Value":1.005}]}}}}`,
+			expectedErr: errInvalidCallback,
+		},
+		{
+			name:        "invalid transaction date",
+			data:        `{"Body":{"stkCallback":{"CheckoutRequestID":"ws_CO_191220191020363925","ResultCode":0,"CallbackMetadata":{"Item":[{"Name":"TransactionDate","Value":"yesterday"}]}}}}`,
+			expectedErr: errInvalidCallback,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cb, err := ParseExpressCallback([]byte(tc.data))
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			assert.True(t, tc.expected.TransactionDate.Equal(cb.TransactionDate), "expected %s got %s", tc.expected.TransactionDate, cb.TransactionDate)
+			tc.expected.TransactionDate, cb.TransactionDate = time.Time{}, time.Time{}
+			cb.Items = nil
+			assert.Equal(t, tc.expected, cb)
+		})
+	}
+}
+
+func TestExpressCallbackHandler(t *testing.T) {
+	_, err := NewExpressCallbackHandler(nil)
+	assert.ErrorIs(t, err, errMissingCallbackHandler)
+
+	errHandler := errors.New("handler error")
+
+	testCases := []struct {
+		name           string
+		method         string
+		body           string
+		handlerErr     error
+		expectedStatus int
+		expectedResp   CallbackResp
+		expectedCalls  int
+	}{
+		{
+			name:           "success",
+			method:         http.MethodPost,
+			body:           successfulExpressCallback,
+			expectedStatus: http.StatusOK,
+			expectedResp:   callbackAccepted,
+			expectedCalls:  1,
+		},
+		{
+			name:           "cancelled",
+			method:         http.MethodPost,
+			body:           cancelledExpressCallback,
+			expectedStatus: http.StatusOK,
+			expectedResp:   callbackAccepted,
+			expectedCalls:  1,
+		},
+		{
+			name:           "handler error",
+			method:         http.MethodPost,
+			body:           successfulExpressCallback,
+			handlerErr:     errHandler,
+			expectedStatus: http.StatusInternalServerError,
+			expectedResp:   callbackFailed,
+			expectedCalls:  1,
+		},
+		{
+			name:           "invalid payload",
+			method:         http.MethodPost,
+			body:           `{"Body":{}}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   callbackFailed,
+		},
+		{
+			name:           "invalid method",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedResp:   callbackFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int
+			handler, err := NewExpressCallbackHandler(func(_ context.Context, cb ExpressCallback) error {
+				calls++
+				assert.Equal(t, "ws_CO_191220191020363925", cb.CheckoutRequestID)
+
+				return tc.handlerErr
+			})
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tc.method, "/callback", strings.NewReader(tc.body)))
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Equal(t, tc.expectedCalls, calls)
+
+			var resp CallbackResp
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, tc.expectedResp, resp)
+		})
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"embed"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"sync"
+)
+
+const (
+	sandboxCertificateFile = "certs/sandbox.cer"
+	prodCertificateFile    = "certs/production.cer"
+)
+
+// embeddedCertificates holds the Daraja certificates that are compiled into the binary.
+//
+//go:embed certs
+var embeddedCertificates embed.FS
+
+// certificates is where the default certificates are read from.
+var certificates fs.FS = embeddedCertificates
+
+var (
+	// errMissingCertificate indicates that the sdk has no certificate to encrypt with.
+	errMissingCertificate = errors.New("missing certificate")
+
+	// errInvalidCertificateData indicates that the certificate is neither PEM nor DER encoded.
+	errInvalidCertificateData = errors.New("certificate is neither PEM nor DER encoded")
+
+	// errUnsupportedPublicKey indicates that the certificate does not hold an RSA public key.
+	errUnsupportedPublicKey = errors.New("certificate public key is not an RSA key")
+)
+
+// CertificateError is returned when the certificate used to encrypt the
+// initiator password cannot be loaded or parsed.
+type CertificateError struct {
+	Source string // The embedded file, local path or URL the certificate was loaded from.
+	Err    error
+}
+
+func (e *CertificateError) Error() string {
+	return fmt.Sprintf("invalid certificate %s: %v", e.Source, e.Err)
+}
+
+func (e *CertificateError) Unwrap() error {
+	return e.Err
+}
+
+// certificate lazily loads a certificate and caches its public key.
+type certificate struct {
+	source string
+	remote bool
+	load   func(ctx context.Context, client *http.Client) ([]byte, error)
+
+	mu  sync.Mutex
+	key *rsa.PublicKey
+}
+
+// newStaticCertificate returns a certificate backed by data already in memory.
+func newStaticCertificate(source string, data []byte) *certificate {
+	return &certificate{
+		source: source,
+		load: func(context.Context, *http.Client) ([]byte, error) {
+			return data, nil
+		},
+	}
+}
+
+// newFileCertificate returns a certificate read from a local file.
+func newFileCertificate(path string) (*certificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, &CertificateError{Source: path, Err: err}
+	}
+
+	return newStaticCertificate(path, data), nil
+}
+
+// newRemoteCertificate returns a certificate that is downloaded on first use
+// with the sdk's client. Only a successfully parsed certificate is cached so a
+// failed download is retried on the next call.
+func newRemoteCertificate(url string) *certificate {
+	return &certificate{
+		source: url,
+		remote: true,
+		load: func(ctx context.Context, client *http.Client) ([]byte, error) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create certificate request: %w", err)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get certificate: %w", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				return nil, fmt.Errorf("failed to get certificate: unexpected status %s", resp.Status)
+			}
+
+			data, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read certificate: %w", err)
+			}
+
+			return data, nil
+		},
+	}
+}
+
+// newCertificate returns the certificate configured for the sdk. Certificates
+// that are available locally are parsed immediately so that a bad certificate
+// is reported when the sdk is created.
+func newCertificate(conf Config) (*certificate, error) {
+	var (
+		cert *certificate
+		err  error
+	)
+	switch {
+	case conf.CertFile != "":
+		cert, err = newFileCertificate(conf.CertFile)
+	case conf.environment() == Custom:
+		// There is no default certificate for custom hosts, operations that
+		// need a security credential fail unless the request carries one.
+		return nil, nil
+	default:
+		cert, err = defaultCertificate(conf.environment() == Sandbox)
+		switch {
+		case errors.Is(err, fs.ErrNotExist) && conf.DownloadCertificate:
+			cert, err = newRemoteCertificate(defaultCertificateURL(conf.environment() == Sandbox)), nil
+		case errors.Is(err, fs.ErrNotExist):
+			// Without the embedded certificate operations that need a security
+			// credential fail unless the request carries one.
+			return nil, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cert.remote {
+		return cert, nil
+	}
+
+	if _, err := cert.publicKey(context.Background(), nil); err != nil {
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+// defaultCertificate returns the embedded certificate for the environment.
+func defaultCertificate(sandbox bool) (*certificate, error) {
+	name := prodCertificateFile
+	if sandbox {
+		name = sandboxCertificateFile
+	}
+
+	data, err := fs.ReadFile(certificates, name)
+	if err != nil {
+		return nil, &CertificateError{Source: name, Err: err}
+	}
+
+	return newStaticCertificate(name, data), nil
+}
+
+// defaultCertificateURL returns the Daraja URL of the certificate for the environment.
+func defaultCertificateURL(sandbox bool) string {
+	if sandbox {
+		return sandboxCertificate
+	}
+
+	return prodCertificate
+}
+
+// publicKey returns the RSA public key of the certificate, parsing it on first use.
+func (c *certificate) publicKey(ctx context.Context, client *http.Client) (*rsa.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.key != nil {
+		return c.key, nil
+	}
+
+	data, err := c.load(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := parsePublicKey(data)
+	if err != nil {
+		return nil, &CertificateError{Source: c.source, Err: err}
+	}
+	c.key = key
+
+	return key, nil
+}
+
+// encrypt encrypts the password with the certificate's public key and
+// returns it base64 encoded as expected by Daraja.
+func (c *certificate) encrypt(ctx context.Context, client *http.Client, password string) (string, error) {
+	key, err := c.publicKey(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	cipher, err := rsa.EncryptPKCS1v15(rand.Reader, key, []byte(password))
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt password: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(cipher), nil
+}
+
+// parsePublicKey parses a PEM or DER encoded certificate and returns its RSA public key.
+func parsePublicKey(data []byte) (*rsa.PublicKey, error) {
+	der := data
+	if block, _ := pem.Decode(data); block != nil {
+		der = block.Bytes
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, errors.Join(errInvalidCertificateData, err)
+	}
+
+	key, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, errUnsupportedPublicKey
+	}
+
+	return key, nil
+}
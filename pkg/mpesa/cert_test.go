@@ -0,0 +1,377 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io/fs"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testCertOnce sync.Once
+	testCertKey  *rsa.PrivateKey
+	testCertDER  []byte
+)
+
+// generateTestCertificate returns a self signed certificate for the given key.
+func generateTestCertificate(t *testing.T, pub, priv interface{}) []byte {
+	t.Helper()
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "apicrypt.safaricom.co.ke"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, pub, priv)
+	require.NoError(t, err)
+
+	return der
+}
+
+// testCertificatePEM returns a PEM encoded test certificate and its private key.
+func testCertificatePEM(t *testing.T) ([]byte, *rsa.PrivateKey) {
+	t.Helper()
+
+	testCertOnce.Do(func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		testCertKey = key
+		testCertDER = generateTestCertificate(t, &key.PublicKey, key)
+	})
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: testCertDER}), testCertKey
+}
+
+// testCertificate returns a certificate that can be used in an mSDK literal.
+func testCertificate(t *testing.T) *certificate {
+	t.Helper()
+
+	data, _ := testCertificatePEM(t)
+
+	return newStaticCertificate("test", data)
+}
+
+func decryptCredential(t *testing.T, key *rsa.PrivateKey, credential string) string {
+	t.Helper()
+
+	cipher, err := base64.StdEncoding.DecodeString(credential)
+	require.NoError(t, err)
+
+	plain, err := rsa.DecryptPKCS1v15(rand.Reader, key, cipher)
+	require.NoError(t, err)
+
+	return string(plain)
+}
+
+func TestParsePublicKey(t *testing.T) {
+	pemData, key := testCertificatePEM(t)
+	block, _ := pem.Decode(pemData)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecCert := generateTestCertificate(t, &ecKey.PublicKey, ecKey)
+
+	testCases := []struct {
+		name        string
+		data        []byte
+		expectedErr error
+	}{
+		{
+			name: "pem encoded certificate",
+			data: pemData,
+		},
+		{
+			name: "der encoded certificate",
+			data: block.Bytes,
+		},
+		{
+			name:        "empty certificate",
+			data:        nil,
+			expectedErr: errInvalidCertificateData,
+		},
+		{
+			name:        "html page instead of certificate",
+			data:        []byte("<html><body>Not Found</body></html>"),
+			expectedErr: errInvalidCertificateData,
+		},
+		{
+			name:        "pem block that is not a certificate",
+			data:        pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("invalid")}),
+			expectedErr: errInvalidCertificateData,
+		},
+		{
+			name:        "certificate with ecdsa key",
+			data:        ecCert,
+			expectedErr: errUnsupportedPublicKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parsePublicKey(tc.data)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr == nil {
+				assert.Equal(t, &key.PublicKey, got)
+			}
+		})
+	}
+}
+
+func TestNewCertificate(t *testing.T) {
+	pemData, _ := testCertificatePEM(t)
+
+	dir := t.TempDir()
+	validFile := filepath.Join(dir, "valid.cer")
+	require.NoError(t, os.WriteFile(validFile, pemData, 0o600))
+	invalidFile := filepath.Join(dir, "invalid.cer")
+	require.NoError(t, os.WriteFile(invalidFile, []byte("invalid"), 0o600))
+
+	testCases := []struct {
+		name        string
+		config      Config
+		remote      bool
+		expectedErr error
+	}{
+		{
+			name:   "certificate from local file",
+			config: Config{BaseURL: "https://sandbox.safaricom.co.ke", CertFile: validFile},
+		},
+		{
+			name:        "certificate from missing file",
+			config:      Config{BaseURL: "https://sandbox.safaricom.co.ke", CertFile: filepath.Join(dir, "missing.cer")},
+			expectedErr: os.ErrNotExist,
+		},
+		{
+			name:        "certificate from invalid file",
+			config:      Config{BaseURL: "https://sandbox.safaricom.co.ke", CertFile: invalidFile},
+			expectedErr: errInvalidCertificateData,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cert, err := newCertificate(tc.config)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				var certErr *CertificateError
+				assert.True(t, errors.As(err, &certErr), "expected a certificate error, got %v", err)
+				assert.Equal(t, tc.config.CertFile, certErr.Source)
+
+				return
+			}
+
+			require.NotNil(t, cert)
+			assert.Equal(t, tc.remote, cert.remote)
+			assert.NotNil(t, cert.key, "expected the certificate to be parsed")
+		})
+	}
+}
+
+// withCertificates replaces the embedded certificates for the duration of the test.
+func withCertificates(t *testing.T, fsys fs.FS) {
+	t.Helper()
+
+	embedded := certificates
+	certificates = fsys
+	t.Cleanup(func() { certificates = embedded })
+}
+
+func TestDefaultCertificate(t *testing.T) {
+	pemData, key := testCertificatePEM(t)
+
+	withCertificates(t, fstest.MapFS{
+		sandboxCertificateFile: {Data: pemData},
+		prodCertificateFile:    {Data: testCertDER},
+	})
+
+	for _, sandbox := range []bool{true, false} {
+		cert, err := defaultCertificate(sandbox)
+		require.NoError(t, err)
+
+		name := prodCertificateFile
+		if sandbox {
+			name = sandboxCertificateFile
+		}
+		assert.Equal(t, name, cert.source)
+		assert.False(t, cert.remote)
+
+		pub, err := cert.publicKey(context.Background(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, &key.PublicKey, pub)
+	}
+}
+
+func TestEmbeddedCertificates(t *testing.T) {
+	for _, sandbox := range []bool{true, false} {
+		name := prodCertificateFile
+		if sandbox {
+			name = sandboxCertificateFile
+		}
+
+		data, err := fs.ReadFile(embeddedCertificates, name)
+		if errors.Is(err, fs.ErrNotExist) {
+			t.Skipf("%s is not embedded, see certs/README.md", name)
+		}
+		require.NoError(t, err)
+
+		cert, err := defaultCertificate(sandbox)
+		require.NoError(t, err)
+		assert.Equal(t, name, cert.source)
+
+		pub, err := cert.publicKey(context.Background(), nil)
+		require.NoError(t, err, name)
+
+		want, err := parsePublicKey(data)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, pub)
+	}
+}
+
+func TestMissingDefaultCertificate(t *testing.T) {
+	withCertificates(t, fstest.MapFS{})
+
+	for _, sandbox := range []bool{true, false} {
+		_, err := defaultCertificate(sandbox)
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+
+		env, url := Production, prodCertificate
+		if sandbox {
+			env, url = Sandbox, sandboxCertificate
+		}
+
+		cert, err := newCertificate(Config{Environment: env})
+		require.NoError(t, err)
+		assert.Nil(t, cert, "expected no certificate without DownloadCertificate")
+
+		cert, err = newCertificate(Config{Environment: env, DownloadCertificate: true})
+		require.NoError(t, err)
+		require.NotNil(t, cert)
+		assert.Equal(t, url, cert.source)
+		assert.True(t, cert.remote)
+	}
+}
+
+func TestRemoteCertificate(t *testing.T) {
+	pemData, key := testCertificatePEM(t)
+
+	var (
+		requests atomic.Int64
+		fail     atomic.Bool
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		if fail.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+		_, _ = w.Write(pemData)
+	}))
+	defer server.Close()
+
+	cert := newRemoteCertificate(server.URL)
+
+	fail.Store(true)
+	_, err := cert.encrypt(context.Background(), server.Client(), initiatorPassword)
+	assert.Error(t, err)
+
+	fail.Store(false)
+	for i := 0; i < 3; i++ {
+		credential, err := cert.encrypt(context.Background(), server.Client(), initiatorPassword)
+		require.NoError(t, err)
+		assert.Equal(t, initiatorPassword, decryptCredential(t, key, credential))
+	}
+
+	assert.Equal(t, int64(2), requests.Load(), "expected the certificate to be downloaded once after the failed attempt")
+}
+
+func TestGenerateSecurityCredential(t *testing.T) {
+	_, key := testCertificatePEM(t)
+
+	sdk := mSDK{cert: testCertificate(t)}
+	credential, err := sdk.generateSecurityCredential(context.Background(), initiatorPassword)
+	require.NoError(t, err)
+	assert.Equal(t, initiatorPassword, decryptCredential(t, key, credential))
+
+	_, err = mSDK{}.generateSecurityCredential(context.Background(), initiatorPassword)
+	assert.ErrorIs(t, err, errMissingCertificate)
+}
+
+func TestPreEncryptedSecurityCredential(t *testing.T) {
+	const credential = "pre-encrypted-credential"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/"+strings.Split(authEndpoint, "?")[0] {
+			_ = json.NewEncoder(w).Encode(TokenResp{AccessToken: accessToken, Expiry: "3599"})
+
+			return
+		}
+
+		var req AccountBalanceReq
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+		if req.SecurityCredential != credential {
+			http.Error(w, "unexpected security credential", http.StatusBadRequest)
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(AccountBalanceResp{ValidResp: ValidResp{ResponseCode: "0"}})
+	}))
+	defer server.Close()
+
+	// The sdk has no certificate so encrypting the password would fail.
+	sdk := mSDK{
+		baseURL: server.URL,
+		client:  server.Client(),
+	}
+
+	resp, err := sdk.AccountBalance(context.Background(), AccountBalanceReq{
+		InitiatorName:      initiatorName,
+		SecurityCredential: credential,
+		CommandID:          "AccountBalance",
+		IdentifierType:     4,
+		PartyA:             600772,
+		QueueTimeOutURL:    "https://example.com/timeout",
+		ResultURL:          "https://example.com/result",
+		Remarks:            "test",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0", resp.ResponseCode)
+}
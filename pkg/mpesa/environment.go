@@ -0,0 +1,282 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	sandboxBaseURL = "https://sandbox.safaricom.co.ke"
+	prodBaseURL    = "https://api.safaricom.co.ke"
+)
+
+var (
+	// errInvalidEnvironment indicates that the environment is not supported.
+	errInvalidEnvironment = errors.New("invalid environment, must be one of sandbox, production or custom")
+
+	// errInvalidEndpoint indicates that an endpoint override is not a valid path.
+	errInvalidEndpoint = errors.New("invalid endpoint")
+
+	// errUnknownOperation indicates that an operation is not supported by the SDK.
+	errUnknownOperation = errors.New("unknown operation")
+
+	// errInvalidB2CVersion indicates that the B2C endpoint version is not supported.
+	errInvalidB2CVersion = errors.New("invalid b2c version, must be one of v1 or v3")
+
+	// errInvalidC2BVersion indicates that the C2B endpoint version is not supported.
+	errInvalidC2BVersion = errors.New("invalid c2b version, must be one of v1 or v2")
+)
+
+// Environment is the Daraja environment the SDK talks to.
+type Environment string
+
+const (
+	// Sandbox is the Daraja sandbox at https://sandbox.safaricom.co.ke.
+	Sandbox Environment = "sandbox"
+
+	// Production is the Daraja production API at https://api.safaricom.co.ke.
+	Production Environment = "production"
+
+	// Custom is any other host exposing the Daraja API, such as a local
+	// simulator, an egress proxy or a fixture server. It requires BaseURL.
+	Custom Environment = "custom"
+)
+
+// B2CVersion is the version of the Daraja B2C payment endpoint.
+type B2CVersion string
+
+const (
+	// B2CV1 is the mpesa/b2c/v1/paymentrequest endpoint. It ignores the
+	// OriginatorConversationID of the request.
+	B2CV1 B2CVersion = "v1"
+
+	// B2CV3 is the mpesa/b2c/v3/paymentrequest endpoint. It deduplicates
+	// payments by the OriginatorConversationID of the request and returns it
+	// in the response and the result.
+	B2CV3 B2CVersion = "v3"
+)
+
+// C2BVersion is the version of the Daraja C2B register URL and simulate endpoints.
+type C2BVersion string
+
+const (
+	// C2BV1 is the mpesa/c2b/v1 endpoints. Callbacks carry the full MSISDN
+	// or a masked one.
+	C2BV1 C2BVersion = "v1"
+
+	// C2BV2 is the mpesa/c2b/v2 endpoints. Callbacks carry a masked or
+	// hashed MSISDN, see MatchMSISDN.
+	C2BV2 C2BVersion = "v2"
+)
+
+// c2bV2Endpoints maps the C2B operations to their v2 Daraja paths.
+var c2bV2Endpoints = map[Operation]string{
+	OpC2BRegisterURL: c2bRegisterURLV2,
+	OpC2BSimulate:    c2bSimulateV2,
+}
+
+// Operation identifies an SDK operation. Its value is the name of the SDK method.
+type Operation string
+
+// Operations supported by the SDK.
+const (
+	OpToken             Operation = "Token"
+	OpExpressSimulate   Operation = "ExpressSimulate"
+	OpExpressQuery      Operation = "ExpressQuery"
+	OpB2CPayment        Operation = "B2CPayment"
+	OpAccountBalance    Operation = "AccountBalance"
+	OpC2BRegisterURL    Operation = "C2BRegisterURL"
+	OpC2BSimulate       Operation = "C2BSimulate"
+	OpGenerateQR        Operation = "GenerateQR"
+	OpReverse           Operation = "Reverse"
+	OpTransactionStatus Operation = "TransactionStatus"
+	OpRemitTax          Operation = "RemitTax"
+	OpBusinessPayBill   Operation = "BusinessPayBill"
+
+	OpBillManagerOptIn         Operation = "BillManagerOptIn"
+	OpBillManagerUpdateOptIn   Operation = "BillManagerUpdateOptIn"
+	OpBillManagerSingleInvoice Operation = "BillManagerSingleInvoice"
+	OpBillManagerBulkInvoice   Operation = "BillManagerBulkInvoice"
+	OpBillManagerCancelInvoice Operation = "BillManagerCancelInvoice"
+	OpBillManagerReconcile     Operation = "BillManagerReconcile"
+
+	OpPullTransactionsRegister Operation = "PullTransactionsRegister"
+	OpPullTransactionsQuery    Operation = "PullTransactionsQuery"
+
+	OpStandingOrder Operation = "StandingOrder"
+
+	OpB2BExpressCheckout Operation = "B2BExpressCheckout"
+
+	OpB2CAccountTopUp Operation = "B2CAccountTopUp"
+	OpB2Pochi         Operation = "B2Pochi"
+
+	OpSIMSwap Operation = "SIMSwap"
+)
+
+// defaultEndpoints maps every operation to its Daraja path relative to the base url.
+var defaultEndpoints = map[Operation]string{
+	OpToken:             authEndpoint,
+	OpExpressSimulate:   expressSimulateEndpoint,
+	OpExpressQuery:      queryEndpoint,
+	OpB2CPayment:        b2cEndpoint,
+	OpAccountBalance:    accbalanceEndpoint,
+	OpC2BRegisterURL:    c2bRegisterURLEndpoint,
+	OpC2BSimulate:       c2bSimulateEndpoint,
+	OpGenerateQR:        qrCodeEndpoint,
+	OpReverse:           reversalEndpoint,
+	OpTransactionStatus: transactionEndpoint,
+	OpRemitTax:          taxEndpoint,
+	OpBusinessPayBill:   b2bEndpoint,
+
+	OpBillManagerOptIn:         billManagerOptInEndpoint,
+	OpBillManagerUpdateOptIn:   billManagerUpdateOptInEndpoint,
+	OpBillManagerSingleInvoice: billManagerSingleInvoiceEndpoint,
+	OpBillManagerBulkInvoice:   billManagerBulkInvoiceEndpoint,
+	OpBillManagerCancelInvoice: billManagerCancelInvoiceEndpoint,
+	OpBillManagerReconcile:     billManagerReconcileEndpoint,
+
+	OpPullTransactionsRegister: pullTransactionsRegisterEndpoint,
+	OpPullTransactionsQuery:    pullTransactionsQueryEndpoint,
+
+	OpStandingOrder: standingOrderEndpoint,
+
+	OpB2BExpressCheckout: b2bExpressCheckoutEndpoint,
+
+	OpB2CAccountTopUp: b2bEndpoint,
+	OpB2Pochi:         b2cEndpoint,
+
+	OpSIMSwap: simSwapEndpoint,
+}
+
+// environment returns the configured environment. When it is not set it is
+// derived from the base url so that existing configurations keep working.
+func (cfg Config) environment() Environment {
+	if cfg.Environment != "" {
+		return cfg.Environment
+	}
+
+	switch strings.TrimSuffix(cfg.BaseURL, "/") {
+	case sandboxBaseURL:
+		return Sandbox
+	case prodBaseURL:
+		return Production
+	default:
+		return ""
+	}
+}
+
+// baseURL returns the base url of the configured environment.
+func (cfg Config) baseURL() (string, error) {
+	baseURL := strings.TrimSuffix(cfg.BaseURL, "/")
+
+	switch cfg.Environment {
+	case "":
+		if baseURL == "" {
+			return "", errMissingBaseURL
+		}
+		if cfg.environment() == "" {
+			return "", errInvalidBaseURL
+		}
+
+		return baseURL, nil
+	case Sandbox, Production:
+		want := sandboxBaseURL
+		if cfg.Environment == Production {
+			want = prodBaseURL
+		}
+		if baseURL != "" && baseURL != want {
+			return "", fmt.Errorf("%w: %s environment uses %s", errInvalidBaseURL, cfg.Environment, want)
+		}
+
+		return want, nil
+	case Custom:
+		if baseURL == "" {
+			return "", errMissingBaseURL
+		}
+		u, err := url.Parse(baseURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return "", fmt.Errorf("%w: %q is not an absolute http url", errInvalidBaseURL, cfg.BaseURL)
+		}
+
+		return baseURL, nil
+	default:
+		return "", errInvalidEnvironment
+	}
+}
+
+// endpoints returns the default endpoints with the configured overrides applied.
+func (cfg Config) endpoints() (map[Operation]string, error) {
+	endpoints := make(map[Operation]string, len(defaultEndpoints))
+	for op, path := range defaultEndpoints {
+		endpoints[op] = path
+	}
+
+	switch cfg.B2CVersion {
+	case "", B2CV1:
+	case B2CV3:
+		endpoints[OpB2CPayment] = b2cV3Endpoint
+	default:
+		return nil, fmt.Errorf("%w: %q", errInvalidB2CVersion, cfg.B2CVersion)
+	}
+
+	for op, path := range cfg.Endpoints {
+		if _, ok := defaultEndpoints[op]; !ok {
+			return nil, fmt.Errorf("%w: %w %q", errInvalidEndpoint, errUnknownOperation, op)
+		}
+
+		path = strings.TrimPrefix(strings.TrimSpace(path), "/")
+		if path == "" || strings.Contains(path, "://") {
+			return nil, fmt.Errorf("%w: %q for %s must be a path relative to the base url", errInvalidEndpoint, cfg.Endpoints[op], op)
+		}
+		endpoints[op] = path
+	}
+
+	return endpoints, nil
+}
+
+// c2bVersions returns the C2B endpoint version of every configured shortcode.
+func (cfg Config) c2bVersions() (map[uint64]C2BVersion, error) {
+	versions := make(map[uint64]C2BVersion, len(cfg.C2BVersions))
+	for shortCode, version := range cfg.C2BVersions {
+		switch version {
+		case "", C2BV1:
+			versions[shortCode] = C2BV1
+		case C2BV2:
+			versions[shortCode] = C2BV2
+		default:
+			return nil, fmt.Errorf("%w: %q for shortcode %d", errInvalidC2BVersion, version, shortCode)
+		}
+	}
+
+	return versions, nil
+}
+
+// url returns the full url of the operation.
+func (sdk mSDK) url(op Operation) string {
+	path, ok := sdk.endpoints[op]
+	if !ok {
+		path = defaultEndpoints[op]
+	}
+
+	return fmt.Sprintf("%s/%s", sdk.baseURL, path)
+}
+
+// c2bURL returns the full url of a C2B operation for the endpoint version of
+// the shortcode. An Endpoints override of the operation takes precedence over
+// the version's path.
+func (sdk mSDK) c2bURL(op Operation, shortCode uint64) string {
+	path, ok := sdk.endpoints[op]
+	if sdk.c2bVersions[shortCode] == C2BV2 && (!ok || path == defaultEndpoints[op]) {
+		return fmt.Sprintf("%s/%s", sdk.baseURL, c2bV2Endpoints[op])
+	}
+
+	return sdk.url(op)
+}
@@ -0,0 +1,314 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSDKEnvironment(t *testing.T) {
+	testCases := []struct {
+		name            string
+		config          Config
+		expectedBaseURL string
+		expectedErr     error
+	}{
+		{
+			name:            "sandbox derived from base url",
+			config:          Config{BaseURL: sandboxBaseURL},
+			expectedBaseURL: sandboxBaseURL,
+		},
+		{
+			name:            "production derived from base url with trailing slash",
+			config:          Config{BaseURL: prodBaseURL + "/"},
+			expectedBaseURL: prodBaseURL,
+		},
+		{
+			name:        "missing base url without environment",
+			config:      Config{},
+			expectedErr: errMissingBaseURL,
+		},
+		{
+			name:        "unknown base url without environment",
+			config:      Config{BaseURL: "http://localhost:8080"},
+			expectedErr: errInvalidBaseURL,
+		},
+		{
+			name:            "sandbox environment without base url",
+			config:          Config{Environment: Sandbox},
+			expectedBaseURL: sandboxBaseURL,
+		},
+		{
+			name:            "production environment without base url",
+			config:          Config{Environment: Production},
+			expectedBaseURL: prodBaseURL,
+		},
+		{
+			name:        "production environment with sandbox base url",
+			config:      Config{Environment: Production, BaseURL: sandboxBaseURL},
+			expectedErr: errInvalidBaseURL,
+		},
+		{
+			name:            "custom environment",
+			config:          Config{Environment: Custom, BaseURL: "http://localhost:8080/daraja/"},
+			expectedBaseURL: "http://localhost:8080/daraja",
+		},
+		{
+			name:        "custom environment without base url",
+			config:      Config{Environment: Custom},
+			expectedErr: errMissingBaseURL,
+		},
+		{
+			name:        "custom environment with relative base url",
+			config:      Config{Environment: Custom, BaseURL: "localhost:8080"},
+			expectedErr: errInvalidBaseURL,
+		},
+		{
+			name:        "invalid environment",
+			config:      Config{Environment: "staging", BaseURL: sandboxBaseURL},
+			expectedErr: errInvalidEnvironment,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.config.AppKey = appKey
+			tc.config.AppSecret = appSecret
+
+			sdk, err := NewSDK(tc.config)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			msdk, ok := sdk.(*mSDK)
+			require.True(t, ok)
+			assert.Equal(t, tc.expectedBaseURL, msdk.baseURL)
+		})
+	}
+}
+
+func TestNewSDKEndpoints(t *testing.T) {
+	testCases := []struct {
+		name        string
+		endpoints   map[Operation]string
+		b2cVersion  B2CVersion
+		expected    map[Operation]string
+		expectedErr error
+	}{
+		{
+			name:     "default endpoints",
+			expected: defaultEndpoints,
+		},
+		{
+			name:      "override endpoint",
+			endpoints: map[Operation]string{OpB2CPayment: "/mpesa/b2c/v3/paymentrequest"},
+			expected:  map[Operation]string{OpB2CPayment: "mpesa/b2c/v3/paymentrequest", OpExpressQuery: queryEndpoint},
+		},
+		{
+			name:       "b2c v1",
+			b2cVersion: B2CV1,
+			expected:   map[Operation]string{OpB2CPayment: b2cEndpoint, OpB2Pochi: b2cEndpoint},
+		},
+		{
+			name:       "b2c v3",
+			b2cVersion: B2CV3,
+			expected:   map[Operation]string{OpB2CPayment: b2cV3Endpoint, OpB2Pochi: b2cEndpoint},
+		},
+		{
+			name:       "override b2c v3 endpoint",
+			b2cVersion: B2CV3,
+			endpoints:  map[Operation]string{OpB2CPayment: "simulator/b2c/v3/paymentrequest"},
+			expected:   map[Operation]string{OpB2CPayment: "simulator/b2c/v3/paymentrequest"},
+		},
+		{
+			name:        "invalid b2c version",
+			b2cVersion:  "v2",
+			expectedErr: errInvalidB2CVersion,
+		},
+		{
+			name:        "override unknown operation",
+			endpoints:   map[Operation]string{"Unknown": "mpesa/unknown"},
+			expectedErr: errInvalidEndpoint,
+		},
+		{
+			name:        "override with empty path",
+			endpoints:   map[Operation]string{OpB2CPayment: " "},
+			expectedErr: errInvalidEndpoint,
+		},
+		{
+			name:        "override with absolute url",
+			endpoints:   map[Operation]string{OpB2CPayment: "https://example.com/b2c"},
+			expectedErr: errInvalidEndpoint,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sdk, err := NewSDK(Config{
+				Environment: Sandbox,
+				AppKey:      appKey,
+				AppSecret:   appSecret,
+				Endpoints:   tc.endpoints,
+				B2CVersion:  tc.b2cVersion,
+			})
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			msdk, ok := sdk.(*mSDK)
+			require.True(t, ok)
+			for op, path := range tc.expected {
+				assert.Equal(t, sandboxBaseURL+"/"+path, msdk.url(op))
+			}
+		})
+	}
+}
+
+func TestNewSDKC2BVersions(t *testing.T) {
+	const (
+		v1ShortCode = 600981
+		v2ShortCode = 600982
+	)
+
+	testCases := []struct {
+		name        string
+		versions    map[uint64]C2BVersion
+		endpoints   map[Operation]string
+		expected    map[uint64]map[Operation]string
+		expectedErr error
+	}{
+		{
+			name: "default",
+			expected: map[uint64]map[Operation]string{
+				v2ShortCode: {OpC2BRegisterURL: c2bRegisterURLEndpoint, OpC2BSimulate: c2bSimulateEndpoint},
+			},
+		},
+		{
+			name:     "v2 shortcode",
+			versions: map[uint64]C2BVersion{v1ShortCode: C2BV1, v2ShortCode: C2BV2},
+			expected: map[uint64]map[Operation]string{
+				v1ShortCode: {OpC2BRegisterURL: c2bRegisterURLEndpoint, OpC2BSimulate: c2bSimulateEndpoint},
+				v2ShortCode: {OpC2BRegisterURL: c2bRegisterURLV2, OpC2BSimulate: c2bSimulateV2},
+			},
+		},
+		{
+			name:      "override v2 endpoint",
+			versions:  map[uint64]C2BVersion{v2ShortCode: C2BV2},
+			endpoints: map[Operation]string{OpC2BSimulate: "simulator/c2b/v2/simulate"},
+			expected: map[uint64]map[Operation]string{
+				v2ShortCode: {OpC2BRegisterURL: c2bRegisterURLV2, OpC2BSimulate: "simulator/c2b/v2/simulate"},
+			},
+		},
+		{
+			name:        "invalid c2b version",
+			versions:    map[uint64]C2BVersion{v2ShortCode: "v3"},
+			expectedErr: errInvalidC2BVersion,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sdk, err := NewSDK(Config{
+				Environment: Sandbox,
+				AppKey:      appKey,
+				AppSecret:   appSecret,
+				Endpoints:   tc.endpoints,
+				C2BVersions: tc.versions,
+			})
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			msdk, ok := sdk.(*mSDK)
+			require.True(t, ok)
+			for shortCode, endpoints := range tc.expected {
+				for op, path := range endpoints {
+					assert.Equal(t, sandboxBaseURL+"/"+path, msdk.c2bURL(op, shortCode))
+				}
+			}
+		})
+	}
+}
+
+func TestCustomEnvironment(t *testing.T) {
+	const queryPath = "/simulator/stkpushquery/v2/query"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/oauth/v1/generate":
+			_ = json.NewEncoder(w).Encode(TokenResp{AccessToken: accessToken, Expiry: "3599"})
+		case queryPath:
+			_ = json.NewEncoder(w).Encode(ExpressQueryResp{ResponseCode: "0"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	sdk, err := NewSDK(Config{
+		Environment: Custom,
+		BaseURL:     server.URL,
+		AppKey:      appKey,
+		AppSecret:   appSecret,
+		Endpoints:   map[Operation]string{OpExpressQuery: queryPath},
+	})
+	require.NoError(t, err)
+
+	resp, err := sdk.ExpressQuery(context.Background(), ExpressQueryReq{
+		PassKey:           passKey,
+		BusinessShortCode: 174379,
+		CheckoutRequestID: "ws_CO_07092023195244460720136271",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0", resp.ResponseCode)
+
+	// Custom hosts have no default certificate.
+	_, err = sdk.AccountBalance(context.Background(), AccountBalanceReq{
+		InitiatorName:     initiatorName,
+		InitiatorPassword: initiatorPassword,
+		CommandID:         "AccountBalance",
+		IdentifierType:    4,
+		PartyA:            600772,
+		QueueTimeOutURL:   "https://example.com/timeout",
+		ResultURL:         "https://example.com/result",
+		Remarks:           "test",
+	})
+	assert.ErrorIs(t, err, errMissingCertificate)
+}
+
+func TestCustomEnvironmentWithCertFile(t *testing.T) {
+	data, _ := testCertificatePEM(t)
+	path := filepath.Join(t.TempDir(), "simulator.cer")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	sdk, err := NewSDK(Config{
+		Environment: Custom,
+		BaseURL:     "http://localhost:8080",
+		AppKey:      appKey,
+		AppSecret:   appSecret,
+		CertFile:    path,
+	})
+	require.NoError(t, err)
+
+	msdk, ok := sdk.(*mSDK)
+	require.True(t, ok)
+	assert.NotNil(t, msdk.cert)
+}
@@ -0,0 +1,262 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Known Daraja failures. Use errors.Is to check for them on an error returned
+// by the SDK and errors.As with *Error to get the details of the response.
+var (
+	// ErrInvalidAccessToken indicates that the access token is invalid or expired.
+	ErrInvalidAccessToken = errors.New("invalid access token")
+
+	// ErrInvalidAuthHeader indicates that the authorization header is malformed.
+	ErrInvalidAuthHeader = errors.New("invalid authentication header")
+
+	// ErrInvalidCredentials indicates that the app key or app secret was rejected.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+
+	// ErrInvalidGrantType indicates that the token request used an unsupported grant type.
+	ErrInvalidGrantType = errors.New("invalid grant type")
+
+	// ErrInvalidRequest indicates that Daraja rejected the request payload.
+	ErrInvalidRequest = errors.New("invalid request")
+
+	// ErrResourceNotFound indicates that the requested endpoint does not exist.
+	ErrResourceNotFound = errors.New("resource not found")
+
+	// ErrSpikeArrest indicates that requests were sent faster than allowed.
+	ErrSpikeArrest = errors.New("spike arrest violation")
+
+	// ErrQuotaViolation indicates that the request quota has been exhausted.
+	ErrQuotaViolation = errors.New("quota violation")
+
+	// ErrDuplicateOriginatorConversationID indicates that the OriginatorConversationID was already used.
+	ErrDuplicateOriginatorConversationID = errors.New("duplicate originator conversation id")
+
+	// ErrInsufficientBalance indicates that the account balance cannot cover the transaction.
+	ErrInsufficientBalance = errors.New("insufficient balance")
+
+	// ErrTransactionInProgress indicates that the subscriber is already processing a transaction.
+	ErrTransactionInProgress = errors.New("transaction already in progress")
+
+	// ErrTransactionProcessing indicates that the queried transaction has no final result yet.
+	ErrTransactionProcessing = errors.New("transaction is being processed")
+
+	// ErrInternalServer indicates an internal Daraja failure.
+	ErrInternalServer = errors.New("internal server error")
+)
+
+// errorCodes maps Daraja error codes to the known failures.
+var errorCodes = map[string]error{
+	"400.002.01":   ErrInvalidAccessToken,
+	"404.001.03":   ErrInvalidAccessToken,
+	"404.001.04":   ErrInvalidAuthHeader,
+	"400.008.01":   ErrInvalidCredentials,
+	"999991":       ErrInvalidCredentials,
+	"400.008.02":   ErrInvalidGrantType,
+	"400.002.02":   ErrInvalidRequest,
+	"400.002.05":   ErrInvalidRequest,
+	"404.001.01":   ErrResourceNotFound,
+	"500.003.02":   ErrSpikeArrest,
+	"500.003.03":   ErrQuotaViolation,
+	"500.001.1001": ErrInternalServer,
+	"500.002.1001": ErrInternalServer,
+	"500.003.1001": ErrInternalServer,
+}
+
+// errorMessages maps fragments of Daraja error messages to the known failures.
+// Daraja reuses generic codes such as 500.001.1001 for unrelated failures, so
+// the message is checked before the code.
+var errorMessages = []struct {
+	fragment string
+	err      error
+}{
+	{"duplicate originatorconversationid", ErrDuplicateOriginatorConversationID},
+	{"duplicate originator conversation", ErrDuplicateOriginatorConversationID},
+	{"insufficient", ErrInsufficientBalance},
+	{"transaction is already in process", ErrTransactionInProgress},
+	{"unable to lock subscriber", ErrTransactionInProgress},
+	{"transaction is being processed", ErrTransactionProcessing},
+	{"spike arrest", ErrSpikeArrest},
+	{"quota violation", ErrQuotaViolation},
+	{"invalid access token", ErrInvalidAccessToken},
+}
+
+// Error is a request that Daraja answered with a status other than 200 OK.
+type Error struct {
+	StatusCode int    // The HTTP status code of the response.
+	RequestID  string // The requestId reported by Daraja.
+	Code       string // The errorCode reported by Daraja.
+	Message    string // The errorMessage reported by Daraja.
+	Body       []byte // The raw response body.
+
+	kind  error // One of the known failures, or nil if the failure is not known.
+	cause error // The SDK error the response is reported as.
+}
+
+// newError returns the error for a Daraja response with the given status and
+// body. The body does not need to be JSON; if it is not, the status is kept.
+func newError(statusCode int, body []byte, cause error) *Error {
+	var resp struct {
+		RespError
+		ResultCode string `json:"resultCode,omitempty"`
+		ResultDesc string `json:"resultDesc,omitempty"`
+		ResCode    string `json:"rescode,omitempty"`
+		ResMsg     string `json:"resmsg,omitempty"`
+	}
+	_ = json.Unmarshal(body, &resp)
+
+	e := &Error{
+		StatusCode: statusCode,
+		RequestID:  resp.RequestID,
+		Code:       resp.Code,
+		Message:    resp.Message,
+		Body:       body,
+		cause:      cause,
+	}
+	if e.Code == "" {
+		e.Code = resp.ResultCode
+	}
+	if e.Code == "" {
+		e.Code = resp.ResCode
+	}
+	if e.Message == "" {
+		e.Message = resp.ResultDesc
+	}
+	if e.Message == "" {
+		e.Message = resp.ResMsg
+	}
+	e.kind = e.classify()
+
+	return e
+}
+
+// NewError returns the error the SDK reports for a Daraja response with the
+// given status and body. It is useful to test code that handles SDK errors.
+func NewError(statusCode int, body []byte) *Error {
+	return newError(statusCode, body, errFailedToSendReq)
+}
+
+// classify returns the known failure the error corresponds to, if any.
+func (e *Error) classify() error {
+	message := strings.ToLower(e.Message)
+	for _, m := range errorMessages {
+		if strings.Contains(message, m.fragment) {
+			return m.err
+		}
+	}
+
+	if err, ok := errorCodes[e.Code]; ok {
+		return err
+	}
+
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return ErrInvalidAccessToken
+	case http.StatusTooManyRequests:
+		return ErrSpikeArrest
+	case http.StatusNotFound:
+		return ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	if e.cause != nil {
+		fmt.Fprintf(&b, "%v: ", e.cause)
+	}
+	fmt.Fprintf(&b, "status %d", e.StatusCode)
+
+	switch {
+	case e.Code != "" || e.Message != "":
+		fmt.Fprintf(&b, ": %s: %s", e.Code, e.Message)
+	case e.kind != nil:
+		fmt.Fprintf(&b, ": %v", e.kind)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " (request id %s)", e.RequestID)
+	}
+
+	return b.String()
+}
+
+// Unwrap returns the known failure and the SDK error the response is reported
+// as, so that both can be matched with errors.Is.
+func (e *Error) Unwrap() []error {
+	errs := make([]error, 0, 3)
+	if e.kind != nil {
+		errs = append(errs, e.kind)
+	}
+	if e.cause != nil {
+		errs = append(errs, e.cause)
+	}
+	if e.Code != "" || e.Message != "" || e.RequestID != "" {
+		errs = append(errs, RespError{RequestID: e.RequestID, Code: e.Code, Message: e.Message})
+	}
+
+	return errs
+}
+
+// Kind returns the known failure the error corresponds to, or nil.
+func (e *Error) Kind() error {
+	return e.kind
+}
+
+// IsRetryable reports whether the failure is transient, so that the same
+// request can succeed if it is sent again later. It does not mean that
+// re-sending is safe: a money-moving request may already have been processed
+// unless Daraja rejected it before processing, as with a spike arrest.
+func (e *Error) IsRetryable() bool {
+	switch e.kind {
+	case ErrSpikeArrest, ErrQuotaViolation, ErrTransactionInProgress, ErrTransactionProcessing:
+		return true
+	case nil, ErrInternalServer:
+		return retryableStatusCodes[e.StatusCode]
+	default:
+		return false
+	}
+}
+
+// IsAuth reports whether the failure is caused by the access token or by the
+// app credentials.
+func (e *Error) IsAuth() bool {
+	switch e.kind {
+	case ErrInvalidAccessToken, ErrInvalidAuthHeader, ErrInvalidCredentials, ErrInvalidGrantType:
+		return true
+	}
+
+	return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+}
+
+// IsRetryable reports whether err is a transient Daraja failure.
+func IsRetryable(err error) bool {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.IsRetryable()
+	}
+
+	return false
+}
+
+// IsAuth reports whether err is a Daraja authentication failure.
+func IsAuth(err error) bool {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.IsAuth()
+	}
+
+	return false
+}
@@ -0,0 +1,262 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewError(t *testing.T) {
+	testCases := []struct {
+		name          string
+		statusCode    int
+		body          string
+		expectedKind  error
+		expectedCode  string
+		expectedMsg   string
+		expectedReqID string
+		retryable     bool
+		auth          bool
+	}{
+		{
+			name:          "invalid access token",
+			statusCode:    http.StatusUnauthorized,
+			body:          `{"requestId":"11728-2929992-1","errorCode":"404.001.03","errorMessage":"Invalid Access Token"}`,
+			expectedKind:  ErrInvalidAccessToken,
+			expectedCode:  "404.001.03",
+			expectedMsg:   "Invalid Access Token",
+			expectedReqID: "11728-2929992-1",
+			auth:          true,
+		},
+		{
+			name:          "invalid credentials",
+			statusCode:    http.StatusBadRequest,
+			body:          `{"requestId":"","errorCode":"400.008.01","errorMessage":"Invalid Authentication passed"}`,
+			expectedKind:  ErrInvalidCredentials,
+			expectedCode:  "400.008.01",
+			expectedMsg:   "Invalid Authentication passed",
+			expectedReqID: "",
+			auth:          true,
+		},
+		{
+			name:         "invalid client id in result format",
+			statusCode:   http.StatusBadRequest,
+			body:         `{"resultCode":"999991","resultDesc":"Invalid client id passed"}`,
+			expectedKind: ErrInvalidCredentials,
+			expectedCode: "999991",
+			expectedMsg:  "Invalid client id passed",
+			auth:         true,
+		},
+		{
+			name:          "spike arrest",
+			statusCode:    http.StatusInternalServerError,
+			body:          `{"requestId":"11728-2929992-1","errorCode":"500.003.02","errorMessage":"Error Occurred: Spike Arrest Violation"}`,
+			expectedKind:  ErrSpikeArrest,
+			expectedCode:  "500.003.02",
+			expectedMsg:   "Error Occurred: Spike Arrest Violation",
+			expectedReqID: "11728-2929992-1",
+			retryable:     true,
+		},
+		{
+			name:          "quota violation",
+			statusCode:    http.StatusInternalServerError,
+			body:          `{"requestId":"11728-2929992-1","errorCode":"500.003.03","errorMessage":"Error Occurred: Quota Violation"}`,
+			expectedKind:  ErrQuotaViolation,
+			expectedCode:  "500.003.03",
+			expectedMsg:   "Error Occurred: Quota Violation",
+			expectedReqID: "11728-2929992-1",
+			retryable:     true,
+		},
+		{
+			name:          "duplicate originator conversation id",
+			statusCode:    http.StatusBadRequest,
+			body:          `{"requestId":"11728-2929992-1","errorCode":"400.002.02","errorMessage":"Bad Request - Duplicate OriginatorConversationID"}`,
+			expectedKind:  ErrDuplicateOriginatorConversationID,
+			expectedCode:  "400.002.02",
+			expectedMsg:   "Bad Request - Duplicate OriginatorConversationID",
+			expectedReqID: "11728-2929992-1",
+		},
+		{
+			name:          "insufficient balance",
+			statusCode:    http.StatusInternalServerError,
+			body:          `{"requestId":"11728-2929992-1","errorCode":"500.001.1001","errorMessage":"The balance is insufficient for the transaction"}`,
+			expectedKind:  ErrInsufficientBalance,
+			expectedCode:  "500.001.1001",
+			expectedMsg:   "The balance is insufficient for the transaction",
+			expectedReqID: "11728-2929992-1",
+		},
+		{
+			name:          "transaction in progress",
+			statusCode:    http.StatusInternalServerError,
+			body:          `{"requestId":"11728-2929992-1","errorCode":"500.001.1001","errorMessage":"Unable to lock subscriber, a transaction is already in process for the current subscriber"}`,
+			expectedKind:  ErrTransactionInProgress,
+			expectedCode:  "500.001.1001",
+			expectedMsg:   "Unable to lock subscriber, a transaction is already in process for the current subscriber",
+			expectedReqID: "11728-2929992-1",
+			retryable:     true,
+		},
+		{
+			name:          "transaction being processed",
+			statusCode:    http.StatusInternalServerError,
+			body:          `{"requestId":"11728-2929992-1","errorCode":"500.001.1001","errorMessage":"The transaction is being processed"}`,
+			expectedKind:  ErrTransactionProcessing,
+			expectedCode:  "500.001.1001",
+			expectedMsg:   "The transaction is being processed",
+			expectedReqID: "11728-2929992-1",
+			retryable:     true,
+		},
+		{
+			name:          "invalid request",
+			statusCode:    http.StatusBadRequest,
+			body:          `{"requestId":"11728-2929992-1","errorCode":"400.002.02","errorMessage":"Bad Request - Invalid PartyA"}`,
+			expectedKind:  ErrInvalidRequest,
+			expectedCode:  "400.002.02",
+			expectedMsg:   "Bad Request - Invalid PartyA",
+			expectedReqID: "11728-2929992-1",
+		},
+		{
+			name:          "internal server error",
+			statusCode:    http.StatusServiceUnavailable,
+			body:          `{"requestId":"11728-2929992-1","errorCode":"500.003.1001","errorMessage":"Internal Server Error"}`,
+			expectedKind:  ErrInternalServer,
+			expectedCode:  "500.003.1001",
+			expectedMsg:   "Internal Server Error",
+			expectedReqID: "11728-2929992-1",
+			retryable:     true,
+		},
+		{
+			name:         "bill manager error",
+			statusCode:   http.StatusBadRequest,
+			body:         `{"resmsg":"Shortcode already opted in","rescode":"400"}`,
+			expectedCode: "400",
+			expectedMsg:  "Shortcode already opted in",
+		},
+		{
+			name:       "gateway timeout with html body",
+			statusCode: http.StatusGatewayTimeout,
+			body:       `<html><body>Gateway Timeout</body></html>`,
+			retryable:  true,
+		},
+		{
+			name:         "empty body with too many requests",
+			statusCode:   http.StatusTooManyRequests,
+			body:         ``,
+			expectedKind: ErrSpikeArrest,
+			retryable:    true,
+		},
+		{
+			name:       "unknown error",
+			statusCode: http.StatusBadRequest,
+			body:       `{"requestId":"11728-2929992-1","errorCode":"400.999.99","errorMessage":"Something else"}`,
+
+			expectedCode:  "400.999.99",
+			expectedMsg:   "Something else",
+			expectedReqID: "11728-2929992-1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewError(tc.statusCode, []byte(tc.body))
+
+			assert.Equal(t, tc.statusCode, err.StatusCode)
+			assert.Equal(t, tc.expectedCode, err.Code)
+			assert.Equal(t, tc.expectedMsg, err.Message)
+			assert.Equal(t, tc.expectedReqID, err.RequestID)
+			assert.Equal(t, tc.body, string(err.Body))
+			assert.Equal(t, tc.expectedKind, err.Kind())
+			assert.Equal(t, tc.retryable, err.IsRetryable(), "IsRetryable")
+			assert.Equal(t, tc.auth, err.IsAuth(), "IsAuth")
+			assert.ErrorIs(t, err, errFailedToSendReq)
+			if tc.expectedKind != nil {
+				assert.ErrorIs(t, err, tc.expectedKind)
+			}
+			assert.Contains(t, err.Error(), fmt.Sprintf("status %d", tc.statusCode))
+
+			wrapped := fmt.Errorf("wrapped: %w", err)
+			assert.Equal(t, tc.retryable, IsRetryable(wrapped))
+			assert.Equal(t, tc.auth, IsAuth(wrapped))
+		})
+	}
+}
+
+func TestErrorAsRespError(t *testing.T) {
+	err := error(NewError(http.StatusBadRequest, []byte(`{"requestId":"11728-2929992-1","errorCode":"400.002.02","errorMessage":"Bad Request - Invalid PartyA"}`)))
+
+	var respErr RespError
+	require.True(t, errors.As(err, &respErr))
+	assert.Equal(t, RespError{RequestID: "11728-2929992-1", Code: "400.002.02", Message: "Bad Request - Invalid PartyA"}, respErr)
+
+	assert.False(t, IsRetryable(errFailedToSendReq))
+	assert.False(t, IsAuth(errFailedToSendReq))
+}
+
+func TestSendRequestError(t *testing.T) {
+	testCases := []struct {
+		name         string
+		statusCode   int
+		body         string
+		expectedKind error
+	}{
+		{
+			name:         "json error",
+			statusCode:   http.StatusBadRequest,
+			body:         `{"requestId":"11728-2929992-1","errorCode":"400.002.02","errorMessage":"Bad Request - Invalid CheckoutRequestID"}`,
+			expectedKind: ErrInvalidRequest,
+		},
+		{
+			name:       "html error",
+			statusCode: http.StatusBadGateway,
+			body:       `<html><body>Bad Gateway</body></html>`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+
+			_, err := sdk.ExpressQuery(context.Background(), testExpressQueryReq)
+			assert.ErrorIs(t, err, errFailedToSendReq)
+
+			var darajaErr *Error
+			require.True(t, errors.As(err, &darajaErr))
+			assert.Equal(t, tc.statusCode, darajaErr.StatusCode)
+			assert.Equal(t, tc.expectedKind, darajaErr.Kind())
+			assert.Equal(t, tc.body, string(darajaErr.Body))
+		})
+	}
+}
+
+func TestTokenError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	sdk := mSDK{baseURL: server.URL, client: server.Client()}
+
+	_, err := sdk.Token(context.Background())
+	assert.ErrorIs(t, err, errFailedToGetToken)
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	assert.True(t, IsAuth(err))
+	assert.True(t, strings.Contains(err.Error(), "status 400"))
+}
@@ -8,12 +8,15 @@ package mpesa
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 )
 
-func (sdk mSDK) ExpressSimulate(eReq ExpressSimulateReq) (ExpressSimulateResp, error) {
+func (sdk mSDK) ExpressSimulate(ctx context.Context, eReq ExpressSimulateReq) (ExpressSimulateResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpExpressSimulate)
+	defer cancel()
+
 	if err := eReq.Validate(); err != nil {
 		return ExpressSimulateResp{}, err
 	}
@@ -25,14 +28,14 @@ func (sdk mSDK) ExpressSimulate(eReq ExpressSimulateReq) (ExpressSimulateResp, e
 		return ExpressSimulateResp{}, err
 	}
 
-	url := fmt.Sprintf("%s/%s", sdk.baseURL, expressSimulateEndpoint)
+	url := sdk.url(OpExpressSimulate)
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
 	if err != nil {
 		return ExpressSimulateResp{}, err
 	}
 
-	resp, err := sdk.sendRequest(req)
+	resp, err := sdk.sendRequest(OpExpressSimulate, req)
 	if err != nil {
 		return ExpressSimulateResp{}, err
 	}
@@ -42,10 +45,17 @@ func (sdk mSDK) ExpressSimulate(eReq ExpressSimulateReq) (ExpressSimulateResp, e
 		return ExpressSimulateResp{}, err
 	}
 
+	if err := sdk.checkResponseCode(OpExpressSimulate, esr.ResponseCode, esr.ResponseDescription, resp); err != nil {
+		return ExpressSimulateResp{}, err
+	}
+
 	return esr, nil
 }
 
-func (sdk mSDK) ExpressQuery(eqReq ExpressQueryReq) (ExpressQueryResp, error) {
+func (sdk mSDK) ExpressQuery(ctx context.Context, eqReq ExpressQueryReq) (ExpressQueryResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpExpressQuery)
+	defer cancel()
+
 	if err := eqReq.Validate(); err != nil {
 		return ExpressQueryResp{}, err
 	}
@@ -57,14 +67,14 @@ func (sdk mSDK) ExpressQuery(eqReq ExpressQueryReq) (ExpressQueryResp, error) {
 		return ExpressQueryResp{}, err
 	}
 
-	url := fmt.Sprintf("%s/%s", sdk.baseURL, queryEndpoint)
+	url := sdk.url(OpExpressQuery)
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
 	if err != nil {
 		return ExpressQueryResp{}, err
 	}
 
-	resp, err := sdk.sendRequest(req)
+	resp, err := sdk.sendRequest(OpExpressQuery, req)
 	if err != nil {
 		return ExpressQueryResp{}, err
 	}
@@ -74,5 +84,9 @@ func (sdk mSDK) ExpressQuery(eqReq ExpressQueryReq) (ExpressQueryResp, error) {
 		return ExpressQueryResp{}, err
 	}
 
+	if err := sdk.checkResponseCode(OpExpressQuery, eqr.ResponseCode, eqr.ResponseDescription, resp); err != nil {
+		return ExpressQueryResp{}, err
+	}
+
 	return eqr, nil
 }
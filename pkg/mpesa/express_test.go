@@ -7,6 +7,7 @@
 package mpesa
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -236,11 +237,10 @@ func TestExpressSimulate(t *testing.T) {
 				baseURL:   server.URL,
 				appKey:    appKey,
 				appSecret: appSecret,
-				certFile:  sandboxCertificate,
 				client:    server.Client(),
 			}
 
-			response, err := sdk.ExpressSimulate(tc.request)
+			response, err := sdk.ExpressSimulate(context.Background(), tc.request)
 			assert.ErrorIs(t, err, tc.expectedErr, "%s: Expected error '%v', got '%v'", tc.name, tc.expectedErr, err)
 
 			if !reflect.DeepEqual(response, tc.expectedResponse) {
@@ -329,11 +329,10 @@ func TestExpressQuery(t *testing.T) {
 				baseURL:   server.URL,
 				appKey:    appKey,
 				appSecret: appSecret,
-				certFile:  sandboxCertificate,
 				client:    server.Client(),
 			}
 
-			response, err := sdk.ExpressQuery(tc.request)
+			response, err := sdk.ExpressQuery(context.Background(), tc.request)
 			assert.ErrorIs(t, err, tc.expectedErr, "%s: Expected error '%v', got '%v'", tc.name, tc.expectedErr, err)
 
 			if !reflect.DeepEqual(response, tc.expectedResponse) {
@@ -0,0 +1,256 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultExpressPollInterval    = 3 * time.Second
+	defaultExpressMaxPollInterval = 15 * time.Second
+
+	// callbackPollFactor slows polling down when a callback is expected.
+	callbackPollFactor = 4
+)
+
+// ExpressStatus is the final state of an STK push.
+type ExpressStatus string
+
+// STK push final states.
+const (
+	ExpressPending           ExpressStatus = "pending"            // No final state was reached before the context was done.
+	ExpressPaid              ExpressStatus = "paid"               // The customer paid.
+	ExpressCancelled         ExpressStatus = "cancelled"          // The customer cancelled the prompt.
+	ExpressTimedOut          ExpressStatus = "timed_out"          // The customer did not answer the prompt in time.
+	ExpressWrongPIN          ExpressStatus = "wrong_pin"          // The customer entered a wrong PIN.
+	ExpressInsufficientFunds ExpressStatus = "insufficient_funds" // The customer could not cover the amount.
+	ExpressFailed            ExpressStatus = "failed"             // The payment failed for another reason.
+)
+
+// expressStatus returns the final state for a result code.
+func expressStatus(code ResultCode) ExpressStatus {
+	switch {
+	case code == ResultCodeInvalidInitiator:
+		return ExpressWrongPIN
+	case code == ResultCodeInsufficientFunds:
+		return ExpressInsufficientFunds
+	case code.IsSuccess():
+		return ExpressPaid
+	case code.IsCancelled():
+		return ExpressCancelled
+	case code.IsTimeout():
+		return ExpressTimedOut
+	case code.Class() == ResultPending:
+		return ExpressPending
+	default:
+		return ExpressFailed
+	}
+}
+
+// ExpressOutcome is the final state of an STK push started with ExpressPayAndWait.
+type ExpressOutcome struct {
+	Status            ExpressStatus `json:"Status"`
+	MerchantRequestID string        `json:"MerchantRequestID,omitempty"`
+	CheckoutRequestID string        `json:"CheckoutRequestID,omitempty"`
+	ResultCode        ResultCode    `json:"ResultCode,omitempty"`
+	ResultDesc        string        `json:"ResultDesc,omitempty"`
+
+	// MpesaReceiptNumber is the receipt of a payment. Only the callback
+	// carries it, so it is empty if the outcome was polled.
+	MpesaReceiptNumber string `json:"MpesaReceiptNumber,omitempty"`
+
+	// Callback is the STK push callback the outcome was taken from. It is nil
+	// if the outcome was polled.
+	Callback *ExpressCallback `json:"Callback,omitempty"`
+}
+
+// ExpressWaitConfig configures ExpressPayAndWait.
+type ExpressWaitConfig struct {
+	// Callbacks, if set, serves the CallBackURL of the request. The outcome
+	// is then taken from the callback, and ExpressQuery is only polled at a
+	// slower pace in case the callback does not arrive.
+	Callbacks *ExpressCallbacks
+
+	// PollInterval is the delay before the first query. It doubles after
+	// every query up to MaxPollInterval. It defaults to 3 seconds.
+	PollInterval time.Duration
+
+	// MaxPollInterval caps the delay between queries. It defaults to 15 seconds.
+	MaxPollInterval time.Duration
+}
+
+// ExpressPayAndWait starts an STK push and waits until it reaches a final
+// state, which it returns. It polls ExpressQuery with backoff, treating
+// "transaction is being processed" and rate limit errors as pending, and
+// stops early when a callback arrives. If ctx is done first, it returns the
+// pending outcome along with ErrAwaitTimeout.
+func ExpressPayAndWait(ctx context.Context, sdk SDK, req ExpressSimulateReq, conf ExpressWaitConfig) (ExpressOutcome, error) {
+	if conf.PollInterval <= 0 {
+		conf.PollInterval = defaultExpressPollInterval
+	}
+	if conf.MaxPollInterval < conf.PollInterval {
+		conf.MaxPollInterval = max(defaultExpressMaxPollInterval, conf.PollInterval)
+	}
+
+	resp, err := sdk.ExpressSimulate(ctx, req)
+	if err != nil {
+		return ExpressOutcome{}, err
+	}
+
+	outcome := ExpressOutcome{
+		Status:            ExpressPending,
+		MerchantRequestID: resp.MerchantRequestID,
+		CheckoutRequestID: resp.CheckoutRequestID,
+	}
+
+	var callbacks <-chan ExpressCallback
+	interval := conf.PollInterval
+	if conf.Callbacks != nil {
+		ch, stop := conf.Callbacks.wait(resp.CheckoutRequestID)
+		defer stop()
+
+		callbacks = ch
+		interval *= callbackPollFactor
+		conf.MaxPollInterval *= callbackPollFactor
+	}
+
+	query := ExpressQueryReq{
+		PassKey:           req.PassKey,
+		BusinessShortCode: req.BusinessShortCode,
+		CheckoutRequestID: resp.CheckoutRequestID,
+	}
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return outcome, errors.Join(ErrAwaitTimeout, ctx.Err())
+		case cb := <-callbacks:
+			outcome.ResultCode = cb.ResultCode
+			outcome.ResultDesc = cb.ResultDesc
+			outcome.Status = expressStatus(cb.ResultCode)
+			outcome.MpesaReceiptNumber = cb.MpesaReceiptNumber
+			outcome.Callback = &cb
+
+			return outcome, nil
+		case <-timer.C:
+		}
+
+		qresp, err := sdk.ExpressQuery(ctx, query)
+		switch {
+		case err == nil && qresp.ResultCode != "":
+			outcome.ResultCode = qresp.ResultCode
+			outcome.ResultDesc = qresp.ResultDesc
+			outcome.Status = expressStatus(qresp.ResultCode)
+
+			return outcome, nil
+		case err != nil && ctx.Err() != nil:
+			return outcome, errors.Join(ErrAwaitTimeout, ctx.Err())
+		case err != nil && !IsRetryable(err):
+			return outcome, err
+		}
+
+		interval = min(interval*2, conf.MaxPollInterval)
+		timer.Reset(interval)
+	}
+}
+
+// ExpressCallbacks serves the CallBackURL of STK pushes started with
+// ExpressPayAndWait and hands every callback to the call waiting for it.
+type ExpressCallbacks struct {
+	mu        sync.Mutex
+	handler   http.Handler
+	next      ExpressCallbackFunc
+	retention time.Duration
+	received  map[string]receivedCallback
+	waiters   map[string]chan ExpressCallback
+}
+
+// receivedCallback is a callback that nobody has waited for yet.
+type receivedCallback struct {
+	cb         ExpressCallback
+	receivedAt time.Time
+}
+
+// NewExpressCallbacks returns the callback dispatcher of ExpressPayAndWait.
+// If next is not nil it is called with every callback as well.
+func NewExpressCallbacks(next ExpressCallbackFunc) *ExpressCallbacks {
+	c := &ExpressCallbacks{
+		next:      next,
+		retention: defaultResultRetention,
+		received:  make(map[string]receivedCallback),
+		waiters:   make(map[string]chan ExpressCallback),
+	}
+	c.handler = expressCallbackHandler{fn: c.dispatch}
+
+	return c
+}
+
+// Handler returns the handler to serve at the CallBackURL.
+func (c *ExpressCallbacks) Handler() http.Handler {
+	return c.handler
+}
+
+func (c *ExpressCallbacks) dispatch(ctx context.Context, cb ExpressCallback) error {
+	if c.next != nil {
+		if err := c.next(ctx, cb); err != nil {
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if ch, ok := c.waiters[cb.CheckoutRequestID]; ok {
+		ch <- cb
+		delete(c.waiters, cb.CheckoutRequestID)
+
+		return nil
+	}
+
+	deadline := time.Now().Add(-c.retention)
+	for id, received := range c.received {
+		if received.receivedAt.Before(deadline) {
+			delete(c.received, id)
+		}
+	}
+	c.received[cb.CheckoutRequestID] = receivedCallback{cb: cb, receivedAt: time.Now()}
+
+	return nil
+}
+
+// wait returns the channel the callback of the checkout request is sent on
+// and a function that stops waiting for it.
+func (c *ExpressCallbacks) wait(checkoutRequestID string) (<-chan ExpressCallback, func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan ExpressCallback, 1)
+	if received, ok := c.received[checkoutRequestID]; ok {
+		delete(c.received, checkoutRequestID)
+		ch <- received.cb
+
+		return ch, func() {}
+	}
+	c.waiters[checkoutRequestID] = ch
+
+	return ch, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		if c.waiters[checkoutRequestID] == ch {
+			delete(c.waiters, checkoutRequestID)
+		}
+	}
+}
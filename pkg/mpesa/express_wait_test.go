@@ -0,0 +1,258 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCheckoutRequestID = "ws_CO_191220191020363925"
+
+	expressAccepted = `{"MerchantRequestID":"29115-34620561-1","CheckoutRequestID":"ws_CO_191220191020363925","ResponseCode":"0","ResponseDescription":"Success. Request accepted for processing","CustomerMessage":"Success. Request accepted for processing"}`
+	expressPending  = `{"requestId":"29115-34620561-1","errorCode":"500.001.1001","errorMessage":"The transaction is being processed"}`
+)
+
+var testExpressWaitReq = ExpressSimulateReq{
+	PassKey:           passKey,
+	BusinessShortCode: 174379,
+	TransactionType:   "CustomerPayBillOnline",
+	PhoneNumber:       254712345678,
+	Amount:            1,
+	PartyA:            254712345678,
+	PartyB:            174379,
+	CallBackURL:       "https://example.com/callback",
+	AccountReference:  "CompanyXLTD",
+	TransactionDesc:   "Payment of X",
+}
+
+// expressServer accepts STK pushes and answers the first pending queries
+// with "transaction is being processed" and the rest with the given result.
+func expressServer(t *testing.T, pending int64, result string) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+
+	var queries atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if !strings.HasSuffix(r.URL.Path, queryEndpoint) {
+			_, _ = w.Write([]byte(expressAccepted))
+
+			return
+		}
+
+		if queries.Add(1) <= pending {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(expressPending))
+
+			return
+		}
+		_, _ = w.Write([]byte(result))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &queries
+}
+
+func TestExpressPayAndWait(t *testing.T) {
+	testCases := []struct {
+		name            string
+		pending         int64
+		result          string
+		expectedStatus  ExpressStatus
+		expectedCode    ResultCode
+		expectedQueries int64
+	}{
+		{
+			name:            "paid after pending queries",
+			pending:         2,
+			result:          `{"ResponseCode":"0","ResultCode":"0","ResultDesc":"The service request is processed successfully."}`,
+			expectedStatus:  ExpressPaid,
+			expectedCode:    ResultCodeSuccess,
+			expectedQueries: 3,
+		},
+		{
+			name:            "cancelled",
+			result:          `{"ResponseCode":"0","ResultCode":"1032","ResultDesc":"Request cancelled by user"}`,
+			expectedStatus:  ExpressCancelled,
+			expectedCode:    ResultCodeCancelledByUser,
+			expectedQueries: 1,
+		},
+		{
+			name:            "timed out",
+			result:          `{"ResponseCode":"0","ResultCode":"1037","ResultDesc":"DS timeout user cannot be reached"}`,
+			expectedStatus:  ExpressTimedOut,
+			expectedCode:    ResultCodeSubscriberUnreachable,
+			expectedQueries: 1,
+		},
+		{
+			name:            "wrong pin",
+			result:          `{"ResponseCode":"0","ResultCode":2001,"ResultDesc":"The initiator information is invalid."}`,
+			expectedStatus:  ExpressWrongPIN,
+			expectedCode:    ResultCodeInvalidInitiator,
+			expectedQueries: 1,
+		},
+		{
+			name:            "insufficient funds",
+			result:          `{"ResponseCode":"0","ResultCode":"1","ResultDesc":"The balance is insufficient for the transaction."}`,
+			expectedStatus:  ExpressInsufficientFunds,
+			expectedCode:    ResultCodeInsufficientFunds,
+			expectedQueries: 1,
+		},
+		{
+			name:            "failed",
+			result:          `{"ResponseCode":"0","ResultCode":"2","ResultDesc":"Declined due to limit rule"}`,
+			expectedStatus:  ExpressFailed,
+			expectedCode:    "2",
+			expectedQueries: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, queries := expressServer(t, tc.pending, tc.result)
+			sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+
+			outcome, err := ExpressPayAndWait(context.Background(), sdk, testExpressWaitReq, ExpressWaitConfig{PollInterval: time.Millisecond})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, outcome.Status)
+			assert.Equal(t, tc.expectedCode, outcome.ResultCode)
+			assert.Equal(t, testCheckoutRequestID, outcome.CheckoutRequestID)
+			assert.Equal(t, "29115-34620561-1", outcome.MerchantRequestID)
+			assert.Nil(t, outcome.Callback)
+			assert.Equal(t, tc.expectedQueries, queries.Load())
+		})
+	}
+}
+
+func TestExpressPayAndWaitCallback(t *testing.T) {
+	testCases := []struct {
+		name            string
+		callback        string
+		early           bool
+		expectedStatus  ExpressStatus
+		expectedReceipt string
+	}{
+		{
+			name:            "paid",
+			callback:        successfulExpressCallback,
+			expectedStatus:  ExpressPaid,
+			expectedReceipt: "NLJ7RT61SV",
+		},
+		{
+			name:           "cancelled",
+			callback:       cancelledExpressCallback,
+			expectedStatus: ExpressCancelled,
+		},
+		{
+			name:            "callback before wait",
+			callback:        successfulExpressCallback,
+			early:           true,
+			expectedStatus:  ExpressPaid,
+			expectedReceipt: "NLJ7RT61SV",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, queries := expressServer(t, 1<<10, "")
+			sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+
+			var forwarded atomic.Int64
+			callbacks := NewExpressCallbacks(func(context.Context, ExpressCallback) error {
+				forwarded.Add(1)
+
+				return nil
+			})
+
+			if tc.early {
+				postResult(t, callbacks.Handler(), tc.callback)
+			} else {
+				go func() {
+					require.Eventually(t, func() bool {
+						callbacks.mu.Lock()
+						defer callbacks.mu.Unlock()
+
+						return len(callbacks.waiters) == 1
+					}, time.Second, time.Millisecond)
+					postResult(t, callbacks.Handler(), tc.callback)
+				}()
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			outcome, err := ExpressPayAndWait(ctx, sdk, testExpressWaitReq, ExpressWaitConfig{Callbacks: callbacks, PollInterval: time.Millisecond})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, outcome.Status)
+			assert.Equal(t, tc.expectedReceipt, outcome.MpesaReceiptNumber)
+			require.NotNil(t, outcome.Callback)
+			assert.Equal(t, testCheckoutRequestID, outcome.Callback.CheckoutRequestID)
+			assert.Equal(t, int64(1), forwarded.Load())
+			assert.Less(t, queries.Load(), int64(1<<10))
+
+			callbacks.mu.Lock()
+			defer callbacks.mu.Unlock()
+			assert.Empty(t, callbacks.waiters)
+			assert.Empty(t, callbacks.received)
+		})
+	}
+}
+
+func TestExpressPayAndWaitErrors(t *testing.T) {
+	t.Run("context done while pending", func(t *testing.T) {
+		server, _ := expressServer(t, 1<<10, "")
+		sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		outcome, err := ExpressPayAndWait(ctx, sdk, testExpressWaitReq, ExpressWaitConfig{PollInterval: time.Millisecond, MaxPollInterval: 5 * time.Millisecond})
+		assert.ErrorIs(t, err, ErrAwaitTimeout)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, ExpressPending, outcome.Status)
+		assert.Equal(t, testCheckoutRequestID, outcome.CheckoutRequestID)
+	})
+
+	t.Run("query fails", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasSuffix(r.URL.Path, queryEndpoint) {
+				_, _ = w.Write([]byte(expressAccepted))
+
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"requestId":"29115-34620561-1","errorCode":"400.002.02","errorMessage":"Bad Request - Invalid CheckoutRequestID"}`))
+		}))
+		defer server.Close()
+
+		sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+
+		outcome, err := ExpressPayAndWait(context.Background(), sdk, testExpressWaitReq, ExpressWaitConfig{PollInterval: time.Millisecond})
+		assert.ErrorIs(t, err, ErrInvalidRequest)
+		assert.Equal(t, ExpressPending, outcome.Status)
+	})
+
+	t.Run("push fails", func(t *testing.T) {
+		sdk := newRetrySDK(t, "http://localhost", http.DefaultClient, nil)
+
+		req := testExpressWaitReq
+		req.PhoneNumber = 0
+
+		_, err := ExpressPayAndWait(context.Background(), sdk, req, ExpressWaitConfig{})
+		assert.Error(t, err)
+	})
+}
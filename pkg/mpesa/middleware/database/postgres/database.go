@@ -7,6 +7,7 @@
 package postgres
 
 import (
+	"context"
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 	"github.com/oklog/ulid/v2"
 	"gorm.io/driver/postgres"
@@ -15,6 +16,9 @@ import (
 
 var _ mpesa.SDK = (*postgresMiddleware)(nil)
 
+// postgresMiddleware persists every request it forwards. Records are written
+// with a context detached from the caller's cancellation so that a request
+// which reached Daraja is still stored if the caller gave up on it.
 type postgresMiddleware struct {
 	db  *gorm.DB
 	sdk mpesa.SDK
@@ -39,6 +43,19 @@ func WithDatabase(url string) mpesa.Option {
 			&transactionStatusReq{},
 			&remitTaxReq{},
 			&businessPayBillReq{},
+			&billManagerOptInReq{},
+			&billManagerUpdateOptInReq{},
+			&billManagerSingleInvoiceReq{},
+			&billManagerBulkInvoiceReq{},
+			&billManagerCancelInvoiceReq{},
+			&billManagerReconcileReq{},
+			&pullTransactionsRegisterReq{},
+			&pullTransactionsQueryReq{},
+			&standingOrderReq{},
+			&b2bExpressCheckoutReq{},
+			&b2cAccountTopUpReq{},
+			&b2PochiReq{},
+			&simSwapReq{},
 		}
 
 		if err := db.AutoMigrate(tables...); err != nil {
@@ -49,138 +66,294 @@ func WithDatabase(url string) mpesa.Option {
 	}
 }
 
-func (pm *postgresMiddleware) Token() (resp mpesa.TokenResp, err error) {
-	return pm.sdk.Token()
+func (pm *postgresMiddleware) Token(ctx context.Context) (resp mpesa.TokenResp, err error) {
+	return pm.sdk.Token(ctx)
 }
 
-func (pm *postgresMiddleware) ExpressQuery(eqReq mpesa.ExpressQueryReq) (resp mpesa.ExpressQueryResp, err error) {
+func (pm *postgresMiddleware) ExpressQuery(ctx context.Context, eqReq mpesa.ExpressQueryReq) (resp mpesa.ExpressQueryResp, err error) {
 	defer func() {
 		req := expressQueryReq{
 			ExpressQueryReq: eqReq,
 			id:              ulid.Make().String(),
 		}
-		_ = pm.db.Create(&req)
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
 	}()
 
-	return pm.sdk.ExpressQuery(eqReq)
+	return pm.sdk.ExpressQuery(ctx, eqReq)
 }
 
-func (pm *postgresMiddleware) ExpressSimulate(eReq mpesa.ExpressSimulateReq) (resp mpesa.ExpressSimulateResp, err error) {
+func (pm *postgresMiddleware) ExpressSimulate(ctx context.Context, eReq mpesa.ExpressSimulateReq) (resp mpesa.ExpressSimulateResp, err error) {
 	defer func() {
 		req := expressSimulateReq{
 			ExpressSimulateReq: eReq,
 			id:                 ulid.Make().String(),
 		}
-		_ = pm.db.Create(&req)
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
 	}()
 
-	return pm.sdk.ExpressSimulate(eReq)
+	return pm.sdk.ExpressSimulate(ctx, eReq)
 }
 
-func (pm *postgresMiddleware) B2CPayment(b2cReq mpesa.B2CPaymentReq) (resp mpesa.B2CPaymentResp, err error) {
+func (pm *postgresMiddleware) B2CPayment(ctx context.Context, b2cReq mpesa.B2CPaymentReq) (resp mpesa.B2CPaymentResp, err error) {
 	defer func() {
 		req := b2cPaymentReq{
 			B2CPaymentReq: b2cReq,
 			id:            ulid.Make().String(),
 		}
-		_ = pm.db.Create(&req)
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
 	}()
 
-	return pm.sdk.B2CPayment(b2cReq)
+	return pm.sdk.B2CPayment(ctx, b2cReq)
 }
 
-func (pm *postgresMiddleware) AccountBalance(abReq mpesa.AccountBalanceReq) (resp mpesa.AccountBalanceResp, err error) {
+func (pm *postgresMiddleware) AccountBalance(ctx context.Context, abReq mpesa.AccountBalanceReq) (resp mpesa.AccountBalanceResp, err error) {
 	defer func() {
 		req := accountBalanceReq{
 			AccountBalanceReq: abReq,
 			id:                ulid.Make().String(),
 		}
-		_ = pm.db.Create(&req)
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
 	}()
 
-	return pm.sdk.AccountBalance(abReq)
+	return pm.sdk.AccountBalance(ctx, abReq)
 }
 
-func (pm *postgresMiddleware) C2BRegisterURL(c2bReq mpesa.C2BRegisterURLReq) (resp mpesa.C2BRegisterURLResp, err error) {
+func (pm *postgresMiddleware) C2BRegisterURL(ctx context.Context, c2bReq mpesa.C2BRegisterURLReq) (resp mpesa.C2BRegisterURLResp, err error) {
 	defer func() {
 		req := c2bRegisterURLReq{
 			C2BRegisterURLReq: c2bReq,
 			id:                ulid.Make().String(),
 		}
-		_ = pm.db.Create(&req)
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
 	}()
 
-	return pm.sdk.C2BRegisterURL(c2bReq)
+	return pm.sdk.C2BRegisterURL(ctx, c2bReq)
 }
 
-func (pm *postgresMiddleware) C2BSimulate(c2bReq mpesa.C2BSimulateReq) (resp mpesa.C2BSimulateResp, err error) {
+func (pm *postgresMiddleware) C2BSimulate(ctx context.Context, c2bReq mpesa.C2BSimulateReq) (resp mpesa.C2BSimulateResp, err error) {
 	defer func() {
 		req := c2bSimulateReq{
 			C2BSimulateReq: c2bReq,
 			id:             ulid.Make().String(),
 		}
-		_ = pm.db.Create(&req)
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
 	}()
 
-	return pm.sdk.C2BSimulate(c2bReq)
+	return pm.sdk.C2BSimulate(ctx, c2bReq)
 }
 
-func (pm *postgresMiddleware) GenerateQR(gqrReq mpesa.GenerateQRReq) (resp mpesa.GenerateQRResp, err error) {
+func (pm *postgresMiddleware) GenerateQR(ctx context.Context, gqrReq mpesa.GenerateQRReq) (resp mpesa.GenerateQRResp, err error) {
 	defer func() {
 		req := generateQRReq{
 			GenerateQRReq: gqrReq,
 			id:            ulid.Make().String(),
 		}
-		_ = pm.db.Create(&req)
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
 	}()
 
-	return pm.sdk.GenerateQR(gqrReq)
+	return pm.sdk.GenerateQR(ctx, gqrReq)
 }
 
-func (pm *postgresMiddleware) Reverse(rReq mpesa.ReverseReq) (resp mpesa.ReverseResp, err error) {
+func (pm *postgresMiddleware) Reverse(ctx context.Context, rReq mpesa.ReverseReq) (resp mpesa.ReverseResp, err error) {
 	defer func() {
 		req := reverseReq{
 			ReverseReq: rReq,
 			id:         ulid.Make().String(),
 		}
-		_ = pm.db.Create(&req)
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
 	}()
 
-	return pm.sdk.Reverse(rReq)
+	return pm.sdk.Reverse(ctx, rReq)
 }
 
-func (pm *postgresMiddleware) TransactionStatus(tsReq mpesa.TransactionStatusReq) (resp mpesa.TransactionStatusResp, err error) {
+func (pm *postgresMiddleware) TransactionStatus(ctx context.Context, tsReq mpesa.TransactionStatusReq) (resp mpesa.TransactionStatusResp, err error) {
 	defer func() {
 		req := transactionStatusReq{
 			TransactionStatusReq: tsReq,
 			id:                   ulid.Make().String(),
 		}
-		_ = pm.db.Create(&req)
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
 	}()
 
-	return pm.sdk.TransactionStatus(tsReq)
+	return pm.sdk.TransactionStatus(ctx, tsReq)
 }
 
-func (pm *postgresMiddleware) RemitTax(rtReq mpesa.RemitTaxReq) (resp mpesa.RemitTaxResp, err error) {
+func (pm *postgresMiddleware) RemitTax(ctx context.Context, rtReq mpesa.RemitTaxReq) (resp mpesa.RemitTaxResp, err error) {
 	defer func() {
 		req := remitTaxReq{
 			RemitTaxReq: rtReq,
 			id:          ulid.Make().String(),
 		}
-		_ = pm.db.Create(&req)
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
 	}()
 
-	return pm.sdk.RemitTax(rtReq)
+	return pm.sdk.RemitTax(ctx, rtReq)
 }
 
-func (pm *postgresMiddleware) BusinessPayBill(bpbReq mpesa.BusinessPayBillReq) (resp mpesa.BusinessPayBillResp, err error) {
+func (pm *postgresMiddleware) BusinessPayBill(ctx context.Context, bpbReq mpesa.BusinessPayBillReq) (resp mpesa.BusinessPayBillResp, err error) {
 	defer func() {
 		req := businessPayBillReq{
 			BusinessPayBillReq: bpbReq,
 			id:                 ulid.Make().String(),
 		}
-		_ = pm.db.Create(&req)
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
 	}()
 
-	return pm.sdk.BusinessPayBill(bpbReq)
+	return pm.sdk.BusinessPayBill(ctx, bpbReq)
+}
+
+func (pm *postgresMiddleware) BillManagerOptIn(ctx context.Context, oReq mpesa.BillManagerOptInReq) (resp mpesa.BillManagerOptInResp, err error) {
+	defer func() {
+		req := billManagerOptInReq{
+			BillManagerOptInReq: oReq,
+			id:                  ulid.Make().String(),
+		}
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
+	}()
+
+	return pm.sdk.BillManagerOptIn(ctx, oReq)
+}
+
+func (pm *postgresMiddleware) BillManagerUpdateOptIn(ctx context.Context, uReq mpesa.BillManagerUpdateOptInReq) (resp mpesa.BillManagerUpdateOptInResp, err error) {
+	defer func() {
+		req := billManagerUpdateOptInReq{
+			BillManagerUpdateOptInReq: uReq,
+			id:                        ulid.Make().String(),
+		}
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
+	}()
+
+	return pm.sdk.BillManagerUpdateOptIn(ctx, uReq)
+}
+
+func (pm *postgresMiddleware) BillManagerSingleInvoice(ctx context.Context, iReq mpesa.BillManagerSingleInvoiceReq) (resp mpesa.BillManagerSingleInvoiceResp, err error) {
+	defer func() {
+		req := newBillManagerSingleInvoiceReq(iReq)
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
+	}()
+
+	return pm.sdk.BillManagerSingleInvoice(ctx, iReq)
+}
+
+func (pm *postgresMiddleware) BillManagerBulkInvoice(ctx context.Context, bReq mpesa.BillManagerBulkInvoiceReq) (resp mpesa.BillManagerBulkInvoiceResp, err error) {
+	defer func() {
+		req := billManagerBulkInvoiceReq{
+			Invoices: bReq.Invoices,
+			id:       ulid.Make().String(),
+		}
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
+	}()
+
+	return pm.sdk.BillManagerBulkInvoice(ctx, bReq)
+}
+
+func (pm *postgresMiddleware) BillManagerCancelInvoice(ctx context.Context, cReq mpesa.BillManagerCancelInvoiceReq) (resp mpesa.BillManagerCancelInvoiceResp, err error) {
+	defer func() {
+		req := billManagerCancelInvoiceReq{
+			ExternalReferences: cReq.ExternalReferences,
+			id:                 ulid.Make().String(),
+		}
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
+	}()
+
+	return pm.sdk.BillManagerCancelInvoice(ctx, cReq)
+}
+
+func (pm *postgresMiddleware) BillManagerReconcile(ctx context.Context, rReq mpesa.BillManagerReconcileReq) (resp mpesa.BillManagerReconcileResp, err error) {
+	defer func() {
+		req := billManagerReconcileReq{
+			BillManagerReconcileReq: rReq,
+			id:                      ulid.Make().String(),
+		}
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
+	}()
+
+	return pm.sdk.BillManagerReconcile(ctx, rReq)
+}
+
+func (pm *postgresMiddleware) PullTransactionsRegister(ctx context.Context, prReq mpesa.PullTransactionsRegisterReq) (resp mpesa.PullTransactionsRegisterResp, err error) {
+	defer func() {
+		req := pullTransactionsRegisterReq{
+			PullTransactionsRegisterReq: prReq,
+			id:                          ulid.Make().String(),
+		}
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
+	}()
+
+	return pm.sdk.PullTransactionsRegister(ctx, prReq)
+}
+
+func (pm *postgresMiddleware) PullTransactionsQuery(ctx context.Context, pqReq mpesa.PullTransactionsQueryReq) (resp mpesa.PullTransactionsQueryResp, err error) {
+	defer func() {
+		req := pullTransactionsQueryReq{
+			PullTransactionsQueryReq: pqReq,
+			id:                       ulid.Make().String(),
+		}
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
+	}()
+
+	return pm.sdk.PullTransactionsQuery(ctx, pqReq)
+}
+
+func (pm *postgresMiddleware) StandingOrder(ctx context.Context, soReq mpesa.StandingOrderReq) (resp mpesa.StandingOrderResp, err error) {
+	defer func() {
+		req := standingOrderReq{
+			StandingOrderReq: soReq,
+			id:               ulid.Make().String(),
+		}
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
+	}()
+
+	return pm.sdk.StandingOrder(ctx, soReq)
+}
+
+func (pm *postgresMiddleware) B2BExpressCheckout(ctx context.Context, b2bReq mpesa.B2BExpressCheckoutReq) (resp mpesa.B2BExpressCheckoutResp, err error) {
+	defer func() {
+		req := b2bExpressCheckoutReq{
+			B2BExpressCheckoutReq: b2bReq,
+			id:                    ulid.Make().String(),
+		}
+		if req.RequestRefID == "" {
+			req.RequestRefID = resp.RequestRefID
+		}
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
+	}()
+
+	return pm.sdk.B2BExpressCheckout(ctx, b2bReq)
+}
+
+func (pm *postgresMiddleware) B2CAccountTopUp(ctx context.Context, topUpReq mpesa.B2CAccountTopUpReq) (resp mpesa.B2CAccountTopUpResp, err error) {
+	defer func() {
+		req := b2cAccountTopUpReq{
+			B2CAccountTopUpReq: topUpReq,
+			id:                 ulid.Make().String(),
+		}
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
+	}()
+
+	return pm.sdk.B2CAccountTopUp(ctx, topUpReq)
+}
+
+func (pm *postgresMiddleware) B2Pochi(ctx context.Context, pochiReq mpesa.B2PochiReq) (resp mpesa.B2PochiResp, err error) {
+	defer func() {
+		req := b2PochiReq{
+			B2PochiReq: pochiReq,
+			id:         ulid.Make().String(),
+		}
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
+	}()
+
+	return pm.sdk.B2Pochi(ctx, pochiReq)
+}
+
+func (pm *postgresMiddleware) SIMSwap(ctx context.Context, ssReq mpesa.SIMSwapReq) (resp mpesa.SIMSwapResp, err error) {
+	defer func() {
+		req := simSwapReq{
+			SIMSwapReq: ssReq,
+			id:         ulid.Make().String(),
+		}
+		_ = pm.db.WithContext(context.WithoutCancel(ctx)).Create(&req)
+	}()
+
+	return pm.sdk.SIMSwap(ctx, ssReq)
 }
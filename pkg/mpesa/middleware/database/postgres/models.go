@@ -8,6 +8,7 @@ package postgres
 
 import (
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+	"github.com/oklog/ulid/v2"
 	"gorm.io/gorm"
 )
 
@@ -76,3 +77,106 @@ type businessPayBillReq struct {
 	mpesa.BusinessPayBillReq
 	id string
 }
+
+type billManagerOptInReq struct {
+	gorm.Model
+	mpesa.BillManagerOptInReq
+	id string
+}
+
+type billManagerUpdateOptInReq struct {
+	gorm.Model
+	mpesa.BillManagerUpdateOptInReq
+	id string
+}
+
+// billManagerSingleInvoiceReq lists the invoice fields instead of embedding
+// the request since gorm cannot embed the slice of invoice items.
+type billManagerSingleInvoiceReq struct {
+	gorm.Model
+	ExternalReference string
+	BilledFullName    string
+	BilledPhoneNumber uint64
+	BilledPeriod      string
+	InvoiceName       string
+	DueDate           string
+	AccountReference  string
+	Amount            uint64
+	InvoiceItems      []mpesa.BillManagerInvoiceItem `gorm:"serializer:json"`
+	id                string
+}
+
+func newBillManagerSingleInvoiceReq(req mpesa.BillManagerSingleInvoiceReq) billManagerSingleInvoiceReq {
+	return billManagerSingleInvoiceReq{
+		ExternalReference: req.ExternalReference,
+		BilledFullName:    req.BilledFullName,
+		BilledPhoneNumber: req.BilledPhoneNumber,
+		BilledPeriod:      req.BilledPeriod,
+		InvoiceName:       req.InvoiceName,
+		DueDate:           req.DueDate,
+		AccountReference:  req.AccountReference,
+		Amount:            req.Amount,
+		InvoiceItems:      req.InvoiceItems,
+		id:                ulid.Make().String(),
+	}
+}
+
+type billManagerBulkInvoiceReq struct {
+	gorm.Model
+	Invoices []mpesa.BillManagerSingleInvoiceReq `gorm:"serializer:json"`
+	id       string
+}
+
+type billManagerCancelInvoiceReq struct {
+	gorm.Model
+	ExternalReferences []string `gorm:"serializer:json"`
+	id                 string
+}
+
+type billManagerReconcileReq struct {
+	gorm.Model
+	mpesa.BillManagerReconcileReq
+	id string
+}
+
+type pullTransactionsRegisterReq struct {
+	gorm.Model
+	mpesa.PullTransactionsRegisterReq
+	id string
+}
+
+type pullTransactionsQueryReq struct {
+	gorm.Model
+	mpesa.PullTransactionsQueryReq
+	id string
+}
+
+type standingOrderReq struct {
+	gorm.Model
+	mpesa.StandingOrderReq
+	id string
+}
+
+type b2bExpressCheckoutReq struct {
+	gorm.Model
+	mpesa.B2BExpressCheckoutReq
+	id string
+}
+
+type b2cAccountTopUpReq struct {
+	gorm.Model
+	mpesa.B2CAccountTopUpReq
+	id string
+}
+
+type b2PochiReq struct {
+	gorm.Model
+	mpesa.B2PochiReq
+	id string
+}
+
+type simSwapReq struct {
+	gorm.Model
+	mpesa.SIMSwapReq
+	id string
+}
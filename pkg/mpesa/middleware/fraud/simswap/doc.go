@@ -0,0 +1,10 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package simswap implements a fraud screening middleware to be used with
+// MpesaOverlay SDK that checks whether the SIM of the recipient of a payment
+// was swapped recently before the payment is sent.
+package simswap
@@ -0,0 +1,161 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simswap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+)
+
+// DefaultWindow is the screening window used when Config.Window is not set.
+const DefaultWindow = 72 * time.Hour
+
+// acceptedResponseCode is the ResponseCode of a successful SIM swap check.
+const acceptedResponseCode = "200"
+
+var (
+	// errInvalidWindow indicates that the screening window is negative.
+	errInvalidWindow = errors.New("invalid sim swap window")
+
+	// errInvalidAction indicates that the action is not supported.
+	errInvalidAction = errors.New("invalid sim swap action, must be one of block or flag")
+)
+
+var _ mpesa.SDK = (*guardMiddleware)(nil)
+
+// Action is what the guard does with a payment to a recently swapped SIM.
+type Action uint8
+
+const (
+	// Block does not send the payment and returns an error wrapping
+	// mpesa.ErrSIMSwapped.
+	Block Action = iota
+
+	// Flag sends the payment and only reports it to Config.OnFlag.
+	Flag
+)
+
+// Swap describes a payment to a recently swapped SIM.
+type Swap struct {
+	Op           mpesa.Operation // The operation of the payment, B2CPayment or B2Pochi.
+	MSISDN       uint64          // The phone number of the recipient.
+	Amount       uint64          // The amount of the payment.
+	LastSwapDate time.Time       // The time the SIM was last swapped.
+}
+
+// Config configures the SIM swap guard.
+type Config struct {
+	// Window is how recent a SIM swap must be for a payment to be blocked or
+	// flagged. It defaults to DefaultWindow.
+	Window time.Duration
+
+	// MinAmount is the smallest amount that is screened. Smaller payments are
+	// sent without a check.
+	MinAmount uint64
+
+	// Action is what is done with a payment to a recently swapped SIM. It
+	// defaults to Block.
+	Action Action
+
+	// OnFlag is called for every payment to a recently swapped SIM, whether
+	// it is blocked or flagged.
+	OnFlag func(ctx context.Context, swap Swap)
+
+	// FailOpen sends the payment when the SIM swap check fails or Daraja
+	// rejects it with a ResponseCode other than 200. By default the payment is
+	// not sent and the error of the check is returned.
+	FailOpen bool
+}
+
+type guardMiddleware struct {
+	mpesa.SDK
+	cfg Config
+	now func() time.Time
+}
+
+// WithGuard returns a middleware that checks the SIM of the recipient of
+// B2CPayment and B2Pochi requests with SIMSwap before they are sent. The
+// other operations are passed through unchanged.
+func WithGuard(cfg Config) mpesa.Option {
+	return func(sdk mpesa.SDK) (mpesa.SDK, error) {
+		if cfg.Window < 0 {
+			return nil, fmt.Errorf("%w: %s", errInvalidWindow, cfg.Window)
+		}
+		if cfg.Window == 0 {
+			cfg.Window = DefaultWindow
+		}
+		if cfg.Action != Block && cfg.Action != Flag {
+			return nil, errInvalidAction
+		}
+
+		return &guardMiddleware{SDK: sdk, cfg: cfg, now: time.Now}, nil
+	}
+}
+
+func (gm *guardMiddleware) B2CPayment(ctx context.Context, b2cReq mpesa.B2CPaymentReq) (mpesa.B2CPaymentResp, error) {
+	if err := gm.screen(ctx, mpesa.OpB2CPayment, b2cReq.PartyB, b2cReq.Amount); err != nil {
+		return mpesa.B2CPaymentResp{}, err
+	}
+
+	return gm.SDK.B2CPayment(ctx, b2cReq)
+}
+
+func (gm *guardMiddleware) B2Pochi(ctx context.Context, pochiReq mpesa.B2PochiReq) (mpesa.B2PochiResp, error) {
+	if err := gm.screen(ctx, mpesa.OpB2Pochi, pochiReq.PartyB, pochiReq.Amount); err != nil {
+		return mpesa.B2PochiResp{}, err
+	}
+
+	return gm.SDK.B2Pochi(ctx, pochiReq)
+}
+
+// screen returns an error if the payment must not be sent.
+func (gm *guardMiddleware) screen(ctx context.Context, op mpesa.Operation, msisdn, amount uint64) error {
+	if amount < gm.cfg.MinAmount {
+		return nil
+	}
+
+	resp, err := gm.SDK.SIMSwap(ctx, mpesa.SIMSwapReq{CustomerNumber: msisdn})
+	if err == nil && resp.ResponseCode != acceptedResponseCode {
+		// Without strict mode a rejected check is returned in a 200 OK body
+		// with no LastSwapDate, which must not pass as a SIM that was never swapped.
+		err = &mpesa.RejectionError{
+			Op:                  mpesa.OpSIMSwap,
+			ResponseCode:        resp.ResponseCode,
+			ResponseDescription: resp.ResponseDescription,
+		}
+	}
+	if err != nil {
+		if gm.cfg.FailOpen {
+			return nil
+		}
+
+		return fmt.Errorf("sim swap check of %d: %w", msisdn, err)
+	}
+
+	if !resp.SwappedWithin(gm.cfg.Window, gm.now()) {
+		return nil
+	}
+
+	if gm.cfg.OnFlag != nil {
+		gm.cfg.OnFlag(ctx, Swap{
+			Op:           op,
+			MSISDN:       msisdn,
+			Amount:       amount,
+			LastSwapDate: resp.LastSwapDate,
+		})
+	}
+
+	if gm.cfg.Action == Flag {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %d on %s", mpesa.ErrSIMSwapped, msisdn, resp.LastSwapDate.Format(time.RFC3339))
+}
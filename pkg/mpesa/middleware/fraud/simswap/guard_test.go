@@ -0,0 +1,229 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simswap
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
+	"github.com/0x6flab/mpesaoverlay/pkg/mpesa/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errMock = errors.New("mock error")
+	now     = time.Date(2024, time.March, 18, 8, 0, 0, 0, time.UTC)
+	b2cReq  = mpesa.B2CPaymentReq{
+		InitiatorName: "testapi",
+		CommandID:     "BusinessPayment",
+		Amount:        50000,
+		PartyA:        600986,
+		PartyB:        254712345678,
+	}
+	b2cResp = mpesa.B2CPaymentResp{ValidResp: mpesa.ValidResp{ResponseCode: "0"}}
+)
+
+func newGuard(t *testing.T, sdk mpesa.SDK, cfg Config) *guardMiddleware {
+	t.Helper()
+
+	s, err := WithGuard(cfg)(sdk)
+	require.NoError(t, err)
+
+	gm, ok := s.(*guardMiddleware)
+	require.True(t, ok)
+	gm.now = func() time.Time { return now }
+
+	return gm
+}
+
+func TestWithGuard(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cfg         Config
+		window      time.Duration
+		expectedErr error
+	}{
+		{
+			name:   "default window",
+			cfg:    Config{},
+			window: DefaultWindow,
+		},
+		{
+			name:   "custom window",
+			cfg:    Config{Window: time.Hour, Action: Flag},
+			window: time.Hour,
+		},
+		{
+			name:        "negative window",
+			cfg:         Config{Window: -time.Hour},
+			expectedErr: errInvalidWindow,
+		},
+		{
+			name:        "invalid action",
+			cfg:         Config{Action: 2},
+			expectedErr: errInvalidAction,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := WithGuard(tc.cfg)(new(mocks.SDK))
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			gm, ok := s.(*guardMiddleware)
+			require.True(t, ok)
+			assert.Equal(t, tc.window, gm.cfg.Window)
+		})
+	}
+}
+
+func TestB2CPayment(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cfg         Config
+		swapResp    mpesa.SIMSwapResp
+		swapErr     error
+		checked     bool
+		sent        bool
+		flagged     bool
+		expectedErr error
+	}{
+		{
+			name:     "never swapped",
+			swapResp: mpesa.SIMSwapResp{ResponseCode: "200"},
+			checked:  true,
+			sent:     true,
+		},
+		{
+			name:     "swapped before the window",
+			swapResp: mpesa.SIMSwapResp{ResponseCode: "200", LastSwapDate: now.Add(-30 * 24 * time.Hour)},
+			checked:  true,
+			sent:     true,
+		},
+		{
+			name:        "swapped within the window is blocked",
+			swapResp:    mpesa.SIMSwapResp{ResponseCode: "200", LastSwapDate: now.Add(-time.Hour)},
+			checked:     true,
+			flagged:     true,
+			expectedErr: mpesa.ErrSIMSwapped,
+		},
+		{
+			name:     "swapped within the window is flagged",
+			cfg:      Config{Action: Flag},
+			swapResp: mpesa.SIMSwapResp{ResponseCode: "200", LastSwapDate: now.Add(-time.Hour)},
+			checked:  true,
+			sent:     true,
+			flagged:  true,
+		},
+		{
+			name:        "swapped within a custom window",
+			cfg:         Config{Window: 30 * 24 * time.Hour},
+			swapResp:    mpesa.SIMSwapResp{ResponseCode: "200", LastSwapDate: now.Add(-7 * 24 * time.Hour)},
+			checked:     true,
+			flagged:     true,
+			expectedErr: mpesa.ErrSIMSwapped,
+		},
+		{
+			name: "below the minimum amount",
+			cfg:  Config{MinAmount: 100000},
+			sent: true,
+		},
+		{
+			name:        "failed check",
+			swapErr:     errMock,
+			checked:     true,
+			expectedErr: errMock,
+		},
+		{
+			name:    "failed check with fail open",
+			cfg:     Config{FailOpen: true},
+			swapErr: errMock,
+			checked: true,
+			sent:    true,
+		},
+		{
+			name:        "rejected check",
+			swapResp:    mpesa.SIMSwapResp{ResponseCode: "401", ResponseDescription: "Subscriber not found"},
+			checked:     true,
+			expectedErr: mpesa.ErrRejected,
+		},
+		{
+			name:     "rejected check with fail open",
+			cfg:      Config{FailOpen: true},
+			swapResp: mpesa.SIMSwapResp{ResponseCode: "401", ResponseDescription: "Subscriber not found"},
+			checked:  true,
+			sent:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var flagged []Swap
+			tc.cfg.OnFlag = func(_ context.Context, swap Swap) {
+				flagged = append(flagged, swap)
+			}
+
+			sdk := new(mocks.SDK)
+			sdk.On("SIMSwap", mock.Anything, mpesa.SIMSwapReq{CustomerNumber: b2cReq.PartyB}).Return(tc.swapResp, tc.swapErr)
+			sdk.On("B2CPayment", mock.Anything, b2cReq).Return(b2cResp, nil)
+
+			resp, err := newGuard(t, sdk, tc.cfg).B2CPayment(context.Background(), b2cReq)
+			assert.ErrorIs(t, err, tc.expectedErr)
+
+			if tc.checked {
+				sdk.AssertCalled(t, "SIMSwap", mock.Anything, mock.Anything)
+			} else {
+				sdk.AssertNotCalled(t, "SIMSwap", mock.Anything, mock.Anything)
+			}
+			if tc.sent {
+				assert.Equal(t, b2cResp, resp)
+				sdk.AssertCalled(t, "B2CPayment", mock.Anything, b2cReq)
+			} else {
+				assert.Equal(t, mpesa.B2CPaymentResp{}, resp)
+				sdk.AssertNotCalled(t, "B2CPayment", mock.Anything, mock.Anything)
+			}
+			if tc.flagged {
+				require.Len(t, flagged, 1)
+				assert.Equal(t, Swap{Op: mpesa.OpB2CPayment, MSISDN: b2cReq.PartyB, Amount: b2cReq.Amount, LastSwapDate: tc.swapResp.LastSwapDate}, flagged[0])
+			} else {
+				assert.Empty(t, flagged)
+			}
+		})
+	}
+}
+
+func TestB2Pochi(t *testing.T) {
+	req := mpesa.B2PochiReq{Amount: 50000, PartyA: 600996, PartyB: 254728762287}
+
+	sdk := new(mocks.SDK)
+	sdk.On("SIMSwap", mock.Anything, mpesa.SIMSwapReq{CustomerNumber: req.PartyB}).Return(mpesa.SIMSwapResp{ResponseCode: "200", LastSwapDate: now.Add(-time.Hour)}, nil)
+
+	_, err := newGuard(t, sdk, Config{}).B2Pochi(context.Background(), req)
+	assert.ErrorIs(t, err, mpesa.ErrSIMSwapped)
+	sdk.AssertNotCalled(t, "B2Pochi", mock.Anything, mock.Anything)
+}
+
+func TestPassThrough(t *testing.T) {
+	req := mpesa.AccountBalanceReq{CommandID: "AccountBalance", PartyA: 600986, IdentifierType: 4}
+	resp := mpesa.AccountBalanceResp{ValidResp: mpesa.ValidResp{ResponseCode: "0"}}
+
+	sdk := new(mocks.SDK)
+	sdk.On("AccountBalance", mock.Anything, req).Return(resp, nil)
+
+	got, err := newGuard(t, sdk, Config{}).AccountBalance(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, resp, got)
+	sdk.AssertNotCalled(t, "SIMSwap", mock.Anything, mock.Anything)
+}
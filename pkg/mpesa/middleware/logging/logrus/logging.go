@@ -7,6 +7,7 @@
 package logrus
 
 import (
+	"context"
 	"time"
 
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
@@ -32,7 +33,7 @@ func WithLogger(logger *log.Logger) mpesa.Option {
 	}
 }
 
-func (lm *loggingMiddleware) Token() (resp mpesa.TokenResp, err error) {
+func (lm *loggingMiddleware) Token(ctx context.Context) (resp mpesa.TokenResp, err error) {
 	defer func(begin time.Time) {
 		fields := log.Fields{
 			"duration": time.Since(begin).String(),
@@ -46,10 +47,10 @@ func (lm *loggingMiddleware) Token() (resp mpesa.TokenResp, err error) {
 		}
 	}(time.Now())
 
-	return lm.sdk.Token()
+	return lm.sdk.Token(ctx)
 }
 
-func (lm *loggingMiddleware) ExpressQuery(eqReq mpesa.ExpressQueryReq) (resp mpesa.ExpressQueryResp, err error) {
+func (lm *loggingMiddleware) ExpressQuery(ctx context.Context, eqReq mpesa.ExpressQueryReq) (resp mpesa.ExpressQueryResp, err error) {
 	defer func(begin time.Time) {
 		fields := log.Fields{
 			"duration":          time.Since(begin).String(),
@@ -65,10 +66,10 @@ func (lm *loggingMiddleware) ExpressQuery(eqReq mpesa.ExpressQueryReq) (resp mpe
 		}
 	}(time.Now())
 
-	return lm.sdk.ExpressQuery(eqReq)
+	return lm.sdk.ExpressQuery(ctx, eqReq)
 }
 
-func (lm *loggingMiddleware) ExpressSimulate(eReq mpesa.ExpressSimulateReq) (resp mpesa.ExpressSimulateResp, err error) {
+func (lm *loggingMiddleware) ExpressSimulate(ctx context.Context, eReq mpesa.ExpressSimulateReq) (resp mpesa.ExpressSimulateResp, err error) {
 	defer func(begin time.Time) {
 		fields := log.Fields{
 			"duration":          time.Since(begin).String(),
@@ -89,10 +90,10 @@ func (lm *loggingMiddleware) ExpressSimulate(eReq mpesa.ExpressSimulateReq) (res
 		}
 	}(time.Now())
 
-	return lm.sdk.ExpressSimulate(eReq)
+	return lm.sdk.ExpressSimulate(ctx, eReq)
 }
 
-func (lm *loggingMiddleware) B2CPayment(b2cReq mpesa.B2CPaymentReq) (resp mpesa.B2CPaymentResp, err error) {
+func (lm *loggingMiddleware) B2CPayment(ctx context.Context, b2cReq mpesa.B2CPaymentReq) (resp mpesa.B2CPaymentResp, err error) {
 	defer func(begin time.Time) {
 		fields := log.Fields{
 			"duration":               time.Since(begin).String(),
@@ -113,10 +114,10 @@ func (lm *loggingMiddleware) B2CPayment(b2cReq mpesa.B2CPaymentReq) (resp mpesa.
 		}
 	}(time.Now())
 
-	return lm.sdk.B2CPayment(b2cReq)
+	return lm.sdk.B2CPayment(ctx, b2cReq)
 }
 
-func (lm *loggingMiddleware) AccountBalance(abReq mpesa.AccountBalanceReq) (resp mpesa.AccountBalanceResp, err error) {
+func (lm *loggingMiddleware) AccountBalance(ctx context.Context, abReq mpesa.AccountBalanceReq) (resp mpesa.AccountBalanceResp, err error) {
 	defer func(begin time.Time) {
 		fields := log.Fields{
 			"duration":           time.Since(begin).String(),
@@ -135,10 +136,10 @@ func (lm *loggingMiddleware) AccountBalance(abReq mpesa.AccountBalanceReq) (resp
 		}
 	}(time.Now())
 
-	return lm.sdk.AccountBalance(abReq)
+	return lm.sdk.AccountBalance(ctx, abReq)
 }
 
-func (lm *loggingMiddleware) C2BRegisterURL(c2bReq mpesa.C2BRegisterURLReq) (resp mpesa.C2BRegisterURLResp, err error) {
+func (lm *loggingMiddleware) C2BRegisterURL(ctx context.Context, c2bReq mpesa.C2BRegisterURLReq) (resp mpesa.C2BRegisterURLResp, err error) {
 	defer func(begin time.Time) {
 		fields := log.Fields{
 			"duration":     time.Since(begin).String(),
@@ -154,10 +155,10 @@ func (lm *loggingMiddleware) C2BRegisterURL(c2bReq mpesa.C2BRegisterURLReq) (res
 		}
 	}(time.Now())
 
-	return lm.sdk.C2BRegisterURL(c2bReq)
+	return lm.sdk.C2BRegisterURL(ctx, c2bReq)
 }
 
-func (lm *loggingMiddleware) C2BSimulate(c2bReq mpesa.C2BSimulateReq) (resp mpesa.C2BSimulateResp, err error) {
+func (lm *loggingMiddleware) C2BSimulate(ctx context.Context, c2bReq mpesa.C2BSimulateReq) (resp mpesa.C2BSimulateResp, err error) {
 	defer func(begin time.Time) {
 		fields := log.Fields{
 			"duration":      time.Since(begin).String(),
@@ -176,10 +177,10 @@ func (lm *loggingMiddleware) C2BSimulate(c2bReq mpesa.C2BSimulateReq) (resp mpes
 		}
 	}(time.Now())
 
-	return lm.sdk.C2BSimulate(c2bReq)
+	return lm.sdk.C2BSimulate(ctx, c2bReq)
 }
 
-func (lm *loggingMiddleware) GenerateQR(qReq mpesa.GenerateQRReq) (resp mpesa.GenerateQRResp, err error) {
+func (lm *loggingMiddleware) GenerateQR(ctx context.Context, qReq mpesa.GenerateQRReq) (resp mpesa.GenerateQRResp, err error) {
 	defer func(begin time.Time) {
 		fields := log.Fields{
 			"duration":     time.Since(begin).String(),
@@ -199,10 +200,10 @@ func (lm *loggingMiddleware) GenerateQR(qReq mpesa.GenerateQRReq) (resp mpesa.Ge
 		}
 	}(time.Now())
 
-	return lm.sdk.GenerateQR(qReq)
+	return lm.sdk.GenerateQR(ctx, qReq)
 }
 
-func (lm *loggingMiddleware) Reverse(rReq mpesa.ReverseReq) (resp mpesa.ReverseResp, err error) {
+func (lm *loggingMiddleware) Reverse(ctx context.Context, rReq mpesa.ReverseReq) (resp mpesa.ReverseResp, err error) {
 	defer func(begin time.Time) {
 		fields := log.Fields{
 			"duration":               time.Since(begin).String(),
@@ -222,18 +223,19 @@ func (lm *loggingMiddleware) Reverse(rReq mpesa.ReverseReq) (resp mpesa.ReverseR
 		}
 	}(time.Now())
 
-	return lm.sdk.Reverse(rReq)
+	return lm.sdk.Reverse(ctx, rReq)
 }
 
-func (lm *loggingMiddleware) TransactionStatus(tReq mpesa.TransactionStatusReq) (resp mpesa.TransactionStatusResp, err error) {
+func (lm *loggingMiddleware) TransactionStatus(ctx context.Context, tReq mpesa.TransactionStatusReq) (resp mpesa.TransactionStatusResp, err error) {
 	defer func(begin time.Time) {
 		fields := log.Fields{
-			"duration":       time.Since(begin).String(),
-			"CommandID":      tReq.CommandID,
-			"Initiator":      tReq.InitiatorName,
-			"TransactionID":  tReq.TransactionID,
-			"PartyA":         tReq.PartyA,
-			"IdentifierType": tReq.IdentifierType,
+			"duration":                 time.Since(begin).String(),
+			"CommandID":                tReq.CommandID,
+			"Initiator":                tReq.InitiatorName,
+			"TransactionID":            tReq.TransactionID,
+			"OriginatorConversationID": tReq.OriginatorConversationID,
+			"PartyA":                   tReq.PartyA,
+			"IdentifierType":           tReq.IdentifierType,
 		}
 		switch err {
 		case nil:
@@ -244,10 +246,10 @@ func (lm *loggingMiddleware) TransactionStatus(tReq mpesa.TransactionStatusReq)
 		}
 	}(time.Now())
 
-	return lm.sdk.TransactionStatus(tReq)
+	return lm.sdk.TransactionStatus(ctx, tReq)
 }
 
-func (lm *loggingMiddleware) RemitTax(rReq mpesa.RemitTaxReq) (resp mpesa.RemitTaxResp, err error) {
+func (lm *loggingMiddleware) RemitTax(ctx context.Context, rReq mpesa.RemitTaxReq) (resp mpesa.RemitTaxResp, err error) {
 	defer func(begin time.Time) {
 		fields := log.Fields{
 			"duration":               time.Since(begin).String(),
@@ -269,10 +271,10 @@ func (lm *loggingMiddleware) RemitTax(rReq mpesa.RemitTaxReq) (resp mpesa.RemitT
 		}
 	}(time.Now())
 
-	return lm.sdk.RemitTax(rReq)
+	return lm.sdk.RemitTax(ctx, rReq)
 }
 
-func (lm *loggingMiddleware) BusinessPayBill(bReq mpesa.BusinessPayBillReq) (resp mpesa.BusinessPayBillResp, err error) {
+func (lm *loggingMiddleware) BusinessPayBill(ctx context.Context, bReq mpesa.BusinessPayBillReq) (resp mpesa.BusinessPayBillResp, err error) {
 	defer func(begin time.Time) {
 		fields := log.Fields{
 			"duration":               time.Since(begin).String(),
@@ -295,5 +297,285 @@ func (lm *loggingMiddleware) BusinessPayBill(bReq mpesa.BusinessPayBillReq) (res
 		}
 	}(time.Now())
 
-	return lm.sdk.BusinessPayBill(bReq)
+	return lm.sdk.BusinessPayBill(ctx, bReq)
+}
+
+func (lm *loggingMiddleware) BillManagerOptIn(ctx context.Context, oReq mpesa.BillManagerOptInReq) (resp mpesa.BillManagerOptInResp, err error) {
+	defer func(begin time.Time) {
+		fields := log.Fields{
+			"duration":      time.Since(begin).String(),
+			"ShortCode":     oReq.ShortCode,
+			"Email":         oReq.Email,
+			"SendReminders": oReq.SendReminders,
+			"CallbackURL":   oReq.CallbackURL,
+		}
+		switch err {
+		case nil:
+			lm.logger.WithFields(fields).Info("BillManagerOptIn")
+		default:
+			fields["error"] = err
+			lm.logger.WithFields(fields).Error("BillManagerOptIn")
+		}
+	}(time.Now())
+
+	return lm.sdk.BillManagerOptIn(ctx, oReq)
+}
+
+func (lm *loggingMiddleware) BillManagerUpdateOptIn(ctx context.Context, uReq mpesa.BillManagerUpdateOptInReq) (resp mpesa.BillManagerUpdateOptInResp, err error) {
+	defer func(begin time.Time) {
+		fields := log.Fields{
+			"duration":      time.Since(begin).String(),
+			"ShortCode":     uReq.ShortCode,
+			"Email":         uReq.Email,
+			"SendReminders": uReq.SendReminders,
+			"CallbackURL":   uReq.CallbackURL,
+		}
+		switch err {
+		case nil:
+			lm.logger.WithFields(fields).Info("BillManagerUpdateOptIn")
+		default:
+			fields["error"] = err
+			lm.logger.WithFields(fields).Error("BillManagerUpdateOptIn")
+		}
+	}(time.Now())
+
+	return lm.sdk.BillManagerUpdateOptIn(ctx, uReq)
+}
+
+func (lm *loggingMiddleware) BillManagerSingleInvoice(ctx context.Context, iReq mpesa.BillManagerSingleInvoiceReq) (resp mpesa.BillManagerSingleInvoiceResp, err error) {
+	defer func(begin time.Time) {
+		fields := log.Fields{
+			"duration":          time.Since(begin).String(),
+			"ExternalReference": iReq.ExternalReference,
+			"BilledPeriod":      iReq.BilledPeriod,
+			"InvoiceName":       iReq.InvoiceName,
+			"DueDate":           iReq.DueDate,
+			"AccountReference":  iReq.AccountReference,
+			"Amount":            iReq.Amount,
+			"InvoiceItems":      len(iReq.InvoiceItems),
+		}
+		switch err {
+		case nil:
+			lm.logger.WithFields(fields).Info("BillManagerSingleInvoice")
+		default:
+			fields["error"] = err
+			lm.logger.WithFields(fields).Error("BillManagerSingleInvoice")
+		}
+	}(time.Now())
+
+	return lm.sdk.BillManagerSingleInvoice(ctx, iReq)
+}
+
+func (lm *loggingMiddleware) BillManagerBulkInvoice(ctx context.Context, bReq mpesa.BillManagerBulkInvoiceReq) (resp mpesa.BillManagerBulkInvoiceResp, err error) {
+	defer func(begin time.Time) {
+		fields := log.Fields{
+			"duration": time.Since(begin).String(),
+			"Invoices": len(bReq.Invoices),
+		}
+		switch err {
+		case nil:
+			lm.logger.WithFields(fields).Info("BillManagerBulkInvoice")
+		default:
+			fields["error"] = err
+			lm.logger.WithFields(fields).Error("BillManagerBulkInvoice")
+		}
+	}(time.Now())
+
+	return lm.sdk.BillManagerBulkInvoice(ctx, bReq)
+}
+
+func (lm *loggingMiddleware) BillManagerCancelInvoice(ctx context.Context, cReq mpesa.BillManagerCancelInvoiceReq) (resp mpesa.BillManagerCancelInvoiceResp, err error) {
+	defer func(begin time.Time) {
+		fields := log.Fields{
+			"duration":           time.Since(begin).String(),
+			"ExternalReferences": cReq.ExternalReferences,
+		}
+		switch err {
+		case nil:
+			lm.logger.WithFields(fields).Info("BillManagerCancelInvoice")
+		default:
+			fields["error"] = err
+			lm.logger.WithFields(fields).Error("BillManagerCancelInvoice")
+		}
+	}(time.Now())
+
+	return lm.sdk.BillManagerCancelInvoice(ctx, cReq)
+}
+
+func (lm *loggingMiddleware) BillManagerReconcile(ctx context.Context, rReq mpesa.BillManagerReconcileReq) (resp mpesa.BillManagerReconcileResp, err error) {
+	defer func(begin time.Time) {
+		fields := log.Fields{
+			"duration":          time.Since(begin).String(),
+			"PaymentDate":       rReq.PaymentDate,
+			"PaidAmount":        rReq.PaidAmount,
+			"AccountReference":  rReq.AccountReference,
+			"TransactionID":     rReq.TransactionID,
+			"ExternalReference": rReq.ExternalReference,
+		}
+		switch err {
+		case nil:
+			lm.logger.WithFields(fields).Info("BillManagerReconcile")
+		default:
+			fields["error"] = err
+			lm.logger.WithFields(fields).Error("BillManagerReconcile")
+		}
+	}(time.Now())
+
+	return lm.sdk.BillManagerReconcile(ctx, rReq)
+}
+
+func (lm *loggingMiddleware) PullTransactionsRegister(ctx context.Context, prReq mpesa.PullTransactionsRegisterReq) (resp mpesa.PullTransactionsRegisterResp, err error) {
+	defer func(begin time.Time) {
+		fields := log.Fields{
+			"duration":    time.Since(begin).String(),
+			"ShortCode":   prReq.ShortCode,
+			"RequestType": prReq.RequestType,
+			"CallBackURL": prReq.CallBackURL,
+		}
+		switch err {
+		case nil:
+			lm.logger.WithFields(fields).Info("PullTransactionsRegister")
+		default:
+			fields["error"] = err
+			lm.logger.WithFields(fields).Error("PullTransactionsRegister")
+		}
+	}(time.Now())
+
+	return lm.sdk.PullTransactionsRegister(ctx, prReq)
+}
+
+func (lm *loggingMiddleware) PullTransactionsQuery(ctx context.Context, pqReq mpesa.PullTransactionsQueryReq) (resp mpesa.PullTransactionsQueryResp, err error) {
+	defer func(begin time.Time) {
+		fields := log.Fields{
+			"duration":     time.Since(begin).String(),
+			"ShortCode":    pqReq.ShortCode,
+			"StartDate":    pqReq.StartDate,
+			"EndDate":      pqReq.EndDate,
+			"OffSetValue":  pqReq.OffSetValue,
+			"Transactions": len(resp.Transactions),
+		}
+		switch err {
+		case nil:
+			lm.logger.WithFields(fields).Info("PullTransactionsQuery")
+		default:
+			fields["error"] = err
+			lm.logger.WithFields(fields).Error("PullTransactionsQuery")
+		}
+	}(time.Now())
+
+	return lm.sdk.PullTransactionsQuery(ctx, pqReq)
+}
+
+func (lm *loggingMiddleware) StandingOrder(ctx context.Context, soReq mpesa.StandingOrderReq) (resp mpesa.StandingOrderResp, err error) {
+	defer func(begin time.Time) {
+		fields := log.Fields{
+			"duration":          time.Since(begin).String(),
+			"StandingOrderName": soReq.StandingOrderName,
+			"BusinessShortCode": soReq.BusinessShortCode,
+			"TransactionType":   soReq.TransactionType,
+			"Amount":            soReq.Amount,
+			"StartDate":         soReq.StartDate,
+			"EndDate":           soReq.EndDate,
+			"Frequency":         soReq.Frequency.String(),
+			"CallBackURL":       soReq.CallBackURL,
+		}
+		switch err {
+		case nil:
+			lm.logger.WithFields(fields).Info("StandingOrder")
+		default:
+			fields["error"] = err
+			lm.logger.WithFields(fields).Error("StandingOrder")
+		}
+	}(time.Now())
+
+	return lm.sdk.StandingOrder(ctx, soReq)
+}
+
+func (lm *loggingMiddleware) B2BExpressCheckout(ctx context.Context, b2bReq mpesa.B2BExpressCheckoutReq) (resp mpesa.B2BExpressCheckoutResp, err error) {
+	defer func(begin time.Time) {
+		fields := log.Fields{
+			"duration":          time.Since(begin).String(),
+			"PrimaryShortCode":  b2bReq.PrimaryShortCode,
+			"ReceiverShortCode": b2bReq.ReceiverShortCode,
+			"Amount":            b2bReq.Amount,
+			"PaymentRef":        b2bReq.PaymentRef,
+			"PartnerName":       b2bReq.PartnerName,
+			"CallbackURL":       b2bReq.CallbackURL,
+			"RequestRefID":      resp.RequestRefID,
+		}
+		switch err {
+		case nil:
+			lm.logger.WithFields(fields).Info("B2BExpressCheckout")
+		default:
+			fields["error"] = err
+			lm.logger.WithFields(fields).Error("B2BExpressCheckout")
+		}
+	}(time.Now())
+
+	return lm.sdk.B2BExpressCheckout(ctx, b2bReq)
+}
+
+func (lm *loggingMiddleware) B2CAccountTopUp(ctx context.Context, topUpReq mpesa.B2CAccountTopUpReq) (resp mpesa.B2CAccountTopUpResp, err error) {
+	defer func(begin time.Time) {
+		fields := log.Fields{
+			"duration":         time.Since(begin).String(),
+			"Initiator":        topUpReq.Initiator,
+			"CommandID":        topUpReq.CommandID,
+			"Amount":           topUpReq.Amount,
+			"PartyA":           topUpReq.PartyA,
+			"PartyB":           topUpReq.PartyB,
+			"AccountReference": topUpReq.AccountReference,
+			"Requester":        topUpReq.Requester,
+		}
+		switch err {
+		case nil:
+			lm.logger.WithFields(fields).Info("B2CAccountTopUp")
+		default:
+			fields["error"] = err
+			lm.logger.WithFields(fields).Error("B2CAccountTopUp")
+		}
+	}(time.Now())
+
+	return lm.sdk.B2CAccountTopUp(ctx, topUpReq)
+}
+
+func (lm *loggingMiddleware) B2Pochi(ctx context.Context, pochiReq mpesa.B2PochiReq) (resp mpesa.B2PochiResp, err error) {
+	defer func(begin time.Time) {
+		fields := log.Fields{
+			"duration":                 time.Since(begin).String(),
+			"InitiatorName":            pochiReq.InitiatorName,
+			"OriginatorConversationID": pochiReq.OriginatorConversationID,
+			"CommandID":                pochiReq.CommandID,
+			"Amount":                   pochiReq.Amount,
+			"PartyA":                   pochiReq.PartyA,
+			"PartyB":                   pochiReq.PartyB,
+		}
+		switch err {
+		case nil:
+			lm.logger.WithFields(fields).Info("B2Pochi")
+		default:
+			fields["error"] = err
+			lm.logger.WithFields(fields).Error("B2Pochi")
+		}
+	}(time.Now())
+
+	return lm.sdk.B2Pochi(ctx, pochiReq)
+}
+
+func (lm *loggingMiddleware) SIMSwap(ctx context.Context, ssReq mpesa.SIMSwapReq) (resp mpesa.SIMSwapResp, err error) {
+	defer func(begin time.Time) {
+		fields := log.Fields{
+			"duration":       time.Since(begin).String(),
+			"CustomerNumber": ssReq.CustomerNumber,
+		}
+		switch err {
+		case nil:
+			lm.logger.WithFields(fields).Info("SIMSwap")
+		default:
+			fields["error"] = err
+			lm.logger.WithFields(fields).Error("SIMSwap")
+		}
+	}(time.Now())
+
+	return lm.sdk.SIMSwap(ctx, ssReq)
 }
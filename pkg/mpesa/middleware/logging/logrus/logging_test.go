@@ -7,6 +7,7 @@
 package logrus
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -26,6 +27,10 @@ var (
 		ResponseDescription:      "Accept the service request successfully.",
 		ResponseCode:             "0",
 	}
+	billManagerResp = mpesa.BillManagerResp{
+		ResponseMessage: "Success",
+		ResponseCode:    "200",
+	}
 )
 
 func generateMockLoggingMiddleware(sdk mpesa.SDK) *loggingMiddleware {
@@ -72,14 +77,14 @@ func TestToken(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("Token").Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("Token", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.Token()
+		resp, err := s.Token(context.Background())
 
 		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
 		assert.Equal(t, tc.expectedErr, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 
-		call.Parent.AssertCalled(t, "Token")
+		call.Parent.AssertCalled(t, "Token", mock.Anything)
 		call.Unset()
 	}
 }
@@ -111,9 +116,9 @@ func TestAccountBalance(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("AccountBalance", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("AccountBalance", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.AccountBalance(tc.req)
+		resp, err := s.AccountBalance(context.Background(), tc.req)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -152,9 +157,9 @@ func TestC2BRegisterURL(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("C2BRegisterURL", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("C2BRegisterURL", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.C2BRegisterURL(tc.req)
+		resp, err := s.C2BRegisterURL(context.Background(), tc.req)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -193,9 +198,9 @@ func TestC2BSimulate(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("C2BSimulate", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("C2BSimulate", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.C2BSimulate(tc.req)
+		resp, err := s.C2BSimulate(context.Background(), tc.req)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -237,9 +242,9 @@ func TestGenerateQR(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("GenerateQR", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("GenerateQR", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.GenerateQR(tc.req)
+		resp, err := s.GenerateQR(context.Background(), tc.req)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -284,9 +289,9 @@ func TestExpressQuery(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("ExpressQuery", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("ExpressQuery", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.ExpressQuery(tc.req)
+		resp, err := s.ExpressQuery(context.Background(), tc.req)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -325,9 +330,9 @@ func TestReverse(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("Reverse", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("Reverse", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.Reverse(tc.req)
+		resp, err := s.Reverse(context.Background(), tc.req)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -370,9 +375,9 @@ func TestExpressSimulate(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("ExpressSimulate", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("ExpressSimulate", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.ExpressSimulate(tc.req)
+		resp, err := s.ExpressSimulate(context.Background(), tc.req)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -411,9 +416,9 @@ func TestRemitTax(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("RemitTax", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("RemitTax", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.RemitTax(tc.req)
+		resp, err := s.RemitTax(context.Background(), tc.req)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -452,9 +457,9 @@ func TestTransactionStatus(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("TransactionStatus", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("TransactionStatus", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.TransactionStatus(tc.req)
+		resp, err := s.TransactionStatus(context.Background(), tc.req)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -493,9 +498,9 @@ func TestB2CPayment(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("B2CPayment", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("B2CPayment", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.B2CPayment(tc.req)
+		resp, err := s.B2CPayment(context.Background(), tc.req)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -534,9 +539,556 @@ func TestBusinessPayBill(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		call := mockSDK.On("BusinessPayBill", mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+		call := mockSDK.On("BusinessPayBill", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.BusinessPayBill(context.Background(), tc.req)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Nil(t, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestBillManagerOptIn(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := generateMockLoggingMiddleware(mockSDK)
+
+	cases := []struct {
+		name         string
+		req          mpesa.BillManagerOptInReq
+		expectedResp mpesa.BillManagerOptInResp
+		expectedErr  error
+	}{
+		{
+			name: "BillManagerOptIn success",
+			req:  mpesa.BillManagerOptInReq{},
+			expectedResp: mpesa.BillManagerOptInResp{
+				AppKey:          "AG_2376487236_126732989KJ",
+				BillManagerResp: billManagerResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "BillManagerOptIn error",
+			req:          mpesa.BillManagerOptInReq{},
+			expectedResp: mpesa.BillManagerOptInResp{},
+			expectedErr:  errMock,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("BillManagerOptIn", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.BillManagerOptIn(context.Background(), tc.req)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Nil(t, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestBillManagerUpdateOptIn(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := generateMockLoggingMiddleware(mockSDK)
+
+	cases := []struct {
+		name         string
+		req          mpesa.BillManagerUpdateOptInReq
+		expectedResp mpesa.BillManagerUpdateOptInResp
+		expectedErr  error
+	}{
+		{
+			name: "BillManagerUpdateOptIn success",
+			req:  mpesa.BillManagerUpdateOptInReq{},
+			expectedResp: mpesa.BillManagerUpdateOptInResp{
+				BillManagerResp: billManagerResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "BillManagerUpdateOptIn error",
+			req:          mpesa.BillManagerUpdateOptInReq{},
+			expectedResp: mpesa.BillManagerUpdateOptInResp{},
+			expectedErr:  errMock,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("BillManagerUpdateOptIn", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.BillManagerUpdateOptIn(context.Background(), tc.req)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Nil(t, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestBillManagerSingleInvoice(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := generateMockLoggingMiddleware(mockSDK)
+
+	cases := []struct {
+		name         string
+		req          mpesa.BillManagerSingleInvoiceReq
+		expectedResp mpesa.BillManagerSingleInvoiceResp
+		expectedErr  error
+	}{
+		{
+			name: "BillManagerSingleInvoice success",
+			req:  mpesa.BillManagerSingleInvoiceReq{},
+			expectedResp: mpesa.BillManagerSingleInvoiceResp{
+				BillManagerResp: billManagerResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "BillManagerSingleInvoice error",
+			req:          mpesa.BillManagerSingleInvoiceReq{},
+			expectedResp: mpesa.BillManagerSingleInvoiceResp{},
+			expectedErr:  errMock,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("BillManagerSingleInvoice", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.BillManagerSingleInvoice(context.Background(), tc.req)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Nil(t, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestBillManagerBulkInvoice(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := generateMockLoggingMiddleware(mockSDK)
+
+	cases := []struct {
+		name         string
+		req          mpesa.BillManagerBulkInvoiceReq
+		expectedResp mpesa.BillManagerBulkInvoiceResp
+		expectedErr  error
+	}{
+		{
+			name: "BillManagerBulkInvoice success",
+			req:  mpesa.BillManagerBulkInvoiceReq{},
+			expectedResp: mpesa.BillManagerBulkInvoiceResp{
+				BillManagerResp: billManagerResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "BillManagerBulkInvoice error",
+			req:          mpesa.BillManagerBulkInvoiceReq{},
+			expectedResp: mpesa.BillManagerBulkInvoiceResp{},
+			expectedErr:  errMock,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("BillManagerBulkInvoice", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.BillManagerBulkInvoice(context.Background(), tc.req)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Nil(t, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestBillManagerCancelInvoice(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := generateMockLoggingMiddleware(mockSDK)
+
+	cases := []struct {
+		name         string
+		req          mpesa.BillManagerCancelInvoiceReq
+		expectedResp mpesa.BillManagerCancelInvoiceResp
+		expectedErr  error
+	}{
+		{
+			name: "BillManagerCancelInvoice success",
+			req:  mpesa.BillManagerCancelInvoiceReq{},
+			expectedResp: mpesa.BillManagerCancelInvoiceResp{
+				BillManagerResp: billManagerResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "BillManagerCancelInvoice error",
+			req:          mpesa.BillManagerCancelInvoiceReq{},
+			expectedResp: mpesa.BillManagerCancelInvoiceResp{},
+			expectedErr:  errMock,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("BillManagerCancelInvoice", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.BillManagerCancelInvoice(context.Background(), tc.req)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Nil(t, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestBillManagerReconcile(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := generateMockLoggingMiddleware(mockSDK)
+
+	cases := []struct {
+		name         string
+		req          mpesa.BillManagerReconcileReq
+		expectedResp mpesa.BillManagerReconcileResp
+		expectedErr  error
+	}{
+		{
+			name: "BillManagerReconcile success",
+			req:  mpesa.BillManagerReconcileReq{},
+			expectedResp: mpesa.BillManagerReconcileResp{
+				BillManagerResp: billManagerResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "BillManagerReconcile error",
+			req:          mpesa.BillManagerReconcileReq{},
+			expectedResp: mpesa.BillManagerReconcileResp{},
+			expectedErr:  errMock,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("BillManagerReconcile", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.BillManagerReconcile(context.Background(), tc.req)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Nil(t, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestPullTransactionsRegister(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := generateMockLoggingMiddleware(mockSDK)
+
+	cases := []struct {
+		name         string
+		req          mpesa.PullTransactionsRegisterReq
+		expectedResp mpesa.PullTransactionsRegisterResp
+		expectedErr  error
+	}{
+		{
+			name: "PullTransactionsRegister success",
+			req:  mpesa.PullTransactionsRegisterReq{},
+			expectedResp: mpesa.PullTransactionsRegisterResp{
+				ResponseRefID:   "1a2b3c4d",
+				ResponseCode:    "1000",
+				ResponseMessage: "Success",
+				ShortCode:       "600984",
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "PullTransactionsRegister error",
+			req:          mpesa.PullTransactionsRegisterReq{},
+			expectedResp: mpesa.PullTransactionsRegisterResp{},
+			expectedErr:  errMock,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("PullTransactionsRegister", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.PullTransactionsRegister(context.Background(), tc.req)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Nil(t, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestPullTransactionsQuery(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := generateMockLoggingMiddleware(mockSDK)
+
+	cases := []struct {
+		name         string
+		req          mpesa.PullTransactionsQueryReq
+		expectedResp mpesa.PullTransactionsQueryResp
+		expectedErr  error
+	}{
+		{
+			name: "PullTransactionsQuery success",
+			req:  mpesa.PullTransactionsQueryReq{},
+			expectedResp: mpesa.PullTransactionsQueryResp{
+				ResponseRefID:   "1a2b3c4d",
+				ResponseCode:    "1000",
+				ResponseMessage: "Success",
+				Transactions: []mpesa.PullTransaction{
+					{TransactionID: "RJB53MYR1N", MSISDN: "254700000000", Amount: 100},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "PullTransactionsQuery error",
+			req:          mpesa.PullTransactionsQueryReq{},
+			expectedResp: mpesa.PullTransactionsQueryResp{},
+			expectedErr:  errMock,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("PullTransactionsQuery", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.PullTransactionsQuery(context.Background(), tc.req)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Nil(t, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestStandingOrder(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := generateMockLoggingMiddleware(mockSDK)
+
+	cases := []struct {
+		name         string
+		req          mpesa.StandingOrderReq
+		expectedResp mpesa.StandingOrderResp
+		expectedErr  error
+	}{
+		{
+			name: "StandingOrder success",
+			req:  mpesa.StandingOrderReq{},
+			expectedResp: mpesa.StandingOrderResp{
+				ResponseRefID:       "4dd9b5d9-d738-42ba-9326-2cc99e966000",
+				ResponseCode:        "200",
+				ResponseDescription: "Request accepted for processing",
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "StandingOrder error",
+			req:          mpesa.StandingOrderReq{},
+			expectedResp: mpesa.StandingOrderResp{},
+			expectedErr:  errMock,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("StandingOrder", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.StandingOrder(context.Background(), tc.req)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Nil(t, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestB2BExpressCheckout(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := generateMockLoggingMiddleware(mockSDK)
+
+	cases := []struct {
+		name         string
+		req          mpesa.B2BExpressCheckoutReq
+		expectedResp mpesa.B2BExpressCheckoutResp
+		expectedErr  error
+	}{
+		{
+			name: "B2BExpressCheckout success",
+			req:  mpesa.B2BExpressCheckoutReq{},
+			expectedResp: mpesa.B2BExpressCheckoutResp{
+				Code:   "0",
+				Status: "USSD Initiated Successfully",
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "B2BExpressCheckout error",
+			req:          mpesa.B2BExpressCheckoutReq{},
+			expectedResp: mpesa.B2BExpressCheckoutResp{},
+			expectedErr:  errMock,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("B2BExpressCheckout", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.B2BExpressCheckout(context.Background(), tc.req)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Nil(t, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestB2CAccountTopUp(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := generateMockLoggingMiddleware(mockSDK)
+
+	cases := []struct {
+		name         string
+		req          mpesa.B2CAccountTopUpReq
+		expectedResp mpesa.B2CAccountTopUpResp
+		expectedErr  error
+	}{
+		{
+			name: "B2CAccountTopUp success",
+			req:  mpesa.B2CAccountTopUpReq{},
+			expectedResp: mpesa.B2CAccountTopUpResp{
+				ValidResp: validResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "B2CAccountTopUp error",
+			req:          mpesa.B2CAccountTopUpReq{},
+			expectedResp: mpesa.B2CAccountTopUpResp{},
+			expectedErr:  errMock,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("B2CAccountTopUp", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.B2CAccountTopUp(context.Background(), tc.req)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Nil(t, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestB2Pochi(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := generateMockLoggingMiddleware(mockSDK)
+
+	cases := []struct {
+		name         string
+		req          mpesa.B2PochiReq
+		expectedResp mpesa.B2PochiResp
+		expectedErr  error
+	}{
+		{
+			name: "B2Pochi success",
+			req:  mpesa.B2PochiReq{},
+			expectedResp: mpesa.B2PochiResp{
+				ValidResp: validResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "B2Pochi error",
+			req:          mpesa.B2PochiReq{},
+			expectedResp: mpesa.B2PochiResp{},
+			expectedErr:  errMock,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("B2Pochi", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
+
+		resp, err := s.B2Pochi(context.Background(), tc.req)
+		if err != nil {
+			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		} else {
+			assert.Nil(t, err, fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
+		}
+		assert.Equal(t, tc.expectedResp, resp, fmt.Sprintf("expected response: %v, got: %v", tc.expectedResp, resp))
+
+		call.Unset()
+	}
+}
+
+func TestSIMSwap(t *testing.T) {
+	mockSDK := new(mocks.SDK)
+	s := generateMockLoggingMiddleware(mockSDK)
+
+	cases := []struct {
+		name         string
+		req          mpesa.SIMSwapReq
+		expectedResp mpesa.SIMSwapResp
+		expectedErr  error
+	}{
+		{
+			name: "SIMSwap success",
+			req:  mpesa.SIMSwapReq{},
+			expectedResp: mpesa.SIMSwapResp{
+				ResponseCode:        "200",
+				ResponseDescription: "Success",
+				CustomerNumber:      "254722000000",
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "SIMSwap error",
+			req:          mpesa.SIMSwapReq{},
+			expectedResp: mpesa.SIMSwapResp{},
+			expectedErr:  errMock,
+		},
+	}
+
+	for _, tc := range cases {
+		call := mockSDK.On("SIMSwap", mock.Anything, mock.Anything).Return(tc.expectedResp, tc.expectedErr)
 
-		resp, err := s.BusinessPayBill(tc.req)
+		resp, err := s.SIMSwap(context.Background(), tc.req)
 		if err != nil {
 			assert.Contains(t, err.Error(), tc.expectedErr.Error(), fmt.Sprintf("%s: expected error: %v, got: %v", tc.name, tc.expectedErr, err))
 		} else {
@@ -7,6 +7,7 @@
 package slog
 
 import (
+	"context"
 	log "log/slog"
 	"time"
 
@@ -27,21 +28,23 @@ func WithLogger(logger *log.Logger) mpesa.Option {
 	}
 }
 
-func (lm *loggingMiddleware) Token() (resp mpesa.TokenResp, err error) {
+func (lm *loggingMiddleware) Token(ctx context.Context) (resp mpesa.TokenResp, err error) {
 	defer func(begin time.Time) {
-		lm.logger.Info(
+		lm.logger.InfoContext(
+			ctx,
 			"Token",
 			log.Any("error", err),
 			log.String("duration", time.Since(begin).String()),
 		)
 	}(time.Now())
 
-	return lm.sdk.Token()
+	return lm.sdk.Token(ctx)
 }
 
-func (lm *loggingMiddleware) ExpressQuery(eqReq mpesa.ExpressQueryReq) (resp mpesa.ExpressQueryResp, err error) {
+func (lm *loggingMiddleware) ExpressQuery(ctx context.Context, eqReq mpesa.ExpressQueryReq) (resp mpesa.ExpressQueryResp, err error) {
 	defer func(begin time.Time) {
-		lm.logger.Info(
+		lm.logger.InfoContext(
+			ctx,
 			"ExpressQuery",
 			log.Any("error", err),
 			log.String("duration", time.Since(begin).String()),
@@ -50,12 +53,13 @@ func (lm *loggingMiddleware) ExpressQuery(eqReq mpesa.ExpressQueryReq) (resp mpe
 		)
 	}(time.Now())
 
-	return lm.sdk.ExpressQuery(eqReq)
+	return lm.sdk.ExpressQuery(ctx, eqReq)
 }
 
-func (lm *loggingMiddleware) ExpressSimulate(eReq mpesa.ExpressSimulateReq) (resp mpesa.ExpressSimulateResp, err error) {
+func (lm *loggingMiddleware) ExpressSimulate(ctx context.Context, eReq mpesa.ExpressSimulateReq) (resp mpesa.ExpressSimulateResp, err error) {
 	defer func(begin time.Time) {
-		lm.logger.Info(
+		lm.logger.InfoContext(
+			ctx,
 			"ExpressSimulate",
 			log.Any("error", err),
 			log.String("duration", time.Since(begin).String()),
@@ -69,12 +73,13 @@ func (lm *loggingMiddleware) ExpressSimulate(eReq mpesa.ExpressSimulateReq) (res
 		)
 	}(time.Now())
 
-	return lm.sdk.ExpressSimulate(eReq)
+	return lm.sdk.ExpressSimulate(ctx, eReq)
 }
 
-func (lm *loggingMiddleware) B2CPayment(b2cReq mpesa.B2CPaymentReq) (resp mpesa.B2CPaymentResp, err error) {
+func (lm *loggingMiddleware) B2CPayment(ctx context.Context, b2cReq mpesa.B2CPaymentReq) (resp mpesa.B2CPaymentResp, err error) {
 	defer func(begin time.Time) {
-		lm.logger.Info(
+		lm.logger.InfoContext(
+			ctx,
 			"B2CPayment",
 			log.Any("error", err),
 			log.String("duration", time.Since(begin).String()),
@@ -88,12 +93,13 @@ func (lm *loggingMiddleware) B2CPayment(b2cReq mpesa.B2CPaymentReq) (resp mpesa.
 		)
 	}(time.Now())
 
-	return lm.sdk.B2CPayment(b2cReq)
+	return lm.sdk.B2CPayment(ctx, b2cReq)
 }
 
-func (lm *loggingMiddleware) AccountBalance(abReq mpesa.AccountBalanceReq) (resp mpesa.AccountBalanceResp, err error) {
+func (lm *loggingMiddleware) AccountBalance(ctx context.Context, abReq mpesa.AccountBalanceReq) (resp mpesa.AccountBalanceResp, err error) {
 	defer func(begin time.Time) {
-		lm.logger.Info(
+		lm.logger.InfoContext(
+			ctx,
 			"AccountBalance",
 			log.Any("error", err),
 			log.String("duration", time.Since(begin).String()),
@@ -104,12 +110,13 @@ func (lm *loggingMiddleware) AccountBalance(abReq mpesa.AccountBalanceReq) (resp
 		)
 	}(time.Now())
 
-	return lm.sdk.AccountBalance(abReq)
+	return lm.sdk.AccountBalance(ctx, abReq)
 }
 
-func (lm *loggingMiddleware) C2BRegisterURL(c2bReq mpesa.C2BRegisterURLReq) (resp mpesa.C2BRegisterURLResp, err error) {
+func (lm *loggingMiddleware) C2BRegisterURL(ctx context.Context, c2bReq mpesa.C2BRegisterURLReq) (resp mpesa.C2BRegisterURLResp, err error) {
 	defer func(begin time.Time) {
-		lm.logger.Info(
+		lm.logger.InfoContext(
+			ctx,
 			"C2BRegisterURL",
 			log.Any("error", err),
 			log.String("duration", time.Since(begin).String()),
@@ -118,12 +125,13 @@ func (lm *loggingMiddleware) C2BRegisterURL(c2bReq mpesa.C2BRegisterURLReq) (res
 		)
 	}(time.Now())
 
-	return lm.sdk.C2BRegisterURL(c2bReq)
+	return lm.sdk.C2BRegisterURL(ctx, c2bReq)
 }
 
-func (lm *loggingMiddleware) C2BSimulate(c2bReq mpesa.C2BSimulateReq) (resp mpesa.C2BSimulateResp, err error) {
+func (lm *loggingMiddleware) C2BSimulate(ctx context.Context, c2bReq mpesa.C2BSimulateReq) (resp mpesa.C2BSimulateResp, err error) {
 	defer func(begin time.Time) {
-		lm.logger.Info(
+		lm.logger.InfoContext(
+			ctx,
 			"C2BSimulate",
 			log.Any("error", err),
 			log.String("duration", time.Since(begin).String()),
@@ -135,12 +143,13 @@ func (lm *loggingMiddleware) C2BSimulate(c2bReq mpesa.C2BSimulateReq) (resp mpes
 		)
 	}(time.Now())
 
-	return lm.sdk.C2BSimulate(c2bReq)
+	return lm.sdk.C2BSimulate(ctx, c2bReq)
 }
 
-func (lm *loggingMiddleware) GenerateQR(qReq mpesa.GenerateQRReq) (resp mpesa.GenerateQRResp, err error) {
+func (lm *loggingMiddleware) GenerateQR(ctx context.Context, qReq mpesa.GenerateQRReq) (resp mpesa.GenerateQRResp, err error) {
 	defer func(begin time.Time) {
-		lm.logger.Info(
+		lm.logger.InfoContext(
+			ctx,
 			"GenerateQR",
 			log.Any("error", err),
 			log.String("duration", time.Since(begin).String()),
@@ -153,12 +162,13 @@ func (lm *loggingMiddleware) GenerateQR(qReq mpesa.GenerateQRReq) (resp mpesa.Ge
 		)
 	}(time.Now())
 
-	return lm.sdk.GenerateQR(qReq)
+	return lm.sdk.GenerateQR(ctx, qReq)
 }
 
-func (lm *loggingMiddleware) Reverse(rReq mpesa.ReverseReq) (resp mpesa.ReverseResp, err error) {
+func (lm *loggingMiddleware) Reverse(ctx context.Context, rReq mpesa.ReverseReq) (resp mpesa.ReverseResp, err error) {
 	defer func(begin time.Time) {
-		lm.logger.Info(
+		lm.logger.InfoContext(
+			ctx,
 			"Reverse",
 			log.Any("error", err),
 			log.String("duration", time.Since(begin).String()),
@@ -171,29 +181,32 @@ func (lm *loggingMiddleware) Reverse(rReq mpesa.ReverseReq) (resp mpesa.ReverseR
 		)
 	}(time.Now())
 
-	return lm.sdk.Reverse(rReq)
+	return lm.sdk.Reverse(ctx, rReq)
 }
 
-func (lm *loggingMiddleware) TransactionStatus(tReq mpesa.TransactionStatusReq) (resp mpesa.TransactionStatusResp, err error) {
+func (lm *loggingMiddleware) TransactionStatus(ctx context.Context, tReq mpesa.TransactionStatusReq) (resp mpesa.TransactionStatusResp, err error) {
 	defer func(begin time.Time) {
-		lm.logger.Info(
+		lm.logger.InfoContext(
+			ctx,
 			"TransactionStatus",
 			log.Any("error", err),
 			log.String("duration", time.Since(begin).String()),
 			log.String("CommandID", tReq.CommandID),
 			log.String("Initiator", tReq.InitiatorName),
 			log.String("TransactionID", tReq.TransactionID),
+			log.String("OriginatorConversationID", tReq.OriginatorConversationID),
 			log.Uint64("PartyA", tReq.PartyA),
 			log.Int("IdentifierType", int(tReq.IdentifierType)),
 		)
 	}(time.Now())
 
-	return lm.sdk.TransactionStatus(tReq)
+	return lm.sdk.TransactionStatus(ctx, tReq)
 }
 
-func (lm *loggingMiddleware) RemitTax(rReq mpesa.RemitTaxReq) (resp mpesa.RemitTaxResp, err error) {
+func (lm *loggingMiddleware) RemitTax(ctx context.Context, rReq mpesa.RemitTaxReq) (resp mpesa.RemitTaxResp, err error) {
 	defer func(begin time.Time) {
-		lm.logger.Info(
+		lm.logger.InfoContext(
+			ctx,
 			"RemitTax",
 			log.Any("error", err),
 			log.String("duration", time.Since(begin).String()),
@@ -209,12 +222,13 @@ func (lm *loggingMiddleware) RemitTax(rReq mpesa.RemitTaxReq) (resp mpesa.RemitT
 		)
 	}(time.Now())
 
-	return lm.sdk.RemitTax(rReq)
+	return lm.sdk.RemitTax(ctx, rReq)
 }
 
-func (lm *loggingMiddleware) BusinessPayBill(bReq mpesa.BusinessPayBillReq) (resp mpesa.BusinessPayBillResp, err error) {
+func (lm *loggingMiddleware) BusinessPayBill(ctx context.Context, bReq mpesa.BusinessPayBillReq) (resp mpesa.BusinessPayBillResp, err error) {
 	defer func(begin time.Time) {
-		lm.logger.Info(
+		lm.logger.InfoContext(
+			ctx,
 			"BusinessPayBill",
 			log.Any("error", err),
 			log.String("duration", time.Since(begin).String()),
@@ -230,5 +244,233 @@ func (lm *loggingMiddleware) BusinessPayBill(bReq mpesa.BusinessPayBillReq) (res
 		)
 	}(time.Now())
 
-	return lm.sdk.BusinessPayBill(bReq)
+	return lm.sdk.BusinessPayBill(ctx, bReq)
+}
+
+func (lm *loggingMiddleware) BillManagerOptIn(ctx context.Context, oReq mpesa.BillManagerOptInReq) (resp mpesa.BillManagerOptInResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.InfoContext(
+			ctx,
+			"BillManagerOptIn",
+			log.Any("error", err),
+			log.String("duration", time.Since(begin).String()),
+			log.Uint64("ShortCode", oReq.ShortCode),
+			log.String("Email", oReq.Email),
+			log.Int("SendReminders", int(oReq.SendReminders)),
+			log.String("CallbackURL", oReq.CallbackURL),
+		)
+	}(time.Now())
+
+	return lm.sdk.BillManagerOptIn(ctx, oReq)
+}
+
+func (lm *loggingMiddleware) BillManagerUpdateOptIn(ctx context.Context, uReq mpesa.BillManagerUpdateOptInReq) (resp mpesa.BillManagerUpdateOptInResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.InfoContext(
+			ctx,
+			"BillManagerUpdateOptIn",
+			log.Any("error", err),
+			log.String("duration", time.Since(begin).String()),
+			log.Uint64("ShortCode", uReq.ShortCode),
+			log.String("Email", uReq.Email),
+			log.Int("SendReminders", int(uReq.SendReminders)),
+			log.String("CallbackURL", uReq.CallbackURL),
+		)
+	}(time.Now())
+
+	return lm.sdk.BillManagerUpdateOptIn(ctx, uReq)
+}
+
+func (lm *loggingMiddleware) BillManagerSingleInvoice(ctx context.Context, iReq mpesa.BillManagerSingleInvoiceReq) (resp mpesa.BillManagerSingleInvoiceResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.InfoContext(
+			ctx,
+			"BillManagerSingleInvoice",
+			log.Any("error", err),
+			log.String("duration", time.Since(begin).String()),
+			log.String("ExternalReference", iReq.ExternalReference),
+			log.String("BilledPeriod", iReq.BilledPeriod),
+			log.String("InvoiceName", iReq.InvoiceName),
+			log.String("DueDate", iReq.DueDate),
+			log.String("AccountReference", iReq.AccountReference),
+			log.Uint64("Amount", iReq.Amount),
+			log.Int("InvoiceItems", len(iReq.InvoiceItems)),
+		)
+	}(time.Now())
+
+	return lm.sdk.BillManagerSingleInvoice(ctx, iReq)
+}
+
+func (lm *loggingMiddleware) BillManagerBulkInvoice(ctx context.Context, bReq mpesa.BillManagerBulkInvoiceReq) (resp mpesa.BillManagerBulkInvoiceResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.InfoContext(
+			ctx,
+			"BillManagerBulkInvoice",
+			log.Any("error", err),
+			log.String("duration", time.Since(begin).String()),
+			log.Int("Invoices", len(bReq.Invoices)),
+		)
+	}(time.Now())
+
+	return lm.sdk.BillManagerBulkInvoice(ctx, bReq)
+}
+
+func (lm *loggingMiddleware) BillManagerCancelInvoice(ctx context.Context, cReq mpesa.BillManagerCancelInvoiceReq) (resp mpesa.BillManagerCancelInvoiceResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.InfoContext(
+			ctx,
+			"BillManagerCancelInvoice",
+			log.Any("error", err),
+			log.String("duration", time.Since(begin).String()),
+			log.Any("ExternalReferences", cReq.ExternalReferences),
+		)
+	}(time.Now())
+
+	return lm.sdk.BillManagerCancelInvoice(ctx, cReq)
+}
+
+func (lm *loggingMiddleware) BillManagerReconcile(ctx context.Context, rReq mpesa.BillManagerReconcileReq) (resp mpesa.BillManagerReconcileResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.InfoContext(
+			ctx,
+			"BillManagerReconcile",
+			log.Any("error", err),
+			log.String("duration", time.Since(begin).String()),
+			log.String("PaymentDate", rReq.PaymentDate),
+			log.Uint64("PaidAmount", rReq.PaidAmount),
+			log.String("AccountReference", rReq.AccountReference),
+			log.String("TransactionID", rReq.TransactionID),
+			log.String("ExternalReference", rReq.ExternalReference),
+		)
+	}(time.Now())
+
+	return lm.sdk.BillManagerReconcile(ctx, rReq)
+}
+
+func (lm *loggingMiddleware) PullTransactionsRegister(ctx context.Context, prReq mpesa.PullTransactionsRegisterReq) (resp mpesa.PullTransactionsRegisterResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.InfoContext(
+			ctx,
+			"PullTransactionsRegister",
+			log.Any("error", err),
+			log.String("duration", time.Since(begin).String()),
+			log.Uint64("ShortCode", prReq.ShortCode),
+			log.String("RequestType", prReq.RequestType),
+			log.String("CallBackURL", prReq.CallBackURL),
+		)
+	}(time.Now())
+
+	return lm.sdk.PullTransactionsRegister(ctx, prReq)
+}
+
+func (lm *loggingMiddleware) PullTransactionsQuery(ctx context.Context, pqReq mpesa.PullTransactionsQueryReq) (resp mpesa.PullTransactionsQueryResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.InfoContext(
+			ctx,
+			"PullTransactionsQuery",
+			log.Any("error", err),
+			log.String("duration", time.Since(begin).String()),
+			log.Uint64("ShortCode", pqReq.ShortCode),
+			log.String("StartDate", pqReq.StartDate),
+			log.String("EndDate", pqReq.EndDate),
+			log.Uint64("OffSetValue", pqReq.OffSetValue),
+			log.Int("Transactions", len(resp.Transactions)),
+		)
+	}(time.Now())
+
+	return lm.sdk.PullTransactionsQuery(ctx, pqReq)
+}
+
+func (lm *loggingMiddleware) StandingOrder(ctx context.Context, soReq mpesa.StandingOrderReq) (resp mpesa.StandingOrderResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.InfoContext(
+			ctx,
+			"StandingOrder",
+			log.Any("error", err),
+			log.String("duration", time.Since(begin).String()),
+			log.String("StandingOrderName", soReq.StandingOrderName),
+			log.Uint64("BusinessShortCode", soReq.BusinessShortCode),
+			log.String("TransactionType", soReq.TransactionType),
+			log.Uint64("Amount", soReq.Amount),
+			log.String("StartDate", soReq.StartDate),
+			log.String("EndDate", soReq.EndDate),
+			log.String("Frequency", soReq.Frequency.String()),
+			log.String("CallBackURL", soReq.CallBackURL),
+		)
+	}(time.Now())
+
+	return lm.sdk.StandingOrder(ctx, soReq)
+}
+
+func (lm *loggingMiddleware) B2BExpressCheckout(ctx context.Context, b2bReq mpesa.B2BExpressCheckoutReq) (resp mpesa.B2BExpressCheckoutResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.InfoContext(
+			ctx,
+			"B2BExpressCheckout",
+			log.Any("error", err),
+			log.String("duration", time.Since(begin).String()),
+			log.Uint64("PrimaryShortCode", b2bReq.PrimaryShortCode),
+			log.Uint64("ReceiverShortCode", b2bReq.ReceiverShortCode),
+			log.Uint64("Amount", b2bReq.Amount),
+			log.String("PaymentRef", b2bReq.PaymentRef),
+			log.String("PartnerName", b2bReq.PartnerName),
+			log.String("CallbackURL", b2bReq.CallbackURL),
+			log.String("RequestRefID", resp.RequestRefID),
+		)
+	}(time.Now())
+
+	return lm.sdk.B2BExpressCheckout(ctx, b2bReq)
+}
+
+func (lm *loggingMiddleware) B2CAccountTopUp(ctx context.Context, topUpReq mpesa.B2CAccountTopUpReq) (resp mpesa.B2CAccountTopUpResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.InfoContext(
+			ctx,
+			"B2CAccountTopUp",
+			log.Any("error", err),
+			log.String("duration", time.Since(begin).String()),
+			log.String("Initiator", topUpReq.Initiator),
+			log.String("CommandID", topUpReq.CommandID),
+			log.Uint64("Amount", topUpReq.Amount),
+			log.Uint64("PartyA", topUpReq.PartyA),
+			log.Uint64("PartyB", topUpReq.PartyB),
+			log.String("AccountReference", topUpReq.AccountReference),
+			log.Uint64("Requester", topUpReq.Requester),
+		)
+	}(time.Now())
+
+	return lm.sdk.B2CAccountTopUp(ctx, topUpReq)
+}
+
+func (lm *loggingMiddleware) B2Pochi(ctx context.Context, pochiReq mpesa.B2PochiReq) (resp mpesa.B2PochiResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.InfoContext(
+			ctx,
+			"B2Pochi",
+			log.Any("error", err),
+			log.String("duration", time.Since(begin).String()),
+			log.String("InitiatorName", pochiReq.InitiatorName),
+			log.String("OriginatorConversationID", pochiReq.OriginatorConversationID),
+			log.String("CommandID", pochiReq.CommandID),
+			log.Uint64("Amount", pochiReq.Amount),
+			log.Uint64("PartyA", pochiReq.PartyA),
+			log.Uint64("PartyB", pochiReq.PartyB),
+		)
+	}(time.Now())
+
+	return lm.sdk.B2Pochi(ctx, pochiReq)
+}
+
+func (lm *loggingMiddleware) SIMSwap(ctx context.Context, ssReq mpesa.SIMSwapReq) (resp mpesa.SIMSwapResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.InfoContext(
+			ctx,
+			"SIMSwap",
+			log.Any("error", err),
+			log.String("duration", time.Since(begin).String()),
+			log.Uint64("CustomerNumber", ssReq.CustomerNumber),
+		)
+	}(time.Now())
+
+	return lm.sdk.SIMSwap(ctx, ssReq)
 }
@@ -7,6 +7,7 @@
 package zap
 
 import (
+	"context"
 	"time"
 
 	"github.com/0x6flab/mpesaoverlay/pkg/mpesa"
@@ -27,7 +28,7 @@ func WithLogger(logger *log.Logger) mpesa.Option {
 	}
 }
 
-func (lm *loggingMiddleware) Token() (resp mpesa.TokenResp, err error) {
+func (lm *loggingMiddleware) Token(ctx context.Context) (resp mpesa.TokenResp, err error) {
 	defer func(begin time.Time) {
 		lm.logger.Info(
 			"Token",
@@ -36,10 +37,10 @@ func (lm *loggingMiddleware) Token() (resp mpesa.TokenResp, err error) {
 		)
 	}(time.Now())
 
-	return lm.sdk.Token()
+	return lm.sdk.Token(ctx)
 }
 
-func (lm *loggingMiddleware) ExpressQuery(eqReq mpesa.ExpressQueryReq) (resp mpesa.ExpressQueryResp, err error) {
+func (lm *loggingMiddleware) ExpressQuery(ctx context.Context, eqReq mpesa.ExpressQueryReq) (resp mpesa.ExpressQueryResp, err error) {
 	defer func(begin time.Time) {
 		lm.logger.Info(
 			"ExpressQuery",
@@ -50,10 +51,10 @@ func (lm *loggingMiddleware) ExpressQuery(eqReq mpesa.ExpressQueryReq) (resp mpe
 		)
 	}(time.Now())
 
-	return lm.sdk.ExpressQuery(eqReq)
+	return lm.sdk.ExpressQuery(ctx, eqReq)
 }
 
-func (lm *loggingMiddleware) ExpressSimulate(eReq mpesa.ExpressSimulateReq) (resp mpesa.ExpressSimulateResp, err error) {
+func (lm *loggingMiddleware) ExpressSimulate(ctx context.Context, eReq mpesa.ExpressSimulateReq) (resp mpesa.ExpressSimulateResp, err error) {
 	defer func(begin time.Time) {
 		lm.logger.Info(
 			"ExpressSimulate",
@@ -69,10 +70,10 @@ func (lm *loggingMiddleware) ExpressSimulate(eReq mpesa.ExpressSimulateReq) (res
 		)
 	}(time.Now())
 
-	return lm.sdk.ExpressSimulate(eReq)
+	return lm.sdk.ExpressSimulate(ctx, eReq)
 }
 
-func (lm *loggingMiddleware) B2CPayment(b2cReq mpesa.B2CPaymentReq) (resp mpesa.B2CPaymentResp, err error) {
+func (lm *loggingMiddleware) B2CPayment(ctx context.Context, b2cReq mpesa.B2CPaymentReq) (resp mpesa.B2CPaymentResp, err error) {
 	defer func(begin time.Time) {
 		lm.logger.Info(
 			"B2CPayment",
@@ -88,10 +89,10 @@ func (lm *loggingMiddleware) B2CPayment(b2cReq mpesa.B2CPaymentReq) (resp mpesa.
 		)
 	}(time.Now())
 
-	return lm.sdk.B2CPayment(b2cReq)
+	return lm.sdk.B2CPayment(ctx, b2cReq)
 }
 
-func (lm *loggingMiddleware) AccountBalance(abReq mpesa.AccountBalanceReq) (resp mpesa.AccountBalanceResp, err error) {
+func (lm *loggingMiddleware) AccountBalance(ctx context.Context, abReq mpesa.AccountBalanceReq) (resp mpesa.AccountBalanceResp, err error) {
 	defer func(begin time.Time) {
 		lm.logger.Info(
 			"AccountBalance",
@@ -104,10 +105,10 @@ func (lm *loggingMiddleware) AccountBalance(abReq mpesa.AccountBalanceReq) (resp
 		)
 	}(time.Now())
 
-	return lm.sdk.AccountBalance(abReq)
+	return lm.sdk.AccountBalance(ctx, abReq)
 }
 
-func (lm *loggingMiddleware) C2BRegisterURL(c2bReq mpesa.C2BRegisterURLReq) (resp mpesa.C2BRegisterURLResp, err error) {
+func (lm *loggingMiddleware) C2BRegisterURL(ctx context.Context, c2bReq mpesa.C2BRegisterURLReq) (resp mpesa.C2BRegisterURLResp, err error) {
 	defer func(begin time.Time) {
 		lm.logger.Info(
 			"C2BRegisterURL",
@@ -118,10 +119,10 @@ func (lm *loggingMiddleware) C2BRegisterURL(c2bReq mpesa.C2BRegisterURLReq) (res
 		)
 	}(time.Now())
 
-	return lm.sdk.C2BRegisterURL(c2bReq)
+	return lm.sdk.C2BRegisterURL(ctx, c2bReq)
 }
 
-func (lm *loggingMiddleware) C2BSimulate(c2bReq mpesa.C2BSimulateReq) (resp mpesa.C2BSimulateResp, err error) {
+func (lm *loggingMiddleware) C2BSimulate(ctx context.Context, c2bReq mpesa.C2BSimulateReq) (resp mpesa.C2BSimulateResp, err error) {
 	defer func(begin time.Time) {
 		lm.logger.Info(
 			"C2BSimulate",
@@ -135,10 +136,10 @@ func (lm *loggingMiddleware) C2BSimulate(c2bReq mpesa.C2BSimulateReq) (resp mpes
 		)
 	}(time.Now())
 
-	return lm.sdk.C2BSimulate(c2bReq)
+	return lm.sdk.C2BSimulate(ctx, c2bReq)
 }
 
-func (lm *loggingMiddleware) GenerateQR(qReq mpesa.GenerateQRReq) (resp mpesa.GenerateQRResp, err error) {
+func (lm *loggingMiddleware) GenerateQR(ctx context.Context, qReq mpesa.GenerateQRReq) (resp mpesa.GenerateQRResp, err error) {
 	defer func(begin time.Time) {
 		lm.logger.Info(
 			"GenerateQR",
@@ -153,10 +154,10 @@ func (lm *loggingMiddleware) GenerateQR(qReq mpesa.GenerateQRReq) (resp mpesa.Ge
 		)
 	}(time.Now())
 
-	return lm.sdk.GenerateQR(qReq)
+	return lm.sdk.GenerateQR(ctx, qReq)
 }
 
-func (lm *loggingMiddleware) Reverse(rReq mpesa.ReverseReq) (resp mpesa.ReverseResp, err error) {
+func (lm *loggingMiddleware) Reverse(ctx context.Context, rReq mpesa.ReverseReq) (resp mpesa.ReverseResp, err error) {
 	defer func(begin time.Time) {
 		lm.logger.Info(
 			"Reverse",
@@ -171,10 +172,10 @@ func (lm *loggingMiddleware) Reverse(rReq mpesa.ReverseReq) (resp mpesa.ReverseR
 		)
 	}(time.Now())
 
-	return lm.sdk.Reverse(rReq)
+	return lm.sdk.Reverse(ctx, rReq)
 }
 
-func (lm *loggingMiddleware) TransactionStatus(tReq mpesa.TransactionStatusReq) (resp mpesa.TransactionStatusResp, err error) {
+func (lm *loggingMiddleware) TransactionStatus(ctx context.Context, tReq mpesa.TransactionStatusReq) (resp mpesa.TransactionStatusResp, err error) {
 	defer func(begin time.Time) {
 		lm.logger.Info(
 			"TransactionStatus",
@@ -183,15 +184,16 @@ func (lm *loggingMiddleware) TransactionStatus(tReq mpesa.TransactionStatusReq)
 			log.String("CommandID", tReq.CommandID),
 			log.String("Initiator", tReq.InitiatorName),
 			log.String("TransactionID", tReq.TransactionID),
+			log.String("OriginatorConversationID", tReq.OriginatorConversationID),
 			log.Uint64("PartyA", tReq.PartyA),
 			log.Uint8("IdentifierType", tReq.IdentifierType),
 		)
 	}(time.Now())
 
-	return lm.sdk.TransactionStatus(tReq)
+	return lm.sdk.TransactionStatus(ctx, tReq)
 }
 
-func (lm *loggingMiddleware) RemitTax(rReq mpesa.RemitTaxReq) (resp mpesa.RemitTaxResp, err error) {
+func (lm *loggingMiddleware) RemitTax(ctx context.Context, rReq mpesa.RemitTaxReq) (resp mpesa.RemitTaxResp, err error) {
 	defer func(begin time.Time) {
 		lm.logger.Info(
 			"RemitTax",
@@ -209,10 +211,10 @@ func (lm *loggingMiddleware) RemitTax(rReq mpesa.RemitTaxReq) (resp mpesa.RemitT
 		)
 	}(time.Now())
 
-	return lm.sdk.RemitTax(rReq)
+	return lm.sdk.RemitTax(ctx, rReq)
 }
 
-func (lm *loggingMiddleware) BusinessPayBill(b2bReq mpesa.BusinessPayBillReq) (resp mpesa.BusinessPayBillResp, err error) {
+func (lm *loggingMiddleware) BusinessPayBill(ctx context.Context, b2bReq mpesa.BusinessPayBillReq) (resp mpesa.BusinessPayBillResp, err error) {
 	defer func(begin time.Time) {
 		lm.logger.Info(
 			"BusinessPayBill",
@@ -230,5 +232,220 @@ func (lm *loggingMiddleware) BusinessPayBill(b2bReq mpesa.BusinessPayBillReq) (r
 		)
 	}(time.Now())
 
-	return lm.sdk.BusinessPayBill(b2bReq)
+	return lm.sdk.BusinessPayBill(ctx, b2bReq)
+}
+
+func (lm *loggingMiddleware) BillManagerOptIn(ctx context.Context, oReq mpesa.BillManagerOptInReq) (resp mpesa.BillManagerOptInResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.Info(
+			"BillManagerOptIn",
+			log.Error(err),
+			log.String("duration", time.Since(begin).String()),
+			log.Uint64("ShortCode", oReq.ShortCode),
+			log.String("Email", oReq.Email),
+			log.Uint8("SendReminders", oReq.SendReminders),
+			log.String("CallbackURL", oReq.CallbackURL),
+		)
+	}(time.Now())
+
+	return lm.sdk.BillManagerOptIn(ctx, oReq)
+}
+
+func (lm *loggingMiddleware) BillManagerUpdateOptIn(ctx context.Context, uReq mpesa.BillManagerUpdateOptInReq) (resp mpesa.BillManagerUpdateOptInResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.Info(
+			"BillManagerUpdateOptIn",
+			log.Error(err),
+			log.String("duration", time.Since(begin).String()),
+			log.Uint64("ShortCode", uReq.ShortCode),
+			log.String("Email", uReq.Email),
+			log.Uint8("SendReminders", uReq.SendReminders),
+			log.String("CallbackURL", uReq.CallbackURL),
+		)
+	}(time.Now())
+
+	return lm.sdk.BillManagerUpdateOptIn(ctx, uReq)
+}
+
+func (lm *loggingMiddleware) BillManagerSingleInvoice(ctx context.Context, iReq mpesa.BillManagerSingleInvoiceReq) (resp mpesa.BillManagerSingleInvoiceResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.Info(
+			"BillManagerSingleInvoice",
+			log.Error(err),
+			log.String("duration", time.Since(begin).String()),
+			log.String("ExternalReference", iReq.ExternalReference),
+			log.String("BilledPeriod", iReq.BilledPeriod),
+			log.String("InvoiceName", iReq.InvoiceName),
+			log.String("DueDate", iReq.DueDate),
+			log.String("AccountReference", iReq.AccountReference),
+			log.Uint64("Amount", iReq.Amount),
+			log.Int("InvoiceItems", len(iReq.InvoiceItems)),
+		)
+	}(time.Now())
+
+	return lm.sdk.BillManagerSingleInvoice(ctx, iReq)
+}
+
+func (lm *loggingMiddleware) BillManagerBulkInvoice(ctx context.Context, bReq mpesa.BillManagerBulkInvoiceReq) (resp mpesa.BillManagerBulkInvoiceResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.Info(
+			"BillManagerBulkInvoice",
+			log.Error(err),
+			log.String("duration", time.Since(begin).String()),
+			log.Int("Invoices", len(bReq.Invoices)),
+		)
+	}(time.Now())
+
+	return lm.sdk.BillManagerBulkInvoice(ctx, bReq)
+}
+
+func (lm *loggingMiddleware) BillManagerCancelInvoice(ctx context.Context, cReq mpesa.BillManagerCancelInvoiceReq) (resp mpesa.BillManagerCancelInvoiceResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.Info(
+			"BillManagerCancelInvoice",
+			log.Error(err),
+			log.String("duration", time.Since(begin).String()),
+			log.Strings("ExternalReferences", cReq.ExternalReferences),
+		)
+	}(time.Now())
+
+	return lm.sdk.BillManagerCancelInvoice(ctx, cReq)
+}
+
+func (lm *loggingMiddleware) BillManagerReconcile(ctx context.Context, rReq mpesa.BillManagerReconcileReq) (resp mpesa.BillManagerReconcileResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.Info(
+			"BillManagerReconcile",
+			log.Error(err),
+			log.String("duration", time.Since(begin).String()),
+			log.String("PaymentDate", rReq.PaymentDate),
+			log.Uint64("PaidAmount", rReq.PaidAmount),
+			log.String("AccountReference", rReq.AccountReference),
+			log.String("TransactionID", rReq.TransactionID),
+			log.String("ExternalReference", rReq.ExternalReference),
+		)
+	}(time.Now())
+
+	return lm.sdk.BillManagerReconcile(ctx, rReq)
+}
+
+func (lm *loggingMiddleware) PullTransactionsRegister(ctx context.Context, prReq mpesa.PullTransactionsRegisterReq) (resp mpesa.PullTransactionsRegisterResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.Info(
+			"PullTransactionsRegister",
+			log.Error(err),
+			log.String("duration", time.Since(begin).String()),
+			log.Uint64("ShortCode", prReq.ShortCode),
+			log.String("RequestType", prReq.RequestType),
+			log.String("CallBackURL", prReq.CallBackURL),
+		)
+	}(time.Now())
+
+	return lm.sdk.PullTransactionsRegister(ctx, prReq)
+}
+
+func (lm *loggingMiddleware) PullTransactionsQuery(ctx context.Context, pqReq mpesa.PullTransactionsQueryReq) (resp mpesa.PullTransactionsQueryResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.Info(
+			"PullTransactionsQuery",
+			log.Error(err),
+			log.String("duration", time.Since(begin).String()),
+			log.Uint64("ShortCode", pqReq.ShortCode),
+			log.String("StartDate", pqReq.StartDate),
+			log.String("EndDate", pqReq.EndDate),
+			log.Uint64("OffSetValue", pqReq.OffSetValue),
+			log.Int("Transactions", len(resp.Transactions)),
+		)
+	}(time.Now())
+
+	return lm.sdk.PullTransactionsQuery(ctx, pqReq)
+}
+
+func (lm *loggingMiddleware) StandingOrder(ctx context.Context, soReq mpesa.StandingOrderReq) (resp mpesa.StandingOrderResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.Info(
+			"StandingOrder",
+			log.Error(err),
+			log.String("duration", time.Since(begin).String()),
+			log.String("StandingOrderName", soReq.StandingOrderName),
+			log.Uint64("BusinessShortCode", soReq.BusinessShortCode),
+			log.String("TransactionType", soReq.TransactionType),
+			log.Uint64("Amount", soReq.Amount),
+			log.String("StartDate", soReq.StartDate),
+			log.String("EndDate", soReq.EndDate),
+			log.String("Frequency", soReq.Frequency.String()),
+			log.String("CallBackURL", soReq.CallBackURL),
+		)
+	}(time.Now())
+
+	return lm.sdk.StandingOrder(ctx, soReq)
+}
+
+func (lm *loggingMiddleware) B2BExpressCheckout(ctx context.Context, b2bReq mpesa.B2BExpressCheckoutReq) (resp mpesa.B2BExpressCheckoutResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.Info(
+			"B2BExpressCheckout",
+			log.Error(err),
+			log.String("duration", time.Since(begin).String()),
+			log.Uint64("PrimaryShortCode", b2bReq.PrimaryShortCode),
+			log.Uint64("ReceiverShortCode", b2bReq.ReceiverShortCode),
+			log.Uint64("Amount", b2bReq.Amount),
+			log.String("PaymentRef", b2bReq.PaymentRef),
+			log.String("PartnerName", b2bReq.PartnerName),
+			log.String("CallbackURL", b2bReq.CallbackURL),
+			log.String("RequestRefID", resp.RequestRefID),
+		)
+	}(time.Now())
+
+	return lm.sdk.B2BExpressCheckout(ctx, b2bReq)
+}
+
+func (lm *loggingMiddleware) B2CAccountTopUp(ctx context.Context, topUpReq mpesa.B2CAccountTopUpReq) (resp mpesa.B2CAccountTopUpResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.Info(
+			"B2CAccountTopUp",
+			log.Error(err),
+			log.String("duration", time.Since(begin).String()),
+			log.String("Initiator", topUpReq.Initiator),
+			log.String("CommandID", topUpReq.CommandID),
+			log.Uint64("Amount", topUpReq.Amount),
+			log.Uint64("PartyA", topUpReq.PartyA),
+			log.Uint64("PartyB", topUpReq.PartyB),
+			log.String("AccountReference", topUpReq.AccountReference),
+			log.Uint64("Requester", topUpReq.Requester),
+		)
+	}(time.Now())
+
+	return lm.sdk.B2CAccountTopUp(ctx, topUpReq)
+}
+
+func (lm *loggingMiddleware) B2Pochi(ctx context.Context, pochiReq mpesa.B2PochiReq) (resp mpesa.B2PochiResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.Info(
+			"B2Pochi",
+			log.Error(err),
+			log.String("duration", time.Since(begin).String()),
+			log.String("InitiatorName", pochiReq.InitiatorName),
+			log.String("OriginatorConversationID", pochiReq.OriginatorConversationID),
+			log.String("CommandID", pochiReq.CommandID),
+			log.Uint64("Amount", pochiReq.Amount),
+			log.Uint64("PartyA", pochiReq.PartyA),
+			log.Uint64("PartyB", pochiReq.PartyB),
+		)
+	}(time.Now())
+
+	return lm.sdk.B2Pochi(ctx, pochiReq)
+}
+
+func (lm *loggingMiddleware) SIMSwap(ctx context.Context, ssReq mpesa.SIMSwapReq) (resp mpesa.SIMSwapResp, err error) {
+	defer func(begin time.Time) {
+		lm.logger.Info(
+			"SIMSwap",
+			log.Error(err),
+			log.String("duration", time.Since(begin).String()),
+			log.Uint64("CustomerNumber", ssReq.CustomerNumber),
+		)
+	}(time.Now())
+
+	return lm.sdk.SIMSwap(ctx, ssReq)
 }
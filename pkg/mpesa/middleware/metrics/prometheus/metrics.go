@@ -7,6 +7,7 @@
 package prometheus
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -19,7 +20,7 @@ import (
 
 var _ mpesa.SDK = (*metricsMiddleware)(nil)
 
-var funcNames = []string{"Token", "ExpressQuery", "ExpressSimulate", "B2CPayment", "AccountBalance", "C2BRegisterURL", "C2BSimulate", "GenerateQR", "Reverse", "TransactionStatus", "RemitTax", "BusinessPayBill"}
+var funcNames = []string{"Token", "ExpressQuery", "ExpressSimulate", "B2CPayment", "AccountBalance", "C2BRegisterURL", "C2BSimulate", "GenerateQR", "Reverse", "TransactionStatus", "RemitTax", "BusinessPayBill", "BillManagerOptIn", "BillManagerUpdateOptIn", "BillManagerSingleInvoice", "BillManagerBulkInvoice", "BillManagerCancelInvoice", "BillManagerReconcile", "PullTransactionsRegister", "PullTransactionsQuery", "StandingOrder", "B2BExpressCheckout", "B2CAccountTopUp", "B2Pochi", "SIMSwap"}
 
 type metricsMiddleware struct {
 	counters  map[string]prom.Counter
@@ -58,7 +59,7 @@ func WithMetrics(svcName, url string) mpesa.Option {
 	}
 }
 
-func (mm *metricsMiddleware) Token() (resp mpesa.TokenResp, err error) {
+func (mm *metricsMiddleware) Token(ctx context.Context) (resp mpesa.TokenResp, err error) {
 	defer func(begin time.Time) {
 		mm.counters["Token"].Inc()
 		mm.latencies["Token"].Observe(time.Since(begin).Seconds())
@@ -67,10 +68,10 @@ func (mm *metricsMiddleware) Token() (resp mpesa.TokenResp, err error) {
 		}
 	}(time.Now())
 
-	return mm.sdk.Token()
+	return mm.sdk.Token(ctx)
 }
 
-func (mm *metricsMiddleware) ExpressQuery(eqReq mpesa.ExpressQueryReq) (resp mpesa.ExpressQueryResp, err error) {
+func (mm *metricsMiddleware) ExpressQuery(ctx context.Context, eqReq mpesa.ExpressQueryReq) (resp mpesa.ExpressQueryResp, err error) {
 	defer func(begin time.Time) {
 		mm.counters["ExpressQuery"].Inc()
 		mm.latencies["ExpressQuery"].Observe(time.Since(begin).Seconds())
@@ -79,10 +80,10 @@ func (mm *metricsMiddleware) ExpressQuery(eqReq mpesa.ExpressQueryReq) (resp mpe
 		}
 	}(time.Now())
 
-	return mm.sdk.ExpressQuery(eqReq)
+	return mm.sdk.ExpressQuery(ctx, eqReq)
 }
 
-func (mm *metricsMiddleware) ExpressSimulate(eReq mpesa.ExpressSimulateReq) (resp mpesa.ExpressSimulateResp, err error) {
+func (mm *metricsMiddleware) ExpressSimulate(ctx context.Context, eReq mpesa.ExpressSimulateReq) (resp mpesa.ExpressSimulateResp, err error) {
 	defer func(begin time.Time) {
 		mm.counters["ExpressSimulate"].Inc()
 		mm.latencies["ExpressSimulate"].Observe(time.Since(begin).Seconds())
@@ -91,10 +92,10 @@ func (mm *metricsMiddleware) ExpressSimulate(eReq mpesa.ExpressSimulateReq) (res
 		}
 	}(time.Now())
 
-	return mm.sdk.ExpressSimulate(eReq)
+	return mm.sdk.ExpressSimulate(ctx, eReq)
 }
 
-func (mm *metricsMiddleware) B2CPayment(b2cReq mpesa.B2CPaymentReq) (resp mpesa.B2CPaymentResp, err error) {
+func (mm *metricsMiddleware) B2CPayment(ctx context.Context, b2cReq mpesa.B2CPaymentReq) (resp mpesa.B2CPaymentResp, err error) {
 	defer func(begin time.Time) {
 		mm.counters["B2CPayment"].Inc()
 		mm.latencies["B2CPayment"].Observe(time.Since(begin).Seconds())
@@ -103,10 +104,10 @@ func (mm *metricsMiddleware) B2CPayment(b2cReq mpesa.B2CPaymentReq) (resp mpesa.
 		}
 	}(time.Now())
 
-	return mm.sdk.B2CPayment(b2cReq)
+	return mm.sdk.B2CPayment(ctx, b2cReq)
 }
 
-func (mm *metricsMiddleware) AccountBalance(abReq mpesa.AccountBalanceReq) (resp mpesa.AccountBalanceResp, err error) {
+func (mm *metricsMiddleware) AccountBalance(ctx context.Context, abReq mpesa.AccountBalanceReq) (resp mpesa.AccountBalanceResp, err error) {
 	defer func(begin time.Time) {
 		mm.counters["AccountBalance"].Inc()
 		mm.latencies["AccountBalance"].Observe(time.Since(begin).Seconds())
@@ -115,10 +116,10 @@ func (mm *metricsMiddleware) AccountBalance(abReq mpesa.AccountBalanceReq) (resp
 		}
 	}(time.Now())
 
-	return mm.sdk.AccountBalance(abReq)
+	return mm.sdk.AccountBalance(ctx, abReq)
 }
 
-func (mm *metricsMiddleware) C2BRegisterURL(c2bReq mpesa.C2BRegisterURLReq) (resp mpesa.C2BRegisterURLResp, err error) {
+func (mm *metricsMiddleware) C2BRegisterURL(ctx context.Context, c2bReq mpesa.C2BRegisterURLReq) (resp mpesa.C2BRegisterURLResp, err error) {
 	defer func(begin time.Time) {
 		mm.counters["C2BRegisterURL"].Inc()
 		mm.latencies["C2BRegisterURL"].Observe(time.Since(begin).Seconds())
@@ -127,10 +128,10 @@ func (mm *metricsMiddleware) C2BRegisterURL(c2bReq mpesa.C2BRegisterURLReq) (res
 		}
 	}(time.Now())
 
-	return mm.sdk.C2BRegisterURL(c2bReq)
+	return mm.sdk.C2BRegisterURL(ctx, c2bReq)
 }
 
-func (mm *metricsMiddleware) C2BSimulate(c2bReq mpesa.C2BSimulateReq) (resp mpesa.C2BSimulateResp, err error) {
+func (mm *metricsMiddleware) C2BSimulate(ctx context.Context, c2bReq mpesa.C2BSimulateReq) (resp mpesa.C2BSimulateResp, err error) {
 	defer func(begin time.Time) {
 		mm.counters["C2BSimulate"].Inc()
 		mm.latencies["C2BSimulate"].Observe(time.Since(begin).Seconds())
@@ -139,10 +140,10 @@ func (mm *metricsMiddleware) C2BSimulate(c2bReq mpesa.C2BSimulateReq) (resp mpes
 		}
 	}(time.Now())
 
-	return mm.sdk.C2BSimulate(c2bReq)
+	return mm.sdk.C2BSimulate(ctx, c2bReq)
 }
 
-func (mm *metricsMiddleware) GenerateQR(qReq mpesa.GenerateQRReq) (resp mpesa.GenerateQRResp, err error) {
+func (mm *metricsMiddleware) GenerateQR(ctx context.Context, qReq mpesa.GenerateQRReq) (resp mpesa.GenerateQRResp, err error) {
 	defer func(begin time.Time) {
 		mm.counters["GenerateQR"].Inc()
 		mm.latencies["GenerateQR"].Observe(time.Since(begin).Seconds())
@@ -151,10 +152,10 @@ func (mm *metricsMiddleware) GenerateQR(qReq mpesa.GenerateQRReq) (resp mpesa.Ge
 		}
 	}(time.Now())
 
-	return mm.sdk.GenerateQR(qReq)
+	return mm.sdk.GenerateQR(ctx, qReq)
 }
 
-func (mm *metricsMiddleware) Reverse(rReq mpesa.ReverseReq) (resp mpesa.ReverseResp, err error) {
+func (mm *metricsMiddleware) Reverse(ctx context.Context, rReq mpesa.ReverseReq) (resp mpesa.ReverseResp, err error) {
 	defer func(begin time.Time) {
 		mm.counters["Reverse"].Inc()
 		mm.latencies["Reverse"].Observe(time.Since(begin).Seconds())
@@ -163,10 +164,10 @@ func (mm *metricsMiddleware) Reverse(rReq mpesa.ReverseReq) (resp mpesa.ReverseR
 		}
 	}(time.Now())
 
-	return mm.sdk.Reverse(rReq)
+	return mm.sdk.Reverse(ctx, rReq)
 }
 
-func (mm *metricsMiddleware) TransactionStatus(tReq mpesa.TransactionStatusReq) (resp mpesa.TransactionStatusResp, err error) {
+func (mm *metricsMiddleware) TransactionStatus(ctx context.Context, tReq mpesa.TransactionStatusReq) (resp mpesa.TransactionStatusResp, err error) {
 	defer func(begin time.Time) {
 		mm.counters["TransactionStatus"].Inc()
 		mm.latencies["TransactionStatus"].Observe(time.Since(begin).Seconds())
@@ -175,10 +176,10 @@ func (mm *metricsMiddleware) TransactionStatus(tReq mpesa.TransactionStatusReq)
 		}
 	}(time.Now())
 
-	return mm.sdk.TransactionStatus(tReq)
+	return mm.sdk.TransactionStatus(ctx, tReq)
 }
 
-func (mm *metricsMiddleware) RemitTax(rReq mpesa.RemitTaxReq) (resp mpesa.RemitTaxResp, err error) {
+func (mm *metricsMiddleware) RemitTax(ctx context.Context, rReq mpesa.RemitTaxReq) (resp mpesa.RemitTaxResp, err error) {
 	defer func(begin time.Time) {
 		mm.counters["RemitTax"].Inc()
 		mm.latencies["RemitTax"].Observe(time.Since(begin).Seconds())
@@ -187,10 +188,10 @@ func (mm *metricsMiddleware) RemitTax(rReq mpesa.RemitTaxReq) (resp mpesa.RemitT
 		}
 	}(time.Now())
 
-	return mm.sdk.RemitTax(rReq)
+	return mm.sdk.RemitTax(ctx, rReq)
 }
 
-func (mm *metricsMiddleware) BusinessPayBill(bpbReq mpesa.BusinessPayBillReq) (resp mpesa.BusinessPayBillResp, err error) {
+func (mm *metricsMiddleware) BusinessPayBill(ctx context.Context, bpbReq mpesa.BusinessPayBillReq) (resp mpesa.BusinessPayBillResp, err error) {
 	defer func(begin time.Time) {
 		mm.counters["BusinessPayBill"].Inc()
 		mm.latencies["BusinessPayBill"].Observe(time.Since(begin).Seconds())
@@ -199,7 +200,163 @@ func (mm *metricsMiddleware) BusinessPayBill(bpbReq mpesa.BusinessPayBillReq) (r
 		}
 	}(time.Now())
 
-	return mm.sdk.BusinessPayBill(bpbReq)
+	return mm.sdk.BusinessPayBill(ctx, bpbReq)
+}
+
+func (mm *metricsMiddleware) BillManagerOptIn(ctx context.Context, oReq mpesa.BillManagerOptInReq) (resp mpesa.BillManagerOptInResp, err error) {
+	defer func(begin time.Time) {
+		mm.counters["BillManagerOptIn"].Inc()
+		mm.latencies["BillManagerOptIn"].Observe(time.Since(begin).Seconds())
+		if err1 := mm.pusher.Add(); err1 != nil {
+			err = fmt.Errorf("%w: %w", err, err1)
+		}
+	}(time.Now())
+
+	return mm.sdk.BillManagerOptIn(ctx, oReq)
+}
+
+func (mm *metricsMiddleware) BillManagerUpdateOptIn(ctx context.Context, uReq mpesa.BillManagerUpdateOptInReq) (resp mpesa.BillManagerUpdateOptInResp, err error) {
+	defer func(begin time.Time) {
+		mm.counters["BillManagerUpdateOptIn"].Inc()
+		mm.latencies["BillManagerUpdateOptIn"].Observe(time.Since(begin).Seconds())
+		if err1 := mm.pusher.Add(); err1 != nil {
+			err = fmt.Errorf("%w: %w", err, err1)
+		}
+	}(time.Now())
+
+	return mm.sdk.BillManagerUpdateOptIn(ctx, uReq)
+}
+
+func (mm *metricsMiddleware) BillManagerSingleInvoice(ctx context.Context, iReq mpesa.BillManagerSingleInvoiceReq) (resp mpesa.BillManagerSingleInvoiceResp, err error) {
+	defer func(begin time.Time) {
+		mm.counters["BillManagerSingleInvoice"].Inc()
+		mm.latencies["BillManagerSingleInvoice"].Observe(time.Since(begin).Seconds())
+		if err1 := mm.pusher.Add(); err1 != nil {
+			err = fmt.Errorf("%w: %w", err, err1)
+		}
+	}(time.Now())
+
+	return mm.sdk.BillManagerSingleInvoice(ctx, iReq)
+}
+
+func (mm *metricsMiddleware) BillManagerBulkInvoice(ctx context.Context, bReq mpesa.BillManagerBulkInvoiceReq) (resp mpesa.BillManagerBulkInvoiceResp, err error) {
+	defer func(begin time.Time) {
+		mm.counters["BillManagerBulkInvoice"].Inc()
+		mm.latencies["BillManagerBulkInvoice"].Observe(time.Since(begin).Seconds())
+		if err1 := mm.pusher.Add(); err1 != nil {
+			err = fmt.Errorf("%w: %w", err, err1)
+		}
+	}(time.Now())
+
+	return mm.sdk.BillManagerBulkInvoice(ctx, bReq)
+}
+
+func (mm *metricsMiddleware) BillManagerCancelInvoice(ctx context.Context, cReq mpesa.BillManagerCancelInvoiceReq) (resp mpesa.BillManagerCancelInvoiceResp, err error) {
+	defer func(begin time.Time) {
+		mm.counters["BillManagerCancelInvoice"].Inc()
+		mm.latencies["BillManagerCancelInvoice"].Observe(time.Since(begin).Seconds())
+		if err1 := mm.pusher.Add(); err1 != nil {
+			err = fmt.Errorf("%w: %w", err, err1)
+		}
+	}(time.Now())
+
+	return mm.sdk.BillManagerCancelInvoice(ctx, cReq)
+}
+
+func (mm *metricsMiddleware) BillManagerReconcile(ctx context.Context, rReq mpesa.BillManagerReconcileReq) (resp mpesa.BillManagerReconcileResp, err error) {
+	defer func(begin time.Time) {
+		mm.counters["BillManagerReconcile"].Inc()
+		mm.latencies["BillManagerReconcile"].Observe(time.Since(begin).Seconds())
+		if err1 := mm.pusher.Add(); err1 != nil {
+			err = fmt.Errorf("%w: %w", err, err1)
+		}
+	}(time.Now())
+
+	return mm.sdk.BillManagerReconcile(ctx, rReq)
+}
+
+func (mm *metricsMiddleware) PullTransactionsRegister(ctx context.Context, prReq mpesa.PullTransactionsRegisterReq) (resp mpesa.PullTransactionsRegisterResp, err error) {
+	defer func(begin time.Time) {
+		mm.counters["PullTransactionsRegister"].Inc()
+		mm.latencies["PullTransactionsRegister"].Observe(time.Since(begin).Seconds())
+		if err1 := mm.pusher.Add(); err1 != nil {
+			err = fmt.Errorf("%w: %w", err, err1)
+		}
+	}(time.Now())
+
+	return mm.sdk.PullTransactionsRegister(ctx, prReq)
+}
+
+func (mm *metricsMiddleware) PullTransactionsQuery(ctx context.Context, pqReq mpesa.PullTransactionsQueryReq) (resp mpesa.PullTransactionsQueryResp, err error) {
+	defer func(begin time.Time) {
+		mm.counters["PullTransactionsQuery"].Inc()
+		mm.latencies["PullTransactionsQuery"].Observe(time.Since(begin).Seconds())
+		if err1 := mm.pusher.Add(); err1 != nil {
+			err = fmt.Errorf("%w: %w", err, err1)
+		}
+	}(time.Now())
+
+	return mm.sdk.PullTransactionsQuery(ctx, pqReq)
+}
+
+func (mm *metricsMiddleware) StandingOrder(ctx context.Context, soReq mpesa.StandingOrderReq) (resp mpesa.StandingOrderResp, err error) {
+	defer func(begin time.Time) {
+		mm.counters["StandingOrder"].Inc()
+		mm.latencies["StandingOrder"].Observe(time.Since(begin).Seconds())
+		if err1 := mm.pusher.Add(); err1 != nil {
+			err = fmt.Errorf("%w: %w", err, err1)
+		}
+	}(time.Now())
+
+	return mm.sdk.StandingOrder(ctx, soReq)
+}
+
+func (mm *metricsMiddleware) B2BExpressCheckout(ctx context.Context, b2bReq mpesa.B2BExpressCheckoutReq) (resp mpesa.B2BExpressCheckoutResp, err error) {
+	defer func(begin time.Time) {
+		mm.counters["B2BExpressCheckout"].Inc()
+		mm.latencies["B2BExpressCheckout"].Observe(time.Since(begin).Seconds())
+		if err1 := mm.pusher.Add(); err1 != nil {
+			err = fmt.Errorf("%w: %w", err, err1)
+		}
+	}(time.Now())
+
+	return mm.sdk.B2BExpressCheckout(ctx, b2bReq)
+}
+
+func (mm *metricsMiddleware) B2CAccountTopUp(ctx context.Context, topUpReq mpesa.B2CAccountTopUpReq) (resp mpesa.B2CAccountTopUpResp, err error) {
+	defer func(begin time.Time) {
+		mm.counters["B2CAccountTopUp"].Inc()
+		mm.latencies["B2CAccountTopUp"].Observe(time.Since(begin).Seconds())
+		if err1 := mm.pusher.Add(); err1 != nil {
+			err = fmt.Errorf("%w: %w", err, err1)
+		}
+	}(time.Now())
+
+	return mm.sdk.B2CAccountTopUp(ctx, topUpReq)
+}
+
+func (mm *metricsMiddleware) B2Pochi(ctx context.Context, pochiReq mpesa.B2PochiReq) (resp mpesa.B2PochiResp, err error) {
+	defer func(begin time.Time) {
+		mm.counters["B2Pochi"].Inc()
+		mm.latencies["B2Pochi"].Observe(time.Since(begin).Seconds())
+		if err1 := mm.pusher.Add(); err1 != nil {
+			err = fmt.Errorf("%w: %w", err, err1)
+		}
+	}(time.Now())
+
+	return mm.sdk.B2Pochi(ctx, pochiReq)
+}
+
+func (mm *metricsMiddleware) SIMSwap(ctx context.Context, ssReq mpesa.SIMSwapReq) (resp mpesa.SIMSwapResp, err error) {
+	defer func(begin time.Time) {
+		mm.counters["SIMSwap"].Inc()
+		mm.latencies["SIMSwap"].Observe(time.Since(begin).Seconds())
+		if err1 := mm.pusher.Add(); err1 != nil {
+			err = fmt.Errorf("%w: %w", err, err1)
+		}
+	}(time.Now())
+
+	return mm.sdk.SIMSwap(ctx, ssReq)
 }
 
 func (mm *metricsMiddleware) counter(name string) prom.Counter {
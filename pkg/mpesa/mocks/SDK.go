@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	mpesa "github.com/0x6flab/mpesaoverlay/pkg/mpesa"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,9 +14,9 @@ type SDK struct {
 	mock.Mock
 }
 
-// AccountBalance provides a mock function with given fields: abReq
-func (_m *SDK) AccountBalance(abReq mpesa.AccountBalanceReq) (mpesa.AccountBalanceResp, error) {
-	ret := _m.Called(abReq)
+// AccountBalance provides a mock function with given fields: ctx, abReq
+func (_m *SDK) AccountBalance(ctx context.Context, abReq mpesa.AccountBalanceReq) (mpesa.AccountBalanceResp, error) {
+	ret := _m.Called(ctx, abReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AccountBalance")
@@ -22,17 +24,17 @@ func (_m *SDK) AccountBalance(abReq mpesa.AccountBalanceReq) (mpesa.AccountBalan
 
 	var r0 mpesa.AccountBalanceResp
 	var r1 error
-	if rf, ok := ret.Get(0).(func(mpesa.AccountBalanceReq) (mpesa.AccountBalanceResp, error)); ok {
-		return rf(abReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.AccountBalanceReq) (mpesa.AccountBalanceResp, error)); ok {
+		return rf(ctx, abReq)
 	}
-	if rf, ok := ret.Get(0).(func(mpesa.AccountBalanceReq) mpesa.AccountBalanceResp); ok {
-		r0 = rf(abReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.AccountBalanceReq) mpesa.AccountBalanceResp); ok {
+		r0 = rf(ctx, abReq)
 	} else {
 		r0 = ret.Get(0).(mpesa.AccountBalanceResp)
 	}
 
-	if rf, ok := ret.Get(1).(func(mpesa.AccountBalanceReq) error); ok {
-		r1 = rf(abReq)
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.AccountBalanceReq) error); ok {
+		r1 = rf(ctx, abReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,9 +42,65 @@ func (_m *SDK) AccountBalance(abReq mpesa.AccountBalanceReq) (mpesa.AccountBalan
 	return r0, r1
 }
 
-// B2CPayment provides a mock function with given fields: b2cReq
-func (_m *SDK) B2CPayment(b2cReq mpesa.B2CPaymentReq) (mpesa.B2CPaymentResp, error) {
-	ret := _m.Called(b2cReq)
+// B2BExpressCheckout provides a mock function with given fields: ctx, b2bReq
+func (_m *SDK) B2BExpressCheckout(ctx context.Context, b2bReq mpesa.B2BExpressCheckoutReq) (mpesa.B2BExpressCheckoutResp, error) {
+	ret := _m.Called(ctx, b2bReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for B2BExpressCheckout")
+	}
+
+	var r0 mpesa.B2BExpressCheckoutResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.B2BExpressCheckoutReq) (mpesa.B2BExpressCheckoutResp, error)); ok {
+		return rf(ctx, b2bReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.B2BExpressCheckoutReq) mpesa.B2BExpressCheckoutResp); ok {
+		r0 = rf(ctx, b2bReq)
+	} else {
+		r0 = ret.Get(0).(mpesa.B2BExpressCheckoutResp)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.B2BExpressCheckoutReq) error); ok {
+		r1 = rf(ctx, b2bReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// B2CAccountTopUp provides a mock function with given fields: ctx, topUpReq
+func (_m *SDK) B2CAccountTopUp(ctx context.Context, topUpReq mpesa.B2CAccountTopUpReq) (mpesa.B2CAccountTopUpResp, error) {
+	ret := _m.Called(ctx, topUpReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for B2CAccountTopUp")
+	}
+
+	var r0 mpesa.B2CAccountTopUpResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.B2CAccountTopUpReq) (mpesa.B2CAccountTopUpResp, error)); ok {
+		return rf(ctx, topUpReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.B2CAccountTopUpReq) mpesa.B2CAccountTopUpResp); ok {
+		r0 = rf(ctx, topUpReq)
+	} else {
+		r0 = ret.Get(0).(mpesa.B2CAccountTopUpResp)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.B2CAccountTopUpReq) error); ok {
+		r1 = rf(ctx, topUpReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// B2CPayment provides a mock function with given fields: ctx, b2cReq
+func (_m *SDK) B2CPayment(ctx context.Context, b2cReq mpesa.B2CPaymentReq) (mpesa.B2CPaymentResp, error) {
+	ret := _m.Called(ctx, b2cReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for B2CPayment")
@@ -50,17 +108,45 @@ func (_m *SDK) B2CPayment(b2cReq mpesa.B2CPaymentReq) (mpesa.B2CPaymentResp, err
 
 	var r0 mpesa.B2CPaymentResp
 	var r1 error
-	if rf, ok := ret.Get(0).(func(mpesa.B2CPaymentReq) (mpesa.B2CPaymentResp, error)); ok {
-		return rf(b2cReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.B2CPaymentReq) (mpesa.B2CPaymentResp, error)); ok {
+		return rf(ctx, b2cReq)
 	}
-	if rf, ok := ret.Get(0).(func(mpesa.B2CPaymentReq) mpesa.B2CPaymentResp); ok {
-		r0 = rf(b2cReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.B2CPaymentReq) mpesa.B2CPaymentResp); ok {
+		r0 = rf(ctx, b2cReq)
 	} else {
 		r0 = ret.Get(0).(mpesa.B2CPaymentResp)
 	}
 
-	if rf, ok := ret.Get(1).(func(mpesa.B2CPaymentReq) error); ok {
-		r1 = rf(b2cReq)
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.B2CPaymentReq) error); ok {
+		r1 = rf(ctx, b2cReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// B2Pochi provides a mock function with given fields: ctx, pochiReq
+func (_m *SDK) B2Pochi(ctx context.Context, pochiReq mpesa.B2PochiReq) (mpesa.B2PochiResp, error) {
+	ret := _m.Called(ctx, pochiReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for B2Pochi")
+	}
+
+	var r0 mpesa.B2PochiResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.B2PochiReq) (mpesa.B2PochiResp, error)); ok {
+		return rf(ctx, pochiReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.B2PochiReq) mpesa.B2PochiResp); ok {
+		r0 = rf(ctx, pochiReq)
+	} else {
+		r0 = ret.Get(0).(mpesa.B2PochiResp)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.B2PochiReq) error); ok {
+		r1 = rf(ctx, pochiReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -68,9 +154,177 @@ func (_m *SDK) B2CPayment(b2cReq mpesa.B2CPaymentReq) (mpesa.B2CPaymentResp, err
 	return r0, r1
 }
 
-// BusinessPayBill provides a mock function with given fields: bpbReq
-func (_m *SDK) BusinessPayBill(bpbReq mpesa.BusinessPayBillReq) (mpesa.BusinessPayBillResp, error) {
-	ret := _m.Called(bpbReq)
+// BillManagerBulkInvoice provides a mock function with given fields: ctx, bReq
+func (_m *SDK) BillManagerBulkInvoice(ctx context.Context, bReq mpesa.BillManagerBulkInvoiceReq) (mpesa.BillManagerBulkInvoiceResp, error) {
+	ret := _m.Called(ctx, bReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BillManagerBulkInvoice")
+	}
+
+	var r0 mpesa.BillManagerBulkInvoiceResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.BillManagerBulkInvoiceReq) (mpesa.BillManagerBulkInvoiceResp, error)); ok {
+		return rf(ctx, bReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.BillManagerBulkInvoiceReq) mpesa.BillManagerBulkInvoiceResp); ok {
+		r0 = rf(ctx, bReq)
+	} else {
+		r0 = ret.Get(0).(mpesa.BillManagerBulkInvoiceResp)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.BillManagerBulkInvoiceReq) error); ok {
+		r1 = rf(ctx, bReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BillManagerCancelInvoice provides a mock function with given fields: ctx, cReq
+func (_m *SDK) BillManagerCancelInvoice(ctx context.Context, cReq mpesa.BillManagerCancelInvoiceReq) (mpesa.BillManagerCancelInvoiceResp, error) {
+	ret := _m.Called(ctx, cReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BillManagerCancelInvoice")
+	}
+
+	var r0 mpesa.BillManagerCancelInvoiceResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.BillManagerCancelInvoiceReq) (mpesa.BillManagerCancelInvoiceResp, error)); ok {
+		return rf(ctx, cReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.BillManagerCancelInvoiceReq) mpesa.BillManagerCancelInvoiceResp); ok {
+		r0 = rf(ctx, cReq)
+	} else {
+		r0 = ret.Get(0).(mpesa.BillManagerCancelInvoiceResp)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.BillManagerCancelInvoiceReq) error); ok {
+		r1 = rf(ctx, cReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BillManagerOptIn provides a mock function with given fields: ctx, oReq
+func (_m *SDK) BillManagerOptIn(ctx context.Context, oReq mpesa.BillManagerOptInReq) (mpesa.BillManagerOptInResp, error) {
+	ret := _m.Called(ctx, oReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BillManagerOptIn")
+	}
+
+	var r0 mpesa.BillManagerOptInResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.BillManagerOptInReq) (mpesa.BillManagerOptInResp, error)); ok {
+		return rf(ctx, oReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.BillManagerOptInReq) mpesa.BillManagerOptInResp); ok {
+		r0 = rf(ctx, oReq)
+	} else {
+		r0 = ret.Get(0).(mpesa.BillManagerOptInResp)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.BillManagerOptInReq) error); ok {
+		r1 = rf(ctx, oReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BillManagerReconcile provides a mock function with given fields: ctx, rReq
+func (_m *SDK) BillManagerReconcile(ctx context.Context, rReq mpesa.BillManagerReconcileReq) (mpesa.BillManagerReconcileResp, error) {
+	ret := _m.Called(ctx, rReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BillManagerReconcile")
+	}
+
+	var r0 mpesa.BillManagerReconcileResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.BillManagerReconcileReq) (mpesa.BillManagerReconcileResp, error)); ok {
+		return rf(ctx, rReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.BillManagerReconcileReq) mpesa.BillManagerReconcileResp); ok {
+		r0 = rf(ctx, rReq)
+	} else {
+		r0 = ret.Get(0).(mpesa.BillManagerReconcileResp)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.BillManagerReconcileReq) error); ok {
+		r1 = rf(ctx, rReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BillManagerSingleInvoice provides a mock function with given fields: ctx, iReq
+func (_m *SDK) BillManagerSingleInvoice(ctx context.Context, iReq mpesa.BillManagerSingleInvoiceReq) (mpesa.BillManagerSingleInvoiceResp, error) {
+	ret := _m.Called(ctx, iReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BillManagerSingleInvoice")
+	}
+
+	var r0 mpesa.BillManagerSingleInvoiceResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.BillManagerSingleInvoiceReq) (mpesa.BillManagerSingleInvoiceResp, error)); ok {
+		return rf(ctx, iReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.BillManagerSingleInvoiceReq) mpesa.BillManagerSingleInvoiceResp); ok {
+		r0 = rf(ctx, iReq)
+	} else {
+		r0 = ret.Get(0).(mpesa.BillManagerSingleInvoiceResp)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.BillManagerSingleInvoiceReq) error); ok {
+		r1 = rf(ctx, iReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BillManagerUpdateOptIn provides a mock function with given fields: ctx, uReq
+func (_m *SDK) BillManagerUpdateOptIn(ctx context.Context, uReq mpesa.BillManagerUpdateOptInReq) (mpesa.BillManagerUpdateOptInResp, error) {
+	ret := _m.Called(ctx, uReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BillManagerUpdateOptIn")
+	}
+
+	var r0 mpesa.BillManagerUpdateOptInResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.BillManagerUpdateOptInReq) (mpesa.BillManagerUpdateOptInResp, error)); ok {
+		return rf(ctx, uReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.BillManagerUpdateOptInReq) mpesa.BillManagerUpdateOptInResp); ok {
+		r0 = rf(ctx, uReq)
+	} else {
+		r0 = ret.Get(0).(mpesa.BillManagerUpdateOptInResp)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.BillManagerUpdateOptInReq) error); ok {
+		r1 = rf(ctx, uReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BusinessPayBill provides a mock function with given fields: ctx, bpbReq
+func (_m *SDK) BusinessPayBill(ctx context.Context, bpbReq mpesa.BusinessPayBillReq) (mpesa.BusinessPayBillResp, error) {
+	ret := _m.Called(ctx, bpbReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BusinessPayBill")
@@ -78,17 +332,17 @@ func (_m *SDK) BusinessPayBill(bpbReq mpesa.BusinessPayBillReq) (mpesa.BusinessP
 
 	var r0 mpesa.BusinessPayBillResp
 	var r1 error
-	if rf, ok := ret.Get(0).(func(mpesa.BusinessPayBillReq) (mpesa.BusinessPayBillResp, error)); ok {
-		return rf(bpbReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.BusinessPayBillReq) (mpesa.BusinessPayBillResp, error)); ok {
+		return rf(ctx, bpbReq)
 	}
-	if rf, ok := ret.Get(0).(func(mpesa.BusinessPayBillReq) mpesa.BusinessPayBillResp); ok {
-		r0 = rf(bpbReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.BusinessPayBillReq) mpesa.BusinessPayBillResp); ok {
+		r0 = rf(ctx, bpbReq)
 	} else {
 		r0 = ret.Get(0).(mpesa.BusinessPayBillResp)
 	}
 
-	if rf, ok := ret.Get(1).(func(mpesa.BusinessPayBillReq) error); ok {
-		r1 = rf(bpbReq)
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.BusinessPayBillReq) error); ok {
+		r1 = rf(ctx, bpbReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -96,9 +350,9 @@ func (_m *SDK) BusinessPayBill(bpbReq mpesa.BusinessPayBillReq) (mpesa.BusinessP
 	return r0, r1
 }
 
-// C2BRegisterURL provides a mock function with given fields: c2bReq
-func (_m *SDK) C2BRegisterURL(c2bReq mpesa.C2BRegisterURLReq) (mpesa.C2BRegisterURLResp, error) {
-	ret := _m.Called(c2bReq)
+// C2BRegisterURL provides a mock function with given fields: ctx, c2bReq
+func (_m *SDK) C2BRegisterURL(ctx context.Context, c2bReq mpesa.C2BRegisterURLReq) (mpesa.C2BRegisterURLResp, error) {
+	ret := _m.Called(ctx, c2bReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for C2BRegisterURL")
@@ -106,17 +360,17 @@ func (_m *SDK) C2BRegisterURL(c2bReq mpesa.C2BRegisterURLReq) (mpesa.C2BRegister
 
 	var r0 mpesa.C2BRegisterURLResp
 	var r1 error
-	if rf, ok := ret.Get(0).(func(mpesa.C2BRegisterURLReq) (mpesa.C2BRegisterURLResp, error)); ok {
-		return rf(c2bReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.C2BRegisterURLReq) (mpesa.C2BRegisterURLResp, error)); ok {
+		return rf(ctx, c2bReq)
 	}
-	if rf, ok := ret.Get(0).(func(mpesa.C2BRegisterURLReq) mpesa.C2BRegisterURLResp); ok {
-		r0 = rf(c2bReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.C2BRegisterURLReq) mpesa.C2BRegisterURLResp); ok {
+		r0 = rf(ctx, c2bReq)
 	} else {
 		r0 = ret.Get(0).(mpesa.C2BRegisterURLResp)
 	}
 
-	if rf, ok := ret.Get(1).(func(mpesa.C2BRegisterURLReq) error); ok {
-		r1 = rf(c2bReq)
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.C2BRegisterURLReq) error); ok {
+		r1 = rf(ctx, c2bReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -124,9 +378,9 @@ func (_m *SDK) C2BRegisterURL(c2bReq mpesa.C2BRegisterURLReq) (mpesa.C2BRegister
 	return r0, r1
 }
 
-// C2BSimulate provides a mock function with given fields: c2bReq
-func (_m *SDK) C2BSimulate(c2bReq mpesa.C2BSimulateReq) (mpesa.C2BSimulateResp, error) {
-	ret := _m.Called(c2bReq)
+// C2BSimulate provides a mock function with given fields: ctx, c2bReq
+func (_m *SDK) C2BSimulate(ctx context.Context, c2bReq mpesa.C2BSimulateReq) (mpesa.C2BSimulateResp, error) {
+	ret := _m.Called(ctx, c2bReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for C2BSimulate")
@@ -134,17 +388,17 @@ func (_m *SDK) C2BSimulate(c2bReq mpesa.C2BSimulateReq) (mpesa.C2BSimulateResp,
 
 	var r0 mpesa.C2BSimulateResp
 	var r1 error
-	if rf, ok := ret.Get(0).(func(mpesa.C2BSimulateReq) (mpesa.C2BSimulateResp, error)); ok {
-		return rf(c2bReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.C2BSimulateReq) (mpesa.C2BSimulateResp, error)); ok {
+		return rf(ctx, c2bReq)
 	}
-	if rf, ok := ret.Get(0).(func(mpesa.C2BSimulateReq) mpesa.C2BSimulateResp); ok {
-		r0 = rf(c2bReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.C2BSimulateReq) mpesa.C2BSimulateResp); ok {
+		r0 = rf(ctx, c2bReq)
 	} else {
 		r0 = ret.Get(0).(mpesa.C2BSimulateResp)
 	}
 
-	if rf, ok := ret.Get(1).(func(mpesa.C2BSimulateReq) error); ok {
-		r1 = rf(c2bReq)
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.C2BSimulateReq) error); ok {
+		r1 = rf(ctx, c2bReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -152,9 +406,9 @@ func (_m *SDK) C2BSimulate(c2bReq mpesa.C2BSimulateReq) (mpesa.C2BSimulateResp,
 	return r0, r1
 }
 
-// ExpressQuery provides a mock function with given fields: eqReq
-func (_m *SDK) ExpressQuery(eqReq mpesa.ExpressQueryReq) (mpesa.ExpressQueryResp, error) {
-	ret := _m.Called(eqReq)
+// ExpressQuery provides a mock function with given fields: ctx, eqReq
+func (_m *SDK) ExpressQuery(ctx context.Context, eqReq mpesa.ExpressQueryReq) (mpesa.ExpressQueryResp, error) {
+	ret := _m.Called(ctx, eqReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ExpressQuery")
@@ -162,17 +416,17 @@ func (_m *SDK) ExpressQuery(eqReq mpesa.ExpressQueryReq) (mpesa.ExpressQueryResp
 
 	var r0 mpesa.ExpressQueryResp
 	var r1 error
-	if rf, ok := ret.Get(0).(func(mpesa.ExpressQueryReq) (mpesa.ExpressQueryResp, error)); ok {
-		return rf(eqReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.ExpressQueryReq) (mpesa.ExpressQueryResp, error)); ok {
+		return rf(ctx, eqReq)
 	}
-	if rf, ok := ret.Get(0).(func(mpesa.ExpressQueryReq) mpesa.ExpressQueryResp); ok {
-		r0 = rf(eqReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.ExpressQueryReq) mpesa.ExpressQueryResp); ok {
+		r0 = rf(ctx, eqReq)
 	} else {
 		r0 = ret.Get(0).(mpesa.ExpressQueryResp)
 	}
 
-	if rf, ok := ret.Get(1).(func(mpesa.ExpressQueryReq) error); ok {
-		r1 = rf(eqReq)
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.ExpressQueryReq) error); ok {
+		r1 = rf(ctx, eqReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -180,9 +434,9 @@ func (_m *SDK) ExpressQuery(eqReq mpesa.ExpressQueryReq) (mpesa.ExpressQueryResp
 	return r0, r1
 }
 
-// ExpressSimulate provides a mock function with given fields: eReq
-func (_m *SDK) ExpressSimulate(eReq mpesa.ExpressSimulateReq) (mpesa.ExpressSimulateResp, error) {
-	ret := _m.Called(eReq)
+// ExpressSimulate provides a mock function with given fields: ctx, eReq
+func (_m *SDK) ExpressSimulate(ctx context.Context, eReq mpesa.ExpressSimulateReq) (mpesa.ExpressSimulateResp, error) {
+	ret := _m.Called(ctx, eReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ExpressSimulate")
@@ -190,17 +444,17 @@ func (_m *SDK) ExpressSimulate(eReq mpesa.ExpressSimulateReq) (mpesa.ExpressSimu
 
 	var r0 mpesa.ExpressSimulateResp
 	var r1 error
-	if rf, ok := ret.Get(0).(func(mpesa.ExpressSimulateReq) (mpesa.ExpressSimulateResp, error)); ok {
-		return rf(eReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.ExpressSimulateReq) (mpesa.ExpressSimulateResp, error)); ok {
+		return rf(ctx, eReq)
 	}
-	if rf, ok := ret.Get(0).(func(mpesa.ExpressSimulateReq) mpesa.ExpressSimulateResp); ok {
-		r0 = rf(eReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.ExpressSimulateReq) mpesa.ExpressSimulateResp); ok {
+		r0 = rf(ctx, eReq)
 	} else {
 		r0 = ret.Get(0).(mpesa.ExpressSimulateResp)
 	}
 
-	if rf, ok := ret.Get(1).(func(mpesa.ExpressSimulateReq) error); ok {
-		r1 = rf(eReq)
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.ExpressSimulateReq) error); ok {
+		r1 = rf(ctx, eReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -208,9 +462,9 @@ func (_m *SDK) ExpressSimulate(eReq mpesa.ExpressSimulateReq) (mpesa.ExpressSimu
 	return r0, r1
 }
 
-// GenerateQR provides a mock function with given fields: qReq
-func (_m *SDK) GenerateQR(qReq mpesa.GenerateQRReq) (mpesa.GenerateQRResp, error) {
-	ret := _m.Called(qReq)
+// GenerateQR provides a mock function with given fields: ctx, qReq
+func (_m *SDK) GenerateQR(ctx context.Context, qReq mpesa.GenerateQRReq) (mpesa.GenerateQRResp, error) {
+	ret := _m.Called(ctx, qReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateQR")
@@ -218,17 +472,73 @@ func (_m *SDK) GenerateQR(qReq mpesa.GenerateQRReq) (mpesa.GenerateQRResp, error
 
 	var r0 mpesa.GenerateQRResp
 	var r1 error
-	if rf, ok := ret.Get(0).(func(mpesa.GenerateQRReq) (mpesa.GenerateQRResp, error)); ok {
-		return rf(qReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.GenerateQRReq) (mpesa.GenerateQRResp, error)); ok {
+		return rf(ctx, qReq)
 	}
-	if rf, ok := ret.Get(0).(func(mpesa.GenerateQRReq) mpesa.GenerateQRResp); ok {
-		r0 = rf(qReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.GenerateQRReq) mpesa.GenerateQRResp); ok {
+		r0 = rf(ctx, qReq)
 	} else {
 		r0 = ret.Get(0).(mpesa.GenerateQRResp)
 	}
 
-	if rf, ok := ret.Get(1).(func(mpesa.GenerateQRReq) error); ok {
-		r1 = rf(qReq)
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.GenerateQRReq) error); ok {
+		r1 = rf(ctx, qReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullTransactionsQuery provides a mock function with given fields: ctx, pqReq
+func (_m *SDK) PullTransactionsQuery(ctx context.Context, pqReq mpesa.PullTransactionsQueryReq) (mpesa.PullTransactionsQueryResp, error) {
+	ret := _m.Called(ctx, pqReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PullTransactionsQuery")
+	}
+
+	var r0 mpesa.PullTransactionsQueryResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.PullTransactionsQueryReq) (mpesa.PullTransactionsQueryResp, error)); ok {
+		return rf(ctx, pqReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.PullTransactionsQueryReq) mpesa.PullTransactionsQueryResp); ok {
+		r0 = rf(ctx, pqReq)
+	} else {
+		r0 = ret.Get(0).(mpesa.PullTransactionsQueryResp)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.PullTransactionsQueryReq) error); ok {
+		r1 = rf(ctx, pqReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullTransactionsRegister provides a mock function with given fields: ctx, prReq
+func (_m *SDK) PullTransactionsRegister(ctx context.Context, prReq mpesa.PullTransactionsRegisterReq) (mpesa.PullTransactionsRegisterResp, error) {
+	ret := _m.Called(ctx, prReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PullTransactionsRegister")
+	}
+
+	var r0 mpesa.PullTransactionsRegisterResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.PullTransactionsRegisterReq) (mpesa.PullTransactionsRegisterResp, error)); ok {
+		return rf(ctx, prReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.PullTransactionsRegisterReq) mpesa.PullTransactionsRegisterResp); ok {
+		r0 = rf(ctx, prReq)
+	} else {
+		r0 = ret.Get(0).(mpesa.PullTransactionsRegisterResp)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.PullTransactionsRegisterReq) error); ok {
+		r1 = rf(ctx, prReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -236,9 +546,9 @@ func (_m *SDK) GenerateQR(qReq mpesa.GenerateQRReq) (mpesa.GenerateQRResp, error
 	return r0, r1
 }
 
-// RemitTax provides a mock function with given fields: rReq
-func (_m *SDK) RemitTax(rReq mpesa.RemitTaxReq) (mpesa.RemitTaxResp, error) {
-	ret := _m.Called(rReq)
+// RemitTax provides a mock function with given fields: ctx, rReq
+func (_m *SDK) RemitTax(ctx context.Context, rReq mpesa.RemitTaxReq) (mpesa.RemitTaxResp, error) {
+	ret := _m.Called(ctx, rReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RemitTax")
@@ -246,17 +556,17 @@ func (_m *SDK) RemitTax(rReq mpesa.RemitTaxReq) (mpesa.RemitTaxResp, error) {
 
 	var r0 mpesa.RemitTaxResp
 	var r1 error
-	if rf, ok := ret.Get(0).(func(mpesa.RemitTaxReq) (mpesa.RemitTaxResp, error)); ok {
-		return rf(rReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.RemitTaxReq) (mpesa.RemitTaxResp, error)); ok {
+		return rf(ctx, rReq)
 	}
-	if rf, ok := ret.Get(0).(func(mpesa.RemitTaxReq) mpesa.RemitTaxResp); ok {
-		r0 = rf(rReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.RemitTaxReq) mpesa.RemitTaxResp); ok {
+		r0 = rf(ctx, rReq)
 	} else {
 		r0 = ret.Get(0).(mpesa.RemitTaxResp)
 	}
 
-	if rf, ok := ret.Get(1).(func(mpesa.RemitTaxReq) error); ok {
-		r1 = rf(rReq)
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.RemitTaxReq) error); ok {
+		r1 = rf(ctx, rReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -264,9 +574,9 @@ func (_m *SDK) RemitTax(rReq mpesa.RemitTaxReq) (mpesa.RemitTaxResp, error) {
 	return r0, r1
 }
 
-// Reverse provides a mock function with given fields: rReq
-func (_m *SDK) Reverse(rReq mpesa.ReverseReq) (mpesa.ReverseResp, error) {
-	ret := _m.Called(rReq)
+// Reverse provides a mock function with given fields: ctx, rReq
+func (_m *SDK) Reverse(ctx context.Context, rReq mpesa.ReverseReq) (mpesa.ReverseResp, error) {
+	ret := _m.Called(ctx, rReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Reverse")
@@ -274,17 +584,73 @@ func (_m *SDK) Reverse(rReq mpesa.ReverseReq) (mpesa.ReverseResp, error) {
 
 	var r0 mpesa.ReverseResp
 	var r1 error
-	if rf, ok := ret.Get(0).(func(mpesa.ReverseReq) (mpesa.ReverseResp, error)); ok {
-		return rf(rReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.ReverseReq) (mpesa.ReverseResp, error)); ok {
+		return rf(ctx, rReq)
 	}
-	if rf, ok := ret.Get(0).(func(mpesa.ReverseReq) mpesa.ReverseResp); ok {
-		r0 = rf(rReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.ReverseReq) mpesa.ReverseResp); ok {
+		r0 = rf(ctx, rReq)
 	} else {
 		r0 = ret.Get(0).(mpesa.ReverseResp)
 	}
 
-	if rf, ok := ret.Get(1).(func(mpesa.ReverseReq) error); ok {
-		r1 = rf(rReq)
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.ReverseReq) error); ok {
+		r1 = rf(ctx, rReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SIMSwap provides a mock function with given fields: ctx, ssReq
+func (_m *SDK) SIMSwap(ctx context.Context, ssReq mpesa.SIMSwapReq) (mpesa.SIMSwapResp, error) {
+	ret := _m.Called(ctx, ssReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SIMSwap")
+	}
+
+	var r0 mpesa.SIMSwapResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.SIMSwapReq) (mpesa.SIMSwapResp, error)); ok {
+		return rf(ctx, ssReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.SIMSwapReq) mpesa.SIMSwapResp); ok {
+		r0 = rf(ctx, ssReq)
+	} else {
+		r0 = ret.Get(0).(mpesa.SIMSwapResp)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.SIMSwapReq) error); ok {
+		r1 = rf(ctx, ssReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StandingOrder provides a mock function with given fields: ctx, soReq
+func (_m *SDK) StandingOrder(ctx context.Context, soReq mpesa.StandingOrderReq) (mpesa.StandingOrderResp, error) {
+	ret := _m.Called(ctx, soReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StandingOrder")
+	}
+
+	var r0 mpesa.StandingOrderResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.StandingOrderReq) (mpesa.StandingOrderResp, error)); ok {
+		return rf(ctx, soReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.StandingOrderReq) mpesa.StandingOrderResp); ok {
+		r0 = rf(ctx, soReq)
+	} else {
+		r0 = ret.Get(0).(mpesa.StandingOrderResp)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.StandingOrderReq) error); ok {
+		r1 = rf(ctx, soReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -292,9 +658,9 @@ func (_m *SDK) Reverse(rReq mpesa.ReverseReq) (mpesa.ReverseResp, error) {
 	return r0, r1
 }
 
-// Token provides a mock function with given fields:
-func (_m *SDK) Token() (mpesa.TokenResp, error) {
-	ret := _m.Called()
+// Token provides a mock function with given fields: ctx
+func (_m *SDK) Token(ctx context.Context) (mpesa.TokenResp, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Token")
@@ -302,17 +668,17 @@ func (_m *SDK) Token() (mpesa.TokenResp, error) {
 
 	var r0 mpesa.TokenResp
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (mpesa.TokenResp, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (mpesa.TokenResp, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() mpesa.TokenResp); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) mpesa.TokenResp); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(mpesa.TokenResp)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -320,9 +686,9 @@ func (_m *SDK) Token() (mpesa.TokenResp, error) {
 	return r0, r1
 }
 
-// TransactionStatus provides a mock function with given fields: tReq
-func (_m *SDK) TransactionStatus(tReq mpesa.TransactionStatusReq) (mpesa.TransactionStatusResp, error) {
-	ret := _m.Called(tReq)
+// TransactionStatus provides a mock function with given fields: ctx, tReq
+func (_m *SDK) TransactionStatus(ctx context.Context, tReq mpesa.TransactionStatusReq) (mpesa.TransactionStatusResp, error) {
+	ret := _m.Called(ctx, tReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for TransactionStatus")
@@ -330,17 +696,17 @@ func (_m *SDK) TransactionStatus(tReq mpesa.TransactionStatusReq) (mpesa.Transac
 
 	var r0 mpesa.TransactionStatusResp
 	var r1 error
-	if rf, ok := ret.Get(0).(func(mpesa.TransactionStatusReq) (mpesa.TransactionStatusResp, error)); ok {
-		return rf(tReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.TransactionStatusReq) (mpesa.TransactionStatusResp, error)); ok {
+		return rf(ctx, tReq)
 	}
-	if rf, ok := ret.Get(0).(func(mpesa.TransactionStatusReq) mpesa.TransactionStatusResp); ok {
-		r0 = rf(tReq)
+	if rf, ok := ret.Get(0).(func(context.Context, mpesa.TransactionStatusReq) mpesa.TransactionStatusResp); ok {
+		r0 = rf(ctx, tReq)
 	} else {
 		r0 = ret.Get(0).(mpesa.TransactionStatusResp)
 	}
 
-	if rf, ok := ret.Get(1).(func(mpesa.TransactionStatusReq) error); ok {
-		r1 = rf(tReq)
+	if rf, ok := ret.Get(1).(func(context.Context, mpesa.TransactionStatusReq) error); ok {
+		r1 = rf(ctx, tReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,86 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+const (
+	msisdnMaskChar  = '*'
+	msisdnHashedLen = sha256.Size * 2
+)
+
+// MSISDNFormat is how Daraja presents the phone number of a customer in a callback.
+type MSISDNFormat string
+
+// MSISDN formats.
+const (
+	MSISDNUnknown MSISDNFormat = ""       // The MSISDN is empty or not recognised.
+	MSISDNPlain   MSISDNFormat = "plain"  // The full phone number, e.g. 254708374149.
+	MSISDNMasked  MSISDNFormat = "masked" // Some digits are replaced by asterisks, e.g. 2547*****149.
+	MSISDNHashed  MSISDNFormat = "hashed" // The hex encoded SHA-256 hash of the phone number.
+)
+
+// ParseMSISDNFormat returns the format of an MSISDN received in a callback.
+func ParseMSISDNFormat(msisdn string) MSISDNFormat {
+	msisdn = strings.ReplaceAll(msisdn, " ", "")
+
+	switch {
+	case msisdn == "":
+		return MSISDNUnknown
+	case strings.Trim(msisdn, "0123456789") == "":
+		return MSISDNPlain
+	case strings.Trim(msisdn, "0123456789*") == "" && strings.ContainsRune(msisdn, msisdnMaskChar):
+		return MSISDNMasked
+	case len(msisdn) == msisdnHashedLen && strings.Trim(strings.ToLower(msisdn), "0123456789abcdef") == "":
+		return MSISDNHashed
+	default:
+		return MSISDNUnknown
+	}
+}
+
+// MatchMSISDN reports whether the MSISDN of a callback belongs to the phone
+// number, e.g. 254708374149. A hashed MSISDN matches when it is the SHA-256
+// hash of the phone number. A masked MSISDN only reveals some digits, so it
+// matches every phone number that has the same digits at the same positions.
+func MatchMSISDN(msisdn string, phoneNumber uint64) bool {
+	msisdn = strings.ReplaceAll(msisdn, " ", "")
+	phone := strconv.FormatUint(phoneNumber, 10)
+
+	switch ParseMSISDNFormat(msisdn) {
+	case MSISDNPlain:
+		return msisdn == phone
+	case MSISDNMasked:
+		if len(msisdn) != len(phone) {
+			return false
+		}
+		for i := range msisdn {
+			if msisdn[i] != msisdnMaskChar && msisdn[i] != phone[i] {
+				return false
+			}
+		}
+
+		return true
+	case MSISDNHashed:
+		return subtle.ConstantTimeCompare([]byte(strings.ToLower(msisdn)), []byte(HashMSISDN(phoneNumber))) == 1
+	default:
+		return false
+	}
+}
+
+// HashMSISDN returns the hex encoded SHA-256 hash of the phone number, the
+// way Daraja hashes the MSISDN in the callbacks of the v2 C2B endpoints.
+func HashMSISDN(phoneNumber uint64) string {
+	sum := sha256.Sum256([]byte(strconv.FormatUint(phoneNumber, 10)))
+
+	return hex.EncodeToString(sum[:])
+}
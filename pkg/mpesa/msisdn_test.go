@@ -0,0 +1,71 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	knownPhoneNumber = 254708374149
+	knownHash        = "bbff37cea44ac0b2d964ee0dfb8d2df8513dc7ba1b36129a929fc3fbd6dd4af4"
+)
+
+func TestParseMSISDNFormat(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msisdn   string
+		expected MSISDNFormat
+	}{
+		{name: "empty", msisdn: "", expected: MSISDNUnknown},
+		{name: "plain", msisdn: "254708374149", expected: MSISDNPlain},
+		{name: "masked", msisdn: "2547*****149", expected: MSISDNMasked},
+		{name: "masked with spaces", msisdn: "2547 ***** 149", expected: MSISDNMasked},
+		{name: "hashed", msisdn: HashMSISDN(knownPhoneNumber), expected: MSISDNHashed},
+		{name: "hashed upper case", msisdn: strings.ToUpper(HashMSISDN(knownPhoneNumber)), expected: MSISDNHashed},
+		{name: "unknown", msisdn: "john.doe", expected: MSISDNUnknown},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ParseMSISDNFormat(tc.msisdn))
+		})
+	}
+}
+
+func TestMatchMSISDN(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msisdn   string
+		phone    uint64
+		expected bool
+	}{
+		{name: "plain", msisdn: "254708374149", phone: knownPhoneNumber, expected: true},
+		{name: "plain mismatch", msisdn: "254708374148", phone: knownPhoneNumber, expected: false},
+		{name: "masked", msisdn: "2547*****149", phone: knownPhoneNumber, expected: true},
+		{name: "masked with spaces", msisdn: "2547 ***** 149", phone: knownPhoneNumber, expected: true},
+		{name: "masked mismatch", msisdn: "2547*****148", phone: knownPhoneNumber, expected: false},
+		{name: "masked different length", msisdn: "2547****149", phone: knownPhoneNumber, expected: false},
+		{name: "hashed", msisdn: HashMSISDN(knownPhoneNumber), phone: knownPhoneNumber, expected: true},
+		{name: "hashed upper case", msisdn: strings.ToUpper(HashMSISDN(knownPhoneNumber)), phone: knownPhoneNumber, expected: true},
+		{name: "hashed mismatch", msisdn: HashMSISDN(254708374148), phone: knownPhoneNumber, expected: false},
+		{name: "unknown", msisdn: "john.doe", phone: knownPhoneNumber, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, MatchMSISDN(tc.msisdn, tc.phone))
+		})
+	}
+}
+
+func TestHashMSISDN(t *testing.T) {
+	assert.Equal(t, knownHash, HashMSISDN(knownPhoneNumber))
+}
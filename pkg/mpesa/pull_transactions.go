@@ -0,0 +1,214 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+func (sdk mSDK) PullTransactionsRegister(ctx context.Context, prReq PullTransactionsRegisterReq) (PullTransactionsRegisterResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpPullTransactionsRegister)
+	defer cancel()
+
+	if prReq.RequestType == "" {
+		prReq.RequestType = pullRequestType
+	}
+
+	if err := prReq.Validate(); err != nil {
+		return PullTransactionsRegisterResp{}, err
+	}
+
+	resp, err := sdk.sendPullRequest(ctx, OpPullTransactionsRegister, prReq)
+	if err != nil {
+		return PullTransactionsRegisterResp{}, err
+	}
+
+	var pr PullTransactionsRegisterResp
+	if err := json.Unmarshal(resp, &pr); err != nil {
+		return PullTransactionsRegisterResp{}, err
+	}
+
+	if err := sdk.checkResponseCode(OpPullTransactionsRegister, pr.ResponseCode, pr.ResponseMessage, resp); err != nil {
+		return PullTransactionsRegisterResp{}, err
+	}
+
+	return pr, nil
+}
+
+func (sdk mSDK) PullTransactionsQuery(ctx context.Context, pqReq PullTransactionsQueryReq) (PullTransactionsQueryResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpPullTransactionsQuery)
+	defer cancel()
+
+	if err := pqReq.Validate(); err != nil {
+		return PullTransactionsQueryResp{}, err
+	}
+
+	var (
+		qr   PullTransactionsQueryResp
+		seen = make(map[string]struct{})
+	)
+	for page := 0; ; page++ {
+		resp, err := sdk.sendPullRequest(ctx, OpPullTransactionsQuery, pqReq)
+		if err != nil {
+			return PullTransactionsQueryResp{}, err
+		}
+
+		var pr pullTransactionsPage
+		if err := json.Unmarshal(resp, &pr); err != nil {
+			return PullTransactionsQueryResp{}, err
+		}
+
+		// The first page carries the status of the query. Later pages are
+		// only read for their transactions.
+		if page == 0 {
+			if err := sdk.checkResponseCode(OpPullTransactionsQuery, pr.ResponseCode, pr.ResponseMessage, resp); err != nil {
+				return PullTransactionsQueryResp{}, err
+			}
+			qr.ResponseRefID = pr.ResponseRefID
+			qr.ResponseCode = pr.ResponseCode
+			qr.ResponseMessage = pr.ResponseMessage
+		}
+
+		var count, added int
+		for _, transactions := range pr.Response {
+			for _, trx := range transactions {
+				count++
+				if _, ok := seen[trx.TransactionID]; ok {
+					continue
+				}
+				seen[trx.TransactionID] = struct{}{}
+				qr.Transactions = append(qr.Transactions, trx)
+				added++
+			}
+		}
+
+		// Daraja answers past the last page with no transactions. A page
+		// without new transactions means that the offset was not honoured,
+		// so paging stops there as well.
+		if added == 0 {
+			return qr, nil
+		}
+
+		pqReq.OffSetValue += uint64(count)
+	}
+}
+
+// sendPullRequest posts payload to the endpoint of a Pull Transactions
+// operation and returns the response body.
+func (sdk mSDK) sendPullRequest(ctx context.Context, op Operation, payload interface{}) ([]byte, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	url := sdk.url(op)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.sendRequest(op, req)
+}
+
+// pullTransactionsPage is a page of transactions as returned by Daraja.
+type pullTransactionsPage struct {
+	ResponseRefID   string              `json:"ResponseRefID"`
+	ResponseCode    string              `json:"ResponseCode"`
+	ResponseMessage string              `json:"ResponseMessage"`
+	Response        [][]PullTransaction `json:"Response"`
+}
+
+// UnmarshalJSON accepts both the documented "Response Status" and
+// "Response Description" fields and their spelling without spaces.
+func (r *PullTransactionsRegisterResp) UnmarshalJSON(data []byte) error {
+	type resp PullTransactionsRegisterResp
+	var body struct {
+		resp
+		ResponseStatus      string `json:"Response Status"`
+		ResponseDescription string `json:"Response Description"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+
+	*r = PullTransactionsRegisterResp(body.resp)
+	if r.ResponseCode == "" {
+		r.ResponseCode = body.ResponseStatus
+	}
+	if r.ResponseMessage == "" {
+		r.ResponseMessage = body.ResponseDescription
+	}
+
+	return nil
+}
+
+// UnmarshalJSON accepts the msisdn and amount as either JSON numbers or
+// strings and the transaction date with or without a time zone, in which
+// case it is taken to be in Nairobi time.
+func (t *PullTransaction) UnmarshalJSON(data []byte) error {
+	var body struct {
+		TransactionID    string          `json:"transactionId"`
+		TransactionDate  string          `json:"trxDate"`
+		MSISDN           json.RawMessage `json:"msisdn"`
+		Sender           string          `json:"sender"`
+		TransactionType  string          `json:"transactiontype"`
+		BillReference    string          `json:"billreference"`
+		Amount           json.Number     `json:"amount"`
+		OrganizationName string          `json:"organizationname"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+
+	trx := PullTransaction{
+		TransactionID:    body.TransactionID,
+		Sender:           body.Sender,
+		TransactionType:  body.TransactionType,
+		BillReference:    body.BillReference,
+		OrganizationName: body.OrganizationName,
+	}
+
+	if len(body.MSISDN) > 0 && !bytes.Equal(body.MSISDN, []byte("null")) {
+		if body.MSISDN[0] == '"' {
+			if err := json.Unmarshal(body.MSISDN, &trx.MSISDN); err != nil {
+				return fmt.Errorf("msisdn: %w", err)
+			}
+		} else {
+			trx.MSISDN = string(body.MSISDN)
+		}
+	}
+
+	var err error
+	if body.Amount != "" {
+		if trx.Amount, err = ParseAmount(body.Amount.String()); err != nil {
+			return fmt.Errorf("amount: %w", err)
+		}
+	}
+	if body.TransactionDate != "" {
+		if trx.TransactionDate, err = parsePullTransactionDate(body.TransactionDate); err != nil {
+			return fmt.Errorf("trxDate: %w", err)
+		}
+	}
+
+	*t = trx
+
+	return nil
+}
+
+func parsePullTransactionDate(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	return time.ParseInLocation(pullTransactionsDate, value, darajaLocation)
+}
@@ -0,0 +1,331 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testPullTransactionsRegisterReq = PullTransactionsRegisterReq{
+		ShortCode:       600984,
+		NominatedNumber: "0722000000",
+		CallBackURL:     "https://example.com/pulltransactions",
+	}
+
+	testPullTransactionsQueryReq = PullTransactionsQueryReq{
+		ShortCode: 600984,
+		StartDate: "2024-03-01 08:00:00",
+		EndDate:   "2024-03-01 18:00:00",
+	}
+)
+
+func pullTransaction(id string) string {
+	return fmt.Sprintf(`{"transactionId":%q,"trxDate":"2024-03-01T10:00:00Z","msisdn":254722000000,"sender":"John Doe","transactiontype":"c2b-pay-bill-debit","billreference":"A1","amount":"100.50","organizationname":"Example Ltd"}`, id)
+}
+
+func pullTransactionsPageBody(ids ...string) string {
+	var transactions string
+	for i, id := range ids {
+		if i > 0 {
+			transactions += ","
+		}
+		transactions += pullTransaction(id)
+	}
+
+	return `{"ResponseRefID":"ref","ResponseCode":"1000","ResponseMessage":"Success","Response":[[` + transactions + `]]}`
+}
+
+func TestPullTransactionsRegister(t *testing.T) {
+	testCases := []struct {
+		name         string
+		response     string
+		expectedResp PullTransactionsRegisterResp
+	}{
+		{
+			name:     "response code",
+			response: `{"ResponseRefID":"ref","ResponseCode":"1000","ResponseMessage":"Success","ShortCode":"600984"}`,
+			expectedResp: PullTransactionsRegisterResp{
+				ResponseRefID:   "ref",
+				ResponseCode:    "1000",
+				ResponseMessage: "Success",
+				ShortCode:       "600984",
+			},
+		},
+		{
+			name:     "response status",
+			response: `{"ResponseRefID":"ref","Response Status":"1000","Response Description":"Shortcode registered successfully"}`,
+			expectedResp: PullTransactionsRegisterResp{
+				ResponseRefID:   "ref",
+				ResponseCode:    "1000",
+				ResponseMessage: "Shortcode registered successfully",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/"+pullTransactionsRegisterEndpoint, r.URL.Path)
+
+				var req map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, "600984", req["ShortCode"])
+				assert.Equal(t, "Pull", req["RequestType"])
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+			sdk.strict = true
+
+			resp, err := sdk.PullTransactionsRegister(context.Background(), testPullTransactionsRegisterReq)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResp, resp)
+		})
+	}
+}
+
+func TestPullTransactionsQuery(t *testing.T) {
+	testCases := []struct {
+		name             string
+		pages            map[string]string
+		expectedIDs      []string
+		expectedRequests int
+	}{
+		{
+			name: "single page",
+			pages: map[string]string{
+				"0": pullTransactionsPageBody("RC11", "RC12"),
+				"2": pullTransactionsPageBody(),
+			},
+			expectedIDs:      []string{"RC11", "RC12"},
+			expectedRequests: 2,
+		},
+		{
+			name: "overlapping pages",
+			pages: map[string]string{
+				"0": pullTransactionsPageBody("RC11", "RC12"),
+				"2": pullTransactionsPageBody("RC12", "RC13"),
+				"4": pullTransactionsPageBody(),
+			},
+			expectedIDs:      []string{"RC11", "RC12", "RC13"},
+			expectedRequests: 3,
+		},
+		{
+			name: "offset not honoured",
+			pages: map[string]string{
+				"0": pullTransactionsPageBody("RC11", "RC12"),
+				"2": pullTransactionsPageBody("RC11", "RC12"),
+			},
+			expectedIDs:      []string{"RC11", "RC12"},
+			expectedRequests: 2,
+		},
+		{
+			name: "no transactions",
+			pages: map[string]string{
+				"0": `{"ResponseRefID":"ref","ResponseCode":"1000","ResponseMessage":"Success","Response":[[]]}`,
+			},
+			expectedRequests: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, "/"+pullTransactionsQueryEndpoint, r.URL.Path)
+
+				var req map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+				page, ok := tc.pages[req["OffSetValue"]]
+				assert.True(t, ok, "unexpected offset %s", req["OffSetValue"])
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(page))
+			}))
+			defer server.Close()
+
+			sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+			sdk.strict = true
+
+			resp, err := sdk.PullTransactionsQuery(context.Background(), testPullTransactionsQueryReq)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Equal(t, "ref", resp.ResponseRefID)
+			assert.Equal(t, "1000", resp.ResponseCode)
+
+			var ids []string
+			for _, trx := range resp.Transactions {
+				ids = append(ids, trx.TransactionID)
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+
+	t.Run("rejected", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"ResponseRefID":"ref","ResponseCode":"1001","ResponseMessage":"Shortcode not registered"}`))
+		}))
+		defer server.Close()
+
+		sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+		sdk.strict = true
+
+		_, err := sdk.PullTransactionsQuery(context.Background(), testPullTransactionsQueryReq)
+		assert.ErrorIs(t, err, ErrRejected)
+	})
+}
+
+func TestPullTransactionUnmarshal(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        string
+		expected    PullTransaction
+		expectedErr bool
+	}{
+		{
+			name: "numbers",
+			data: pullTransaction("RC11"),
+			expected: PullTransaction{
+				TransactionID:    "RC11",
+				TransactionDate:  time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC),
+				MSISDN:           "254722000000",
+				Sender:           "John Doe",
+				TransactionType:  "c2b-pay-bill-debit",
+				BillReference:    "A1",
+				Amount:           10050,
+				OrganizationName: "Example Ltd",
+			},
+		},
+		{
+			name: "strings and local date",
+			data: `{"transactionId":"RC11","trxDate":"2024-03-01 13:00:00","msisdn":"2547******000","amount":100}`,
+			expected: PullTransaction{
+				TransactionID:   "RC11",
+				TransactionDate: time.Date(2024, time.March, 1, 13, 0, 0, 0, darajaLocation),
+				MSISDN:          "2547******000",
+				Amount:          10000,
+			},
+		},
+		{
+			name:        "invalid date",
+			data:        `{"transactionId":"RC11","trxDate":"01/03/2024"}`,
+			expectedErr: true,
+		},
+		{
+			name:        "invalid amount",
+			data:        `{"transactionId":"RC11","amount":"ten"}`,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var trx PullTransaction
+			err := json.Unmarshal([]byte(tc.data), &trx)
+			if tc.expectedErr {
+				assert.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tc.expected.TransactionDate.Equal(trx.TransactionDate))
+			tc.expected.TransactionDate = trx.TransactionDate
+			assert.Equal(t, tc.expected, trx)
+
+			data, err := json.Marshal(trx)
+			require.NoError(t, err)
+			var decoded PullTransaction
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			assert.Equal(t, trx.Amount, decoded.Amount)
+		})
+	}
+}
+
+func TestPullTransactionsValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		req         interface{ Validate() error }
+		expectedErr error
+	}{
+		{
+			name: "register",
+			req:  with(testPullTransactionsRegisterReq, func(r *PullTransactionsRegisterReq) { r.RequestType = "Pull" }),
+		},
+		{
+			name:        "register without request type",
+			req:         testPullTransactionsRegisterReq,
+			expectedErr: errInvalidRequestType,
+		},
+		{
+			name:        "register with invalid shortcode",
+			req:         with(testPullTransactionsRegisterReq, func(r *PullTransactionsRegisterReq) { r.ShortCode = 1 }),
+			expectedErr: errInvalidShortCode,
+		},
+		{
+			name: "register without nominated number",
+			req: with(testPullTransactionsRegisterReq, func(r *PullTransactionsRegisterReq) {
+				r.RequestType = "Pull"
+				r.NominatedNumber = ""
+			}),
+			expectedErr: errInvalidPhoneNumber,
+		},
+		{
+			name: "register with invalid callback url",
+			req: with(testPullTransactionsRegisterReq, func(r *PullTransactionsRegisterReq) {
+				r.RequestType = "Pull"
+				r.CallBackURL = invalidURL
+			}),
+			expectedErr: errInvalidURL,
+		},
+		{
+			name: "query",
+			req:  testPullTransactionsQueryReq,
+		},
+		{
+			name:        "query with invalid shortcode",
+			req:         with(testPullTransactionsQueryReq, func(r *PullTransactionsQueryReq) { r.ShortCode = 0 }),
+			expectedErr: errInvalidShortCode,
+		},
+		{
+			name:        "query with invalid start date",
+			req:         with(testPullTransactionsQueryReq, func(r *PullTransactionsQueryReq) { r.StartDate = "2024-03-01" }),
+			expectedErr: errInvalidDate,
+		},
+		{
+			name:        "query with invalid end date",
+			req:         with(testPullTransactionsQueryReq, func(r *PullTransactionsQueryReq) { r.EndDate = "01/03/2024 18:00:00" }),
+			expectedErr: errInvalidDate,
+		},
+		{
+			name:        "query with end before start",
+			req:         with(testPullTransactionsQueryReq, func(r *PullTransactionsQueryReq) { r.EndDate = "2024-03-01 07:00:00" }),
+			expectedErr: errInvalidDateRange,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.req.Validate(), tc.expectedErr)
+		})
+	}
+}
@@ -7,12 +7,15 @@ package mpesa
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 )
 
-func (sdk mSDK) GenerateQR(qReq GenerateQRReq) (GenerateQRResp, error) {
+func (sdk mSDK) GenerateQR(ctx context.Context, qReq GenerateQRReq) (GenerateQRResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpGenerateQR)
+	defer cancel()
+
 	if err := qReq.Validate(); err != nil {
 		return GenerateQRResp{}, err
 	}
@@ -22,14 +25,14 @@ func (sdk mSDK) GenerateQR(qReq GenerateQRReq) (GenerateQRResp, error) {
 		return GenerateQRResp{}, err
 	}
 
-	url := fmt.Sprintf("%s/%s", sdk.baseURL, qrCodeEndpoint)
+	url := sdk.url(OpGenerateQR)
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
 	if err != nil {
 		return GenerateQRResp{}, err
 	}
 
-	resp, err := sdk.sendRequest(req)
+	resp, err := sdk.sendRequest(OpGenerateQR, req)
 	if err != nil {
 		return GenerateQRResp{}, err
 	}
@@ -39,5 +42,9 @@ func (sdk mSDK) GenerateQR(qReq GenerateQRReq) (GenerateQRResp, error) {
 		return GenerateQRResp{}, err
 	}
 
+	if err := sdk.checkResponseCode(OpGenerateQR, qrr.ResponseCode, qrr.ResponseDescription, resp); err != nil {
+		return GenerateQRResp{}, err
+	}
+
 	return qrr, nil
 }
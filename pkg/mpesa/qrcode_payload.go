@@ -0,0 +1,394 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+)
+
+// The EMVCo merchant presented QR tags used in the payload of an M-Pesa QR code.
+const (
+	qrTagFormat        = "00" // Payload format indicator.
+	qrTagInitiation    = "01" // Point of initiation method.
+	qrTagMerchant      = "26" // M-Pesa merchant account information template.
+	qrTagCurrency      = "53" // Transaction currency, ISO 4217 numeric.
+	qrTagAmount        = "54" // Transaction amount.
+	qrTagCountry       = "58" // Country code, ISO 3166-1 alpha-2.
+	qrTagMerchantName  = "59" // Merchant name.
+	qrTagAdditional    = "62" // Additional data field template.
+	qrTagCRC           = "63" // CRC of the payload.
+	qrSubTagGUID       = "00" // Globally unique identifier of the merchant account template.
+	qrSubTagTrxCode    = "01" // M-Pesa transaction type.
+	qrSubTagCPI        = "02" // M-Pesa credit party identifier.
+	qrSubTagBillNumber = "01" // Bill number of the additional data template.
+
+	qrFormat       = "01"
+	qrStatic       = "11"
+	qrDynamic      = "12"
+	qrMPesaGUID    = "ke.co.safaricom.mpesa"
+	qrCurrencyKES  = "404"
+	qrCountryKenya = "KE"
+	qrMaxValueLen  = 99
+	qrCRCLen       = 4
+	qrCRCField     = qrTagCRC + "04"
+	qrFinderLen    = 7
+	qrMinModules   = 21
+	qrVersionStep  = 4
+	qrQuietZone    = 4
+
+	defaultQRSize = 300
+)
+
+var (
+	// errInvalidQRPayload indicates that a QR payload is not a valid M-Pesa QR payload.
+	errInvalidQRPayload = errors.New("invalid qr payload")
+
+	// errInvalidQRChecksum indicates that the CRC of a QR payload does not match its content.
+	errInvalidQRChecksum = errors.New("invalid qr payload checksum")
+
+	// errInvalidQRSize indicates that the size of a QR code is not a positive number of pixels.
+	errInvalidQRSize = errors.New("invalid qr code size")
+
+	// errInvalidQRImage indicates that an image does not hold a QR code.
+	errInvalidQRImage = errors.New("invalid qr code image")
+)
+
+// QRCode is an M-Pesa QR code built locally, without calling Daraja.
+type QRCode struct {
+	Payload string // The EMVCo payload encoded in the QR code.
+	Size    int    // The width and height of the rendered image in pixels.
+}
+
+// NewQRCode builds the EMVCo payload of an M-Pesa QR code from the request.
+// The QR code is static when the request has no amount and dynamic otherwise.
+// Size defaults to 300 pixels.
+func NewQRCode(req GenerateQRReq) (QRCode, error) {
+	if err := req.Validate(); err != nil {
+		return QRCode{}, err
+	}
+
+	size := defaultQRSize
+	if req.Size != "" {
+		var err error
+		if size, err = strconv.Atoi(req.Size); err != nil || size <= 0 {
+			return QRCode{}, fmt.Errorf("%w: %q", errInvalidQRSize, req.Size)
+		}
+	}
+
+	initiation := qrStatic
+	if req.Amount > 0 {
+		initiation = qrDynamic
+	}
+
+	merchant, err := qrFields(
+		qrSubTagGUID, qrMPesaGUID,
+		qrSubTagTrxCode, req.TrxCode,
+		qrSubTagCPI, req.CPI,
+	)
+	if err != nil {
+		return QRCode{}, err
+	}
+
+	var amount string
+	if req.Amount > 0 {
+		amount = strconv.FormatUint(req.Amount, 10)
+	}
+
+	additional, err := qrFields(qrSubTagBillNumber, req.RefNo)
+	if err != nil {
+		return QRCode{}, err
+	}
+
+	payload, err := qrFields(
+		qrTagFormat, qrFormat,
+		qrTagInitiation, initiation,
+		qrTagMerchant, merchant,
+		qrTagCurrency, qrCurrencyKES,
+		qrTagAmount, amount,
+		qrTagCountry, qrCountryKenya,
+		qrTagMerchantName, req.MerchantName,
+		qrTagAdditional, additional,
+	)
+	if err != nil {
+		return QRCode{}, err
+	}
+
+	payload += qrCRCField
+	payload += fmt.Sprintf("%04X", crc16(payload))
+
+	return QRCode{Payload: payload, Size: size}, nil
+}
+
+// ParseQRCode decodes the EMVCo payload of an M-Pesa QR code, such as the
+// Payload of a QRCode, back into the request it was built from.
+func ParseQRCode(payload string) (GenerateQRReq, error) {
+	if len(payload) < qrCRCLen {
+		return GenerateQRReq{}, errInvalidQRPayload
+	}
+	content, checksum := payload[:len(payload)-qrCRCLen], payload[len(payload)-qrCRCLen:]
+	if !strings.HasSuffix(content, qrCRCField) {
+		return GenerateQRReq{}, fmt.Errorf("%w: missing checksum", errInvalidQRPayload)
+	}
+	if !strings.EqualFold(checksum, fmt.Sprintf("%04X", crc16(content))) {
+		return GenerateQRReq{}, errInvalidQRChecksum
+	}
+
+	fields, err := parseQRFields(strings.TrimSuffix(content, qrCRCField))
+	if err != nil {
+		return GenerateQRReq{}, err
+	}
+	if fields[qrTagFormat] != qrFormat {
+		return GenerateQRReq{}, fmt.Errorf("%w: unsupported payload format %q", errInvalidQRPayload, fields[qrTagFormat])
+	}
+	if currency, ok := fields[qrTagCurrency]; ok && currency != qrCurrencyKES {
+		return GenerateQRReq{}, fmt.Errorf("%w: unsupported currency %q", errInvalidQRPayload, currency)
+	}
+
+	merchant, err := parseQRFields(fields[qrTagMerchant])
+	if err != nil {
+		return GenerateQRReq{}, err
+	}
+	if merchant[qrSubTagGUID] != qrMPesaGUID {
+		return GenerateQRReq{}, fmt.Errorf("%w: not an M-Pesa QR code", errInvalidQRPayload)
+	}
+
+	additional, err := parseQRFields(fields[qrTagAdditional])
+	if err != nil {
+		return GenerateQRReq{}, err
+	}
+
+	req := GenerateQRReq{
+		MerchantName: fields[qrTagMerchantName],
+		RefNo:        additional[qrSubTagBillNumber],
+		TrxCode:      merchant[qrSubTagTrxCode],
+		CPI:          merchant[qrSubTagCPI],
+	}
+	if amount, ok := fields[qrTagAmount]; ok {
+		if req.Amount, err = strconv.ParseUint(amount, 10, 64); err != nil {
+			return GenerateQRReq{}, fmt.Errorf("%w: amount: %w", errInvalidQRPayload, err)
+		}
+	}
+
+	if err := req.Validate(); err != nil {
+		return GenerateQRReq{}, err
+	}
+
+	return req, nil
+}
+
+// PNG renders the QR code as a PNG image.
+func (qr QRCode) PNG() ([]byte, error) {
+	if qr.Size <= 0 {
+		return nil, fmt.Errorf("%w: %d", errInvalidQRSize, qr.Size)
+	}
+
+	code, err := qr.encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return code.PNG(qr.Size)
+}
+
+// SVG renders the QR code as an SVG image.
+func (qr QRCode) SVG() ([]byte, error) {
+	if qr.Size <= 0 {
+		return nil, fmt.Errorf("%w: %d", errInvalidQRSize, qr.Size)
+	}
+
+	code, err := qr.encode()
+	if err != nil {
+		return nil, err
+	}
+
+	bitmap := code.Bitmap()
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, qr.Size, qr.Size, len(bitmap), len(bitmap))
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, len(bitmap), len(bitmap))
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&buf, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+	buf.WriteString(`"/></svg>`)
+
+	return buf.Bytes(), nil
+}
+
+// Terminal renders the QR code as text that can be printed to a terminal.
+func (qr QRCode) Terminal() (string, error) {
+	code, err := qr.encode()
+	if err != nil {
+		return "", err
+	}
+
+	return terminalQR(code.Bitmap()), nil
+}
+
+// QRImageTerminal renders a PNG image of a QR code, such as the base64
+// decoded QRCode of a GenerateQRResp, as text that can be printed to a terminal.
+func QRImageTerminal(data []byte) (string, error) {
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return "", errors.Join(errInvalidQRImage, err)
+	}
+
+	bitmap, err := qrBitmap(img)
+	if err != nil {
+		return "", err
+	}
+
+	return terminalQR(bitmap), nil
+}
+
+// qrBitmap samples the modules of the QR code in the image. The module size
+// is taken from the top left finder pattern, which is 7 modules wide.
+func qrBitmap(img image.Image) ([][]bool, error) {
+	bounds := img.Bounds()
+	dark := func(x, y int) bool {
+		gray, _ := color.GrayModel.Convert(img.At(x, y)).(color.Gray)
+
+		return gray.Y < math.MaxUint8/2
+	}
+
+	minX, minY, maxX, maxY := bounds.Max.X, bounds.Max.Y, bounds.Min.X-1, bounds.Min.Y-1
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if dark(x, y) {
+				minX, minY = min(minX, x), min(minY, y)
+				maxX, maxY = max(maxX, x), max(maxY, y)
+			}
+		}
+	}
+	if maxX < minX {
+		return nil, fmt.Errorf("%w: no dark modules", errInvalidQRImage)
+	}
+
+	finder := 0
+	for x := minX; x <= maxX && dark(x, minY); x++ {
+		finder++
+	}
+	width := float64(maxX - minX + 1)
+	module := float64(finder) / qrFinderLen
+	if module < 1 || width/module < qrMinModules-qrVersionStep/2 {
+		return nil, fmt.Errorf("%w: no finder pattern", errInvalidQRImage)
+	}
+
+	// Scaled images have modules of uneven width, so snap the estimate to the
+	// nearest symbol size of 17+4*version modules and derive the module width from it.
+	version := max(1, int(math.Round((width/module-qrMinModules)/qrVersionStep))+1)
+	modules := qrMinModules + (version-1)*qrVersionStep
+	module = width / float64(modules)
+
+	bitmap := make([][]bool, modules+2*qrQuietZone)
+	for i := range bitmap {
+		bitmap[i] = make([]bool, modules+2*qrQuietZone)
+	}
+	for y := 0; y < modules; y++ {
+		for x := 0; x < modules; x++ {
+			px := minX + int((float64(x)+0.5)*module)
+			py := minY + int((float64(y)+0.5)*module)
+			bitmap[y+qrQuietZone][x+qrQuietZone] = dark(px, py)
+		}
+	}
+
+	return bitmap, nil
+}
+
+// terminalQR draws the modules with half block characters so that every line
+// holds two rows. Dark modules are left blank for terminals with a dark background.
+func terminalQR(bitmap [][]bool) string {
+	var b strings.Builder
+	for y := 0; y < len(bitmap); y += 2 {
+		for x := range bitmap[y] {
+			bottom := y+1 < len(bitmap) && bitmap[y+1][x]
+			switch top := bitmap[y][x]; {
+			case top && bottom:
+				b.WriteString(" ")
+			case top:
+				b.WriteString("▄")
+			case bottom:
+				b.WriteString("▀")
+			default:
+				b.WriteString("█")
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func (qr QRCode) encode() (*qrcode.QRCode, error) {
+	return qrcode.New(qr.Payload, qrcode.Medium)
+}
+
+// qrFields encodes tag and value pairs as EMVCo TLV fields, skipping empty values.
+func qrFields(pairs ...string) (string, error) {
+	var b strings.Builder
+	for i := 0; i+1 < len(pairs); i += 2 {
+		tag, value := pairs[i], pairs[i+1]
+		if value == "" {
+			continue
+		}
+		if len(value) > qrMaxValueLen {
+			return "", fmt.Errorf("%w: value of tag %s is longer than %d characters", errInvalidQRPayload, tag, qrMaxValueLen)
+		}
+		fmt.Fprintf(&b, "%s%02d%s", tag, len(value), value)
+	}
+
+	return b.String(), nil
+}
+
+// parseQRFields decodes EMVCo TLV fields into a map of tag to value.
+func parseQRFields(data string) (map[string]string, error) {
+	fields := make(map[string]string)
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, fmt.Errorf("%w: truncated field %q", errInvalidQRPayload, data)
+		}
+		tag := data[:2]
+		n, err := strconv.Atoi(data[2:4])
+		if err != nil || n < 0 || len(data) < 4+n {
+			return nil, fmt.Errorf("%w: invalid length of tag %s", errInvalidQRPayload, tag)
+		}
+		fields[tag] = data[4 : 4+n]
+		data = data[4+n:]
+	}
+
+	return fields, nil
+}
+
+// crc16 returns the CRC-16/CCITT-FALSE checksum that EMVCo QR payloads end with.
+func crc16(data string) uint16 {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(data); i++ {
+		crc ^= uint16(data[i]) << 8
+		for j := 0; j < 8; j++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
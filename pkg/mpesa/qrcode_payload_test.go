@@ -0,0 +1,248 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var qrReq = GenerateQRReq{
+	MerchantName: "Mpesa Overlay",
+	RefNo:        "invoice008",
+	Amount:       10,
+	TrxCode:      "BG",
+	CPI:          "174379",
+	Size:         "300",
+}
+
+func TestNewQRCode(t *testing.T) {
+	testCases := []struct {
+		name            string
+		request         GenerateQRReq
+		expectedPayload string
+		expectedSize    int
+		expectedErr     error
+	}{
+		{
+			name:            "dynamic",
+			request:         qrReq,
+			expectedPayload: "00020101021226410021ke.co.safaricom.mpesa0102BG020617437953034045402105802KE5913Mpesa Overlay62140110invoice0086304",
+			expectedSize:    300,
+		},
+		{
+			name:            "static with default size",
+			request:         with(qrReq, func(r *GenerateQRReq) { r.Amount, r.RefNo, r.MerchantName, r.Size = 0, "", "", "" }),
+			expectedPayload: "00020101021126410021ke.co.safaricom.mpesa0102BG020617437953034045802KE6304",
+			expectedSize:    defaultQRSize,
+		},
+		{
+			name:        "invalid transaction type",
+			request:     with(qrReq, func(r *GenerateQRReq) { r.TrxCode = invalidString }),
+			expectedErr: errInvalidTransactionType,
+		},
+		{
+			name:        "invalid size",
+			request:     with(qrReq, func(r *GenerateQRReq) { r.Size = "large" }),
+			expectedErr: errInvalidQRSize,
+		},
+		{
+			name:        "negative size",
+			request:     with(qrReq, func(r *GenerateQRReq) { r.Size = "-1" }),
+			expectedErr: errInvalidQRSize,
+		},
+		{
+			name:        "too long merchant name",
+			request:     with(qrReq, func(r *GenerateQRReq) { r.MerchantName = strings.Repeat("a", 100) }),
+			expectedErr: errInvalidQRPayload,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			qr, err := NewQRCode(tc.request)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, tc.expectedPayload, qr.Payload[:len(qr.Payload)-qrCRCLen])
+			assert.Equal(t, tc.expectedSize, qr.Size)
+		})
+	}
+}
+
+func TestParseQRCode(t *testing.T) {
+	qr, err := NewQRCode(qrReq)
+	require.NoError(t, err)
+
+	content := qr.Payload[:len(qr.Payload)-qrCRCLen]
+	checksum := qr.Payload[len(qr.Payload)-qrCRCLen:]
+
+	static, err := NewQRCode(with(qrReq, func(r *GenerateQRReq) { r.Amount = 0 }))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		payload     string
+		expected    GenerateQRReq
+		expectedErr error
+	}{
+		{
+			name:     "dynamic",
+			payload:  qr.Payload,
+			expected: with(qrReq, func(r *GenerateQRReq) { r.Size = "" }),
+		},
+		{
+			name:     "static",
+			payload:  static.Payload,
+			expected: with(qrReq, func(r *GenerateQRReq) { r.Amount, r.Size = 0, "" }),
+		},
+		{
+			name:     "lower case checksum",
+			payload:  content + strings.ToLower(checksum),
+			expected: with(qrReq, func(r *GenerateQRReq) { r.Size = "" }),
+		},
+		{
+			name:        "invalid checksum",
+			payload:     content + "0000",
+			expectedErr: errInvalidQRChecksum,
+		},
+		{
+			name:        "missing checksum",
+			payload:     "000201",
+			expectedErr: errInvalidQRPayload,
+		},
+		{
+			name:        "empty",
+			payload:     "",
+			expectedErr: errInvalidQRPayload,
+		},
+		{
+			name:        "not an mpesa qr code",
+			payload:     qrWithChecksum("00020101021126200008com.bank0102BG5303404"),
+			expectedErr: errInvalidQRPayload,
+		},
+		{
+			name:        "unsupported currency",
+			payload:     qrWithChecksum("00020101021126410021ke.co.safaricom.mpesa0102BG02061743795303840"),
+			expectedErr: errInvalidQRPayload,
+		},
+		{
+			name:        "truncated field",
+			payload:     qrWithChecksum("0002010102122699"),
+			expectedErr: errInvalidQRPayload,
+		},
+		{
+			name:        "invalid transaction type",
+			payload:     qrWithChecksum("00020101021126410021ke.co.safaricom.mpesa0102XX0206174379"),
+			expectedErr: errInvalidTransactionType,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := ParseQRCode(tc.payload)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expected, req)
+		})
+	}
+}
+
+func TestQRCodeRender(t *testing.T) {
+	qr, err := NewQRCode(qrReq)
+	require.NoError(t, err)
+
+	data, err := qr.PNG()
+	require.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, 300, img.Bounds().Dx())
+
+	data, err = qr.SVG()
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(data, []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="300" height="300"`)))
+	assert.True(t, bytes.HasSuffix(data, []byte(`</svg>`)))
+
+	text, err := qr.Terminal()
+	require.NoError(t, err)
+	assert.NotEmpty(t, text)
+
+	_, err = QRCode{Payload: qr.Payload}.PNG()
+	assert.ErrorIs(t, err, errInvalidQRSize)
+
+	_, err = QRCode{Payload: qr.Payload}.SVG()
+	assert.ErrorIs(t, err, errInvalidQRSize)
+}
+
+func TestQRImageTerminal(t *testing.T) {
+	qr, err := NewQRCode(qrReq)
+	require.NoError(t, err)
+
+	expected, err := qr.Terminal()
+	require.NoError(t, err)
+
+	for _, size := range []int{100, 300, 512} {
+		qr.Size = size
+		data, err := qr.PNG()
+		require.NoError(t, err)
+
+		text, err := QRImageTerminal(data)
+		require.NoError(t, err)
+		assert.Equal(t, expected, text, "size %d", size)
+	}
+
+	var blank bytes.Buffer
+	require.NoError(t, png.Encode(&blank, image.NewGray(image.Rect(0, 0, 10, 10))))
+
+	testCases := []struct {
+		name string
+		data []byte
+	}{
+		{name: "not a png", data: []byte("QR")},
+		{name: "blank image", data: whiteImage(t)},
+		{name: "too small", data: blank.Bytes()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := QRImageTerminal(tc.data)
+			assert.ErrorIs(t, err, errInvalidQRImage)
+		})
+	}
+}
+
+func whiteImage(t *testing.T) []byte {
+	t.Helper()
+
+	img := image.NewGray(image.Rect(0, 0, 10, 10))
+	for i := range img.Pix {
+		img.Pix[i] = 0xFF
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	return buf.Bytes()
+}
+
+func TestCRC16(t *testing.T) {
+	assert.Equal(t, uint16(0x29B1), crc16("123456789"))
+}
+
+func qrWithChecksum(content string) string {
+	content += qrCRCField
+
+	return content + fmt.Sprintf("%04X", crc16(content))
+}
@@ -60,7 +60,7 @@ type C2BSimulateReq struct {
 
 // B2CPaymentReq is used to make a B2C payment.
 type B2CPaymentReq struct {
-	OriginatorConversationID string `json:"OriginatorConversationID,omitempty"` // This is a unique string you specify for every API request you simulate.
+	OriginatorConversationID string `json:"OriginatorConversationID,omitempty"` // This is a unique string you specify for every API request you simulate. Generated when empty. Honoured by Daraja only with B2CV3.
 	CommandID                string `json:"CommandID,omitempty"`                // This is a unique command that specifies B2C transaction type.
 	PartyA                   uint64 `json:"PartyA,omitempty"`                   // This is the B2C organization shortcode from which the money is sent from.
 	PartyB                   uint64 `json:"PartyB,omitempty"`                   // This is the customer mobile number to receive the amount. - The number should have the country code (254) without the plus sign.
@@ -77,17 +77,18 @@ type B2CPaymentReq struct {
 
 // TransactionStatusReq is used to query the status of a transaction.
 type TransactionStatusReq struct {
-	CommandID          string `json:"CommandID,omitempty"`          // Takes only the 'TransactionStatusQuery' Command ID.
-	PartyA             uint64 `json:"PartyA,omitempty"`             // Organization/MSISDN receiving the transaction
-	IdentifierType     uint8  `json:"IdentifierType,omitempty"`     // Type of organization receiving the transaction
-	Remarks            string `json:"Remarks,omitempty"`            // Comments that are sent along with the transaction.
-	InitiatorName      string `json:"Initiator,omitempty"`          // The name of Initiator to initiating  the request
-	InitiatorPassword  string `json:"InitiatorPassword,omitempty"`  // The password of the API user. This is the same password used while creating the API user.
-	SecurityCredential string `json:"SecurityCredential,omitempty"` // Encrypted Credential of user getting transaction amoun
-	QueueTimeOutURL    string `json:"QueueTimeOutURL,omitempty"`    // The path that stores information of time out transaction
-	ResultURL          string `json:"ResultURL,omitempty"`          // The path that stores information of transaction
-	TransactionID      string `json:"TransactionID,omitempty"`      // Unique identifier to identify a transaction on M-Pesa
-	Occasion           string `json:"Occasion,omitempty"`
+	CommandID                string `json:"CommandID,omitempty"`          // Takes only the 'TransactionStatusQuery' Command ID.
+	PartyA                   uint64 `json:"PartyA,omitempty"`             // Organization/MSISDN receiving the transaction
+	IdentifierType           uint8  `json:"IdentifierType,omitempty"`     // Type of organization receiving the transaction
+	Remarks                  string `json:"Remarks,omitempty"`            // Comments that are sent along with the transaction.
+	InitiatorName            string `json:"Initiator,omitempty"`          // The name of Initiator to initiating  the request
+	InitiatorPassword        string `json:"InitiatorPassword,omitempty"`  // The password of the API user. This is the same password used while creating the API user.
+	SecurityCredential       string `json:"SecurityCredential,omitempty"` // Encrypted Credential of user getting transaction amoun
+	QueueTimeOutURL          string `json:"QueueTimeOutURL,omitempty"`    // The path that stores information of time out transaction
+	ResultURL                string `json:"ResultURL,omitempty"`          // The path that stores information of transaction
+	TransactionID            string `json:"TransactionID,omitempty"`      // Unique identifier to identify a transaction on M-Pesa
+	Occasion                 string `json:"Occasion,omitempty"`
+	OriginatorConversationID string `json:"OriginalConversationID,omitempty"` // The OriginatorConversationID the transaction was requested with, e.g. of a B2C v3 payment. Required when TransactionID is empty.
 }
 
 // AccountBalanceReq is used to query the balance of an account.
@@ -136,13 +137,47 @@ type RemitTaxReq struct {
 	Remarks                string `json:"Remarks,omitempty"`                // Comments that are sent along with the transaction.
 }
 
+// B2CAccountTopUpReq is used to load funds from a paybill into the utility account of a B2C shortcode.
+type B2CAccountTopUpReq struct {
+	Initiator              string `json:"Initiator,omitempty"`              // The name of Initiator to initiating  the request
+	InitiatorPassword      string `json:"InitiatorPassword,omitempty"`      // The password of the API user. This is the same password used while creating the API user.
+	SecurityCredential     string `json:"SecurityCredential,omitempty"`     // Encrypted Credential of user getting transaction amoun
+	CommandID              string `json:"CommandID,omitempty"`              // Takes only the 'BusinessPayToBulk' Command ID. Set when empty.
+	SenderIdentifierType   uint8  `json:"SenderIdentifierType,omitempty"`   // The type of shortcode from which money is deducted. Only "4" is allowed. Set when empty.
+	RecieverIdentifierType uint8  `json:"RecieverIdentifierType,omitempty"` // The type of shortcode to which money is credited. Only "4" is allowed. Set when empty.
+	Amount                 uint64 `json:"Amount,omitempty"`                 // The amount of money loaded into the B2C utility account.
+	PartyA                 uint64 `json:"PartyA,omitempty"`                 // This is your own shortcode from which the money will be deducted.
+	PartyB                 uint64 `json:"PartyB,omitempty"`                 // The B2C shortcode whose utility account is loaded.
+	AccountReference       string `json:"AccountReference,omitempty"`       // The account number to be associated with the payment. Up to 13 characters.
+	QueueTimeOutURL        string `json:"QueueTimeOutURL,omitempty"`        // The path that stores information of time out transaction
+	ResultURL              string `json:"ResultURL,omitempty"`              // The path that stores information of transaction
+	Remarks                string `json:"Remarks,omitempty"`                // Comments that are sent along with the transaction.
+	Requester              uint64 `json:"Requester,omitempty"`              // Optional. The consumer’s mobile number on behalf of whom you are paying.
+}
+
+// B2PochiReq is used to pay into the Pochi la Biashara wallet of a customer.
+type B2PochiReq struct {
+	OriginatorConversationID string `json:"OriginatorConversationID,omitempty"` // This is a unique string you specify for every API request you simulate. Generated when empty.
+	InitiatorName            string `json:"InitiatorName,omitempty"`            // This is an API user created by the Business Administrator of the M-PESA Bulk disbursement account that is active and authorized to initiate B2C transactions via API.
+	InitiatorPassword        string `json:"InitiatorPassword,omitempty"`        // The password of the API user. This is the same password used while creating the API user.
+	SecurityCredential       string `json:"SecurityCredential,omitempty"`       // This is the value obtained after encrypting the API initiator password.
+	CommandID                string `json:"CommandID,omitempty"`                // Takes only the 'BusinessPayToPochi' Command ID. Set when empty.
+	Amount                   uint64 `json:"Amount,omitempty"`                   // The amount of money being sent to the customer.
+	PartyA                   uint64 `json:"PartyA,omitempty"`                   // This is the B2C organization shortcode from which the money is sent from.
+	PartyB                   uint64 `json:"PartyB,omitempty"`                   // This is the mobile number of the Pochi la Biashara wallet to receive the amount. - The number should have the country code (254) without the plus sign.
+	Remarks                  string `json:"Remarks,omitempty"`                  // Any additional information to be associated with the transaction.
+	QueueTimeOutURL          string `json:"QueueTimeOutURL,omitempty"`          // This is the URL to be specified in your request that will be used by API Proxy to send notification incase the payment request is timed out while awaiting processing in the queue
+	ResultURL                string `json:"ResultURL,omitempty"`                // This is the URL to be specified in your request that will be used by M-PESA to send notification upon processing of the payment request.
+	Occasion                 string `json:"Occasion,omitempty"`                 // Any additional information to be associated with the transaction.
+}
+
 type BusinessPayBillReq struct {
 	Initiator              string `json:"Initiator,omitempty"`              // The name of Initiator to initiating  the request
 	InitiatorPassword      string `json:"InitiatorPassword,omitempty"`      // The password of the API user. This is the same password used while creating the API user.
 	SecurityCredential     string `json:"SecurityCredential,omitempty"`     // Encrypted Credential of user getting transaction amoun
-	CommandID              string `json:"CommandID,omitempty"`              // Takes only the 'BusinessPayBill' Command ID.
-	SenderIdentifierType   uint8  `json:"SenderIdentifierType,omitempty"`   // The type of shortcode from which money is deducted. For this API, only "4" is allowed.
-	RecieverIdentifierType uint8  `json:"RecieverIdentifierType,omitempty"` // The type of shortcode to which money is credited. For this API, only "4" is allowed.
+	CommandID              string `json:"CommandID,omitempty"`              // One of BusinessPayBill, BusinessBuyGoods, BusinessToBusinessTransfer, MerchantToMerchantTransfer, MerchantTransferFromMerchantToWorking, MerchantServicesMMFAccountTransfer or AgencyFloatAdvance.
+	SenderIdentifierType   uint8  `json:"SenderIdentifierType,omitempty"`   // The type of shortcode from which money is deducted: 2 for a Till number and 4 for a shortcode. Set from the CommandID when empty.
+	RecieverIdentifierType uint8  `json:"RecieverIdentifierType,omitempty"` // The type of shortcode to which money is credited: 2 for a Till number and 4 for a shortcode. Set from the CommandID when empty.
 	Amount                 uint64 `json:"Amount,omitempty"`                 // The amount of money being sent to the customer.
 	PartyA                 uint64 `json:"PartyA,omitempty"`                 // This is your own shortcode from which the money will be deducted.
 	PartyB                 uint64 `json:"PartyB,omitempty"`                 // The account to which money will be credited.
@@ -152,3 +187,112 @@ type BusinessPayBillReq struct {
 	Remarks                string `json:"Remarks,omitempty"`                // Comments that are sent along with the transaction.
 	Requester              uint64 `json:"Requester,omitempty"`              // Optional. The consumer’s mobile number on behalf of whom you are paying.
 }
+
+// BillManagerOptInReq is used to onboard a shortcode onto Bill Manager.
+type BillManagerOptInReq struct {
+	ShortCode       uint64 `json:"shortcode,omitempty,string"` // The organization's shortcode (Paybill or Buygoods) used to identify the organization on Bill Manager.
+	Email           string `json:"email,omitempty"`            // The official contact email address of the organization.
+	OfficialContact string `json:"officialContact,omitempty"`  // The official contact phone number of the organization.
+	SendReminders   uint8  `json:"sendReminders,string"`       // Whether Bill Manager sends payment reminders to the customers. 1 enables and 0 disables reminders.
+	Logo            string `json:"logo,omitempty"`             // Optional. The logo of the organization shown on the invoices.
+	CallbackURL     string `json:"callbackurl,omitempty"`      // The URL that receives the payment notifications of the invoices.
+}
+
+// BillManagerUpdateOptInReq is used to update the opt-in details of a shortcode.
+type BillManagerUpdateOptInReq struct {
+	ShortCode       uint64 `json:"shortcode,omitempty,string"` // The organization's shortcode (Paybill or Buygoods) used to identify the organization on Bill Manager.
+	Email           string `json:"email,omitempty"`            // The official contact email address of the organization.
+	OfficialContact string `json:"officialContact,omitempty"`  // The official contact phone number of the organization.
+	SendReminders   uint8  `json:"sendReminders,string"`       // Whether Bill Manager sends payment reminders to the customers. 1 enables and 0 disables reminders.
+	Logo            string `json:"logo,omitempty"`             // Optional. The logo of the organization shown on the invoices.
+	CallbackURL     string `json:"callbackurl,omitempty"`      // The URL that receives the payment notifications of the invoices.
+}
+
+// BillManagerInvoiceItem is an additional billable item of an invoice.
+type BillManagerInvoiceItem struct {
+	ItemName string `json:"itemName,omitempty"`      // The name of the billable item.
+	Amount   uint64 `json:"amount,omitempty,string"` // The amount billed for the item.
+}
+
+// BillManagerSingleInvoiceReq is used to send an invoice to a customer.
+type BillManagerSingleInvoiceReq struct {
+	ExternalReference string                   `json:"externalReference,omitempty"`        // A unique reference of the invoice in your system.
+	BilledFullName    string                   `json:"billedFullName,omitempty"`           // The full name of the customer being billed.
+	BilledPhoneNumber uint64                   `json:"billedPhoneNumber,omitempty,string"` // The phone number of the customer that receives the invoice (format: 2547XXXXXXXX).
+	BilledPeriod      string                   `json:"billedPeriod,omitempty"`             // The month and year of the billing period, e.g. "August 2021".
+	InvoiceName       string                   `json:"invoiceName,omitempty"`              // A descriptive name of what the customer is billed for.
+	DueDate           string                   `json:"dueDate,omitempty"`                  // The date the customer is expected to pay by (format: YYYY-MM-DD HH:MM:SS).
+	AccountReference  string                   `json:"accountReference,omitempty"`         // The account number the customer pays the invoice to.
+	Amount            uint64                   `json:"amount,omitempty,string"`            // The total amount of the invoice.
+	InvoiceItems      []BillManagerInvoiceItem `json:"invoiceItems,omitempty"`             // Optional. The additional billable items of the invoice.
+}
+
+// BillManagerBulkInvoiceReq is used to send several invoices at once.
+type BillManagerBulkInvoiceReq struct {
+	Invoices []BillManagerSingleInvoiceReq `json:"invoices,omitempty"` // The invoices to send, at most 1000.
+}
+
+// BillManagerCancelInvoiceReq is used to cancel invoices that have not been paid.
+type BillManagerCancelInvoiceReq struct {
+	ExternalReferences []string `json:"externalReferences,omitempty"` // The external references of the invoices to cancel.
+}
+
+// BillManagerReconcileReq is used to acknowledge a payment received for an invoice.
+type BillManagerReconcileReq struct {
+	PaymentDate       string `json:"paymentDate,omitempty"`        // The date the payment was made (format: YYYY-MM-DD).
+	PaidAmount        uint64 `json:"paidAmount,omitempty,string"`  // The amount paid.
+	AccountReference  string `json:"accountReference,omitempty"`   // The account number the payment was made to.
+	TransactionID     string `json:"transactionId,omitempty"`      // The M-PESA transaction ID of the payment.
+	PhoneNumber       uint64 `json:"phoneNumber,omitempty,string"` // The phone number that made the payment (format: 2547XXXXXXXX).
+	FullName          string `json:"fullName,omitempty"`           // The full name of the customer that made the payment.
+	InvoiceName       string `json:"invoiceName,omitempty"`        // The name of the invoice that was paid.
+	ExternalReference string `json:"externalReference,omitempty"`  // The external reference of the invoice that was paid.
+}
+
+// PullTransactionsRegisterReq is used to register a shortcode for the Pull Transactions API.
+type PullTransactionsRegisterReq struct {
+	ShortCode       uint64 `json:"ShortCode,omitempty,string"` // The organization's shortcode (Paybill or Buygoods) whose transactions are pulled.
+	RequestType     string `json:"RequestType,omitempty"`      // The type of the request. It is always "Pull" and is set if left empty.
+	NominatedNumber string `json:"NominatedNumber,omitempty"`  // The phone number that receives the confirmation of the registration (format: 07XXXXXXXX).
+	CallBackURL     string `json:"CallBackURL,omitempty"`      // The URL that receives the notifications of the registration.
+}
+
+// PullTransactionsQueryReq is used to query the transactions of a shortcode within a time window.
+type PullTransactionsQueryReq struct {
+	ShortCode   uint64 `json:"ShortCode,omitempty,string"` // The shortcode registered with PullTransactionsRegister.
+	StartDate   string `json:"StartDate,omitempty"`        // The start of the time window (format: YYYY-MM-DD HH:MM:SS).
+	EndDate     string `json:"EndDate,omitempty"`          // The end of the time window (format: YYYY-MM-DD HH:MM:SS).
+	OffSetValue uint64 `json:"OffSetValue,string"`         // The number of transactions to skip. Transactions are paged through from here onwards.
+}
+
+// StandingOrderReq is used to create an M-PESA Ratiba standing order.
+type StandingOrderReq struct {
+	StandingOrderName           string                 `json:"StandingOrderName,omitempty"`                  // A name of the standing order that is unique for the customer.
+	StartDate                   string                 `json:"StartDate,omitempty"`                          // The date of the first payment (format: YYYYMMDD).
+	EndDate                     string                 `json:"EndDate,omitempty"`                            // The date after which no more payments are made (format: YYYYMMDD).
+	BusinessShortCode           uint64                 `json:"BusinessShortCode,omitempty,string"`           // The organization's shortcode (Paybill or Buygoods) that receives the payments.
+	TransactionType             string                 `json:"TransactionType,omitempty"`                    // "Standing Order Customer Pay Bill" for a Paybill or "Standing Order Customer Pay Marchant" for a Till number.
+	ReceiverPartyIdentifierType uint8                  `json:"ReceiverPartyIdentifierType,omitempty,string"` // The type of BusinessShortCode: 4 for a Paybill and 2 for a Till number.
+	Amount                      uint64                 `json:"Amount,omitempty,string"`                      // The amount of every payment.
+	PartyA                      uint64                 `json:"PartyA,omitempty,string"`                      // The phone number of the customer that pays.
+	CallBackURL                 string                 `json:"CallBackURL,omitempty"`                        // The URL that receives the outcome of the standing order.
+	AccountReference            string                 `json:"AccountReference,omitempty"`                   // The account number the customer pays to. Only used for a Paybill.
+	TransactionDesc             string                 `json:"TransactionDesc,omitempty"`                    // Any additional information about the payments.
+	Frequency                   StandingOrderFrequency `json:"Frequency,omitempty,string"`                   // How often the payment is made.
+}
+
+// B2BExpressCheckoutReq is used to send a USSD push to a merchant's till to pay a paybill.
+type B2BExpressCheckoutReq struct {
+	PrimaryShortCode  uint64 `json:"primaryShortCode,omitempty,string"`  // The till number of the merchant that pays.
+	ReceiverShortCode uint64 `json:"receiverShortCode,omitempty,string"` // The paybill that receives the payment.
+	Amount            uint64 `json:"amount,omitempty,string"`            // The amount to be paid.
+	PaymentRef        string `json:"paymentRef,omitempty"`               // The account number the merchant pays to, shown in the USSD push.
+	CallbackURL       string `json:"callbackUrl,omitempty"`              // The URL that receives the outcome of the payment.
+	PartnerName       string `json:"partnerName,omitempty"`              // The name of the organization shown to the merchant in the USSD push.
+	RequestRefID      string `json:"RequestRefID,omitempty"`             // A unique identifier of the request. Generated when empty.
+}
+
+// SIMSwapReq is used to check when the SIM of a customer was last swapped.
+type SIMSwapReq struct {
+	CustomerNumber uint64 `json:"customerNumber,string,omitempty"` // The phone number of the customer (format: 2547XXXXXXXX).
+}
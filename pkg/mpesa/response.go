@@ -6,6 +6,11 @@
 
 package mpesa
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // TokenResp is the response from the token endpoint.
 type TokenResp struct {
 	AccessToken string `json:"access_token,omitempty"` // Access token to access other APIs
@@ -27,6 +32,26 @@ type ValidResp struct {
 	ResponseCode             string `json:"ResponseCode,omitempty"`            // It indicates whether Mobile Money accepts the request or not.
 }
 
+// UnmarshalJSON decodes the OriginatorConversationID from both the misspelled
+// key returned by the v1 endpoints and the one returned by the v3 endpoints.
+func (r *ValidResp) UnmarshalJSON(data []byte) error {
+	type validResp ValidResp
+
+	aux := struct {
+		*validResp
+		OriginatorConversationID string `json:"OriginatorConversationID,omitempty"`
+	}{validResp: (*validResp)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if aux.OriginatorConversationID != "" {
+		r.OriginatorConversationID = aux.OriginatorConversationID
+	}
+
+	return nil
+}
+
 // ExpressSimulateResp is the response from the ExpressSimulate endpoint.
 type ExpressSimulateResp struct {
 	ResponseDescription string `json:"ResponseDescription,omitempty"` // Response description is an acknowledgment message from the API that gives the status of the request submission.
@@ -38,13 +63,13 @@ type ExpressSimulateResp struct {
 
 // ExpressQueryResp is the response from the ExpressQuery endpoint.
 type ExpressQueryResp struct {
-	ResponseDescription string `json:"ResponseDescription,omitempty"` // Response Description message. It can be a Success submission message or an error description.
-	ResponseCode        string `json:"ResponseCode,omitempty"`        // This is a numeric status code that indicates the status of the transaction submission. 0 means successful submission and any other code means an error occurred.
-	MerchantRequestID   string `json:"MerchantRequestID,omitempty"`   // This is a global unique Identifier for any submitted payment request.
-	CheckoutRequestID   string `json:"CheckoutRequestID,omitempty"`   // This is a global unique identifier of the processed checkout transaction request.
-	CustomerMessage     string `json:"CustomerMessage,omitempty"`     // This is a message that your system can display to the Customer as an acknowledgement of the payment request submission.
-	ResultCode          string `json:"ResultCode,omitempty"`          // This is a numeric status code that indicates the status of the transaction processing. 0 means successful processing and any other code means an error occurred or the transaction failed.
-	ResultDesc          string `json:"ResultDesc,omitempty"`          // Response description is an acknowledgment message from the API that gives the status of the request submission usually maps to a specific ResponseCode value. It can be a "Success" submission message or an error description.
+	ResponseDescription string     `json:"ResponseDescription,omitempty"` // Response Description message. It can be a Success submission message or an error description.
+	ResponseCode        string     `json:"ResponseCode,omitempty"`        // This is a numeric status code that indicates the status of the transaction submission. 0 means successful submission and any other code means an error occurred.
+	MerchantRequestID   string     `json:"MerchantRequestID,omitempty"`   // This is a global unique Identifier for any submitted payment request.
+	CheckoutRequestID   string     `json:"CheckoutRequestID,omitempty"`   // This is a global unique identifier of the processed checkout transaction request.
+	CustomerMessage     string     `json:"CustomerMessage,omitempty"`     // This is a message that your system can display to the Customer as an acknowledgement of the payment request submission.
+	ResultCode          ResultCode `json:"ResultCode,omitempty"`          // This is a numeric status code that indicates the status of the transaction processing. 0 means successful processing and any other code means an error occurred or the transaction failed.
+	ResultDesc          string     `json:"ResultDesc,omitempty"`          // Response description is an acknowledgment message from the API that gives the status of the request submission usually maps to a specific ResponseCode value. It can be a "Success" submission message or an error description.
 }
 
 // GenerateQRResp is the response from the GenerateQR endpoint.
@@ -70,6 +95,16 @@ type B2CPaymentResp struct {
 	ValidResp
 }
 
+// B2CAccountTopUpResp is the response from the B2CAccountTopUp endpoint.
+type B2CAccountTopUpResp struct {
+	ValidResp
+}
+
+// B2PochiResp is the response from the B2Pochi endpoint.
+type B2PochiResp struct {
+	ValidResp
+}
+
 // TransactionStatusResp is the response from the TransactionStatus endpoint.
 type TransactionStatusResp struct {
 	ValidResp
@@ -94,3 +129,94 @@ type RemitTaxResp struct {
 type BusinessPayBillResp struct {
 	ValidResp
 }
+
+// BillManagerResp is a common response for the Bill Manager endpoints.
+type BillManagerResp struct {
+	ResponseMessage string `json:"resmsg,omitempty"`         // The status message of the request.
+	ResponseCode    string `json:"rescode,omitempty"`        // The status code of the request. 200 means the request was successful.
+	StatusMessage   string `json:"Status_Message,omitempty"` // Additional information about the status of the request.
+}
+
+// BillManagerOptInResp is the response from the BillManagerOptIn endpoint.
+type BillManagerOptInResp struct {
+	AppKey string `json:"app_key,omitempty"` // The key that identifies the shortcode on Bill Manager.
+	BillManagerResp
+}
+
+// BillManagerUpdateOptInResp is the response from the BillManagerUpdateOptIn endpoint.
+type BillManagerUpdateOptInResp struct {
+	BillManagerResp
+}
+
+// BillManagerSingleInvoiceResp is the response from the BillManagerSingleInvoice endpoint.
+type BillManagerSingleInvoiceResp struct {
+	BillManagerResp
+}
+
+// BillManagerBulkInvoiceResp is the response from the BillManagerBulkInvoice endpoint.
+type BillManagerBulkInvoiceResp struct {
+	BillManagerResp
+}
+
+// BillManagerCancelInvoiceResp is the response from the BillManagerCancelInvoice endpoint.
+type BillManagerCancelInvoiceResp struct {
+	BillManagerResp
+}
+
+// BillManagerReconcileResp is the response from the BillManagerReconcile endpoint.
+type BillManagerReconcileResp struct {
+	BillManagerResp
+}
+
+// PullTransactionsRegisterResp is the response from the PullTransactionsRegister endpoint.
+type PullTransactionsRegisterResp struct {
+	ResponseRefID   string `json:"ResponseRefID,omitempty"`   // The unique identifier of the request.
+	ResponseCode    string `json:"ResponseCode,omitempty"`    // The status of the request. 1000 indicates success.
+	ResponseMessage string `json:"ResponseMessage,omitempty"` // The description of the status of the request.
+	ShortCode       string `json:"ShortCode,omitempty"`       // The shortcode that was registered.
+}
+
+// PullTransactionsQueryResp is the response from the PullTransactionsQuery endpoint.
+// It holds the transactions of every page of the query.
+type PullTransactionsQueryResp struct {
+	ResponseRefID   string            `json:"ResponseRefID,omitempty"`   // The unique identifier of the request of the first page.
+	ResponseCode    string            `json:"ResponseCode,omitempty"`    // The status of the request. 1000 indicates success.
+	ResponseMessage string            `json:"ResponseMessage,omitempty"` // The description of the status of the request.
+	Transactions    []PullTransaction `json:"Transactions,omitempty"`    // The transactions within the time window.
+}
+
+// PullTransaction is a transaction returned by the PullTransactionsQuery endpoint.
+type PullTransaction struct {
+	TransactionID    string    `json:"transactionId"`    // The M-PESA transaction ID.
+	TransactionDate  time.Time `json:"trxDate"`          // The time of the transaction.
+	MSISDN           string    `json:"msisdn"`           // The phone number of the customer, masked by Daraja.
+	Sender           string    `json:"sender"`           // The name of the sender of the transaction.
+	TransactionType  string    `json:"transactiontype"`  // The type of the transaction, e.g. c2b-pay-bill-debit.
+	BillReference    string    `json:"billreference"`    // The account number the customer paid to.
+	Amount           Amount    `json:"amount"`           // The amount of the transaction.
+	OrganizationName string    `json:"organizationname"` // The name of the organization that received the transaction.
+}
+
+// StandingOrderResp is the response from the StandingOrder endpoint.
+type StandingOrderResp struct {
+	ResponseRefID       string `json:"ResponseRefID,omitempty"`       // The unique identifier of the request.
+	ResponseCode        string `json:"ResponseCode,omitempty"`        // The status of the request. 200 indicates that it was accepted for processing.
+	ResponseDescription string `json:"ResponseDescription,omitempty"` // The description of the status of the request.
+	ResultDesc          string `json:"ResultDesc,omitempty"`          // The description of the result of the request.
+}
+
+// B2BExpressCheckoutResp is the response from the B2BExpressCheckout endpoint.
+type B2BExpressCheckoutResp struct {
+	Code         string `json:"code,omitempty"`         // The status of the request. 0 indicates that the USSD push was initiated.
+	Status       string `json:"status,omitempty"`       // The description of the status of the request.
+	RequestRefID string `json:"RequestRefID,omitempty"` // The RequestRefID of the request, generated when it was empty. The callback carries it as requestId.
+}
+
+// SIMSwapResp is the response from the SIMSwap endpoint.
+type SIMSwapResp struct {
+	ResponseRefID       string    `json:"ResponseRefID,omitempty"`       // The unique identifier of the request.
+	ResponseCode        string    `json:"ResponseCode,omitempty"`        // The status of the request. 200 indicates success.
+	ResponseDescription string    `json:"ResponseDescription,omitempty"` // The description of the status of the request.
+	CustomerNumber      string    `json:"CustomerNumber,omitempty"`      // The phone number of the customer.
+	LastSwapDate        time.Time `json:"LastSwapDate,omitempty"`        // The time the SIM was last swapped. Zero when it was never swapped.
+}
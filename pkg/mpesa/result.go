@@ -0,0 +1,192 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+)
+
+// errInvalidResultCode indicates that a result code is neither a string nor a number.
+var errInvalidResultCode = errors.New("invalid result code")
+
+// ResultCode is the outcome of a transaction as reported by ExpressQuery and
+// by the STK push and asynchronous result callbacks.
+type ResultCode string
+
+// Known result codes.
+const (
+	ResultCodeSuccess                  ResultCode = "0"    // The transaction was processed successfully.
+	ResultCodeInsufficientFunds        ResultCode = "1"    // The balance is insufficient for the transaction.
+	ResultCodeLessThanMinimum          ResultCode = "2"    // The amount is less than the minimum transaction value.
+	ResultCodeMoreThanMaximum          ResultCode = "3"    // The amount is more than the maximum transaction value.
+	ResultCodeDailyLimitExceeded       ResultCode = "4"    // The transaction would exceed the daily transfer limit.
+	ResultCodeMinimumBalanceExceeded   ResultCode = "5"    // The transaction would exceed the minimum balance.
+	ResultCodeMaximumBalanceExceeded   ResultCode = "8"    // The transaction would exceed the maximum balance.
+	ResultCodeInvalidDebitParty        ResultCode = "11"   // The debit party is in an invalid state.
+	ResultCodeDuplicate                ResultCode = "15"   // A duplicate transaction was detected.
+	ResultCodeInternalFailure          ResultCode = "17"   // Daraja failed internally.
+	ResultCodeUnresolvedInitiator      ResultCode = "20"   // The initiator could not be resolved.
+	ResultCodeSystemBusy               ResultCode = "26"   // Daraja is throttling traffic.
+	ResultCodeTransactionInProgress    ResultCode = "1001" // The subscriber is already processing a transaction.
+	ResultCodeTransactionExpired       ResultCode = "1019" // The transaction expired before it was completed.
+	ResultCodePushRequestFailed        ResultCode = "1025" // The STK push could not be sent to the subscriber.
+	ResultCodeCancelledByUser          ResultCode = "1032" // The subscriber cancelled the STK push.
+	ResultCodeSubscriberUnreachable    ResultCode = "1037" // The subscriber could not be reached before the request timed out.
+	ResultCodeInvalidInitiator         ResultCode = "2001" // The initiator information, such as the PIN, is invalid.
+	ResultCodePushRequestFailedUnknown ResultCode = "9999" // The STK push could not be sent for an unknown reason.
+)
+
+// ResultClass groups result codes by how a caller should react to them.
+type ResultClass int
+
+const (
+	// ResultPending means that no result is available yet.
+	ResultPending ResultClass = iota
+	// ResultSuccess means that the transaction was completed.
+	ResultSuccess
+	// ResultCancelled means that the subscriber declined the transaction.
+	ResultCancelled
+	// ResultTimeout means that the subscriber did not respond in time.
+	ResultTimeout
+	// ResultRetryable means that the transaction failed but can be attempted again.
+	ResultRetryable
+	// ResultFailed means that the transaction failed and will fail again if repeated.
+	ResultFailed
+)
+
+var resultClassNames = map[ResultClass]string{
+	ResultPending:   "pending",
+	ResultSuccess:   "success",
+	ResultCancelled: "cancelled",
+	ResultTimeout:   "timeout",
+	ResultRetryable: "retryable",
+	ResultFailed:    "failed",
+}
+
+func (c ResultClass) String() string {
+	if name, ok := resultClassNames[c]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+type resultCodeInfo struct {
+	description string
+	class       ResultClass
+}
+
+var resultCodes = map[ResultCode]resultCodeInfo{
+	ResultCodeSuccess:                  {"The transaction was processed successfully", ResultSuccess},
+	ResultCodeInsufficientFunds:        {"The balance is insufficient for the transaction", ResultFailed},
+	ResultCodeLessThanMinimum:          {"The amount is less than the minimum transaction value", ResultFailed},
+	ResultCodeMoreThanMaximum:          {"The amount is more than the maximum transaction value", ResultFailed},
+	ResultCodeDailyLimitExceeded:       {"The transaction would exceed the daily transfer limit", ResultFailed},
+	ResultCodeMinimumBalanceExceeded:   {"The transaction would exceed the minimum balance", ResultFailed},
+	ResultCodeMaximumBalanceExceeded:   {"The transaction would exceed the maximum balance", ResultFailed},
+	ResultCodeInvalidDebitParty:        {"The debit party is in an invalid state", ResultFailed},
+	ResultCodeDuplicate:                {"A duplicate transaction was detected", ResultFailed},
+	ResultCodeInternalFailure:          {"Daraja failed to process the transaction", ResultRetryable},
+	ResultCodeUnresolvedInitiator:      {"The initiator could not be resolved", ResultFailed},
+	ResultCodeSystemBusy:               {"The system is busy", ResultRetryable},
+	ResultCodeTransactionInProgress:    {"A transaction is already in process for the subscriber", ResultRetryable},
+	ResultCodeTransactionExpired:       {"The transaction has expired", ResultTimeout},
+	ResultCodePushRequestFailed:        {"An error occurred while sending the push request", ResultRetryable},
+	ResultCodeCancelledByUser:          {"The request was cancelled by the user", ResultCancelled},
+	ResultCodeSubscriberUnreachable:    {"The user could not be reached", ResultTimeout},
+	ResultCodeInvalidInitiator:         {"The initiator information is invalid", ResultFailed},
+	ResultCodePushRequestFailedUnknown: {"An error occurred while sending the push request", ResultRetryable},
+}
+
+func (c ResultCode) String() string {
+	return string(c)
+}
+
+// Description returns a human-readable description of the result code.
+func (c ResultCode) Description() string {
+	if info, ok := resultCodes[c]; ok {
+		return info.description
+	}
+	if c == "" {
+		return "No result is available yet"
+	}
+
+	return "Unknown result code " + string(c)
+}
+
+// Class returns how the result should be handled. An empty code is pending
+// and an unknown non-zero code is a failure.
+func (c ResultCode) Class() ResultClass {
+	if info, ok := resultCodes[c]; ok {
+		return info.class
+	}
+	if c == "" {
+		return ResultPending
+	}
+
+	return ResultFailed
+}
+
+// IsSuccess reports whether the transaction was completed.
+func (c ResultCode) IsSuccess() bool {
+	return c.Class() == ResultSuccess
+}
+
+// IsCancelled reports whether the subscriber declined the transaction.
+func (c ResultCode) IsCancelled() bool {
+	return c.Class() == ResultCancelled
+}
+
+// IsTimeout reports whether the subscriber did not respond in time.
+func (c ResultCode) IsTimeout() bool {
+	return c.Class() == ResultTimeout
+}
+
+// IsRetryable reports whether the transaction can be attempted again.
+func (c ResultCode) IsRetryable() bool {
+	return c.Class() == ResultRetryable
+}
+
+// IsFailure reports whether the transaction has a final result other than success.
+func (c ResultCode) IsFailure() bool {
+	switch c.Class() {
+	case ResultPending, ResultSuccess:
+		return false
+	default:
+		return true
+	}
+}
+
+// UnmarshalJSON accepts the result code as a string, as returned by
+// ExpressQuery, or as a number, as sent in callbacks.
+func (c *ResultCode) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*c = ResultCode(s)
+
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return errors.Join(errInvalidResultCode, err)
+	}
+	if _, err := strconv.ParseInt(n.String(), 10, 64); err != nil {
+		return errors.Join(errInvalidResultCode, err)
+	}
+	*c = ResultCode(n.String())
+
+	return nil
+}
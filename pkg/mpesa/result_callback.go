@@ -0,0 +1,706 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// completedTimeLayout is the layout of TransactionCompletedDateTime.
+const completedTimeLayout = "02.01.2006 15:04:05"
+
+// ResultParameter is a key and value pair of the parameters sent with a
+// result. Numbers are decoded as json.Number so that no precision is lost.
+type ResultParameter struct {
+	Key   string      `json:"Key"`
+	Value interface{} `json:"Value,omitempty"`
+}
+
+// resultParameters accepts a single parameter as well as a list of them, since
+// Daraja sends an object instead of an array when there is only one.
+type resultParameters []ResultParameter
+
+func (p *resultParameters) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		var param ResultParameter
+		if err := decodeCallback(data, &param); err != nil {
+			return err
+		}
+		*p = resultParameters{param}
+
+		return nil
+	}
+
+	var params []ResultParameter
+	if err := decodeCallback(data, &params); err != nil {
+		return err
+	}
+	*p = params
+
+	return nil
+}
+
+// AsyncResult is the outcome of an asynchronous operation that Safaricom posts
+// to the ResultURL, or to the QueueTimeOutURL if the request timed out in the
+// queue, of the original request.
+type AsyncResult struct {
+	ResultType               int               // The status of the transaction processing.
+	ResultCode               ResultCode        // The outcome of the transaction.
+	ResultDesc               string            // The description of the outcome.
+	OriginatorConversationID string            // The OriginatorConversationID of the original request.
+	ConversationID           string            // The ConversationID returned for the original request.
+	TransactionID            string            // The M-PESA transaction ID.
+	TimedOut                 bool              // Whether the result was posted to the QueueTimeOutURL.
+	Parameters               []ResultParameter // The result parameters as sent by Safaricom.
+	ReferenceData            []ResultParameter // The reference data as sent by Safaricom.
+}
+
+type asyncResultBody struct {
+	Result *struct {
+		ResultType               json.Number `json:"ResultType"`
+		ResultCode               ResultCode  `json:"ResultCode"`
+		ResultDesc               string      `json:"ResultDesc"`
+		OriginatorConversationID string      `json:"OriginatorConversationID"`
+		ConversationID           string      `json:"ConversationID"`
+		TransactionID            string      `json:"TransactionID"`
+		ResultParameters         struct {
+			ResultParameter resultParameters `json:"ResultParameter"`
+		} `json:"ResultParameters"`
+		ReferenceData struct {
+			ReferenceItem resultParameters `json:"ReferenceItem"`
+		} `json:"ReferenceData"`
+	} `json:"Result"`
+}
+
+// ParseAsyncResult parses the body posted to a ResultURL or QueueTimeOutURL.
+func ParseAsyncResult(data []byte) (AsyncResult, error) {
+	var body asyncResultBody
+	if err := decodeCallback(data, &body); err != nil {
+		return AsyncResult{}, err
+	}
+	res := body.Result
+	if res == nil || (res.OriginatorConversationID == "" && res.ConversationID == "") {
+		return AsyncResult{}, fmt.Errorf("%w: missing Result", errInvalidCallback)
+	}
+
+	result := AsyncResult{
+		ResultCode:               res.ResultCode,
+		ResultDesc:               res.ResultDesc,
+		OriginatorConversationID: res.OriginatorConversationID,
+		ConversationID:           res.ConversationID,
+		TransactionID:            res.TransactionID,
+		Parameters:               res.ResultParameters.ResultParameter,
+		ReferenceData:            res.ReferenceData.ReferenceItem,
+	}
+	if res.ResultType != "" {
+		resultType, err := strconv.Atoi(res.ResultType.String())
+		if err != nil {
+			return AsyncResult{}, fmt.Errorf("%w: ResultType: %w", errInvalidCallback, err)
+		}
+		result.ResultType = resultType
+	}
+
+	return result, nil
+}
+
+// Matches reports whether the result belongs to the request that was
+// acknowledged with resp.
+func (r AsyncResult) Matches(resp ValidResp) bool {
+	if r.OriginatorConversationID != "" && r.OriginatorConversationID == resp.OriginatorConversationID {
+		return true
+	}
+
+	return r.ConversationID != "" && r.ConversationID == resp.ConversationID
+}
+
+// Parameter returns the value of the result parameter or reference item with
+// the given key as a string.
+func (r AsyncResult) Parameter(key string) (string, bool) {
+	for _, params := range [][]ResultParameter{r.Parameters, r.ReferenceData} {
+		for _, param := range params {
+			if param.Key == key && param.Value != nil {
+				return fmt.Sprint(param.Value), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// resultParser extracts the typed parameters of a result. The first error is
+// kept and every later call is a no-op.
+type resultParser struct {
+	result AsyncResult
+	err    error
+}
+
+func (p *resultParser) string(key string) string {
+	value, _ := p.result.Parameter(key)
+
+	return value
+}
+
+func (p *resultParser) amount(key string) Amount {
+	value, ok := p.result.Parameter(key)
+	if !ok || value == "" || p.err != nil {
+		return 0
+	}
+
+	a, err := ParseAmount(value)
+	if err != nil {
+		p.err = fmt.Errorf("%w: %s: %w", errInvalidCallback, key, err)
+	}
+
+	return a
+}
+
+func (p *resultParser) time(key string) time.Time {
+	value, ok := p.result.Parameter(key)
+	if !ok || value == "" || p.err != nil {
+		return time.Time{}
+	}
+
+	t, err := parseDarajaTime(value)
+	if err != nil {
+		if t, err = time.ParseInLocation(completedTimeLayout, value, darajaLocation); err != nil {
+			p.err = fmt.Errorf("%w: %s: %w", errInvalidCallback, key, err)
+		}
+	}
+
+	return t
+}
+
+// B2CResult is the outcome of a B2CPayment.
+type B2CResult struct {
+	AsyncResult
+	TransactionAmount                   Amount
+	TransactionReceipt                  string
+	ReceiverPartyPublicName             string
+	TransactionCompletedDateTime        time.Time
+	B2CRecipientIsRegisteredCustomer    bool
+	B2CUtilityAccountAvailableFunds     Amount
+	B2CWorkingAccountAvailableFunds     Amount
+	B2CChargesPaidAccountAvailableFunds Amount
+}
+
+// B2CResultFunc handles the outcome of a B2CPayment.
+type B2CResultFunc func(ctx context.Context, result B2CResult) error
+
+// ParseB2CResult parses the body posted to the ResultURL of a B2CPayment.
+func ParseB2CResult(data []byte) (B2CResult, error) {
+	result, err := ParseAsyncResult(data)
+	if err != nil {
+		return B2CResult{}, err
+	}
+
+	return newB2CResult(result)
+}
+
+func newB2CResult(result AsyncResult) (B2CResult, error) {
+	p := resultParser{result: result}
+	res := B2CResult{
+		AsyncResult:                         result,
+		TransactionAmount:                   p.amount("TransactionAmount"),
+		TransactionReceipt:                  p.string("TransactionReceipt"),
+		ReceiverPartyPublicName:             p.string("ReceiverPartyPublicName"),
+		TransactionCompletedDateTime:        p.time("TransactionCompletedDateTime"),
+		B2CRecipientIsRegisteredCustomer:    p.string("B2CRecipientIsRegisteredCustomer") == "Y",
+		B2CUtilityAccountAvailableFunds:     p.amount("B2CUtilityAccountAvailableFunds"),
+		B2CWorkingAccountAvailableFunds:     p.amount("B2CWorkingAccountAvailableFunds"),
+		B2CChargesPaidAccountAvailableFunds: p.amount("B2CChargesPaidAccountAvailableFunds"),
+	}
+
+	return res, p.err
+}
+
+// NewB2CResultHandlers returns the handlers of the ResultURL and
+// QueueTimeOutURL of B2CPayment requests.
+func NewB2CResultHandlers(fn B2CResultFunc) (ResultHandlers, error) {
+	if fn == nil {
+		return ResultHandlers{}, errMissingCallbackHandler
+	}
+
+	return newResultHandlers(func(ctx context.Context, result AsyncResult) error {
+		res, err := newB2CResult(result)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, res)
+	}), nil
+}
+
+// BusinessPayBillResult is the outcome of a BusinessPayBill.
+type BusinessPayBillResult struct {
+	AsyncResult
+	Amount                           Amount
+	TransCompletedTime               time.Time
+	ReceiverPartyPublicName          string
+	DebitAccountBalance              string
+	DebitPartyAffectedAccountBalance string
+	DebitPartyCharges                string
+	InitiatorAccountCurrentBalance   string
+	Currency                         string
+	BillReferenceNumber              string
+}
+
+// BusinessPayBillResultFunc handles the outcome of a BusinessPayBill.
+type BusinessPayBillResultFunc func(ctx context.Context, result BusinessPayBillResult) error
+
+// ParseBusinessPayBillResult parses the body posted to the ResultURL of a BusinessPayBill.
+func ParseBusinessPayBillResult(data []byte) (BusinessPayBillResult, error) {
+	result, err := ParseAsyncResult(data)
+	if err != nil {
+		return BusinessPayBillResult{}, err
+	}
+
+	return newBusinessPayBillResult(result)
+}
+
+func newBusinessPayBillResult(result AsyncResult) (BusinessPayBillResult, error) {
+	p := resultParser{result: result}
+	res := BusinessPayBillResult{
+		AsyncResult:                      result,
+		Amount:                           p.amount("Amount"),
+		TransCompletedTime:               p.time("TransCompletedTime"),
+		ReceiverPartyPublicName:          p.string("ReceiverPartyPublicName"),
+		DebitAccountBalance:              p.string("DebitAccountBalance"),
+		DebitPartyAffectedAccountBalance: p.string("DebitPartyAffectedAccountBalance"),
+		DebitPartyCharges:                p.string("DebitPartyCharges"),
+		InitiatorAccountCurrentBalance:   p.string("InitiatorAccountCurrentBalance"),
+		Currency:                         p.string("Currency"),
+		BillReferenceNumber:              p.string("BillReferenceNumber"),
+	}
+
+	return res, p.err
+}
+
+// NewBusinessPayBillResultHandlers returns the handlers of the ResultURL and
+// QueueTimeOutURL of BusinessPayBill requests.
+func NewBusinessPayBillResultHandlers(fn BusinessPayBillResultFunc) (ResultHandlers, error) {
+	if fn == nil {
+		return ResultHandlers{}, errMissingCallbackHandler
+	}
+
+	return newResultHandlers(func(ctx context.Context, result AsyncResult) error {
+		res, err := newBusinessPayBillResult(result)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, res)
+	}), nil
+}
+
+// B2CAccountTopUpResult is the outcome of a B2CAccountTopUp.
+type B2CAccountTopUpResult struct {
+	AsyncResult
+	Amount                           Amount
+	TransCompletedTime               time.Time
+	ReceiverPartyPublicName          string
+	DebitAccountBalance              string
+	DebitPartyAffectedAccountBalance string
+	DebitPartyCharges                string
+	InitiatorAccountCurrentBalance   string
+	Currency                         string
+}
+
+// B2CAccountTopUpResultFunc handles the outcome of a B2CAccountTopUp.
+type B2CAccountTopUpResultFunc func(ctx context.Context, result B2CAccountTopUpResult) error
+
+// ParseB2CAccountTopUpResult parses the body posted to the ResultURL of a B2CAccountTopUp.
+func ParseB2CAccountTopUpResult(data []byte) (B2CAccountTopUpResult, error) {
+	result, err := ParseAsyncResult(data)
+	if err != nil {
+		return B2CAccountTopUpResult{}, err
+	}
+
+	return newB2CAccountTopUpResult(result)
+}
+
+func newB2CAccountTopUpResult(result AsyncResult) (B2CAccountTopUpResult, error) {
+	p := resultParser{result: result}
+	res := B2CAccountTopUpResult{
+		AsyncResult:                      result,
+		Amount:                           p.amount("Amount"),
+		TransCompletedTime:               p.time("TransCompletedTime"),
+		ReceiverPartyPublicName:          p.string("ReceiverPartyPublicName"),
+		DebitAccountBalance:              p.string("DebitAccountBalance"),
+		DebitPartyAffectedAccountBalance: p.string("DebitPartyAffectedAccountBalance"),
+		DebitPartyCharges:                p.string("DebitPartyCharges"),
+		InitiatorAccountCurrentBalance:   p.string("InitiatorAccountCurrentBalance"),
+		Currency:                         p.string("Currency"),
+	}
+
+	return res, p.err
+}
+
+// NewB2CAccountTopUpResultHandlers returns the handlers of the ResultURL and
+// QueueTimeOutURL of B2CAccountTopUp requests.
+func NewB2CAccountTopUpResultHandlers(fn B2CAccountTopUpResultFunc) (ResultHandlers, error) {
+	if fn == nil {
+		return ResultHandlers{}, errMissingCallbackHandler
+	}
+
+	return newResultHandlers(func(ctx context.Context, result AsyncResult) error {
+		res, err := newB2CAccountTopUpResult(result)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, res)
+	}), nil
+}
+
+// B2PochiResult is the outcome of a B2Pochi.
+type B2PochiResult struct {
+	AsyncResult
+	TransactionAmount                   Amount
+	TransactionReceipt                  string
+	ReceiverPartyPublicName             string
+	TransactionCompletedDateTime        time.Time
+	B2CUtilityAccountAvailableFunds     Amount
+	B2CWorkingAccountAvailableFunds     Amount
+	B2CChargesPaidAccountAvailableFunds Amount
+}
+
+// B2PochiResultFunc handles the outcome of a B2Pochi.
+type B2PochiResultFunc func(ctx context.Context, result B2PochiResult) error
+
+// ParseB2PochiResult parses the body posted to the ResultURL of a B2Pochi.
+func ParseB2PochiResult(data []byte) (B2PochiResult, error) {
+	result, err := ParseAsyncResult(data)
+	if err != nil {
+		return B2PochiResult{}, err
+	}
+
+	return newB2PochiResult(result)
+}
+
+func newB2PochiResult(result AsyncResult) (B2PochiResult, error) {
+	p := resultParser{result: result}
+	res := B2PochiResult{
+		AsyncResult:                         result,
+		TransactionAmount:                   p.amount("TransactionAmount"),
+		TransactionReceipt:                  p.string("TransactionReceipt"),
+		ReceiverPartyPublicName:             p.string("ReceiverPartyPublicName"),
+		TransactionCompletedDateTime:        p.time("TransactionCompletedDateTime"),
+		B2CUtilityAccountAvailableFunds:     p.amount("B2CUtilityAccountAvailableFunds"),
+		B2CWorkingAccountAvailableFunds:     p.amount("B2CWorkingAccountAvailableFunds"),
+		B2CChargesPaidAccountAvailableFunds: p.amount("B2CChargesPaidAccountAvailableFunds"),
+	}
+
+	return res, p.err
+}
+
+// NewB2PochiResultHandlers returns the handlers of the ResultURL and
+// QueueTimeOutURL of B2Pochi requests.
+func NewB2PochiResultHandlers(fn B2PochiResultFunc) (ResultHandlers, error) {
+	if fn == nil {
+		return ResultHandlers{}, errMissingCallbackHandler
+	}
+
+	return newResultHandlers(func(ctx context.Context, result AsyncResult) error {
+		res, err := newB2PochiResult(result)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, res)
+	}), nil
+}
+
+// RemitTaxResult is the outcome of a RemitTax.
+type RemitTaxResult struct {
+	AsyncResult
+	Amount                         Amount
+	TransCompletedTime             time.Time
+	ReceiverPartyPublicName        string
+	DebitAccountBalance            string
+	DebitPartyCharges              string
+	InitiatorAccountCurrentBalance string
+	Currency                       string
+}
+
+// RemitTaxResultFunc handles the outcome of a RemitTax.
+type RemitTaxResultFunc func(ctx context.Context, result RemitTaxResult) error
+
+// ParseRemitTaxResult parses the body posted to the ResultURL of a RemitTax.
+func ParseRemitTaxResult(data []byte) (RemitTaxResult, error) {
+	result, err := ParseAsyncResult(data)
+	if err != nil {
+		return RemitTaxResult{}, err
+	}
+
+	return newRemitTaxResult(result)
+}
+
+func newRemitTaxResult(result AsyncResult) (RemitTaxResult, error) {
+	p := resultParser{result: result}
+	res := RemitTaxResult{
+		AsyncResult:                    result,
+		Amount:                         p.amount("Amount"),
+		TransCompletedTime:             p.time("TransCompletedTime"),
+		ReceiverPartyPublicName:        p.string("ReceiverPartyPublicName"),
+		DebitAccountBalance:            p.string("DebitAccountBalance"),
+		DebitPartyCharges:              p.string("DebitPartyCharges"),
+		InitiatorAccountCurrentBalance: p.string("InitiatorAccountCurrentBalance"),
+		Currency:                       p.string("Currency"),
+	}
+
+	return res, p.err
+}
+
+// NewRemitTaxResultHandlers returns the handlers of the ResultURL and
+// QueueTimeOutURL of RemitTax requests.
+func NewRemitTaxResultHandlers(fn RemitTaxResultFunc) (ResultHandlers, error) {
+	if fn == nil {
+		return ResultHandlers{}, errMissingCallbackHandler
+	}
+
+	return newResultHandlers(func(ctx context.Context, result AsyncResult) error {
+		res, err := newRemitTaxResult(result)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, res)
+	}), nil
+}
+
+// ReverseResult is the outcome of a Reverse.
+type ReverseResult struct {
+	AsyncResult
+	Amount                Amount
+	OriginalTransactionID string
+	TransCompletedTime    time.Time
+	DebitAccountBalance   string
+	Charge                Amount
+	CreditPartyPublicName string
+	DebitPartyPublicName  string
+}
+
+// ReverseResultFunc handles the outcome of a Reverse.
+type ReverseResultFunc func(ctx context.Context, result ReverseResult) error
+
+// ParseReverseResult parses the body posted to the ResultURL of a Reverse.
+func ParseReverseResult(data []byte) (ReverseResult, error) {
+	result, err := ParseAsyncResult(data)
+	if err != nil {
+		return ReverseResult{}, err
+	}
+
+	return newReverseResult(result)
+}
+
+func newReverseResult(result AsyncResult) (ReverseResult, error) {
+	p := resultParser{result: result}
+	res := ReverseResult{
+		AsyncResult:           result,
+		Amount:                p.amount("Amount"),
+		OriginalTransactionID: p.string("OriginalTransactionID"),
+		TransCompletedTime:    p.time("TransCompletedTime"),
+		DebitAccountBalance:   p.string("DebitAccountBalance"),
+		Charge:                p.amount("Charge"),
+		CreditPartyPublicName: p.string("CreditPartyPublicName"),
+		DebitPartyPublicName:  p.string("DebitPartyPublicName"),
+	}
+
+	return res, p.err
+}
+
+// NewReverseResultHandlers returns the handlers of the ResultURL and
+// QueueTimeOutURL of Reverse requests.
+func NewReverseResultHandlers(fn ReverseResultFunc) (ResultHandlers, error) {
+	if fn == nil {
+		return ResultHandlers{}, errMissingCallbackHandler
+	}
+
+	return newResultHandlers(func(ctx context.Context, result AsyncResult) error {
+		res, err := newReverseResult(result)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, res)
+	}), nil
+}
+
+// AccountBalanceResult is the outcome of an AccountBalance.
+type AccountBalanceResult struct {
+	AsyncResult
+	AccountBalance  string           // The balances of the accounts as sent by Safaricom.
+	Balances        []AccountBalance // The balances of the accounts parsed from AccountBalance.
+	BOCompletedTime time.Time
+}
+
+// AccountBalanceResultFunc handles the outcome of an AccountBalance.
+type AccountBalanceResultFunc func(ctx context.Context, result AccountBalanceResult) error
+
+// ParseAccountBalanceResult parses the body posted to the ResultURL of an AccountBalance.
+func ParseAccountBalanceResult(data []byte) (AccountBalanceResult, error) {
+	result, err := ParseAsyncResult(data)
+	if err != nil {
+		return AccountBalanceResult{}, err
+	}
+
+	return newAccountBalanceResult(result)
+}
+
+func newAccountBalanceResult(result AsyncResult) (AccountBalanceResult, error) {
+	p := resultParser{result: result}
+	res := AccountBalanceResult{
+		AsyncResult:     result,
+		AccountBalance:  p.string("AccountBalance"),
+		BOCompletedTime: p.time("BOCompletedTime"),
+	}
+	if p.err != nil {
+		return AccountBalanceResult{}, p.err
+	}
+
+	balances, err := ParseAccountBalances(res.AccountBalance)
+	if err != nil {
+		return AccountBalanceResult{}, fmt.Errorf("%w: %w", errInvalidCallback, err)
+	}
+	res.Balances = balances
+
+	return res, nil
+}
+
+// NewAccountBalanceResultHandlers returns the handlers of the ResultURL and
+// QueueTimeOutURL of AccountBalance requests.
+func NewAccountBalanceResultHandlers(fn AccountBalanceResultFunc) (ResultHandlers, error) {
+	if fn == nil {
+		return ResultHandlers{}, errMissingCallbackHandler
+	}
+
+	return newResultHandlers(func(ctx context.Context, result AsyncResult) error {
+		res, err := newAccountBalanceResult(result)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, res)
+	}), nil
+}
+
+// TransactionStatusResult is the outcome of a TransactionStatus.
+type TransactionStatusResult struct {
+	AsyncResult
+	ReceiptNo          string
+	TransactionStatus  string
+	Amount             Amount
+	DebitPartyName     string
+	CreditPartyName    string
+	DebitAccountType   string
+	CreditPartyCharges string
+	TransactionReason  string
+	ReasonType         string
+	InitiatedTime      time.Time
+	FinalisedTime      time.Time
+}
+
+// TransactionStatusResultFunc handles the outcome of a TransactionStatus.
+type TransactionStatusResultFunc func(ctx context.Context, result TransactionStatusResult) error
+
+// ParseTransactionStatusResult parses the body posted to the ResultURL of a TransactionStatus.
+func ParseTransactionStatusResult(data []byte) (TransactionStatusResult, error) {
+	result, err := ParseAsyncResult(data)
+	if err != nil {
+		return TransactionStatusResult{}, err
+	}
+
+	return newTransactionStatusResult(result)
+}
+
+func newTransactionStatusResult(result AsyncResult) (TransactionStatusResult, error) {
+	p := resultParser{result: result}
+	res := TransactionStatusResult{
+		AsyncResult:        result,
+		ReceiptNo:          p.string("ReceiptNo"),
+		TransactionStatus:  p.string("TransactionStatus"),
+		Amount:             p.amount("Amount"),
+		DebitPartyName:     p.string("DebitPartyName"),
+		CreditPartyName:    p.string("CreditPartyName"),
+		DebitAccountType:   p.string("DebitAccountType"),
+		CreditPartyCharges: p.string("CreditPartyCharges"),
+		TransactionReason:  p.string("TransactionReason"),
+		ReasonType:         p.string("ReasonType"),
+		InitiatedTime:      p.time("InitiatedTime"),
+		FinalisedTime:      p.time("FinalisedTime"),
+	}
+
+	return res, p.err
+}
+
+// NewTransactionStatusResultHandlers returns the handlers of the ResultURL
+// and QueueTimeOutURL of TransactionStatus requests.
+func NewTransactionStatusResultHandlers(fn TransactionStatusResultFunc) (ResultHandlers, error) {
+	if fn == nil {
+		return ResultHandlers{}, errMissingCallbackHandler
+	}
+
+	return newResultHandlers(func(ctx context.Context, result AsyncResult) error {
+		res, err := newTransactionStatusResult(result)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, res)
+	}), nil
+}
+
+// ResultHandlers serve the URLs an asynchronous operation posts its outcome
+// to. Results posted to QueueTimeOut have TimedOut set.
+type ResultHandlers struct {
+	Result       http.Handler // Serves the ResultURL.
+	QueueTimeOut http.Handler // Serves the QueueTimeOutURL.
+}
+
+type resultHandler struct {
+	timedOut bool
+	fn       func(ctx context.Context, result AsyncResult) error
+}
+
+func newResultHandlers(fn func(ctx context.Context, result AsyncResult) error) ResultHandlers {
+	return ResultHandlers{
+		Result:       resultHandler{fn: fn},
+		QueueTimeOut: resultHandler{timedOut: true, fn: fn},
+	}
+}
+
+func (h resultHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	data, ok := readCallback(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := ParseAsyncResult(data)
+	if err != nil {
+		writeCallbackResp(w, http.StatusBadRequest, callbackFailed)
+
+		return
+	}
+	result.TimedOut = h.timedOut
+
+	switch err := h.fn(r.Context(), result); {
+	case errors.Is(err, errInvalidCallback):
+		writeCallbackResp(w, http.StatusBadRequest, callbackFailed)
+	case err != nil:
+		writeCallbackResp(w, http.StatusInternalServerError, callbackFailed)
+	default:
+		writeCallbackResp(w, http.StatusOK, callbackAccepted)
+	}
+}
@@ -0,0 +1,531 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	b2cResult = `{
+		"Result": {
+			"ResultType": 0,
+			"ResultCode": 0,
+			"ResultDesc": "The service request is processed successfully.",
+			"OriginatorConversationID": "10571-7910404-1",
+			"ConversationID": "AG_20191219_00004e48cf7e3533f581",
+			"TransactionID": "NLJ41HAY6Q",
+			"ResultParameters": {
+				"ResultParameter": [
+					{"Key": "TransactionAmount", "Value": 10},
+					{"Key": "TransactionReceipt", "Value": "NLJ41HAY6Q"},
+					{"Key": "B2CRecipientIsRegisteredCustomer", "Value": "Y"},
+					{"Key": "B2CChargesPaidAccountAvailableFunds", "Value": -4510.00},
+					{"Key": "ReceiverPartyPublicName", "Value": "254708374149 - John Doe"},
+					{"Key": "TransactionCompletedDateTime", "Value": "19.12.2019 11:45:50"},
+					{"Key": "B2CUtilityAccountAvailableFunds", "Value": 10116.00},
+					{"Key": "B2CWorkingAccountAvailableFunds", "Value": 900000.00}
+				]
+			},
+			"ReferenceData": {
+				"ReferenceItem": {
+					"Key": "QueueTimeoutURL",
+					"Value": "https://internalsandbox.safaricom.co.ke/mpesa/b2cresults/v1/submit"
+				}
+			}
+		}
+	}`
+	b2cFailedResult = `{
+		"Result": {
+			"ResultType": 0,
+			"ResultCode": 2001,
+			"ResultDesc": "The initiator information is invalid.",
+			"OriginatorConversationID": "29112-34801843-1",
+			"ConversationID": "AG_20191219_00006c6fddb15123addf",
+			"TransactionID": "NLJ0000000",
+			"ReferenceData": {
+				"ReferenceItem": {
+					"Key": "QueueTimeoutURL",
+					"Value": "https://internalsandbox.safaricom.co.ke/mpesa/b2cresults/v1/submit"
+				}
+			}
+		}
+	}`
+	accountBalanceResult = `{
+		"Result": {
+			"ResultType": 0,
+			"ResultCode": 0,
+			"ResultDesc": "The service request is processed successfully.",
+			"OriginatorConversationID": "16917-22577599-3",
+			"ConversationID": "AG_20200206_00005e091a8ec6b9eac5",
+			"TransactionID": "OA90000000",
+			"ResultParameters": {
+				"ResultParameter": [
+					{"Key": "AccountBalance", "Value": "Working Account|KES|700000.00|700000.00|0.00|0.00&Float Account|KES|0.00|0.00|0.00|0.00&Utility Account|KES|228037.00|228037.00|0.00|0.00&Charges Paid Account|KES|-1540.00|-1540.00|0.00|0.00&Organization Settlement Account|KES|0.00|0.00|0.00|0.00"},
+					{"Key": "BOCompletedTime", "Value": 20200109125710}
+				]
+			},
+			"ReferenceData": {
+				"ReferenceItem": {
+					"Key": "QueueTimeoutURL",
+					"Value": "https://internalsandbox.safaricom.co.ke/mpesa/abresults/v1/submit"
+				}
+			}
+		}
+	}`
+	transactionStatusResult = `{
+		"Result": {
+			"ResultType": 0,
+			"ResultCode": 0,
+			"ResultDesc": "The service request is processed successfully.",
+			"OriginatorConversationID": "10816-694520-2",
+			"ConversationID": "AG_20200120_0000657265d5fa9ae5c0",
+			"TransactionID": "OAK0000000",
+			"ResultParameters": {
+				"ResultParameter": [
+					{"Key": "DebitPartyName", "Value": "600310 - Safaricom333"},
+					{"Key": "CreditPartyName", "Value": "254708374149 - John Doe"},
+					{"Key": "OriginatorConversationID", "Value": "3211-416020-3"},
+					{"Key": "InitiatedTime", "Value": 20200120164825},
+					{"Key": "CreditPartyCharges"},
+					{"Key": "DebitAccountType", "Value": "Utility Account"},
+					{"Key": "TransactionReason"},
+					{"Key": "ReasonType", "Value": "Business Payment to Customer via API"},
+					{"Key": "TransactionStatus", "Value": "Completed"},
+					{"Key": "FinalisedTime", "Value": 20200120164825},
+					{"Key": "Amount", "Value": 10},
+					{"Key": "ConversationID", "Value": "AG_20200120_000049448b24ad831a1e"},
+					{"Key": "ReceiptNo", "Value": "OAK41HAY6Q"}
+				]
+			},
+			"ReferenceData": {
+				"ReferenceItem": {"Key": "Occasion"}
+			}
+		}
+	}`
+	reverseResult = `{
+		"Result": {
+			"ResultType": 0,
+			"ResultCode": 0,
+			"ResultDesc": "The service request is processed successfully.",
+			"OriginatorConversationID": "8521-4298025-1",
+			"ConversationID": "AG_20181005_00004d7ee675c0c7ee0b",
+			"TransactionID": "MJ561H6X5O",
+			"ResultParameters": {
+				"ResultParameter": [
+					{"Key": "DebitAccountBalance", "Value": "Utility Account|KES|51661.00|51661.00|0.00|0.00"},
+					{"Key": "Amount", "Value": 100},
+					{"Key": "TransCompletedTime", "Value": 20181005153225},
+					{"Key": "OriginalTransactionID", "Value": "MJ551H6X5D"},
+					{"Key": "Charge", "Value": 0},
+					{"Key": "CreditPartyPublicName", "Value": "254708374149 - John Doe"},
+					{"Key": "DebitPartyPublicName", "Value": "601315 - Safaricom1338"}
+				]
+			},
+			"ReferenceData": {
+				"ReferenceItem": {
+					"Key": "QueueTimeoutURL",
+					"Value": "https://internalsandbox.safaricom.co.ke/mpesa/reversalresults/v1/submit"
+				}
+			}
+		}
+	}`
+	businessPayBillResult = `{
+		"Result": {
+			"ResultType": "0",
+			"ResultCode": "0",
+			"ResultDesc": "The service request is processed successfully",
+			"OriginatorConversationID": "626f6ddf-ab37-4650-b882-b1de92ec9aa4",
+			"ConversationID": "12345677dfdf89099B3",
+			"TransactionID": "QKA81LK5CY",
+			"ResultParameters": {
+				"ResultParameter": [
+					{"Key": "DebitAccountBalance", "Value": "{Amount={CurrencyCode=KES, MinimumAmount=618683, BasicAmount=6186.83}}"},
+					{"Key": "Amount", "Value": "190.00"},
+					{"Key": "DebitPartyAffectedAccountBalance", "Value": "Working Account|KES|346768.83|6186.83|340582.00|0.00"},
+					{"Key": "TransCompletedTime", "Value": "20221110110717"},
+					{"Key": "DebitPartyCharges", "Value": ""},
+					{"Key": "ReceiverPartyPublicName", "Value": "000000- Biller Companyname"},
+					{"Key": "Currency", "Value": "KES"},
+					{"Key": "InitiatorAccountCurrentBalance", "Value": "{Amount={CurrencyCode=KES, MinimumAmount=618683, BasicAmount=6186.83}}"}
+				]
+			},
+			"ReferenceData": {
+				"ReferenceItem": [
+					{"Key": "BillReferenceNumber", "Value": "19008"},
+					{"Key": "QueueTimeoutURL", "Value": "https://mydomain.com/b2b/businessbuygoods/queue/"}
+				]
+			}
+		}
+	}`
+)
+
+func TestParseB2CResult(t *testing.T) {
+	res, err := ParseB2CResult([]byte(b2cResult))
+	require.NoError(t, err)
+
+	assert.Equal(t, ResultCodeSuccess, res.ResultCode)
+	assert.Equal(t, "10571-7910404-1", res.OriginatorConversationID)
+	assert.Equal(t, "AG_20191219_00004e48cf7e3533f581", res.ConversationID)
+	assert.Equal(t, "NLJ41HAY6Q", res.TransactionID)
+	assert.Equal(t, Amount(1000), res.TransactionAmount)
+	assert.Equal(t, "NLJ41HAY6Q", res.TransactionReceipt)
+	assert.Equal(t, "254708374149 - John Doe", res.ReceiverPartyPublicName)
+	assert.True(t, res.B2CRecipientIsRegisteredCustomer)
+	assert.Equal(t, Amount(1011600), res.B2CUtilityAccountAvailableFunds)
+	assert.Equal(t, Amount(90000000), res.B2CWorkingAccountAvailableFunds)
+	assert.Equal(t, "-4510.00", res.B2CChargesPaidAccountAvailableFunds.String())
+	assert.True(t, time.Date(2019, time.December, 19, 8, 45, 50, 0, time.UTC).Equal(res.TransactionCompletedDateTime))
+
+	queueTimeoutURL, ok := res.Parameter("QueueTimeoutURL")
+	assert.True(t, ok)
+	assert.Equal(t, "https://internalsandbox.safaricom.co.ke/mpesa/b2cresults/v1/submit", queueTimeoutURL)
+
+	res, err = ParseB2CResult([]byte(b2cFailedResult))
+	require.NoError(t, err)
+	assert.Equal(t, ResultCodeInvalidInitiator, res.ResultCode)
+	assert.True(t, res.ResultCode.IsFailure())
+	assert.Zero(t, res.TransactionAmount)
+}
+
+func TestParseAccountBalanceResult(t *testing.T) {
+	res, err := ParseAccountBalanceResult([]byte(accountBalanceResult))
+	require.NoError(t, err)
+
+	assert.Equal(t, "16917-22577599-3", res.OriginatorConversationID)
+	assert.True(t, strings.HasPrefix(res.AccountBalance, "Working Account|KES|700000.00"))
+	require.Len(t, res.Balances, 5)
+	assert.Equal(t, AccountBalance{Name: "Working Account", Currency: "KES", Current: 70000000, Available: 70000000}, res.Balances[0])
+	assert.Equal(t, "-1540.00", res.Balances[3].Available.String())
+	assert.True(t, time.Date(2020, time.January, 9, 9, 57, 10, 0, time.UTC).Equal(res.BOCompletedTime))
+}
+
+func TestParseTransactionStatusResult(t *testing.T) {
+	res, err := ParseTransactionStatusResult([]byte(transactionStatusResult))
+	require.NoError(t, err)
+
+	assert.Equal(t, "OAK41HAY6Q", res.ReceiptNo)
+	assert.Equal(t, "Completed", res.TransactionStatus)
+	assert.Equal(t, Amount(1000), res.Amount)
+	assert.Equal(t, "600310 - Safaricom333", res.DebitPartyName)
+	assert.Equal(t, "254708374149 - John Doe", res.CreditPartyName)
+	assert.Equal(t, "Utility Account", res.DebitAccountType)
+	assert.Equal(t, "Business Payment to Customer via API", res.ReasonType)
+	assert.Empty(t, res.CreditPartyCharges)
+	assert.True(t, time.Date(2020, time.January, 20, 13, 48, 25, 0, time.UTC).Equal(res.InitiatedTime))
+	assert.True(t, res.InitiatedTime.Equal(res.FinalisedTime))
+}
+
+func TestParseReverseResult(t *testing.T) {
+	res, err := ParseReverseResult([]byte(reverseResult))
+	require.NoError(t, err)
+
+	assert.Equal(t, Amount(10000), res.Amount)
+	assert.Equal(t, "MJ551H6X5D", res.OriginalTransactionID)
+	assert.Equal(t, "Utility Account|KES|51661.00|51661.00|0.00|0.00", res.DebitAccountBalance)
+	assert.Zero(t, res.Charge)
+	assert.Equal(t, "254708374149 - John Doe", res.CreditPartyPublicName)
+	assert.Equal(t, "601315 - Safaricom1338", res.DebitPartyPublicName)
+	assert.True(t, time.Date(2018, time.October, 5, 12, 32, 25, 0, time.UTC).Equal(res.TransCompletedTime))
+}
+
+func TestParseBusinessPayBillResult(t *testing.T) {
+	res, err := ParseBusinessPayBillResult([]byte(businessPayBillResult))
+	require.NoError(t, err)
+
+	assert.Equal(t, ResultCodeSuccess, res.ResultCode)
+	assert.Equal(t, Amount(19000), res.Amount)
+	assert.Equal(t, "KES", res.Currency)
+	assert.Equal(t, "19008", res.BillReferenceNumber)
+	assert.Equal(t, "000000- Biller Companyname", res.ReceiverPartyPublicName)
+	assert.Equal(t, "Working Account|KES|346768.83|6186.83|340582.00|0.00", res.DebitPartyAffectedAccountBalance)
+	assert.True(t, time.Date(2022, time.November, 10, 8, 7, 17, 0, time.UTC).Equal(res.TransCompletedTime))
+}
+
+func TestParseB2CAccountTopUpResult(t *testing.T) {
+	res, err := ParseB2CAccountTopUpResult([]byte(businessPayBillResult))
+	require.NoError(t, err)
+
+	assert.Equal(t, ResultCodeSuccess, res.ResultCode)
+	assert.Equal(t, Amount(19000), res.Amount)
+	assert.Equal(t, "KES", res.Currency)
+	assert.Equal(t, "000000- Biller Companyname", res.ReceiverPartyPublicName)
+	assert.True(t, time.Date(2022, time.November, 10, 8, 7, 17, 0, time.UTC).Equal(res.TransCompletedTime))
+}
+
+func TestParseB2PochiResult(t *testing.T) {
+	res, err := ParseB2PochiResult([]byte(b2cResult))
+	require.NoError(t, err)
+
+	assert.Equal(t, Amount(1000), res.TransactionAmount)
+	assert.Equal(t, "NLJ41HAY6Q", res.TransactionReceipt)
+	assert.Equal(t, "254708374149 - John Doe", res.ReceiverPartyPublicName)
+	assert.Equal(t, Amount(1011600), res.B2CUtilityAccountAvailableFunds)
+	assert.True(t, time.Date(2019, time.December, 19, 8, 45, 50, 0, time.UTC).Equal(res.TransactionCompletedDateTime))
+}
+
+func TestParseRemitTaxResult(t *testing.T) {
+	res, err := ParseRemitTaxResult([]byte(businessPayBillResult))
+	require.NoError(t, err)
+
+	assert.Equal(t, Amount(19000), res.Amount)
+	assert.Equal(t, "KES", res.Currency)
+}
+
+func TestParseAsyncResult(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        string
+		expectedErr error
+	}{
+		{
+			name: "single result parameter",
+			data: `{"Result":{"ResultType":0,"ResultCode":0,"ConversationID":"AG_20191219_00004e48cf7e3533f581","ResultParameters":{"ResultParameter":{"Key":"Amount","Value":10}}}}`,
+		},
+		{
+			name:        "missing result",
+			data:        `{}`,
+			expectedErr: errInvalidCallback,
+		},
+		{
+			name:        "missing conversation ids",
+			data:        `{"Result":{"ResultCode":0}}`,
+			expectedErr: errInvalidCallback,
+		},
+		{
+			name:        "invalid result type",
+			data:        `{"Result":{"ResultType":"x","ConversationID":"AG_20191219_00004e48cf7e3533f581"}}`,
+			expectedErr: errInvalidCallback,
+		},
+		{
+			name:        "invalid result parameters",
+			data:        `{"Result":{"ConversationID":"AG_20191219_00004e48cf7e3533f581","ResultParameters":{"ResultParameter":"x"}}}`,
+			expectedErr: errInvalidCallback,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseAsyncResult([]byte(tc.data))
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+
+	_, err := ParseAccountBalanceResult([]byte(`{"Result":{"ConversationID":"AG_20200206_00005e091a8ec6b9eac5","ResultParameters":{"ResultParameter":{"Key":"AccountBalance","Value":"Working Account|KES"}}}}`))
+	assert.ErrorIs(t, err, errInvalidCallback)
+
+	_, err = ParseB2CResult([]byte(`{"Result":{"ConversationID":"AG_20191219_00004e48cf7e3533f581","ResultParameters":{"ResultParameter":{"Key":"TransactionAmount","Value":"ten"}}}}`))
+	assert.ErrorIs(t, err, errInvalidCallback)
+}
+
+func TestAsyncResultMatches(t *testing.T) {
+	res, err := ParseAsyncResult([]byte(b2cResult))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		resp     ValidResp
+		expected bool
+	}{
+		{
+			name:     "both ids",
+			resp:     ValidResp{OriginatorConversationID: "10571-7910404-1", ConversationID: "AG_20191219_00004e48cf7e3533f581"},
+			expected: true,
+		},
+		{
+			name:     "originator conversation id",
+			resp:     ValidResp{OriginatorConversationID: "10571-7910404-1"},
+			expected: true,
+		},
+		{
+			name:     "conversation id",
+			resp:     ValidResp{ConversationID: "AG_20191219_00004e48cf7e3533f581"},
+			expected: true,
+		},
+		{
+			name: "other request",
+			resp: ValidResp{OriginatorConversationID: "29112-34801843-1", ConversationID: "AG_20191219_00006c6fddb15123addf"},
+		},
+		{
+			name: "empty response",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, res.Matches(tc.resp))
+		})
+	}
+}
+
+func TestResultHandlers(t *testing.T) {
+	_, err := NewB2CResultHandlers(nil)
+	assert.ErrorIs(t, err, errMissingCallbackHandler)
+
+	testCases := []struct {
+		name           string
+		timeout        bool
+		body           string
+		handlerErr     error
+		expectedStatus int
+		expectedResp   CallbackResp
+		expectedCalls  int
+	}{
+		{
+			name:           "result",
+			body:           b2cResult,
+			expectedStatus: http.StatusOK,
+			expectedResp:   callbackAccepted,
+			expectedCalls:  1,
+		},
+		{
+			name:           "queue timeout",
+			timeout:        true,
+			body:           b2cResult,
+			expectedStatus: http.StatusOK,
+			expectedResp:   callbackAccepted,
+			expectedCalls:  1,
+		},
+		{
+			name:           "handler error",
+			body:           b2cResult,
+			handlerErr:     errors.New("handler error"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedResp:   callbackFailed,
+			expectedCalls:  1,
+		},
+		{
+			name:           "invalid payload",
+			body:           `{"Result":{}}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   callbackFailed,
+		},
+		{
+			name:           "invalid parameter",
+			body:           `{"Result":{"ConversationID":"AG_20191219_00004e48cf7e3533f581","ResultParameters":{"ResultParameter":{"Key":"TransactionAmount","Value":"ten"}}}}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   callbackFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int
+			handlers, err := NewB2CResultHandlers(func(_ context.Context, res B2CResult) error {
+				calls++
+				assert.Equal(t, tc.timeout, res.TimedOut)
+				assert.Equal(t, "10571-7910404-1", res.OriginatorConversationID)
+
+				return tc.handlerErr
+			})
+			require.NoError(t, err)
+
+			handler := handlers.Result
+			if tc.timeout {
+				handler = handlers.QueueTimeOut
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/result", strings.NewReader(tc.body)))
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Equal(t, tc.expectedCalls, calls)
+
+			var resp CallbackResp
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, tc.expectedResp, resp)
+		})
+	}
+}
+
+func TestOperationResultHandlers(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		handlers func(called *bool) (ResultHandlers, error)
+	}{
+		{
+			name: "business pay bill",
+			body: businessPayBillResult,
+			handlers: func(called *bool) (ResultHandlers, error) {
+				return NewBusinessPayBillResultHandlers(func(_ context.Context, res BusinessPayBillResult) error {
+					*called = res.BillReferenceNumber == "19008"
+
+					return nil
+				})
+			},
+		},
+		{
+			name: "remit tax",
+			body: businessPayBillResult,
+			handlers: func(called *bool) (ResultHandlers, error) {
+				return NewRemitTaxResultHandlers(func(_ context.Context, res RemitTaxResult) error {
+					*called = res.Amount == 19000
+
+					return nil
+				})
+			},
+		},
+		{
+			name: "reverse",
+			body: reverseResult,
+			handlers: func(called *bool) (ResultHandlers, error) {
+				return NewReverseResultHandlers(func(_ context.Context, res ReverseResult) error {
+					*called = res.OriginalTransactionID == "MJ551H6X5D"
+
+					return nil
+				})
+			},
+		},
+		{
+			name: "account balance",
+			body: accountBalanceResult,
+			handlers: func(called *bool) (ResultHandlers, error) {
+				return NewAccountBalanceResultHandlers(func(_ context.Context, res AccountBalanceResult) error {
+					*called = res.AccountBalance != ""
+
+					return nil
+				})
+			},
+		},
+		{
+			name: "transaction status",
+			body: transactionStatusResult,
+			handlers: func(called *bool) (ResultHandlers, error) {
+				return NewTransactionStatusResultHandlers(func(_ context.Context, res TransactionStatusResult) error {
+					*called = res.ReceiptNo == "OAK41HAY6Q"
+
+					return nil
+				})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var called bool
+			handlers, err := tc.handlers(&called)
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			handlers.Result.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/result", strings.NewReader(tc.body)))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.True(t, called)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultCodeClass(t *testing.T) {
+	testCases := []struct {
+		code        ResultCode
+		class       ResultClass
+		description string
+		success     bool
+		cancelled   bool
+		timeout     bool
+		retryable   bool
+		failure     bool
+	}{
+		{
+			code:        ResultCodeSuccess,
+			class:       ResultSuccess,
+			description: "The transaction was processed successfully",
+			success:     true,
+		},
+		{
+			code:        ResultCodeCancelledByUser,
+			class:       ResultCancelled,
+			description: "The request was cancelled by the user",
+			cancelled:   true,
+			failure:     true,
+		},
+		{
+			code:        ResultCodeSubscriberUnreachable,
+			class:       ResultTimeout,
+			description: "The user could not be reached",
+			timeout:     true,
+			failure:     true,
+		},
+		{
+			code:        ResultCodeTransactionExpired,
+			class:       ResultTimeout,
+			description: "The transaction has expired",
+			timeout:     true,
+			failure:     true,
+		},
+		{
+			code:        ResultCodeTransactionInProgress,
+			class:       ResultRetryable,
+			description: "A transaction is already in process for the subscriber",
+			retryable:   true,
+			failure:     true,
+		},
+		{
+			code:        ResultCodeInvalidInitiator,
+			class:       ResultFailed,
+			description: "The initiator information is invalid",
+			failure:     true,
+		},
+		{
+			code:        ResultCodeInsufficientFunds,
+			class:       ResultFailed,
+			description: "The balance is insufficient for the transaction",
+			failure:     true,
+		},
+		{
+			code:        "",
+			class:       ResultPending,
+			description: "No result is available yet",
+		},
+		{
+			code:        "4242",
+			class:       ResultFailed,
+			description: "Unknown result code 4242",
+			failure:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.class.String()+" "+tc.code.String(), func(t *testing.T) {
+			assert.Equal(t, tc.class, tc.code.Class())
+			assert.Equal(t, tc.description, tc.code.Description())
+			assert.Equal(t, tc.success, tc.code.IsSuccess(), "IsSuccess")
+			assert.Equal(t, tc.cancelled, tc.code.IsCancelled(), "IsCancelled")
+			assert.Equal(t, tc.timeout, tc.code.IsTimeout(), "IsTimeout")
+			assert.Equal(t, tc.retryable, tc.code.IsRetryable(), "IsRetryable")
+			assert.Equal(t, tc.failure, tc.code.IsFailure(), "IsFailure")
+		})
+	}
+}
+
+func TestResultCodeUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        string
+		expected    ResultCode
+		expectedErr error
+	}{
+		{
+			name:     "query response",
+			data:     `{"ResponseCode":"0","ResultCode":"1032","ResultDesc":"Request cancelled by user"}`,
+			expected: ResultCodeCancelledByUser,
+		},
+		{
+			name:     "callback",
+			data:     `{"ResultCode":1037,"ResultDesc":"DS timeout user cannot be reached"}`,
+			expected: ResultCodeSubscriberUnreachable,
+		},
+		{
+			name:     "successful callback",
+			data:     `{"ResultCode":0,"ResultDesc":"The service request is processed successfully."}`,
+			expected: ResultCodeSuccess,
+		},
+		{
+			name:     "missing result code",
+			data:     `{"ResponseCode":"0"}`,
+			expected: "",
+		},
+		{
+			name:     "null result code",
+			data:     `{"ResultCode":null}`,
+			expected: "",
+		},
+		{
+			name:        "fractional result code",
+			data:        `{"ResultCode":1.5}`,
+			expectedErr: errInvalidResultCode,
+		},
+		{
+			name:        "boolean result code",
+			data:        `{"ResultCode":true}`,
+			expectedErr: errInvalidResultCode,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var resp ExpressQueryResp
+			err := json.Unmarshal([]byte(tc.data), &resp)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr == nil {
+				assert.Equal(t, tc.expected, resp.ResultCode)
+			}
+		})
+	}
+}
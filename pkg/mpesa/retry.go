@@ -0,0 +1,210 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptrace"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultBaseDelay  = 500 * time.Millisecond
+	defaultMaxDelay   = 10 * time.Second
+)
+
+// errInvalidRetryPolicy indicates that the retry policy is invalid.
+var errInvalidRetryPolicy = errors.New("invalid retry policy")
+
+// idempotentOperations can be sent again after any transient failure since
+// repeating them does not move money.
+var idempotentOperations = map[Operation]bool{
+	OpToken:             true,
+	OpExpressQuery:      true,
+	OpTransactionStatus: true,
+}
+
+// retryableStatusCodes are the transient Daraja responses, such as spike
+// arrest and gateway timeouts, that are retried for idempotent operations.
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:     true,
+	http.StatusInternalServerError: true,
+	http.StatusBadGateway:          true,
+	http.StatusServiceUnavailable:  true,
+	http.StatusGatewayTimeout:      true,
+}
+
+// RetryPolicy configures how failed requests are retried.
+//
+// Token, ExpressQuery and TransactionStatus are retried on network errors and
+// on 429, 500, 502, 503 and 504 responses. Every other operation, including
+// all money-moving ones, is only retried when the request was never written
+// to the connection, for example because the connection could not be
+// established. A request that may have reached Daraja is never sent again.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt.
+	MaxRetries int
+
+	// BaseDelay is the backoff before the first retry. It doubles on every
+	// retry up to MaxDelay, and the actual delay is picked at random between
+	// zero and that value.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the backoff between retries.
+	MaxDelay time.Duration
+
+	// OnRetry, if set, is called before every retry with the operation, the
+	// retry number starting at one and the failure that caused it.
+	OnRetry func(op Operation, retry int, err error)
+}
+
+// DefaultRetryPolicy returns a policy with 3 retries and a backoff starting at
+// 500 milliseconds capped at 10 seconds.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: defaultMaxRetries,
+		BaseDelay:  defaultBaseDelay,
+		MaxDelay:   defaultMaxDelay,
+	}
+}
+
+// WithRetry enables retries with the given policy. Retries are disabled by default.
+func WithRetry(policy RetryPolicy) Option {
+	return withSDK(func(sdk *mSDK) error {
+		if policy.MaxRetries < 0 || policy.BaseDelay < 0 || policy.MaxDelay < 0 {
+			return errInvalidRetryPolicy
+		}
+		if policy.BaseDelay == 0 {
+			policy.BaseDelay = defaultBaseDelay
+		}
+		if policy.MaxDelay == 0 {
+			policy.MaxDelay = defaultMaxDelay
+		}
+		if policy.MaxDelay < policy.BaseDelay {
+			policy.MaxDelay = policy.BaseDelay
+		}
+		sdk.retry = &policy
+
+		return nil
+	})
+}
+
+// RetryError is returned when an operation still fails after being retried.
+type RetryError struct {
+	Op      Operation
+	Retries int // The number of retries after the first attempt.
+	Err     error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("%s failed after %d retries: %v", e.Op, e.Retries, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// Retries returns the number of times the operation that returned err was
+// retried, or zero if it was not.
+func Retries(err error) int {
+	var retryErr *RetryError
+	if errors.As(err, &retryErr) {
+		return retryErr.Retries
+	}
+
+	return 0
+}
+
+// withRetries attaches the retry count to err.
+func withRetries(op Operation, retries int, err error) error {
+	if err == nil || retries == 0 {
+		return err
+	}
+
+	return &RetryError{Op: op, Retries: retries, Err: err}
+}
+
+// shouldRetry reports whether an attempt of the operation can be sent again.
+// sent reports whether the request was completely written to the connection.
+func (policy RetryPolicy) shouldRetry(op Operation, statusCode int, err error, sent bool) bool {
+	if !idempotentOperations[op] {
+		return err != nil && !sent
+	}
+
+	return err != nil || retryableStatusCodes[statusCode]
+}
+
+// backoff returns the delay before the given retry using capped exponential
+// backoff with full jitter.
+func (policy RetryPolicy) backoff(retry int) time.Duration {
+	delay := policy.MaxDelay
+	if shift := retry - 1; shift < 32 {
+		if d := policy.BaseDelay << shift; d > 0 && d < delay {
+			delay = d
+		}
+	}
+
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+// doWithRetry sends the request, retrying it according to the retry policy.
+// It returns the number of retries along with the outcome of the last attempt.
+func (sdk mSDK) doWithRetry(op Operation, req *http.Request, token string) (int, []byte, int, error) {
+	if sdk.retry == nil {
+		statusCode, body, err := sdk.do(req, token)
+
+		return statusCode, body, 0, err
+	}
+
+	ctx := req.Context()
+	for retries := 0; ; retries++ {
+		var sent atomic.Bool
+		trace := &httptrace.ClientTrace{
+			WroteRequest: func(info httptrace.WroteRequestInfo) {
+				if info.Err == nil {
+					sent.Store(true)
+				}
+			},
+		}
+
+		statusCode, body, err := sdk.do(req.WithContext(httptrace.WithClientTrace(ctx, trace)), token)
+
+		canRewind := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+		if retries >= sdk.retry.MaxRetries || ctx.Err() != nil || !canRewind ||
+			!sdk.retry.shouldRetry(op, statusCode, err, sent.Load()) {
+			return statusCode, body, retries, err
+		}
+
+		cause := err
+		if cause == nil {
+			cause = fmt.Errorf("unexpected status code %d", statusCode)
+		}
+		if sdk.retry.OnRetry != nil {
+			sdk.retry.OnRetry(op, retries+1, cause)
+		}
+
+		timer := time.NewTimer(sdk.retry.backoff(retries + 1))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return 0, nil, retries, errors.Join(errFailedToSendReq, cause, ctx.Err())
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return 0, nil, retries, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+		}
+	}
+}
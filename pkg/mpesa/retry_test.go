@@ -0,0 +1,317 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testB2CPaymentReq = B2CPaymentReq{
+	OriginatorConversationID: "feb5e3f2-fbbc-4745-844c-ee37b546f627",
+	InitiatorName:            initiatorName,
+	SecurityCredential:       "pre-encrypted-credential",
+	CommandID:                "BusinessPayment",
+	Amount:                   10,
+	PartyA:                   600986,
+	PartyB:                   254712345678,
+	Remarks:                  "test",
+	QueueTimeOutURL:          "https://example.com/timeout",
+	ResultURL:                "https://example.com/result",
+	Occasion:                 "test",
+}
+
+func testRetryPolicy(maxRetries int, retries *atomic.Int64) RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: maxRetries,
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   2 * time.Millisecond,
+		OnRetry: func(Operation, int, error) {
+			retries.Add(1)
+		},
+	}
+}
+
+// newRetrySDK returns an sdk with a cached token so that only the operation
+// itself reaches the server.
+func newRetrySDK(t *testing.T, baseURL string, client *http.Client, policy *RetryPolicy) mSDK {
+	t.Helper()
+
+	store := NewMemoryTokenStore()
+	require.NoError(t, store.Save(context.Background(), CachedToken{AccessToken: accessToken, ExpiresAt: time.Now().Add(time.Hour)}))
+
+	return mSDK{
+		baseURL: baseURL,
+		client:  client,
+		tokens:  newTokenCache(store, 0),
+		retry:   policy,
+	}
+}
+
+// flakyServer fails the first failures requests with the given status code.
+func flakyServer(t *testing.T, failures int64, statusCode int, response interface{}) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if requests.Add(1) <= failures {
+			w.WriteHeader(statusCode)
+			_ = json.NewEncoder(w).Encode(RespError{Code: "500.003.02", Message: "Spike arrest violation"})
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	for retry := 1; retry <= 64; retry++ {
+		limit := policy.MaxDelay
+		if retry < 5 {
+			limit = policy.BaseDelay << (retry - 1)
+		}
+
+		for i := 0; i < 20; i++ {
+			delay := policy.backoff(retry)
+			assert.GreaterOrEqual(t, delay, time.Duration(0))
+			assert.LessOrEqual(t, delay, limit, "retry %d", retry)
+		}
+	}
+}
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	errNetwork := errors.New("connection reset by peer")
+
+	testCases := []struct {
+		name       string
+		op         Operation
+		statusCode int
+		err        error
+		sent       bool
+		expected   bool
+	}{
+		{name: "query on service unavailable", op: OpExpressQuery, statusCode: http.StatusServiceUnavailable, expected: true},
+		{name: "query on spike arrest", op: OpExpressQuery, statusCode: http.StatusTooManyRequests, expected: true},
+		{name: "query on gateway timeout", op: OpExpressQuery, statusCode: http.StatusGatewayTimeout, expected: true},
+		{name: "query on bad request", op: OpExpressQuery, statusCode: http.StatusBadRequest, expected: false},
+		{name: "query on success", op: OpExpressQuery, statusCode: http.StatusOK, expected: false},
+		{name: "status on network error after send", op: OpTransactionStatus, err: errNetwork, sent: true, expected: true},
+		{name: "token on internal server error", op: OpToken, statusCode: http.StatusInternalServerError, expected: true},
+		{name: "payment on service unavailable", op: OpB2CPayment, statusCode: http.StatusServiceUnavailable, expected: false},
+		{name: "payment on spike arrest", op: OpB2CPayment, statusCode: http.StatusTooManyRequests, expected: false},
+		{name: "payment on network error after send", op: OpB2CPayment, err: errNetwork, sent: true, expected: false},
+		{name: "payment on network error before send", op: OpB2CPayment, err: errNetwork, sent: false, expected: true},
+		{name: "stk push on network error after send", op: OpExpressSimulate, err: errNetwork, sent: true, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := DefaultRetryPolicy().shouldRetry(tc.op, tc.statusCode, tc.err, tc.sent)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestRetryIdempotentOperation(t *testing.T) {
+	server, requests := flakyServer(t, 2, http.StatusServiceUnavailable, ExpressQueryResp{ResponseCode: "0"})
+
+	var retries atomic.Int64
+	policy := testRetryPolicy(3, &retries)
+	sdk := newRetrySDK(t, server.URL, server.Client(), &policy)
+
+	resp, err := sdk.ExpressQuery(context.Background(), testExpressQueryReq)
+	require.NoError(t, err)
+	assert.Equal(t, "0", resp.ResponseCode)
+	assert.Equal(t, int64(3), requests.Load())
+	assert.Equal(t, int64(2), retries.Load())
+}
+
+func TestRetryExhausted(t *testing.T) {
+	server, requests := flakyServer(t, 10, http.StatusTooManyRequests, ExpressQueryResp{ResponseCode: "0"})
+
+	var retries atomic.Int64
+	policy := testRetryPolicy(2, &retries)
+	sdk := newRetrySDK(t, server.URL, server.Client(), &policy)
+
+	_, err := sdk.ExpressQuery(context.Background(), testExpressQueryReq)
+	assert.ErrorIs(t, err, errFailedToSendReq)
+	assert.Equal(t, 2, Retries(err))
+	assert.Equal(t, int64(3), requests.Load())
+
+	var respErr RespError
+	assert.True(t, errors.As(err, &respErr))
+	assert.Equal(t, "500.003.02", respErr.Code)
+}
+
+func TestRetryDisabledByDefault(t *testing.T) {
+	server, requests := flakyServer(t, 1, http.StatusServiceUnavailable, ExpressQueryResp{ResponseCode: "0"})
+
+	sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+
+	_, err := sdk.ExpressQuery(context.Background(), testExpressQueryReq)
+	assert.ErrorIs(t, err, errFailedToSendReq)
+	assert.Equal(t, 0, Retries(err))
+	assert.Equal(t, int64(1), requests.Load())
+}
+
+func TestRetryToken(t *testing.T) {
+	server, requests := flakyServer(t, 1, http.StatusBadGateway, TokenResp{AccessToken: accessToken, Expiry: "3599"})
+
+	var retries atomic.Int64
+	policy := testRetryPolicy(3, &retries)
+	sdk := mSDK{baseURL: server.URL, client: server.Client(), retry: &policy}
+
+	resp, err := sdk.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, accessToken, resp.AccessToken)
+	assert.Equal(t, int64(2), requests.Load())
+}
+
+func TestRetryMoneyMovingOperation(t *testing.T) {
+	t.Run("not retried on server error", func(t *testing.T) {
+		server, requests := flakyServer(t, 1, http.StatusServiceUnavailable, B2CPaymentResp{ValidResp: ValidResp{ResponseCode: "0"}})
+
+		var retries atomic.Int64
+		policy := testRetryPolicy(3, &retries)
+		sdk := newRetrySDK(t, server.URL, server.Client(), &policy)
+
+		_, err := sdk.B2CPayment(context.Background(), testB2CPaymentReq)
+		assert.ErrorIs(t, err, errFailedToSendReq)
+		assert.Equal(t, int64(1), requests.Load())
+		assert.Equal(t, int64(0), retries.Load())
+	})
+
+	t.Run("not retried when the connection drops after sending", func(t *testing.T) {
+		var requests atomic.Int64
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			_, _ = io.Copy(io.Discard, r.Body)
+
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Errorf("failed to hijack connection: %v", err)
+
+				return
+			}
+			conn.Close()
+		}))
+		defer server.Close()
+
+		var retries atomic.Int64
+		policy := testRetryPolicy(3, &retries)
+		sdk := newRetrySDK(t, server.URL, server.Client(), &policy)
+
+		_, err := sdk.B2CPayment(context.Background(), testB2CPaymentReq)
+		assert.ErrorIs(t, err, errFailedToSendReq)
+		assert.Equal(t, int64(1), requests.Load())
+		assert.Equal(t, int64(0), retries.Load())
+	})
+
+	t.Run("retried when the connection cannot be established", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		baseURL := server.URL
+		server.Close()
+
+		var retries atomic.Int64
+		policy := testRetryPolicy(2, &retries)
+		sdk := newRetrySDK(t, baseURL, http.DefaultClient, &policy)
+
+		_, err := sdk.B2CPayment(context.Background(), testB2CPaymentReq)
+		assert.ErrorIs(t, err, errFailedToSendReq)
+		assert.Equal(t, 2, Retries(err))
+		assert.Equal(t, int64(2), retries.Load())
+	})
+}
+
+func TestRetryContextCancelled(t *testing.T) {
+	server, requests := flakyServer(t, 10, http.StatusServiceUnavailable, ExpressQueryResp{ResponseCode: "0"})
+
+	policy := RetryPolicy{MaxRetries: 5, BaseDelay: time.Hour, MaxDelay: time.Hour}
+	sdk := newRetrySDK(t, server.URL, server.Client(), &policy)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Full jitter may pick a short delay, so allow a few quick attempts.
+	_, err := sdk.ExpressQuery(ctx, testExpressQueryReq)
+	assert.Error(t, err)
+	assert.LessOrEqual(t, requests.Load(), int64(6))
+}
+
+func TestWithRetry(t *testing.T) {
+	testCases := []struct {
+		name        string
+		policy      RetryPolicy
+		expected    RetryPolicy
+		expectedErr error
+	}{
+		{
+			name:     "default policy",
+			policy:   DefaultRetryPolicy(),
+			expected: DefaultRetryPolicy(),
+		},
+		{
+			name:     "zero delays use defaults",
+			policy:   RetryPolicy{MaxRetries: 1},
+			expected: RetryPolicy{MaxRetries: 1, BaseDelay: defaultBaseDelay, MaxDelay: defaultMaxDelay},
+		},
+		{
+			name:     "max delay below base delay",
+			policy:   RetryPolicy{MaxRetries: 1, BaseDelay: time.Second, MaxDelay: time.Millisecond},
+			expected: RetryPolicy{MaxRetries: 1, BaseDelay: time.Second, MaxDelay: time.Second},
+		},
+		{
+			name:        "negative retries",
+			policy:      RetryPolicy{MaxRetries: -1},
+			expectedErr: errInvalidRetryPolicy,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sdk, err := NewSDK(Config{Environment: Sandbox, AppKey: appKey, AppSecret: appSecret}, WithRetry(tc.policy))
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			msdk, ok := sdk.(*mSDK)
+			require.True(t, ok)
+			require.NotNil(t, msdk.retry)
+			assert.Equal(t, tc.expected.MaxRetries, msdk.retry.MaxRetries)
+			assert.Equal(t, tc.expected.BaseDelay, msdk.retry.BaseDelay)
+			assert.Equal(t, tc.expected.MaxDelay, msdk.retry.MaxDelay)
+		})
+	}
+}
+
+func TestRetryErrorMessage(t *testing.T) {
+	err := withRetries(OpExpressQuery, 2, errFailedToSendReq)
+	assert.True(t, strings.HasPrefix(err.Error(), "ExpressQuery failed after 2 retries"))
+	assert.ErrorIs(t, err, errFailedToSendReq)
+	assert.NoError(t, withRetries(OpExpressQuery, 2, nil))
+	assert.Equal(t, errFailedToSendReq, withRetries(OpExpressQuery, 0, errFailedToSendReq))
+}
@@ -8,20 +8,25 @@ package mpesa
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 )
 
-func (sdk mSDK) Reverse(rReq ReverseReq) (ReverseResp, error) {
+func (sdk mSDK) Reverse(ctx context.Context, rReq ReverseReq) (ReverseResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpReverse)
+	defer cancel()
+
 	if err := rReq.Validate(); err != nil {
 		return ReverseResp{}, err
 	}
 
-	var err error
-	rReq.SecurityCredential, err = sdk.generateSecurityCredential(rReq.InitiatorPassword)
-	if err != nil {
-		return ReverseResp{}, err
+	if rReq.SecurityCredential == "" {
+		var err error
+		rReq.SecurityCredential, err = sdk.generateSecurityCredential(ctx, rReq.InitiatorPassword)
+		if err != nil {
+			return ReverseResp{}, err
+		}
 	}
 
 	data, err := json.Marshal(rReq)
@@ -29,14 +34,14 @@ func (sdk mSDK) Reverse(rReq ReverseReq) (ReverseResp, error) {
 		return ReverseResp{}, err
 	}
 
-	url := fmt.Sprintf("%s/%s", sdk.baseURL, reversalEndpoint)
+	url := sdk.url(OpReverse)
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
 	if err != nil {
 		return ReverseResp{}, err
 	}
 
-	resp, err := sdk.sendRequest(req)
+	resp, err := sdk.sendRequest(OpReverse, req)
 	if err != nil {
 		return ReverseResp{}, err
 	}
@@ -46,5 +51,9 @@ func (sdk mSDK) Reverse(rReq ReverseReq) (ReverseResp, error) {
 		return ReverseResp{}, err
 	}
 
+	if err := sdk.checkResponseCode(OpReverse, rr.ResponseCode, rr.ResponseDescription, resp); err != nil {
+		return ReverseResp{}, err
+	}
+
 	return rr, nil
 }
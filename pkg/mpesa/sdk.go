@@ -7,18 +7,12 @@
 package mpesa
 
 import (
-	"crypto/rand"
-	"crypto/rsa"
-	"crypto/tls"
-	"crypto/x509"
+	"context"
 	"encoding/base64"
-	"encoding/json"
-	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
 	"time"
 )
 
@@ -27,9 +21,12 @@ const (
 
 	authEndpoint            = "oauth/v1/generate?grant_type=client_credentials"
 	b2cEndpoint             = "mpesa/b2c/v1/paymentrequest"
+	b2cV3Endpoint           = "mpesa/b2c/v3/paymentrequest"
 	accbalanceEndpoint      = "mpesa/accountbalance/v1/query"
 	c2bRegisterURLEndpoint  = "mpesa/c2b/v1/registerurl"
 	c2bSimulateEndpoint     = "mpesa/c2b/v1/simulate"
+	c2bRegisterURLV2        = "mpesa/c2b/v2/registerurl"
+	c2bSimulateV2           = "mpesa/c2b/v2/simulate"
 	qrCodeEndpoint          = "mpesa/qrcode/v1/generate"
 	expressSimulateEndpoint = "mpesa/stkpush/v1/processrequest"
 	queryEndpoint           = "mpesa/stkpushquery/v1/query"
@@ -39,10 +36,26 @@ const (
 	b2bEndpoint             = "mpesa/b2b/v1/paymentrequest"
 	prodCertificate         = "https://developer.safaricom.co.ke/api/v1/GenerateSecurityCredential/ProductionCertificate.cer"
 	sandboxCertificate      = "https://developer.safaricom.co.ke/api/v1/GenerateSecurityCredential/SandboxCertificate.cer"
+
+	billManagerOptInEndpoint         = "v1/billmanager-invoice/optin"
+	billManagerUpdateOptInEndpoint   = "v1/billmanager-invoice/change-optin-details"
+	billManagerSingleInvoiceEndpoint = "v1/billmanager-invoice/single-invoicing"
+	billManagerBulkInvoiceEndpoint   = "v1/billmanager-invoice/bulk-invoicing"
+	billManagerCancelInvoiceEndpoint = "v1/billmanager-invoice/cancel-bulk-invoices"
+	billManagerReconcileEndpoint     = "v1/billmanager-invoice/reconciliation"
+
+	pullTransactionsRegisterEndpoint = "pulltransactions/v1/register"
+	pullTransactionsQueryEndpoint    = "pulltransactions/v1/query"
+
+	standingOrderEndpoint = "standingorder/v1/createStandingOrderExternal"
+
+	b2bExpressCheckoutEndpoint = "v1/ussdpush/get-msisdn"
+
+	simSwapEndpoint = "imsi/v1/checkATI"
 )
 
 var (
-	errInvalidBaseURL   = errors.New("invalid base url, must be either https://api.safaricom.co.ke or https://sandbox.safaricom.co.ke")
+	errInvalidBaseURL   = errors.New("invalid base url, must be either https://api.safaricom.co.ke or https://sandbox.safaricom.co.ke unless the environment is custom")
 	errMissingBaseURL   = errors.New("missing base url")
 	errMissingAppKey    = errors.New("missing app key")
 	errMissingAppSecret = errors.New("missing app secret")
@@ -53,21 +66,26 @@ var _ SDK = (*mSDK)(nil)
 
 // SDK contains MpesaOverlay interface API.
 //
+// Every method takes a context which is attached to the outbound Daraja
+// requests, so cancelling the context or exceeding its deadline aborts the call.
+//
 //go:generate mockery --name SDK
 type SDK interface {
-	// GetToken gives you a time bound access token to call allowed APIs.
+	// Token gives you a time bound access token to call allowed APIs.
+	// It always requests a new token; the other methods reuse a cached token
+	// and only refresh it ahead of its expiry.
 	//
 	// Documentation: https://developer.safaricom.co.ke/APIs/Authorization
 	//
 	// Example:
-	// 	token, err := mp.GetToken()
+	// 	token, err := mp.Token(ctx)
 	// 	if err != nil {
 	// 		log.Fatal(err)
 	// 	}
 	// 	log.Printf("Token: %+v\n", token)
 	// Output:
 	// 	2023/09/06 22:43:21 Token: {AccessToken:unU9joKpPqIsZ1jFiDmQoNJ1cIvK Expiry:3599}
-	Token() (TokenResp, error)
+	Token(ctx context.Context) (TokenResp, error)
 
 	// ExpressQuery check the status of a Lipa Na M-Pesa Online Payment.
 	//
@@ -80,7 +98,7 @@ type SDK interface {
 	// 		CheckoutRequestID: "ws_CO_07092023195244460712345678",
 	// 	}
 	//
-	// 	resp, err := mp.ExpressQuery(eqReq)
+	// 	resp, err := mp.ExpressQuery(ctx, eqReq)
 	// 	if err != nil {
 	// 		log.Fatal(err)
 	// 	}
@@ -88,7 +106,7 @@ type SDK interface {
 	// 	log.Printf("Resp: %+v\n", resp)
 	// Output:
 	//  2023/09/07 20:08:05 Resp: {ResponseDescription:The service request has been accepted successsfully ResponseCode:0 MerchantRequestID:92643-47073138-2 CheckoutRequestID:ws_CO_07092023195244460712345678 CustomerMessage: ResultCode:1032 ResultDesc:Request cancelled by user}
-	ExpressQuery(eqReq ExpressQueryReq) (ExpressQueryResp, error)
+	ExpressQuery(ctx context.Context, eqReq ExpressQueryReq) (ExpressQueryResp, error)
 
 	// ExpressSimulate initiates online payment on behalf of a customer.
 	//
@@ -108,7 +126,7 @@ type SDK interface {
 	// 		TransactionDesc:   "Payment of X",
 	// 	}
 	//
-	// 	resp, err := mp.ExpressSimulate(qrReq)
+	// 	resp, err := mp.ExpressSimulate(ctx, qrReq)
 	// 	if err != nil {
 	// 		log.Fatal(err)
 	// 	}
@@ -116,10 +134,14 @@ type SDK interface {
 	// 	log.Printf("Resp: %+v\n", resp)
 	// Output:
 	//  2023/09/07 00:39:08 Resp: {ResponseDescription:Success. Request accepted for processing ResponseCode:0 MerchantRequestID:27260-79456854-2 CheckoutRequestID:ws_CO_07092023004130971712345678 CustomerMessage:Success. Request accepted for processing}
-	ExpressSimulate(eReq ExpressSimulateReq) (ExpressSimulateResp, error)
+	ExpressSimulate(ctx context.Context, eReq ExpressSimulateReq) (ExpressSimulateResp, error)
 
 	// B2CPayment Transact between an M-Pesa short code to a phone number registered on M-Pesa
 	//
+	// With Config.B2CVersion set to B2CV3 the payment is sent to the v3 endpoint,
+	// which deduplicates by OriginatorConversationID and returns it in the
+	// response. TransactionStatus can then look the payment up by that ID.
+	//
 	// Documentation: https://developer.safaricom.co.ke/APIs/BusinessToCustomer
 	//
 	// Example:
@@ -137,7 +159,7 @@ type SDK interface {
 	// 		Occasion:                 "Test",
 	// 	}
 	//
-	// 	resp, err := mp.B2CPayment(b2cReq)
+	// 	resp, err := mp.B2CPayment(ctx, b2cReq)
 	// 	if err != nil {
 	// 		log.Fatal(err)
 	// 	}
@@ -145,7 +167,7 @@ type SDK interface {
 	// 	log.Printf("Resp: %+v\n", resp)
 	// Output:
 	//  2023/09/07 21:12:26 Resp: {ValidResp:{OriginatorConversationID: ConversationID:AG_20230907_2010325b025970fde878 ResponseDescription:Accept the service request successfully. ResponseCode:0}}
-	B2CPayment(b2cReq B2CPaymentReq) (B2CPaymentResp, error)
+	B2CPayment(ctx context.Context, b2cReq B2CPaymentReq) (B2CPaymentResp, error)
 
 	// AccountBalance Enquire the balance on an M-Pesa BuyGoods (Till Number)
 	//
@@ -163,7 +185,7 @@ type SDK interface {
 	// 		Remarks:           "test",
 	// 	}
 	//
-	// 	resp, err := mp.AccountBalance(balReq)
+	// 	resp, err := mp.AccountBalance(ctx, balReq)
 	// 	if err != nil {
 	// 		log.Fatal(err)
 	// 	}
@@ -171,10 +193,14 @@ type SDK interface {
 	// 	log.Printf("Resp: %+v\n", resp)
 	// Output:
 	//  2023/09/07 22:05:44 Resp: {ValidResp:{OriginatorConversationID: ConversationID:AG_20230907_201045e9b4e4f9bcb4d6 ResponseDescription:Accept the service request successfully. ResponseCode:0}}
-	AccountBalance(abReq AccountBalanceReq) (AccountBalanceResp, error)
+	AccountBalance(ctx context.Context, abReq AccountBalanceReq) (AccountBalanceResp, error)
 
 	// C2BRegisterURL register validation and confirmation URLs on M-Pesa
 	//
+	// The URLs are registered on the v2 endpoint when Config.C2BVersions sets
+	// the shortcode to C2BV2. The callbacks of such a shortcode carry a masked
+	// or hashed MSISDN, which MatchMSISDN compares to a known phone number.
+	//
 	// Documentation: https://developer.safaricom.co.ke/APIs/CustomerToBusinessRegisterURL
 	//
 	// Example:
@@ -185,7 +211,7 @@ type SDK interface {
 	// 		ValidationURL: "https://69a2-105-163-2-116.ngrok.io",
 	// 	}
 	//
-	// 	resp, err := mp.C2BRegisterURL(c2bReq)
+	// 	resp, err := mp.C2BRegisterURL(ctx, c2bReq)
 	// 	if err != nil {
 	// 		log.Fatal(err)
 	// 	}
@@ -193,10 +219,13 @@ type SDK interface {
 	// 	log.Printf("Resp: %+v\n", resp)
 	// Output:
 	//  2023/09/07 20:23:39 Resp: {ValidResp:{OriginatorConversationID:29607-261203248-2 ConversationID: ResponseDescription:Success ResponseCode:0}}
-	C2BRegisterURL(c2bReq C2BRegisterURLReq) (C2BRegisterURLResp, error)
+	C2BRegisterURL(ctx context.Context, c2bReq C2BRegisterURLReq) (C2BRegisterURLResp, error)
 
 	// C2BSimulate Make payment requests from Client to Business (C2B)
 	//
+	// The payment is simulated on the v2 endpoint when Config.C2BVersions sets
+	// the shortcode to C2BV2.
+	//
 	// Documentation: https://developer.safaricom.co.ke/APIs/CustomerToBusinessRegisterURL
 	//
 	// Example:
@@ -208,7 +237,7 @@ type SDK interface {
 	// 		BillRefNumber: "",
 	// 	}
 	//
-	// 	resp, err := mp.C2BSimulate(c2bReq)
+	// 	resp, err := mp.C2BSimulate(ctx, c2bReq)
 	// 	if err != nil {
 	// 		log.Fatal(err)
 	// 	}
@@ -216,10 +245,13 @@ type SDK interface {
 	// 	log.Printf("Resp: %+v\n", resp)
 	// Output:
 	//  2023/09/07 20:33:56 Resp: {ValidResp:{OriginatorConversationID:92647-47234949-2 ConversationID: ResponseDescription:Accept the service request successfully. ResponseCode:0}}
-	C2BSimulate(c2bReq C2BSimulateReq) (C2BSimulateResp, error)
+	C2BSimulate(ctx context.Context, c2bReq C2BSimulateReq) (C2BSimulateResp, error)
 
 	// GenerateQR generates a dynamic M-PESA QR Code.
 	//
+	// NewQRCode builds the same kind of QR code locally, without calling
+	// Daraja, and renders it as PNG or SVG.
+	//
 	// Documentation: https://developer.safaricom.co.ke/APIs/DynamicQRCode
 	//
 	// Example:
@@ -231,14 +263,14 @@ type SDK interface {
 	// 		CPI:          "174379",
 	// 		Size:         "300",
 	// 	}
-	// 	qrcode, err := mp.GenerateQR(qrReq)
+	// 	qrcode, err := mp.GenerateQR(ctx, qrReq)
 	// 	if err != nil {
 	// 		log.Fatal(err)
 	// 	}
 	// 	log.Printf("QR Code: %+v\n", qrcode)
 	// Output:
 	//  2023/09/06 23:22:51 QR Code: {ResponseDescription:The service request is processed successfully. ResponseCode:00 RequestID: QRCode:...}
-	GenerateQR(qReq GenerateQRReq) (GenerateQRResp, error)
+	GenerateQR(ctx context.Context, qReq GenerateQRReq) (GenerateQRResp, error)
 
 	// Reverse Reverses an M-Pesa transaction.
 	//
@@ -259,7 +291,7 @@ type SDK interface {
 	// 		Occasion:               "test",
 	// 	}
 	//
-	// 	resp, err := mp.Reverse(rReq)
+	// 	resp, err := mp.Reverse(ctx, rReq)
 	// 	if err != nil {
 	// 		log.Fatal(err)
 	// 	}
@@ -267,7 +299,7 @@ type SDK interface {
 	// 	log.Printf("Resp: %+v\n", resp)
 	// Output:
 	//  2023/09/07 22:11:13 Resp: {ValidResp:{OriginatorConversationID: ConversationID:AG_20230907_20106204c62f8f1a3f21 ResponseDescription:Accept the service request successfully. ResponseCode:0}}
-	Reverse(rReq ReverseReq) (ReverseResp, error)
+	Reverse(ctx context.Context, rReq ReverseReq) (ReverseResp, error)
 
 	// TransactionStatus check the status of a transaction
 	//
@@ -287,7 +319,7 @@ type SDK interface {
 	// 		Occasion:          "test",
 	// 	}
 	//
-	// 	resp, err := mp.TransactionStatus(tReq)
+	// 	resp, err := mp.TransactionStatus(ctx, tReq)
 	// 	if err != nil {
 	// 		log.Fatal(err)
 	// 	}
@@ -295,7 +327,7 @@ type SDK interface {
 	// 	log.Printf("Resp: %+v\n", resp)
 	// Output:
 	//  2023/09/07 21:56:50 Resp: {ValidResp:{OriginatorConversationID: ConversationID:AG_20230907_20102e33b7103b4f7b0e ResponseDescription:Accept the service request successfully. ResponseCode:0}}
-	TransactionStatus(tReq TransactionStatusReq) (TransactionStatusResp, error)
+	TransactionStatus(ctx context.Context, tReq TransactionStatusReq) (TransactionStatusResp, error)
 
 	// RemitTax enables businesses to remit tax to Kenya Revenue Authority (KRA).
 	//
@@ -317,7 +349,7 @@ type SDK interface {
 	// 		Remarks:                "test",
 	// 	}
 	//
-	// 	resp, err := mp.RemitTax(taxReq)
+	// 	resp, err := mp.RemitTax(ctx, taxReq)
 	// 	if err != nil {
 	// 		log.Fatal(err)
 	// 	}
@@ -325,9 +357,20 @@ type SDK interface {
 	// 	log.Printf("Resp: %+v\n", resp)
 	// Output:
 	//  2023/09/07 22:30:00 Resp: {ValidResp:{OriginatorConversationID: ConversationID:AG_20230907_201001484b176c67b3fb ResponseDescription:Accept the service request successfully. ResponseCode:0}}
-	RemitTax(rReq RemitTaxReq) (RemitTaxResp, error)
+	RemitTax(ctx context.Context, rReq RemitTaxReq) (RemitTaxResp, error)
 
 	// BusinessPayBill enables you to pay bills directly from your business account to a pay bill number, or a paybill store.
+	// The CommandID selects the kind of B2B payment:
+	//
+	//   - BusinessPayBill pays from a shortcode to a paybill.
+	//   - BusinessBuyGoods pays from a shortcode to a till.
+	//   - BusinessToBusinessTransfer moves funds between the working accounts of two shortcodes.
+	//   - MerchantToMerchantTransfer moves funds between the merchant accounts of two tills.
+	//   - MerchantTransferFromMerchantToWorking moves funds from the merchant account of a till to a working account.
+	//   - MerchantServicesMMFAccountTransfer moves funds from the merchant account of a till to an MMF account.
+	//   - AgencyFloatAdvance advances float from a shortcode to an agent.
+	//
+	// SenderIdentifierType and RecieverIdentifierType are set from the CommandID when empty.
 	//
 	// Documentation: https://developer.safaricom.co.ke/APIs/BusinessPayBill
 	//
@@ -348,7 +391,7 @@ type SDK interface {
 	//  	Remarks:                "test",
 	//  }
 
-	//  resp, err := mp.BusinessPayBill(b2cReq)
+	//  resp, err := mp.BusinessPayBill(ctx, b2cReq)
 	//  if err != nil {
 	//  	log.Fatal(err)
 	//  }
@@ -356,18 +399,359 @@ type SDK interface {
 	//  log.Printf("Resp: %+v\n", resp)
 	// Output:
 	//  2023/10/08 14:17:20 Resp: {ValidResp:{OriginatorConversationID: ConversationID:AG_20231008_201077c9426503a5c3ab ResponseDescription:Accept the service request successfully. ResponseCode:0}}
-	BusinessPayBill(bpbReq BusinessPayBillReq) (BusinessPayBillResp, error)
+	BusinessPayBill(ctx context.Context, bpbReq BusinessPayBillReq) (BusinessPayBillResp, error)
+
+	// BillManagerOptIn onboards a shortcode onto Bill Manager so that it can send invoices.
+	//
+	// Documentation: https://developer.safaricom.co.ke/APIs/BillManager
+	//
+	// Example:
+	// 	optInReq := mpesa.BillManagerOptInReq{
+	// 		ShortCode:       600984,
+	// 		Email:           "billing@example.com",
+	// 		OfficialContact: "254712345678",
+	// 		SendReminders:   1,
+	// 		CallbackURL:     "https://example.com/billmanager",
+	// 	}
+	//
+	// 	resp, err := mp.BillManagerOptIn(ctx, optInReq)
+	// 	if err != nil {
+	// 		log.Fatal(err)
+	// 	}
+	//
+	// 	log.Printf("Resp: %+v\n", resp)
+	// Output:
+	//  2024/03/02 10:12:41 Resp: {AppKey:AG_2376487236_126732989KJ BillManagerResp:{ResponseMessage:Success ResponseCode:200 StatusMessage:}}
+	BillManagerOptIn(ctx context.Context, oReq BillManagerOptInReq) (BillManagerOptInResp, error)
+
+	// BillManagerUpdateOptIn updates the opt-in details of a shortcode onboarded onto Bill Manager.
+	//
+	// Documentation: https://developer.safaricom.co.ke/APIs/BillManager
+	//
+	// Example:
+	// 	updateReq := mpesa.BillManagerUpdateOptInReq{
+	// 		ShortCode:       600984,
+	// 		Email:           "accounts@example.com",
+	// 		OfficialContact: "254712345678",
+	// 		SendReminders:   0,
+	// 		CallbackURL:     "https://example.com/billmanager",
+	// 	}
+	//
+	// 	resp, err := mp.BillManagerUpdateOptIn(ctx, updateReq)
+	// 	if err != nil {
+	// 		log.Fatal(err)
+	// 	}
+	//
+	// 	log.Printf("Resp: %+v\n", resp)
+	// Output:
+	//  2024/03/02 10:15:03 Resp: {BillManagerResp:{ResponseMessage:Optin details updated successfully ResponseCode:200 StatusMessage:}}
+	BillManagerUpdateOptIn(ctx context.Context, uReq BillManagerUpdateOptInReq) (BillManagerUpdateOptInResp, error)
+
+	// BillManagerSingleInvoice sends an invoice to a customer.
+	//
+	// Documentation: https://developer.safaricom.co.ke/APIs/BillManager
+	//
+	// Example:
+	// 	invoiceReq := mpesa.BillManagerSingleInvoiceReq{
+	// 		ExternalReference: "INV-2024-03-001",
+	// 		BilledFullName:    "John Doe",
+	// 		BilledPhoneNumber: 254712345678,
+	// 		BilledPeriod:      "March 2024",
+	// 		InvoiceName:       "Rent",
+	// 		DueDate:           "2024-03-10 00:00:00",
+	// 		AccountReference:  "A1",
+	// 		Amount:            15000,
+	// 	}
+	//
+	// 	resp, err := mp.BillManagerSingleInvoice(ctx, invoiceReq)
+	// 	if err != nil {
+	// 		log.Fatal(err)
+	// 	}
+	//
+	// 	log.Printf("Resp: %+v\n", resp)
+	// Output:
+	//  2024/03/02 10:20:17 Resp: {BillManagerResp:{ResponseMessage:Success ResponseCode:200 StatusMessage:Invoice sent successfully}}
+	BillManagerSingleInvoice(ctx context.Context, iReq BillManagerSingleInvoiceReq) (BillManagerSingleInvoiceResp, error)
+
+	// BillManagerBulkInvoice sends up to 1000 invoices at once.
+	//
+	// Documentation: https://developer.safaricom.co.ke/APIs/BillManager
+	//
+	// Example:
+	// 	bulkReq := mpesa.BillManagerBulkInvoiceReq{
+	// 		Invoices: []mpesa.BillManagerSingleInvoiceReq{invoiceReq1, invoiceReq2},
+	// 	}
+	//
+	// 	resp, err := mp.BillManagerBulkInvoice(ctx, bulkReq)
+	// 	if err != nil {
+	// 		log.Fatal(err)
+	// 	}
+	//
+	// 	log.Printf("Resp: %+v\n", resp)
+	// Output:
+	//  2024/03/02 10:24:52 Resp: {BillManagerResp:{ResponseMessage:Success ResponseCode:200 StatusMessage:Invoice sent successfully}}
+	BillManagerBulkInvoice(ctx context.Context, bReq BillManagerBulkInvoiceReq) (BillManagerBulkInvoiceResp, error)
+
+	// BillManagerCancelInvoice cancels invoices that have not been paid.
+	//
+	// Documentation: https://developer.safaricom.co.ke/APIs/BillManager
+	//
+	// Example:
+	// 	cancelReq := mpesa.BillManagerCancelInvoiceReq{
+	// 		ExternalReferences: []string{"INV-2024-03-001"},
+	// 	}
+	//
+	// 	resp, err := mp.BillManagerCancelInvoice(ctx, cancelReq)
+	// 	if err != nil {
+	// 		log.Fatal(err)
+	// 	}
+	//
+	// 	log.Printf("Resp: %+v\n", resp)
+	// Output:
+	//  2024/03/02 10:31:40 Resp: {BillManagerResp:{ResponseMessage:Success ResponseCode:200 StatusMessage:1 Invoices cancelled.}}
+	BillManagerCancelInvoice(ctx context.Context, cReq BillManagerCancelInvoiceReq) (BillManagerCancelInvoiceResp, error)
+
+	// BillManagerReconcile acknowledges a payment that Bill Manager notified
+	// the callback URL of, so that the customer receives an e-receipt.
+	//
+	// Documentation: https://developer.safaricom.co.ke/APIs/BillManager
+	//
+	// Example:
+	// 	reconcileReq := mpesa.BillManagerReconcileReq{
+	// 		PaymentDate:       "2024-03-05",
+	// 		PaidAmount:        15000,
+	// 		AccountReference:  "A1",
+	// 		TransactionID:     "SC54HJ8K9L",
+	// 		PhoneNumber:       254712345678,
+	// 		FullName:          "John Doe",
+	// 		InvoiceName:       "Rent",
+	// 		ExternalReference: "INV-2024-03-001",
+	// 	}
+	//
+	// 	resp, err := mp.BillManagerReconcile(ctx, reconcileReq)
+	// 	if err != nil {
+	// 		log.Fatal(err)
+	// 	}
+	//
+	// 	log.Printf("Resp: %+v\n", resp)
+	// Output:
+	//  2024/03/05 09:02:11 Resp: {BillManagerResp:{ResponseMessage:Success ResponseCode:200 StatusMessage:}}
+	BillManagerReconcile(ctx context.Context, rReq BillManagerReconcileReq) (BillManagerReconcileResp, error)
+
+	// PullTransactionsRegister registers a shortcode for the Pull Transactions
+	// API, which is needed before its transactions can be queried.
+	//
+	// Documentation: https://developer.safaricom.co.ke/APIs/PullTransactions
+	//
+	// Example:
+	// 	registerReq := mpesa.PullTransactionsRegisterReq{
+	// 		ShortCode:       600000,
+	// 		NominatedNumber: "0722000000",
+	// 		CallBackURL:     "https://example.com/pull",
+	// 	}
+	//
+	// 	resp, err := mp.PullTransactionsRegister(ctx, registerReq)
+	// 	if err != nil {
+	// 		log.Fatal(err)
+	// 	}
+	//
+	// 	log.Printf("Resp: %+v\n", resp)
+	// Output:
+	//  2024/03/12 08:41:05 Resp: {ResponseRefID:18633-7271215-1 ResponseCode:1000 ResponseMessage:ShortCode Registered Successfully ShortCode:600000}
+	PullTransactionsRegister(ctx context.Context, prReq PullTransactionsRegisterReq) (PullTransactionsRegisterResp, error)
+
+	// PullTransactionsQuery returns the transactions of a registered shortcode
+	// within a time window. Daraja returns the transactions in pages; every
+	// page from OffSetValue onwards is fetched and the transactions of all of
+	// them are returned. It is useful to backfill C2B confirmations that were
+	// missed while the confirmation URL was unavailable.
+	//
+	// Documentation: https://developer.safaricom.co.ke/APIs/PullTransactions
+	//
+	// Example:
+	// 	queryReq := mpesa.PullTransactionsQueryReq{
+	// 		ShortCode: 600000,
+	// 		StartDate: "2024-03-11 08:00:00",
+	// 		EndDate:   "2024-03-12 08:00:00",
+	// 	}
+	//
+	// 	resp, err := mp.PullTransactionsQuery(ctx, queryReq)
+	// 	if err != nil {
+	// 		log.Fatal(err)
+	// 	}
+	//
+	// 	for _, trx := range resp.Transactions {
+	// 		log.Printf("Transaction: %+v\n", trx)
+	// 	}
+	// Output:
+	//  2024/03/12 08:45:10 Transaction: {TransactionID:SCB1FN3Z4T TransactionDate:2024-03-11 11:34:00 +0300 EAT MSISDN:2547******149 Sender:John Doe TransactionType:c2b-pay-bill-debit BillReference:invoice008 Amount:10 OrganizationName:Daraja Pull API Test}
+	PullTransactionsQuery(ctx context.Context, pqReq PullTransactionsQueryReq) (PullTransactionsQueryResp, error)
+
+	// StandingOrder creates an M-PESA Ratiba standing order. The customer is
+	// prompted to approve it on their phone and the outcome is posted to the
+	// CallBackURL, see NewStandingOrderCallbackHandler. Once approved, Amount
+	// is paid to BusinessShortCode at the given Frequency from StartDate until
+	// EndDate.
+	//
+	// Documentation: https://developer.safaricom.co.ke/APIs/MpesaRatiba
+	//
+	// Example:
+	// 	soReq := mpesa.StandingOrderReq{
+	// 		StandingOrderName:           "Gym Membership",
+	// 		StartDate:                   "20240401",
+	// 		EndDate:                     "20250401",
+	// 		BusinessShortCode:           174379,
+	// 		TransactionType:             "Standing Order Customer Pay Bill",
+	// 		ReceiverPartyIdentifierType: 4,
+	// 		Amount:                      1500,
+	// 		PartyA:                      254708374149,
+	// 		CallBackURL:                 "https://example.com/ratiba",
+	// 		AccountReference:            "GYM001",
+	// 		TransactionDesc:             "Membership",
+	// 		Frequency:                   mpesa.StandingOrderMonthly,
+	// 	}
+	//
+	// 	resp, err := mp.StandingOrder(ctx, soReq)
+	// 	if err != nil {
+	// 		log.Fatal(err)
+	// 	}
+	//
+	// 	log.Printf("Resp: %+v\n", resp)
+	// Output:
+	//  2024/03/12 09:10:20 Resp: {ResponseRefID:4dd9b5d9-d738-42ba-9326-2cc99e966000 ResponseCode:200 ResponseDescription:Request accepted for processing ResultDesc:The service request is processed successfully.}
+	StandingOrder(ctx context.Context, soReq StandingOrderReq) (StandingOrderResp, error)
+
+	// B2BExpressCheckout sends a USSD push to the till of a merchant to pay
+	// ReceiverShortCode. The outcome is posted to the CallbackURL once the
+	// merchant has entered their PIN or cancelled, see
+	// NewB2BExpressCheckoutCallbackHandler. A RequestRefID is generated when
+	// the request has none; it is returned in the response and the callback
+	// carries it as requestId.
+	//
+	// Documentation: https://developer.safaricom.co.ke/APIs/B2BExpressCheckout
+	//
+	// Example:
+	// 	b2bReq := mpesa.B2BExpressCheckoutReq{
+	// 		PrimaryShortCode:  600992,
+	// 		ReceiverShortCode: 600984,
+	// 		Amount:            100,
+	// 		PaymentRef:        "INV001",
+	// 		CallbackURL:       "https://example.com/b2bexpress",
+	// 		PartnerName:       "Vendor",
+	// 	}
+	//
+	// 	resp, err := mp.B2BExpressCheckout(ctx, b2bReq)
+	// 	if err != nil {
+	// 		log.Fatal(err)
+	// 	}
+	//
+	// 	log.Printf("Resp: %+v\n", resp)
+	// Output:
+	//  2024/03/14 11:20:05 Resp: {Code:0 Status:USSD Initiated Successfully RequestRefID:01HRX6Z2T5Q0M8V3K9D7B4C1NE}
+	B2BExpressCheckout(ctx context.Context, b2bReq B2BExpressCheckoutReq) (B2BExpressCheckoutResp, error)
+
+	// B2CAccountTopUp loads funds from the working account of a paybill into
+	// the utility account of a B2C shortcode so that it can make B2C
+	// payments. The result is posted to the ResultURL, see
+	// NewB2CAccountTopUpResultHandlers.
+	//
+	// Documentation: https://developer.safaricom.co.ke/APIs/B2CAccountTopUp
+	//
+	// Example:
+	// 	topUpReq := mpesa.B2CAccountTopUpReq{
+	// 		Initiator:         "testapi",
+	// 		InitiatorPassword: "Safaricom999!*!",
+	// 		Amount:            239,
+	// 		PartyA:            600979,
+	// 		PartyB:            600000,
+	// 		AccountReference:  "353353",
+	// 		Requester:         254708374149,
+	// 		QueueTimeOutURL:   "https://example.com/timeout",
+	// 		ResultURL:         "https://example.com/result",
+	// 		Remarks:           "OK",
+	// 	}
+	//
+	// 	resp, err := mp.B2CAccountTopUp(ctx, topUpReq)
+	// 	if err != nil {
+	// 		log.Fatal(err)
+	// 	}
+	//
+	// 	log.Printf("Resp: %+v\n", resp)
+	// Output:
+	//  2024/03/15 10:02:11 Resp: {ValidResp:{OriginatorConversationID:5118-111210482-1 ConversationID:AG_20230420_2010759fd5662ef6d054 ResponseDescription:Accept the service request successfully. ResponseCode:0}}
+	B2CAccountTopUp(ctx context.Context, topUpReq B2CAccountTopUpReq) (B2CAccountTopUpResp, error)
+
+	// B2Pochi pays from a B2C shortcode into the Pochi la Biashara wallet of
+	// a customer. The result is posted to the ResultURL, see
+	// NewB2PochiResultHandlers.
+	//
+	// Documentation: https://developer.safaricom.co.ke/APIs/B2Pochi
+	//
+	// Example:
+	// 	pochiReq := mpesa.B2PochiReq{
+	// 		InitiatorName:     "testapi",
+	// 		InitiatorPassword: "Safaricom999!*!",
+	// 		Amount:            10,
+	// 		PartyA:            600996,
+	// 		PartyB:            254728762287,
+	// 		Remarks:           "here are my remarks",
+	// 		QueueTimeOutURL:   "https://example.com/timeout",
+	// 		ResultURL:         "https://example.com/result",
+	// 		Occasion:          "Christmas",
+	// 	}
+	//
+	// 	resp, err := mp.B2Pochi(ctx, pochiReq)
+	// 	if err != nil {
+	// 		log.Fatal(err)
+	// 	}
+	//
+	// 	log.Printf("Resp: %+v\n", resp)
+	// Output:
+	//  2024/03/15 10:05:42 Resp: {ValidResp:{OriginatorConversationID:01HS0G4D3N2M6P1R9V5Q8K7T2W ConversationID:AG_20240315_2010325b025970fbc403 ResponseDescription:Accept the service request successfully. ResponseCode:0}}
+	B2Pochi(ctx context.Context, pochiReq B2PochiReq) (B2PochiResp, error)
+
+	// SIMSwap returns when the SIM of a customer was last swapped. A recent
+	// swap is a common fraud signal, so check it before paying out large
+	// amounts, or use the guard in the middleware/fraud/simswap package.
+	//
+	// Documentation: https://developer.safaricom.co.ke/APIs/IMSI
+	//
+	// Example:
+	// 	ssReq := mpesa.SIMSwapReq{
+	// 		CustomerNumber: 254722000000,
+	// 	}
+	//
+	// 	resp, err := mp.SIMSwap(ctx, ssReq)
+	// 	if err != nil {
+	// 		log.Fatal(err)
+	// 	}
+	//
+	// 	if resp.SwappedWithin(72*time.Hour, time.Now()) {
+	// 		log.Fatal("sim swapped recently")
+	// 	}
+	//
+	// 	log.Printf("Resp: %+v\n", resp)
+	// Output:
+	//  2024/03/18 08:15:02 Resp: {ResponseRefID:0b7d3f51-4dc4-45e6-8f2f-6f5b0c3c2a10 ResponseCode:200 ResponseDescription:Success CustomerNumber:254722000000 LastSwapDate:2024-01-15 10:30:00 +0300 EAT}
+	SIMSwap(ctx context.Context, ssReq SIMSwapReq) (SIMSwapResp, error)
 }
 
 // mSDK implements SDK interface.
 type mSDK struct {
 	baseURL           string
+	endpoints         map[Operation]string
 	appKey            string
 	appSecret         string
-	certFile          string
+	cert              *certificate
 	client            *http.Client
 	initiatorName     string
 	initiatorPassword string
+	tokens            *tokenCache
+	timeouts          map[Operation]time.Duration
+	retry             *RetryPolicy
+	strict            bool
+	b2cVersion        B2CVersion
+	c2bVersions       map[uint64]C2BVersion
 }
 
 // Config contains sdk configuration parameters.
@@ -375,20 +759,71 @@ type Config struct {
 	BaseURL           string
 	AppKey            string
 	AppSecret         string
-	CertFile          string
-	HTTPClient        *http.Client
 	InitiatorName     string
 	InitiatorPassword string
+
+	// HTTPClient is used to call Daraja. It defaults to a client with a one
+	// minute timeout. Transport options such as WithProxy and WithCABundle
+	// configure a copy of it.
+	HTTPClient *http.Client
+
+	// Environment selects the Daraja environment. BaseURL is optional for the
+	// sandbox and production environments and required for the custom one.
+	// When Environment is empty it is derived from BaseURL, which must then be
+	// one of the Safaricom hosts.
+	Environment Environment
+
+	// Endpoints overrides the path, relative to BaseURL, of individual
+	// operations, for example to target a versioned endpoint.
+	Endpoints map[Operation]string
+
+	// B2CVersion selects the version of the B2C payment endpoint. It defaults
+	// to B2CV1. With B2CV3 the OriginatorConversationID of a B2CPaymentReq is
+	// honoured by Daraja, so callers can use their own IDs to deduplicate and
+	// correlate payments. An Endpoints override of OpB2CPayment takes
+	// precedence over the version's path.
+	B2CVersion B2CVersion
+
+	// C2BVersions selects the version of the C2B register URL and simulate
+	// endpoints per shortcode. Shortcodes that are not listed use C2BV1. With
+	// C2BV2 Daraja masks or hashes the MSISDN in the callbacks of the
+	// shortcode. An Endpoints override of OpC2BRegisterURL or OpC2BSimulate
+	// takes precedence over the version's path.
+	C2BVersions map[uint64]C2BVersion
+
+	// CertFile is the path to a PEM or DER encoded certificate used to encrypt
+	// the initiator password. When empty, the certificate of the sandbox or
+	// production environment is read from pkg/mpesa/certs if it was embedded at
+	// build time; the custom environment has no default.
+	CertFile string
+
+	// DownloadCertificate allows the SDK to download the certificate of the
+	// sandbox or production environment from Daraja on first use when it was
+	// not embedded. Without it, operations that need a security credential
+	// fail unless the request carries one or CertFile is set.
+	DownloadCertificate bool
+
+	// TokenStore holds the cached access token. It defaults to an in-memory
+	// store; use a shared store to let several instances reuse one token.
+	TokenStore TokenStore
+
+	// TokenRefreshMargin is how long before expiry a cached token is refreshed.
+	// It defaults to one minute.
+	TokenRefreshMargin time.Duration
 }
 
 // validate validates the configuration parameters.
 func (cfg Config) validate() error {
-	if cfg.BaseURL == "" {
-		return errMissingBaseURL
+	if _, err := cfg.baseURL(); err != nil {
+		return err
 	}
 
-	if cfg.BaseURL != "https://api.safaricom.co.ke" && cfg.BaseURL != "https://sandbox.safaricom.co.ke" {
-		return errInvalidBaseURL
+	if _, err := cfg.endpoints(); err != nil {
+		return err
+	}
+
+	if _, err := cfg.c2bVersions(); err != nil {
+		return err
 	}
 
 	if cfg.AppKey == "" {
@@ -404,32 +839,47 @@ func (cfg Config) validate() error {
 
 // newSDK returns new mpesa SDK instance.
 func newSDK(conf Config) (SDK, error) {
-	conf.CertFile = prodCertificate
-	if strings.Contains(conf.BaseURL, "sandbox") {
-		conf.CertFile = sandboxCertificate
+	if err := conf.validate(); err != nil {
+		return nil, err
 	}
 
-	conf.HTTPClient = &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: false,
-			},
-		},
-		Timeout: defaultTimeout,
+	baseURL, err := conf.baseURL()
+	if err != nil {
+		return nil, err
 	}
 
-	if err := conf.validate(); err != nil {
+	endpoints, err := conf.endpoints()
+	if err != nil {
+		return nil, err
+	}
+
+	c2bVersions, err := conf.c2bVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := newCertificate(conf)
+	if err != nil {
 		return nil, err
 	}
 
+	client := conf.HTTPClient
+	if client == nil {
+		client = newHTTPClient()
+	}
+
 	sdk := &mSDK{
-		baseURL:           conf.BaseURL,
+		baseURL:           baseURL,
+		endpoints:         endpoints,
 		appKey:            conf.AppKey,
 		appSecret:         conf.AppSecret,
-		certFile:          conf.CertFile,
-		client:            conf.HTTPClient,
+		cert:              cert,
+		client:            client,
 		initiatorName:     conf.InitiatorName,
 		initiatorPassword: conf.InitiatorPassword,
+		tokens:            newTokenCache(conf.TokenStore, conf.TokenRefreshMargin),
+		b2cVersion:        conf.B2CVersion,
+		c2bVersions:       c2bVersions,
 	}
 
 	return sdk, nil
@@ -453,76 +903,99 @@ func NewSDK(conf Config, opts ...Option) (SDK, error) {
 }
 
 // sendRequest sends a request to the Mpesa API.
-func (sdk mSDK) sendRequest(req *http.Request) ([]byte, error) {
-	token, err := sdk.Token()
+//
+// The access token is taken from the token cache. If Daraja rejects it as
+// unauthorized, the token is refreshed and the request is sent once more.
+func (sdk mSDK) sendRequest(op Operation, req *http.Request) ([]byte, error) {
+	ctx := req.Context()
+
+	token, err := sdk.accessToken(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if token.AccessToken != "" {
-		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
-	}
-
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("Cache-Control", "no-cache")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Cache-Control", "no-cache")
 
-	resp, err := sdk.client.Do(req)
+	statusCode, body, retries, err := sdk.doWithRetry(op, req, token)
 	if err != nil {
-		return nil, errors.Join(errFailedToSendReq, err)
+		return nil, withRetries(op, retries, err)
 	}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-	defer resp.Body.Close()
+	if statusCode == http.StatusUnauthorized && sdk.tokens != nil && req.GetBody != nil {
+		token, err = sdk.tokens.refresh(ctx, sdk.Token, token)
+		if err != nil {
+			return nil, withRetries(op, retries, err)
+		}
 
-	if resp.StatusCode != http.StatusOK {
-		var errResp RespError
-		if err := json.Unmarshal(body, &errResp); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal error response: %w", err)
+		req.Body, err = req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to rewind request body: %w", err)
 		}
 
-		return nil, errors.Join(errFailedToSendReq, errResp)
+		var n int
+		statusCode, body, n, err = sdk.doWithRetry(op, req, token)
+		retries += n
+		if err != nil {
+			return nil, withRetries(op, retries, err)
+		}
+	}
+
+	if statusCode != http.StatusOK {
+		return nil, withRetries(op, retries, newError(statusCode, body, errFailedToSendReq))
 	}
 
 	return body, nil
 }
 
-// generateTimestampAndPassword generates a timestamp and password.
-func (sdk mSDK) generateTimestampAndPassword(shortcode uint64, passkey string) (string, string) {
-	timestamp := time.Now().Local().Format("20060102150405")
-	password := fmt.Sprintf("%d%s%s", shortcode, passkey, timestamp)
+// accessToken returns the access token used to authorize requests.
+// Without a token cache a new token is fetched on every call.
+func (sdk mSDK) accessToken(ctx context.Context) (string, error) {
+	if sdk.tokens == nil {
+		token, err := sdk.Token(ctx)
+		if err != nil {
+			return "", err
+		}
 
-	return timestamp, base64.StdEncoding.EncodeToString([]byte(password))
+		return token.AccessToken, nil
+	}
+
+	return sdk.tokens.token(ctx, sdk.Token)
 }
 
-// generateSecurityCredential generates a security credential.
-func (sdk mSDK) generateSecurityCredential(password string) (string, error) {
-	resp, err := http.Get(sdk.certFile)
+// do sends the request with the given access token and reads the response.
+func (sdk mSDK) do(req *http.Request, token string) (int, []byte, error) {
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := sdk.client.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("failed to get certificate: %w", err)
+		return 0, nil, errors.Join(errFailedToSendReq, err)
 	}
 	defer resp.Body.Close()
 
-	data, err := io.ReadAll(resp.Body)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", fmt.Errorf("failed to read certificate: %w", err)
+		return 0, nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	cBlock, _ := pem.Decode(data)
+	return resp.StatusCode, body, nil
+}
 
-	cert, err := x509.ParseCertificate(cBlock.Bytes)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse certificate: %w", err)
-	}
+// generateTimestampAndPassword generates a timestamp and password.
+func (sdk mSDK) generateTimestampAndPassword(shortcode uint64, passkey string) (string, string) {
+	timestamp := time.Now().Local().Format("20060102150405")
+	password := fmt.Sprintf("%d%s%s", shortcode, passkey, timestamp)
 
-	pubKey := cert.PublicKey.(*rsa.PublicKey)
+	return timestamp, base64.StdEncoding.EncodeToString([]byte(password))
+}
 
-	cipher, err := rsa.EncryptPKCS1v15(rand.Reader, pubKey, []byte(password))
-	if err != nil {
-		return "", fmt.Errorf("failed to encrypt password: %w", err)
+// generateSecurityCredential generates a security credential.
+func (sdk mSDK) generateSecurityCredential(ctx context.Context, password string) (string, error) {
+	if sdk.cert == nil {
+		return "", errMissingCertificate
 	}
 
-	return base64.StdEncoding.EncodeToString(cipher), nil
+	return sdk.cert.encrypt(ctx, sdk.client, password)
 }
@@ -0,0 +1,96 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ErrSIMSwapped indicates that the SIM of the recipient of a payment was
+// swapped within the screening window. It is returned by SIM swap guards such
+// as the one in the middleware/fraud/simswap package.
+var ErrSIMSwapped = errors.New("sim swapped recently")
+
+func (sdk mSDK) SIMSwap(ctx context.Context, ssReq SIMSwapReq) (SIMSwapResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpSIMSwap)
+	defer cancel()
+
+	if err := ssReq.Validate(); err != nil {
+		return SIMSwapResp{}, err
+	}
+
+	data, err := json.Marshal(ssReq)
+	if err != nil {
+		return SIMSwapResp{}, err
+	}
+
+	url := sdk.url(OpSIMSwap)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return SIMSwapResp{}, err
+	}
+
+	resp, err := sdk.sendRequest(OpSIMSwap, req)
+	if err != nil {
+		return SIMSwapResp{}, err
+	}
+
+	var ssr SIMSwapResp
+	if err := json.Unmarshal(resp, &ssr); err != nil {
+		return SIMSwapResp{}, err
+	}
+
+	if err := sdk.checkResponseCode(OpSIMSwap, ssr.ResponseCode, ssr.ResponseDescription, resp); err != nil {
+		return SIMSwapResp{}, err
+	}
+
+	return ssr, nil
+}
+
+// SwappedWithin reports whether the SIM was swapped within the window before now.
+// A SIM that was never swapped has a zero LastSwapDate and is not reported.
+func (r SIMSwapResp) SwappedWithin(window time.Duration, now time.Time) bool {
+	if r.LastSwapDate.IsZero() {
+		return false
+	}
+
+	return now.Sub(r.LastSwapDate) < window
+}
+
+// UnmarshalJSON decodes the LastSwapDate sent by Daraja as 20060102150405.
+func (r *SIMSwapResp) UnmarshalJSON(data []byte) error {
+	type simSwapResp SIMSwapResp
+
+	aux := struct {
+		*simSwapResp
+		LastSwapDate string `json:"LastSwapDate,omitempty"`
+	}{simSwapResp: (*simSwapResp)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if aux.LastSwapDate == "" {
+		return nil
+	}
+
+	date, err := parseDarajaTime(aux.LastSwapDate)
+	if err != nil {
+		// The date was marshalled by the SDK, e.g. when it is relayed over MQTT.
+		if date, err = time.Parse(time.RFC3339, aux.LastSwapDate); err != nil {
+			return err
+		}
+	}
+	r.LastSwapDate = date
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSIMSwap(t *testing.T) {
+	testCases := []struct {
+		name         string
+		response     string
+		expectedDate time.Time
+		expectedErr  error
+	}{
+		{
+			name:         "swapped",
+			response:     `{"ResponseRefID":"0b7d3f51-4dc4-45e6-8f2f-6f5b0c3c2a10","ResponseCode":"200","ResponseDescription":"Success","CustomerNumber":"254722000000","LastSwapDate":"20240115103000"}`,
+			expectedDate: time.Date(2024, time.January, 15, 10, 30, 0, 0, darajaLocation),
+		},
+		{
+			name:     "never swapped",
+			response: `{"ResponseRefID":"0b7d3f51-4dc4-45e6-8f2f-6f5b0c3c2a10","ResponseCode":"200","ResponseDescription":"Success","CustomerNumber":"254722000000"}`,
+		},
+		{
+			name:        "rejected",
+			response:    `{"ResponseRefID":"0b7d3f51-4dc4-45e6-8f2f-6f5b0c3c2a10","ResponseCode":"401","ResponseDescription":"Subscriber not found"}`,
+			expectedErr: ErrRejected,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/"+simSwapEndpoint, r.URL.Path)
+
+				var req map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, "254722000000", req["customerNumber"])
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+			sdk.strict = true
+
+			resp, err := sdk.SIMSwap(context.Background(), SIMSwapReq{CustomerNumber: 254722000000})
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+			assert.Equal(t, "200", resp.ResponseCode)
+			assert.True(t, tc.expectedDate.Equal(resp.LastSwapDate), "expected %s got %s", tc.expectedDate, resp.LastSwapDate)
+		})
+	}
+}
+
+func TestSIMSwapValidate(t *testing.T) {
+	assert.NoError(t, SIMSwapReq{CustomerNumber: 254722000000}.Validate())
+	assert.ErrorIs(t, SIMSwapReq{}.Validate(), errInvalidPhoneNumber)
+	assert.ErrorIs(t, SIMSwapReq{CustomerNumber: invalidPhoneNumber}.Validate(), errInvalidPhoneNumber)
+}
+
+func TestSIMSwapRespSwappedWithin(t *testing.T) {
+	now := time.Date(2024, time.March, 18, 8, 0, 0, 0, time.UTC)
+
+	assert.False(t, SIMSwapResp{}.SwappedWithin(72*time.Hour, now))
+	assert.True(t, SIMSwapResp{LastSwapDate: now.Add(-time.Hour)}.SwappedWithin(72*time.Hour, now))
+	assert.False(t, SIMSwapResp{LastSwapDate: now.Add(-72 * time.Hour)}.SwappedWithin(72*time.Hour, now))
+}
+
+func TestSIMSwapRespJSON(t *testing.T) {
+	resp := SIMSwapResp{ResponseCode: "200", LastSwapDate: time.Date(2024, time.January, 15, 10, 30, 0, 0, darajaLocation)}
+
+	data, err := json.Marshal(resp)
+	require.NoError(t, err)
+
+	var decoded SIMSwapResp
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, resp.LastSwapDate.Equal(decoded.LastSwapDate))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"LastSwapDate":"yesterday"}`), &decoded))
+}
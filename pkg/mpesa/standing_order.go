@@ -0,0 +1,121 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// StandingOrderFrequency is how often the payment of a standing order is made.
+type StandingOrderFrequency uint8
+
+// Standing order frequencies supported by Ratiba.
+const (
+	StandingOrderOneOff     StandingOrderFrequency = iota + 1 // A single payment on the start date.
+	StandingOrderDaily                                        // A payment every day.
+	StandingOrderWeekly                                       // A payment every week.
+	StandingOrderMonthly                                      // A payment every month.
+	StandingOrderBiMonthly                                    // A payment every two months.
+	StandingOrderQuarterly                                    // A payment every three months.
+	StandingOrderHalfYearly                                   // A payment every six months.
+	StandingOrderYearly                                       // A payment every year.
+)
+
+var standingOrderFrequencies = map[StandingOrderFrequency]string{
+	StandingOrderOneOff:     "One Off",
+	StandingOrderDaily:      "Daily",
+	StandingOrderWeekly:     "Weekly",
+	StandingOrderMonthly:    "Monthly",
+	StandingOrderBiMonthly:  "Bi-Monthly",
+	StandingOrderQuarterly:  "Quarterly",
+	StandingOrderHalfYearly: "Half Year",
+	StandingOrderYearly:     "Yearly",
+}
+
+// String returns the name of the frequency.
+func (f StandingOrderFrequency) String() string {
+	if name, ok := standingOrderFrequencies[f]; ok {
+		return name
+	}
+
+	return "Unknown frequency " + strconv.Itoa(int(f))
+}
+
+func (f StandingOrderFrequency) valid() bool {
+	_, ok := standingOrderFrequencies[f]
+
+	return ok
+}
+
+func (sdk mSDK) StandingOrder(ctx context.Context, soReq StandingOrderReq) (StandingOrderResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpStandingOrder)
+	defer cancel()
+
+	if err := soReq.Validate(); err != nil {
+		return StandingOrderResp{}, err
+	}
+
+	data, err := json.Marshal(soReq)
+	if err != nil {
+		return StandingOrderResp{}, err
+	}
+
+	url := sdk.url(OpStandingOrder)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return StandingOrderResp{}, err
+	}
+
+	resp, err := sdk.sendRequest(OpStandingOrder, req)
+	if err != nil {
+		return StandingOrderResp{}, err
+	}
+
+	var sor StandingOrderResp
+	if err := json.Unmarshal(resp, &sor); err != nil {
+		return StandingOrderResp{}, err
+	}
+
+	if err := sdk.checkResponseCode(OpStandingOrder, sor.ResponseCode, sor.ResponseDescription, resp); err != nil {
+		return StandingOrderResp{}, err
+	}
+
+	return sor, nil
+}
+
+// UnmarshalJSON reads the response from the ResponseHeader that Daraja nests
+// it in. A response that is not nested is read as is.
+func (r *StandingOrderResp) UnmarshalJSON(data []byte) error {
+	type resp StandingOrderResp
+	var body struct {
+		resp
+		ResponseHeader *struct {
+			ResponseRefID       string `json:"responseRefID"`
+			ResponseCode        string `json:"responseCode"`
+			ResponseDescription string `json:"responseDescription"`
+			ResultDesc          string `json:"ResultDesc"`
+		} `json:"ResponseHeader"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+
+	*r = StandingOrderResp(body.resp)
+	if header := body.ResponseHeader; header != nil {
+		r.ResponseRefID = header.ResponseRefID
+		r.ResponseCode = header.ResponseCode
+		r.ResponseDescription = header.ResponseDescription
+		r.ResultDesc = header.ResultDesc
+	}
+
+	return nil
+}
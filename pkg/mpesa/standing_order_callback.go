@@ -0,0 +1,118 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StandingOrderCallback is the outcome of a standing order that Safaricom
+// posts to the CallBackURL of the StandingOrder request once the customer
+// has approved or declined it.
+type StandingOrderCallback struct {
+	ResponseRefID       string         // The ResponseRefID returned by StandingOrder.
+	RequestRefID        string         // The unique identifier of the callback.
+	ResponseCode        ResultCode     // The outcome of the standing order. 0 means that it was created.
+	ResponseDescription string         // The description of the outcome.
+	TransactionID       string         // The M-PESA transaction ID. Only set on success.
+	Status              string         // The status of the standing order, e.g. OKAY.
+	MSISDN              string         // The phone number of the customer, masked by Daraja.
+	Items               []CallbackItem // The response data as sent by Safaricom.
+}
+
+type standingOrderCallbackBody struct {
+	ResponseHeader *struct {
+		ResponseRefID       string     `json:"responseRefID"`
+		RequestRefID        string     `json:"requestRefID"`
+		ResponseCode        ResultCode `json:"responseCode"`
+		ResponseDescription string     `json:"responseDescription"`
+	} `json:"ResponseHeader"`
+	ResponseBody struct {
+		ResponseData []CallbackItem `json:"responseData"`
+	} `json:"ResponseBody"`
+}
+
+// ParseStandingOrderCallback parses the body of a Ratiba standing order callback.
+func ParseStandingOrderCallback(data []byte) (StandingOrderCallback, error) {
+	var body standingOrderCallbackBody
+	if err := decodeCallback(data, &body); err != nil {
+		return StandingOrderCallback{}, err
+	}
+	header := body.ResponseHeader
+	if header == nil || header.ResponseCode == "" {
+		return StandingOrderCallback{}, fmt.Errorf("%w: missing ResponseHeader", errInvalidCallback)
+	}
+
+	cb := StandingOrderCallback{
+		ResponseRefID:       header.ResponseRefID,
+		RequestRefID:        header.RequestRefID,
+		ResponseCode:        header.ResponseCode,
+		ResponseDescription: header.ResponseDescription,
+		Items:               body.ResponseBody.ResponseData,
+	}
+
+	for _, item := range cb.Items {
+		if item.Value == nil {
+			continue
+		}
+		value := fmt.Sprint(item.Value)
+
+		switch item.Name {
+		case "TransactionID":
+			cb.TransactionID = value
+		case "Status":
+			cb.Status = value
+		case "Msisdn":
+			cb.MSISDN = value
+		}
+	}
+
+	return cb, nil
+}
+
+// StandingOrderCallbackFunc handles the outcome of a standing order. If it
+// returns an error the callback is not acknowledged.
+type StandingOrderCallbackFunc func(ctx context.Context, cb StandingOrderCallback) error
+
+type standingOrderCallbackHandler struct {
+	fn StandingOrderCallbackFunc
+}
+
+// NewStandingOrderCallbackHandler returns an http.Handler that serves the
+// CallBackURL of StandingOrder requests. It parses every Ratiba callback,
+// passes it to fn and acknowledges it to Safaricom.
+func NewStandingOrderCallbackHandler(fn StandingOrderCallbackFunc) (http.Handler, error) {
+	if fn == nil {
+		return nil, errMissingCallbackHandler
+	}
+
+	return standingOrderCallbackHandler{fn: fn}, nil
+}
+
+func (h standingOrderCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	data, ok := readCallback(w, r)
+	if !ok {
+		return
+	}
+
+	cb, err := ParseStandingOrderCallback(data)
+	if err != nil {
+		writeCallbackResp(w, http.StatusBadRequest, callbackFailed)
+
+		return
+	}
+
+	if err := h.fn(r.Context(), cb); err != nil {
+		writeCallbackResp(w, http.StatusInternalServerError, callbackFailed)
+
+		return
+	}
+
+	writeCallbackResp(w, http.StatusOK, callbackAccepted)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStandingOrderCallback = `{
+	"ResponseHeader": {
+		"responseRefID": "0acd4a73-9d9b-4a5c-8a2b-7d2d5e1e5c01",
+		"requestRefID": "c8c5d2a1-3a6e-4b5f-9e3a-0d2f1b7c9e12",
+		"responseCode": 0,
+		"responseDescription": "The service request is processed successfully"
+	},
+	"ResponseBody": {
+		"responseData": [
+			{"name": "TransactionID", "value": "SC8F2IQMH5"},
+			{"name": "responseCode", "value": "0"},
+			{"name": "Status", "value": "OKAY"},
+			{"name": "Msisdn", "value": "254******867"}
+		]
+	}
+}`
+
+func TestParseStandingOrderCallback(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        string
+		expected    StandingOrderCallback
+		expectedErr error
+	}{
+		{
+			name: "created",
+			data: testStandingOrderCallback,
+			expected: StandingOrderCallback{
+				ResponseRefID:       "0acd4a73-9d9b-4a5c-8a2b-7d2d5e1e5c01",
+				RequestRefID:        "c8c5d2a1-3a6e-4b5f-9e3a-0d2f1b7c9e12",
+				ResponseCode:        "0",
+				ResponseDescription: "The service request is processed successfully",
+				TransactionID:       "SC8F2IQMH5",
+				Status:              "OKAY",
+				MSISDN:              "254******867",
+				Items: []CallbackItem{
+					{Name: "TransactionID", Value: "SC8F2IQMH5"},
+					{Name: "responseCode", Value: "0"},
+					{Name: "Status", Value: "OKAY"},
+					{Name: "Msisdn", Value: "254******867"},
+				},
+			},
+		},
+		{
+			name: "declined",
+			data: `{"ResponseHeader":{"responseRefID":"ref","responseCode":"1032","responseDescription":"Request cancelled by user"},"ResponseBody":{"responseData":[]}}`,
+			expected: StandingOrderCallback{
+				ResponseRefID:       "ref",
+				ResponseCode:        "1032",
+				ResponseDescription: "Request cancelled by user",
+				Items:               []CallbackItem{},
+			},
+		},
+		{
+			name:        "missing header",
+			data:        `{"ResponseBody":{"responseData":[]}}`,
+			expectedErr: errInvalidCallback,
+		},
+		{
+			name:        "missing response code",
+			data:        `{"ResponseHeader":{"responseRefID":"ref"}}`,
+			expectedErr: errInvalidCallback,
+		},
+		{
+			name:        "invalid json",
+			data:        `{"ResponseHeader":`,
+			expectedErr: errInvalidCallback,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cb, err := ParseStandingOrderCallback([]byte(tc.data))
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, cb)
+		})
+	}
+}
+
+func TestStandingOrderCallbackHandler(t *testing.T) {
+	testCases := []struct {
+		name           string
+		body           string
+		fnErr          error
+		expectedStatus int
+		expectedResp   CallbackResp
+	}{
+		{
+			name:           "accepted",
+			body:           testStandingOrderCallback,
+			expectedStatus: http.StatusOK,
+			expectedResp:   callbackAccepted,
+		},
+		{
+			name:           "invalid callback",
+			body:           `{"ResponseBody":{}}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   callbackFailed,
+		},
+		{
+			name:           "handler error",
+			body:           testStandingOrderCallback,
+			fnErr:          errInvalidCallback,
+			expectedStatus: http.StatusInternalServerError,
+			expectedResp:   callbackFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got StandingOrderCallback
+			handler, err := NewStandingOrderCallbackHandler(func(_ context.Context, cb StandingOrderCallback) error {
+				got = cb
+
+				return tc.fnErr
+			})
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/standingorder", strings.NewReader(tc.body)))
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedStatus != http.StatusBadRequest {
+				assert.Equal(t, "SC8F2IQMH5", got.TransactionID)
+			}
+
+			var resp CallbackResp
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, tc.expectedResp, resp)
+		})
+	}
+
+	_, err := NewStandingOrderCallbackHandler(nil)
+	assert.ErrorIs(t, err, errMissingCallbackHandler)
+}
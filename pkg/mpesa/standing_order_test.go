@@ -0,0 +1,197 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testStandingOrderReq = StandingOrderReq{
+	StandingOrderName:           "Gym Membership",
+	StartDate:                   "20240301",
+	EndDate:                     "20250301",
+	BusinessShortCode:           174379,
+	TransactionType:             "Standing Order Customer Pay Bill",
+	ReceiverPartyIdentifierType: 4,
+	Amount:                      500,
+	PartyA:                      254722000000,
+	CallBackURL:                 "https://example.com/standingorder",
+	AccountReference:            "GYM001",
+	TransactionDesc:             "Membership",
+	Frequency:                   StandingOrderMonthly,
+}
+
+func TestStandingOrder(t *testing.T) {
+	testCases := []struct {
+		name         string
+		response     string
+		expectedResp StandingOrderResp
+	}{
+		{
+			name:     "nested response header",
+			response: `{"ResponseHeader":{"responseRefID":"4dd9b5d9","responseCode":"200","responseDescription":"Request accepted for processing","ResultDesc":"The service request is processed successfully."},"ResponseBody":{"responseDescription":"Request accepted for processing","responseCode":"200"}}`,
+			expectedResp: StandingOrderResp{
+				ResponseRefID:       "4dd9b5d9",
+				ResponseCode:        "200",
+				ResponseDescription: "Request accepted for processing",
+				ResultDesc:          "The service request is processed successfully.",
+			},
+		},
+		{
+			name:     "flat response",
+			response: `{"ResponseRefID":"4dd9b5d9","ResponseCode":"200","ResponseDescription":"Request accepted for processing"}`,
+			expectedResp: StandingOrderResp{
+				ResponseRefID:       "4dd9b5d9",
+				ResponseCode:        "200",
+				ResponseDescription: "Request accepted for processing",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/"+standingOrderEndpoint, r.URL.Path)
+
+				var req map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, "174379", req["BusinessShortCode"])
+				assert.Equal(t, "4", req["ReceiverPartyIdentifierType"])
+				assert.Equal(t, "500", req["Amount"])
+				assert.Equal(t, "254722000000", req["PartyA"])
+				assert.Equal(t, "4", req["Frequency"])
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+			sdk.strict = true
+
+			resp, err := sdk.StandingOrder(context.Background(), testStandingOrderReq)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResp, resp)
+		})
+	}
+}
+
+func TestStandingOrderFrequency(t *testing.T) {
+	assert.Equal(t, "One Off", StandingOrderOneOff.String())
+	assert.Equal(t, "Half Year", StandingOrderHalfYearly.String())
+	assert.Equal(t, "Unknown frequency 9", StandingOrderFrequency(9).String())
+}
+
+func TestStandingOrderValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		req         StandingOrderReq
+		expectedErr error
+	}{
+		{
+			name: "pay bill",
+			req:  testStandingOrderReq,
+		},
+		{
+			name: "buy goods",
+			req: with(testStandingOrderReq, func(r *StandingOrderReq) {
+				r.TransactionType = "Standing Order Customer Pay Marchant"
+				r.ReceiverPartyIdentifierType = 2
+			}),
+		},
+		{
+			name: "one off on the same day",
+			req: with(testStandingOrderReq, func(r *StandingOrderReq) {
+				r.Frequency = StandingOrderOneOff
+				r.EndDate = r.StartDate
+			}),
+		},
+		{
+			name:        "without name",
+			req:         with(testStandingOrderReq, func(r *StandingOrderReq) { r.StandingOrderName = "" }),
+			expectedErr: errInvalidStandingOrderName,
+		},
+		{
+			name:        "invalid shortcode",
+			req:         with(testStandingOrderReq, func(r *StandingOrderReq) { r.BusinessShortCode = 1 }),
+			expectedErr: errInvalidShortCode,
+		},
+		{
+			name:        "invalid transaction type",
+			req:         with(testStandingOrderReq, func(r *StandingOrderReq) { r.TransactionType = "CustomerPayBillOnline" }),
+			expectedErr: errInvalidTransactionType,
+		},
+		{
+			name:        "mismatched identifier type",
+			req:         with(testStandingOrderReq, func(r *StandingOrderReq) { r.ReceiverPartyIdentifierType = 2 }),
+			expectedErr: errInvalidIdentifierType,
+		},
+		{
+			name:        "without amount",
+			req:         with(testStandingOrderReq, func(r *StandingOrderReq) { r.Amount = 0 }),
+			expectedErr: errInvalidAmount,
+		},
+		{
+			name:        "invalid phone number",
+			req:         with(testStandingOrderReq, func(r *StandingOrderReq) { r.PartyA = 722000000 }),
+			expectedErr: errInvalidPhoneNumber,
+		},
+		{
+			name:        "invalid callback url",
+			req:         with(testStandingOrderReq, func(r *StandingOrderReq) { r.CallBackURL = invalidURL }),
+			expectedErr: errInvalidURL,
+		},
+		{
+			name:        "long account reference",
+			req:         with(testStandingOrderReq, func(r *StandingOrderReq) { r.AccountReference = "ACCOUNT-REFERENCE" }),
+			expectedErr: errInvalidAccountReference,
+		},
+		{
+			name:        "long transaction description",
+			req:         with(testStandingOrderReq, func(r *StandingOrderReq) { r.TransactionDesc = "Monthly gym membership" }),
+			expectedErr: errInvalidTransactionDesc,
+		},
+		{
+			name:        "invalid frequency",
+			req:         with(testStandingOrderReq, func(r *StandingOrderReq) { r.Frequency = 9 }),
+			expectedErr: errInvalidFrequency,
+		},
+		{
+			name:        "invalid start date",
+			req:         with(testStandingOrderReq, func(r *StandingOrderReq) { r.StartDate = "2024-03-01" }),
+			expectedErr: errInvalidDate,
+		},
+		{
+			name:        "invalid end date",
+			req:         with(testStandingOrderReq, func(r *StandingOrderReq) { r.EndDate = "01032025" }),
+			expectedErr: errInvalidDate,
+		},
+		{
+			name:        "end before start",
+			req:         with(testStandingOrderReq, func(r *StandingOrderReq) { r.EndDate = "20240201" }),
+			expectedErr: errInvalidDateRange,
+		},
+		{
+			name:        "recurring on the same day",
+			req:         with(testStandingOrderReq, func(r *StandingOrderReq) { r.EndDate = r.StartDate }),
+			expectedErr: errInvalidDateRange,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.req.Validate(), tc.expectedErr)
+		})
+	}
+}
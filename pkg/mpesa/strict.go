@@ -0,0 +1,93 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	acceptedResponseCode            = "0"
+	acceptedQRResponseCode          = "00"
+	acceptedBillManagerResponseCode = "200"
+	acceptedPullResponseCode        = "1000"
+	acceptedRatibaResponseCode      = "200"
+	acceptedSIMSwapResponseCode     = "200"
+)
+
+// ErrRejected indicates that Daraja answered with 200 OK but did not accept
+// the request. It is only returned in strict mode.
+var ErrRejected = errors.New("request rejected")
+
+// WithStrictMode makes every operation inspect the ResponseCode of a 200 OK
+// response and return a *RejectionError unless the request was accepted,
+// that is unless the code is "0", "00" for GenerateQR, "200" for the Bill
+// Manager operations, StandingOrder and SIMSwap or "1000" for the Pull
+// Transactions operations.
+func WithStrictMode() Option {
+	return withSDK(func(sdk *mSDK) error {
+		sdk.strict = true
+
+		return nil
+	})
+}
+
+// RejectionError is returned in strict mode when Daraja answers with 200 OK
+// and a ResponseCode other than accepted.
+type RejectionError struct {
+	Op                  Operation
+	ResponseCode        string
+	ResponseDescription string
+	Body                []byte // The raw response body.
+}
+
+func (e *RejectionError) Error() string {
+	return fmt.Sprintf("%s: %v: response code %q: %s", e.Op, ErrRejected, e.ResponseCode, e.ResponseDescription)
+}
+
+func (e *RejectionError) Unwrap() error {
+	return ErrRejected
+}
+
+// checkResponseCode returns a *RejectionError in strict mode if the response
+// code does not mean that the request was accepted.
+func (sdk mSDK) checkResponseCode(op Operation, code, description string, body []byte) error {
+	if !sdk.strict {
+		return nil
+	}
+
+	if code == acceptedCode(op) {
+		return nil
+	}
+
+	return &RejectionError{
+		Op:                  op,
+		ResponseCode:        code,
+		ResponseDescription: description,
+		Body:                body,
+	}
+}
+
+// acceptedCode returns the response code of an accepted request.
+func acceptedCode(op Operation) string {
+	switch op {
+	case OpGenerateQR:
+		return acceptedQRResponseCode
+	case OpBillManagerOptIn, OpBillManagerUpdateOptIn, OpBillManagerSingleInvoice,
+		OpBillManagerBulkInvoice, OpBillManagerCancelInvoice, OpBillManagerReconcile:
+		return acceptedBillManagerResponseCode
+	case OpPullTransactionsRegister, OpPullTransactionsQuery:
+		return acceptedPullResponseCode
+	case OpStandingOrder:
+		return acceptedRatibaResponseCode
+	case OpSIMSwap:
+		return acceptedSIMSwapResponseCode
+	default:
+		return acceptedResponseCode
+	}
+}
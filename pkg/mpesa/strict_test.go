@@ -0,0 +1,252 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testGenerateQRReq = GenerateQRReq{
+	MerchantName: "Test Supermarket",
+	RefNo:        "Invoice No",
+	Amount:       2000,
+	TrxCode:      "BG",
+	CPI:          "174379",
+	Size:         "300",
+}
+
+func TestStrictMode(t *testing.T) {
+	testCases := []struct {
+		name         string
+		strict       bool
+		response     interface{}
+		call         func(sdk mSDK) error
+		expectedCode string
+		rejected     bool
+	}{
+		{
+			name:     "accepted b2c payment",
+			strict:   true,
+			response: B2CPaymentResp{ValidResp: ValidResp{ResponseCode: "0", ResponseDescription: "Accept the service request successfully."}},
+			call: func(sdk mSDK) error {
+				_, err := sdk.B2CPayment(context.Background(), testB2CPaymentReq)
+
+				return err
+			},
+		},
+		{
+			name:     "rejected b2c payment",
+			strict:   true,
+			response: B2CPaymentResp{ValidResp: ValidResp{ResponseCode: "1", ResponseDescription: "Rejected"}},
+			call: func(sdk mSDK) error {
+				_, err := sdk.B2CPayment(context.Background(), testB2CPaymentReq)
+
+				return err
+			},
+			expectedCode: "1",
+			rejected:     true,
+		},
+		{
+			name:     "rejected b2c payment without strict mode",
+			response: B2CPaymentResp{ValidResp: ValidResp{ResponseCode: "1", ResponseDescription: "Rejected"}},
+			call: func(sdk mSDK) error {
+				resp, err := sdk.B2CPayment(context.Background(), testB2CPaymentReq)
+				assert.Equal(t, "1", resp.ResponseCode)
+
+				return err
+			},
+		},
+		{
+			name:     "rejected b2c account top up",
+			strict:   true,
+			response: B2CAccountTopUpResp{ValidResp: ValidResp{ResponseCode: "1", ResponseDescription: "Rejected"}},
+			call: func(sdk mSDK) error {
+				_, err := sdk.B2CAccountTopUp(context.Background(), testB2CAccountTopUpReq)
+
+				return err
+			},
+			expectedCode: "1",
+			rejected:     true,
+		},
+		{
+			name:     "rejected b2pochi",
+			strict:   true,
+			response: B2PochiResp{ValidResp: ValidResp{ResponseCode: "1", ResponseDescription: "Rejected"}},
+			call: func(sdk mSDK) error {
+				_, err := sdk.B2Pochi(context.Background(), testB2PochiReq)
+
+				return err
+			},
+			expectedCode: "1",
+			rejected:     true,
+		},
+		{
+			name:     "missing response code",
+			strict:   true,
+			response: ExpressQueryResp{ResponseDescription: "Unexpected"},
+			call: func(sdk mSDK) error {
+				_, err := sdk.ExpressQuery(context.Background(), testExpressQueryReq)
+
+				return err
+			},
+			rejected: true,
+		},
+		{
+			name:     "cancelled express query is accepted",
+			strict:   true,
+			response: ExpressQueryResp{ResponseCode: "0", ResultCode: ResultCodeCancelledByUser},
+			call: func(sdk mSDK) error {
+				_, err := sdk.ExpressQuery(context.Background(), testExpressQueryReq)
+
+				return err
+			},
+		},
+		{
+			name:     "accepted qr code",
+			strict:   true,
+			response: GenerateQRResp{ResponseCode: "00", QRCode: "qr_code"},
+			call: func(sdk mSDK) error {
+				_, err := sdk.GenerateQR(context.Background(), testGenerateQRReq)
+
+				return err
+			},
+		},
+		{
+			name:     "rejected qr code",
+			strict:   true,
+			response: GenerateQRResp{ResponseCode: "01", ResponseDescription: "Invalid CPI"},
+			call: func(sdk mSDK) error {
+				_, err := sdk.GenerateQR(context.Background(), testGenerateQRReq)
+
+				return err
+			},
+			expectedCode: "01",
+			rejected:     true,
+		},
+		{
+			name:     "accepted bill manager invoice",
+			strict:   true,
+			response: BillManagerSingleInvoiceResp{BillManagerResp: BillManagerResp{ResponseMessage: "Success", ResponseCode: "200"}},
+			call: func(sdk mSDK) error {
+				_, err := sdk.BillManagerSingleInvoice(context.Background(), testBillManagerInvoiceReq)
+
+				return err
+			},
+		},
+		{
+			name:     "rejected bill manager invoice",
+			strict:   true,
+			response: BillManagerSingleInvoiceResp{BillManagerResp: BillManagerResp{ResponseMessage: "Failed", ResponseCode: "0"}},
+			call: func(sdk mSDK) error {
+				_, err := sdk.BillManagerSingleInvoice(context.Background(), testBillManagerInvoiceReq)
+
+				return err
+			},
+			expectedCode: "0",
+			rejected:     true,
+		},
+		{
+			name:     "accepted pull transactions registration",
+			strict:   true,
+			response: PullTransactionsRegisterResp{ResponseCode: "1000", ResponseMessage: "Success"},
+			call: func(sdk mSDK) error {
+				_, err := sdk.PullTransactionsRegister(context.Background(), testPullTransactionsRegisterReq)
+
+				return err
+			},
+		},
+		{
+			name:     "rejected pull transactions registration",
+			strict:   true,
+			response: PullTransactionsRegisterResp{ResponseCode: "1001", ResponseMessage: "Shortcode already registered"},
+			call: func(sdk mSDK) error {
+				_, err := sdk.PullTransactionsRegister(context.Background(), testPullTransactionsRegisterReq)
+
+				return err
+			},
+			expectedCode: "1001",
+			rejected:     true,
+		},
+		{
+			name:     "accepted standing order",
+			strict:   true,
+			response: StandingOrderResp{ResponseCode: "200", ResponseDescription: "Request accepted for processing"},
+			call: func(sdk mSDK) error {
+				_, err := sdk.StandingOrder(context.Background(), testStandingOrderReq)
+
+				return err
+			},
+		},
+		{
+			name:     "rejected standing order",
+			strict:   true,
+			response: StandingOrderResp{ResponseCode: "401", ResponseDescription: "Invalid Request"},
+			call: func(sdk mSDK) error {
+				_, err := sdk.StandingOrder(context.Background(), testStandingOrderReq)
+
+				return err
+			},
+			expectedCode: "401",
+			rejected:     true,
+		},
+		{
+			name:     "rejected b2b express checkout",
+			strict:   true,
+			response: B2BExpressCheckoutResp{Code: "1", Status: "Invalid shortcode"},
+			call: func(sdk mSDK) error {
+				_, err := sdk.B2BExpressCheckout(context.Background(), testB2BExpressCheckoutReq)
+
+				return err
+			},
+			expectedCode: "1",
+			rejected:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, _ := flakyServer(t, 0, 0, tc.response)
+			defer server.Close()
+
+			sdk := newRetrySDK(t, server.URL, server.Client(), nil)
+			sdk.cert = testCertificate(t)
+			sdk.strict = tc.strict
+
+			err := tc.call(sdk)
+			if !tc.rejected {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.ErrorIs(t, err, ErrRejected)
+
+			var rejectionErr *RejectionError
+			require.True(t, errors.As(err, &rejectionErr))
+			assert.Equal(t, tc.expectedCode, rejectionErr.ResponseCode)
+
+			body, err := json.Marshal(tc.response)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(body), string(rejectionErr.Body))
+		})
+	}
+}
+
+func TestWithStrictMode(t *testing.T) {
+	sdk, err := NewSDK(Config{Environment: Sandbox, AppKey: appKey, AppSecret: appSecret}, WithStrictMode())
+	require.NoError(t, err)
+
+	msdk, ok := sdk.(*mSDK)
+	require.True(t, ok)
+	assert.True(t, msdk.strict)
+}
@@ -8,20 +8,25 @@ package mpesa
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 )
 
-func (sdk mSDK) RemitTax(rReq RemitTaxReq) (RemitTaxResp, error) {
+func (sdk mSDK) RemitTax(ctx context.Context, rReq RemitTaxReq) (RemitTaxResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpRemitTax)
+	defer cancel()
+
 	if err := rReq.Validate(); err != nil {
 		return RemitTaxResp{}, err
 	}
 
-	var err error
-	rReq.SecurityCredential, err = sdk.generateSecurityCredential(rReq.InitiatorPassword)
-	if err != nil {
-		return RemitTaxResp{}, err
+	if rReq.SecurityCredential == "" {
+		var err error
+		rReq.SecurityCredential, err = sdk.generateSecurityCredential(ctx, rReq.InitiatorPassword)
+		if err != nil {
+			return RemitTaxResp{}, err
+		}
 	}
 
 	data, err := json.Marshal(rReq)
@@ -29,14 +34,14 @@ func (sdk mSDK) RemitTax(rReq RemitTaxReq) (RemitTaxResp, error) {
 		return RemitTaxResp{}, err
 	}
 
-	url := fmt.Sprintf("%s/%s", sdk.baseURL, taxEndpoint)
+	url := sdk.url(OpRemitTax)
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
 	if err != nil {
 		return RemitTaxResp{}, err
 	}
 
-	resp, err := sdk.sendRequest(req)
+	resp, err := sdk.sendRequest(OpRemitTax, req)
 	if err != nil {
 		return RemitTaxResp{}, err
 	}
@@ -46,5 +51,9 @@ func (sdk mSDK) RemitTax(rReq RemitTaxReq) (RemitTaxResp, error) {
 		return RemitTaxResp{}, err
 	}
 
+	if err := sdk.checkResponseCode(OpRemitTax, tr.ResponseCode, tr.ResponseDescription, resp); err != nil {
+		return RemitTaxResp{}, err
+	}
+
 	return tr, nil
 }
@@ -0,0 +1,251 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const defaultTokenRefreshMargin = 1 * time.Minute
+
+var (
+	// ErrTokenNotFound is returned by a TokenStore when it holds no token.
+	ErrTokenNotFound = errors.New("token not found")
+
+	// errInvalidTokenExpiry indicates the token expiry could not be parsed.
+	errInvalidTokenExpiry = errors.New("invalid token expiry")
+)
+
+// CachedToken is an access token together with the time it expires.
+type CachedToken struct {
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// validAt reports whether the token can still be used at the given time.
+func (ct CachedToken) validAt(t time.Time) bool {
+	return ct.AccessToken != "" && t.Before(ct.ExpiresAt)
+}
+
+// TokenStore stores access tokens so that they can be reused until they expire.
+//
+// Implementations must be safe for concurrent use. A store that is shared
+// between several overlay replicas lets them all use the same token.
+type TokenStore interface {
+	// Load returns the stored token or ErrTokenNotFound if there is none.
+	Load(ctx context.Context) (CachedToken, error)
+
+	// Save stores the token, replacing any existing one.
+	Save(ctx context.Context, token CachedToken) error
+}
+
+// ExpiresIn returns the lifetime of the token as reported by Daraja.
+func (tr TokenResp) ExpiresIn() (time.Duration, error) {
+	seconds, err := strconv.ParseInt(strings.TrimSpace(tr.Expiry), 10, 64)
+	if err != nil || seconds <= 0 {
+		return 0, fmt.Errorf("%w: %q", errInvalidTokenExpiry, tr.Expiry)
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
+
+type memoryTokenStore struct {
+	mu    sync.RWMutex
+	token CachedToken
+}
+
+var _ TokenStore = (*memoryTokenStore)(nil)
+
+// NewMemoryTokenStore returns a TokenStore that keeps the token in memory.
+func NewMemoryTokenStore() TokenStore {
+	return &memoryTokenStore{}
+}
+
+func (s *memoryTokenStore) Load(_ context.Context) (CachedToken, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.token.AccessToken == "" {
+		return CachedToken{}, ErrTokenNotFound
+	}
+
+	return s.token, nil
+}
+
+func (s *memoryTokenStore) Save(_ context.Context, token CachedToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = token
+
+	return nil
+}
+
+type fileTokenStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+var _ TokenStore = (*fileTokenStore)(nil)
+
+// NewFileTokenStore returns a TokenStore that keeps the token in a JSON file.
+//
+// The file is replaced atomically on every save so that processes sharing it,
+// for example through a mounted volume, never read a partially written token.
+func NewFileTokenStore(path string) TokenStore {
+	return &fileTokenStore{path: path}
+}
+
+func (s *fileTokenStore) Load(_ context.Context) (CachedToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return CachedToken{}, ErrTokenNotFound
+		}
+
+		return CachedToken{}, fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	var token CachedToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return CachedToken{}, fmt.Errorf("failed to decode token file: %w", err)
+	}
+	if token.AccessToken == "" {
+		return CachedToken{}, ErrTokenNotFound
+	}
+
+	return token, nil
+}
+
+func (s *fileTokenStore) Save(_ context.Context, token CachedToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("failed to encode token: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create token file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace token file: %w", err)
+	}
+
+	return nil
+}
+
+// tokenCache hands out cached access tokens and refreshes them ahead of expiry.
+// Concurrent callers that need a new token share a single refresh.
+type tokenCache struct {
+	store  TokenStore
+	margin time.Duration
+	group  singleflight.Group
+}
+
+// tokenFetcher requests a new access token from Daraja.
+type tokenFetcher func(ctx context.Context) (TokenResp, error)
+
+func newTokenCache(store TokenStore, margin time.Duration) *tokenCache {
+	if store == nil {
+		store = NewMemoryTokenStore()
+	}
+	if margin <= 0 {
+		margin = defaultTokenRefreshMargin
+	}
+
+	return &tokenCache{
+		store:  store,
+		margin: margin,
+	}
+}
+
+// token returns a valid access token, refreshing it if needed.
+func (tc *tokenCache) token(ctx context.Context, fetch tokenFetcher) (string, error) {
+	if token, err := tc.store.Load(ctx); err == nil && token.validAt(time.Now().Add(tc.margin)) {
+		return token.AccessToken, nil
+	}
+
+	return tc.refresh(ctx, fetch, "")
+}
+
+// refresh fetches a new access token unless the store already holds a valid
+// token other than rejected, which is a token Daraja refused.
+func (tc *tokenCache) refresh(ctx context.Context, fetch tokenFetcher, rejected string) (string, error) {
+	// The refresh is detached from the caller's cancellation since other callers
+	// may be waiting on it; each caller still stops waiting when its context ends.
+	ch := tc.group.DoChan("token", func() (interface{}, error) {
+		rctx := context.WithoutCancel(ctx)
+
+		if token, err := tc.store.Load(rctx); err == nil && token.AccessToken != rejected && token.validAt(time.Now().Add(tc.margin)) {
+			return token.AccessToken, nil
+		}
+
+		resp, err := fetch(rctx)
+		if err != nil {
+			return "", err
+		}
+
+		lifetime, err := resp.ExpiresIn()
+		if err != nil {
+			return "", err
+		}
+
+		token := CachedToken{
+			AccessToken: resp.AccessToken,
+			ExpiresAt:   time.Now().Add(lifetime),
+		}
+		if err := tc.store.Save(rctx, token); err != nil {
+			return "", fmt.Errorf("failed to save token: %w", err)
+		}
+
+		return token.AccessToken, nil
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return "", res.Err
+		}
+
+		return res.Val.(string), nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
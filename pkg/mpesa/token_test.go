@@ -0,0 +1,241 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpiresIn(t *testing.T) {
+	testCases := []struct {
+		name        string
+		expiry      string
+		expected    time.Duration
+		expectedErr error
+	}{
+		{
+			name:     "valid expiry",
+			expiry:   "3599",
+			expected: 3599 * time.Second,
+		},
+		{
+			name:     "valid expiry with spaces",
+			expiry:   " 3599 ",
+			expected: 3599 * time.Second,
+		},
+		{
+			name:        "empty expiry",
+			expiry:      "",
+			expectedErr: errInvalidTokenExpiry,
+		},
+		{
+			name:        "non numeric expiry",
+			expiry:      "one hour",
+			expectedErr: errInvalidTokenExpiry,
+		},
+		{
+			name:        "negative expiry",
+			expiry:      "-1",
+			expectedErr: errInvalidTokenExpiry,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := TokenResp{Expiry: tc.expiry}.ExpiresIn()
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestTokenStores(t *testing.T) {
+	stores := map[string]TokenStore{
+		"memory": NewMemoryTokenStore(),
+		"file":   NewFileTokenStore(filepath.Join(t.TempDir(), "token.json")),
+	}
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			_, err := store.Load(ctx)
+			assert.ErrorIs(t, err, ErrTokenNotFound)
+
+			token := CachedToken{
+				AccessToken: accessToken,
+				ExpiresAt:   time.Now().Add(time.Hour).Truncate(time.Second),
+			}
+			require.NoError(t, store.Save(ctx, token))
+
+			got, err := store.Load(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, token.AccessToken, got.AccessToken)
+			assert.True(t, token.ExpiresAt.Equal(got.ExpiresAt))
+		})
+	}
+}
+
+func TestFileTokenStoreIsShared(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	writer, reader := NewFileTokenStore(path), NewFileTokenStore(path)
+
+	token := CachedToken{AccessToken: accessToken, ExpiresAt: time.Now().Add(time.Hour)}
+	require.NoError(t, writer.Save(context.Background(), token))
+
+	got, err := reader.Load(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, accessToken, got.AccessToken)
+}
+
+// newTokenTestServer returns a server that issues numbered tokens and accepts
+// the query endpoint. The accept function decides whether a token is authorized.
+func newTokenTestServer(t *testing.T, expiry string, accept func(token string) bool) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+
+	var issued atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodGet && r.URL.Path == "/"+strings.Split(authEndpoint, "?")[0] {
+			n := issued.Add(1)
+			tr := TokenResp{AccessToken: accessToken + string(rune('0'+n)), Expiry: expiry}
+			if err := json.NewEncoder(w).Encode(tr); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+
+			return
+		}
+
+		if !accept(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(RespError{Code: "404.001.03", Message: "Invalid Access Token"})
+
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(ExpressQueryResp{ResponseCode: "0"}); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &issued
+}
+
+func TestSendRequestReusesToken(t *testing.T) {
+	server, issued := newTokenTestServer(t, "3599", func(string) bool { return true })
+
+	sdk := mSDK{
+		baseURL: server.URL,
+		client:  server.Client(),
+		tokens:  newTokenCache(nil, 0),
+	}
+
+	req := ExpressQueryReq{PassKey: passKey, BusinessShortCode: 174379, CheckoutRequestID: "ws_CO_1"}
+	for i := 0; i < 3; i++ {
+		_, err := sdk.ExpressQuery(context.Background(), req)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int64(1), issued.Load(), "expected a single token request")
+}
+
+func TestSendRequestRefreshesAheadOfExpiry(t *testing.T) {
+	// The token lives for 30 seconds which is within the one minute refresh
+	// margin, so every call must fetch a new token.
+	server, issued := newTokenTestServer(t, "30", func(string) bool { return true })
+
+	sdk := mSDK{
+		baseURL: server.URL,
+		client:  server.Client(),
+		tokens:  newTokenCache(nil, time.Minute),
+	}
+
+	req := ExpressQueryReq{PassKey: passKey, BusinessShortCode: 174379, CheckoutRequestID: "ws_CO_1"}
+	for i := 0; i < 2; i++ {
+		_, err := sdk.ExpressQuery(context.Background(), req)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int64(2), issued.Load())
+}
+
+func TestSendRequestSharesRefresh(t *testing.T) {
+	server, issued := newTokenTestServer(t, "3599", func(string) bool { return true })
+
+	sdk := mSDK{
+		baseURL: server.URL,
+		client:  server.Client(),
+		tokens:  newTokenCache(nil, 0),
+	}
+
+	var wg sync.WaitGroup
+	req := ExpressQueryReq{PassKey: passKey, BusinessShortCode: 174379, CheckoutRequestID: "ws_CO_1"}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := sdk.ExpressQuery(context.Background(), req); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(1), issued.Load(), "expected concurrent callers to share one token request")
+}
+
+func TestSendRequestRetriesOnUnauthorized(t *testing.T) {
+	// Only the second issued token is accepted.
+	server, issued := newTokenTestServer(t, "3599", func(token string) bool { return token == accessToken+"2" })
+
+	store := NewMemoryTokenStore()
+	sdk := mSDK{
+		baseURL: server.URL,
+		client:  server.Client(),
+		tokens:  newTokenCache(store, 0),
+	}
+
+	req := ExpressQueryReq{PassKey: passKey, BusinessShortCode: 174379, CheckoutRequestID: "ws_CO_1"}
+	resp, err := sdk.ExpressQuery(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "0", resp.ResponseCode)
+	assert.Equal(t, int64(2), issued.Load())
+
+	stored, err := store.Load(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, accessToken+"2", stored.AccessToken)
+}
+
+func TestSendRequestRetriesOnUnauthorizedOnce(t *testing.T) {
+	server, issued := newTokenTestServer(t, "3599", func(string) bool { return false })
+
+	sdk := mSDK{
+		baseURL: server.URL,
+		client:  server.Client(),
+		tokens:  newTokenCache(nil, 0),
+	}
+
+	req := ExpressQueryReq{PassKey: passKey, BusinessShortCode: 174379, CheckoutRequestID: "ws_CO_1"}
+	_, err := sdk.ExpressQuery(context.Background(), req)
+	assert.ErrorIs(t, err, errFailedToSendReq)
+	assert.Equal(t, int64(2), issued.Load())
+}
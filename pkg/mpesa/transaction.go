@@ -8,20 +8,25 @@ package mpesa
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 )
 
-func (sdk mSDK) TransactionStatus(tReq TransactionStatusReq) (TransactionStatusResp, error) {
+func (sdk mSDK) TransactionStatus(ctx context.Context, tReq TransactionStatusReq) (TransactionStatusResp, error) {
+	ctx, cancel := sdk.operationContext(ctx, OpTransactionStatus)
+	defer cancel()
+
 	if err := tReq.Validate(); err != nil {
 		return TransactionStatusResp{}, err
 	}
 
-	var err error
-	tReq.SecurityCredential, err = sdk.generateSecurityCredential(tReq.InitiatorPassword)
-	if err != nil {
-		return TransactionStatusResp{}, err
+	if tReq.SecurityCredential == "" {
+		var err error
+		tReq.SecurityCredential, err = sdk.generateSecurityCredential(ctx, tReq.InitiatorPassword)
+		if err != nil {
+			return TransactionStatusResp{}, err
+		}
 	}
 
 	data, err := json.Marshal(tReq)
@@ -29,14 +34,14 @@ func (sdk mSDK) TransactionStatus(tReq TransactionStatusReq) (TransactionStatusR
 		return TransactionStatusResp{}, err
 	}
 
-	url := fmt.Sprintf("%s/%s", sdk.baseURL, transactionEndpoint)
+	url := sdk.url(OpTransactionStatus)
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
 	if err != nil {
 		return TransactionStatusResp{}, err
 	}
 
-	resp, err := sdk.sendRequest(req)
+	resp, err := sdk.sendRequest(OpTransactionStatus, req)
 	if err != nil {
 		return TransactionStatusResp{}, err
 	}
@@ -46,5 +51,9 @@ func (sdk mSDK) TransactionStatus(tReq TransactionStatusReq) (TransactionStatusR
 		return TransactionStatusResp{}, err
 	}
 
+	if err := sdk.checkResponseCode(OpTransactionStatus, tr.ResponseCode, tr.ResponseDescription, resp); err != nil {
+		return TransactionStatusResp{}, err
+	}
+
 	return tr, nil
 }
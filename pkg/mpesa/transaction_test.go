@@ -7,6 +7,7 @@
 package mpesa
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -45,6 +46,43 @@ func TestTransactionStatus(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			name:       "success with originator conversation id",
+			statusCode: http.StatusOK,
+			request: TransactionStatusReq{
+				InitiatorName:            initiatorName,
+				InitiatorPassword:        initiatorPassword,
+				CommandID:                "TransactionStatusQuery",
+				QueueTimeOutURL:          "https://example.com/timeout",
+				ResultURL:                "https://example.com/result",
+				Remarks:                  "test",
+				Occasion:                 "test",
+				OriginatorConversationID: "feb5e3f2-fbbc-4745-844c-ee37b546f627",
+				IdentifierType:           4,
+				PartyA:                   600986,
+			},
+			expectedResponse: TransactionStatusResp{
+				ValidResp: validResp,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:       "failure without transaction id",
+			statusCode: http.StatusInternalServerError,
+			request: TransactionStatusReq{
+				InitiatorName:     initiatorName,
+				InitiatorPassword: initiatorPassword,
+				CommandID:         "TransactionStatusQuery",
+				QueueTimeOutURL:   "https://example.com/timeout",
+				ResultURL:         "https://example.com/result",
+				Remarks:           "test",
+				Occasion:          "test",
+				IdentifierType:    1,
+				PartyA:            254759764065,
+			},
+			expectedResponse: TransactionStatusResp{},
+			expectedErr:      errInvalidTransactionID,
+		},
 		{
 			name:       "failure with invalid CommandID",
 			statusCode: http.StatusInternalServerError,
@@ -194,11 +232,11 @@ func TestTransactionStatus(t *testing.T) {
 				baseURL:   server.URL,
 				appKey:    appKey,
 				appSecret: appSecret,
-				certFile:  sandboxCertificate,
+				cert:      testCertificate(t),
 				client:    server.Client(),
 			}
 
-			response, err := sdk.TransactionStatus(tc.request)
+			response, err := sdk.TransactionStatus(context.Background(), tc.request)
 			assert.ErrorIs(t, err, tc.expectedErr, "%s: Expected error '%v', got '%v'", tc.name, tc.expectedErr, err)
 
 			if !reflect.DeepEqual(response, tc.expectedResponse) {
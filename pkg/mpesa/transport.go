@@ -0,0 +1,279 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+var (
+	// errTransportOptionOrder indicates that a transport option was applied to a
+	// middleware instead of the sdk. Transport options must come before middlewares.
+	errTransportOptionOrder = errors.New("transport options must be passed before middleware options")
+
+	// errUnsupportedTransport indicates that the http client does not use an *http.Transport.
+	errUnsupportedTransport = errors.New("transport options require the http client to use an *http.Transport")
+
+	// errInvalidCABundle indicates that a CA bundle has no PEM encoded certificates.
+	errInvalidCABundle = errors.New("invalid CA bundle")
+
+	// errInvalidTimeout indicates that a timeout is not positive.
+	errInvalidTimeout = errors.New("invalid timeout, must be positive")
+)
+
+// newHTTPClient returns the http client used when Config.HTTPClient is not set.
+func newHTTPClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   defaultTimeout,
+	}
+}
+
+// withSDK returns an option that configures the underlying sdk.
+func withSDK(fn func(sdk *mSDK) error) Option {
+	return func(s SDK) (SDK, error) {
+		sdk, ok := s.(*mSDK)
+		if !ok {
+			return nil, errTransportOptionOrder
+		}
+
+		if err := fn(sdk); err != nil {
+			return nil, err
+		}
+
+		return sdk, nil
+	}
+}
+
+// withTransport returns an option that configures the http transport. The
+// client and transport are copied first so that a client passed through
+// Config.HTTPClient or WithHTTPClient is never modified.
+func withTransport(fn func(transport *http.Transport) error) Option {
+	return withSDK(func(sdk *mSDK) error {
+		var transport *http.Transport
+		switch rt := sdk.client.Transport.(type) {
+		case nil:
+			transport = http.DefaultTransport.(*http.Transport).Clone()
+		case *http.Transport:
+			transport = rt.Clone()
+		default:
+			return errUnsupportedTransport
+		}
+
+		if err := fn(transport); err != nil {
+			return err
+		}
+
+		client := *sdk.client
+		client.Transport = transport
+		sdk.client = &client
+
+		return nil
+	})
+}
+
+// WithHTTPClient sets the http client used to call Daraja. It has the same
+// effect as Config.HTTPClient.
+func WithHTTPClient(client *http.Client) Option {
+	return withSDK(func(sdk *mSDK) error {
+		sdk.client = client
+		if sdk.client == nil {
+			sdk.client = newHTTPClient()
+		}
+
+		return nil
+	})
+}
+
+// WithRoundTripper sets the transport of the http client, for example to add
+// tracing or request signing. Other transport options cannot be applied after it
+// unless rt is an *http.Transport.
+func WithRoundTripper(rt http.RoundTripper) Option {
+	return withSDK(func(sdk *mSDK) error {
+		client := *sdk.client
+		client.Transport = rt
+		sdk.client = &client
+
+		return nil
+	})
+}
+
+// WithTimeout sets the overall timeout of a single http request.
+// It defaults to one minute.
+func WithTimeout(timeout time.Duration) Option {
+	return withSDK(func(sdk *mSDK) error {
+		if timeout <= 0 {
+			return errInvalidTimeout
+		}
+		client := *sdk.client
+		client.Timeout = timeout
+		sdk.client = &client
+
+		return nil
+	})
+}
+
+// WithOperationTimeout bounds the time spent on an operation, including
+// fetching an access token and the certificate. It applies on top of the
+// deadline of the context passed to the operation.
+func WithOperationTimeout(op Operation, timeout time.Duration) Option {
+	return withSDK(func(sdk *mSDK) error {
+		if _, ok := defaultEndpoints[op]; !ok {
+			return fmt.Errorf("%w: %q", errUnknownOperation, op)
+		}
+		if timeout <= 0 {
+			return errInvalidTimeout
+		}
+
+		timeouts := make(map[Operation]time.Duration, len(sdk.timeouts)+1)
+		for k, v := range sdk.timeouts {
+			timeouts[k] = v
+		}
+		timeouts[op] = timeout
+		sdk.timeouts = timeouts
+
+		return nil
+	})
+}
+
+// WithProxy routes requests through the given proxy, for example a corporate
+// egress proxy. Use http.ProxyFromEnvironment to honor HTTPS_PROXY and NO_PROXY,
+// which is also the default.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) Option {
+	return withTransport(func(transport *http.Transport) error {
+		transport.Proxy = proxy
+
+		return nil
+	})
+}
+
+// WithProxyURL routes all requests through the proxy at the given url.
+func WithProxyURL(proxyURL *url.URL) Option {
+	return WithProxy(http.ProxyURL(proxyURL))
+}
+
+// WithRootCAs sets the certificate authorities trusted when connecting to Daraja
+// or to a TLS intercepting proxy.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return withTransport(func(transport *http.Transport) error {
+		transport.TLSClientConfig = tlsConfig(transport)
+		transport.TLSClientConfig.RootCAs = pool
+
+		return nil
+	})
+}
+
+// WithCABundle adds the PEM encoded certificates in the given files to the
+// system certificate authorities.
+func WithCABundle(paths ...string) Option {
+	return withTransport(func(transport *http.Transport) error {
+		config := tlsConfig(transport)
+
+		pool := config.RootCAs
+		if pool == nil {
+			var err error
+			if pool, err = x509.SystemCertPool(); err != nil {
+				pool = x509.NewCertPool()
+			}
+		}
+
+		for _, path := range paths {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("failed to read CA bundle: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(data) {
+				return fmt.Errorf("%w: no certificates found in %s", errInvalidCABundle, path)
+			}
+		}
+
+		config.RootCAs = pool
+		transport.TLSClientConfig = config
+
+		return nil
+	})
+}
+
+// WithConnectionPool tunes the idle connection pool. A zero value keeps the
+// current setting of the corresponding field on http.Transport.
+func WithConnectionPool(maxIdleConns, maxIdleConnsPerHost, maxConnsPerHost int, idleConnTimeout time.Duration) Option {
+	return withTransport(func(transport *http.Transport) error {
+		if maxIdleConns > 0 {
+			transport.MaxIdleConns = maxIdleConns
+		}
+		if maxIdleConnsPerHost > 0 {
+			transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+		}
+		if maxConnsPerHost > 0 {
+			transport.MaxConnsPerHost = maxConnsPerHost
+		}
+		if idleConnTimeout > 0 {
+			transport.IdleConnTimeout = idleConnTimeout
+		}
+
+		return nil
+	})
+}
+
+// WithKeepAlive sets the interval between TCP keep-alive probes. A negative
+// interval disables keep-alives and connection reuse.
+func WithKeepAlive(interval time.Duration) Option {
+	return withTransport(func(transport *http.Transport) error {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: interval,
+		}
+		transport.DialContext = dialer.DialContext
+		transport.DisableKeepAlives = interval < 0
+
+		return nil
+	})
+}
+
+// WithHTTP2 enables or disables HTTP/2. It is enabled by default.
+func WithHTTP2(enabled bool) Option {
+	return withTransport(func(transport *http.Transport) error {
+		transport.ForceAttemptHTTP2 = enabled
+		transport.TLSNextProto = nil
+		if !enabled {
+			// A non-nil empty map disables HTTP/2 on the transport.
+			transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+		}
+
+		return nil
+	})
+}
+
+// tlsConfig returns a copy of the transport's TLS configuration.
+func tlsConfig(transport *http.Transport) *tls.Config {
+	if transport.TLSClientConfig == nil {
+		return &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	return transport.TLSClientConfig.Clone()
+}
+
+// operationContext applies the timeout configured for the operation, if any.
+func (sdk mSDK) operationContext(ctx context.Context, op Operation) (context.Context, context.CancelFunc) {
+	if timeout, ok := sdk.timeouts[op]; ok {
+		return context.WithTimeout(ctx, timeout)
+	}
+
+	return ctx, func() {}
+}
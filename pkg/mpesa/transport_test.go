@@ -0,0 +1,275 @@
+// Copyright (c) MpesaOverlay. All rights reserved.
+// Use of this source code is governed by a Apache-2.0 license that can be
+// found in the LICENSE file.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// darajaHandler answers token and express query requests after the given delay.
+func darajaHandler(delay time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if strings.HasSuffix(r.URL.Path, "/"+strings.Split(authEndpoint, "?")[0]) {
+			_ = json.NewEncoder(w).Encode(TokenResp{AccessToken: accessToken, Expiry: "3599"})
+
+			return
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		_ = json.NewEncoder(w).Encode(ExpressQueryResp{ResponseCode: "0"})
+	})
+}
+
+var testExpressQueryReq = ExpressQueryReq{
+	PassKey:           passKey,
+	BusinessShortCode: 174379,
+	CheckoutRequestID: "ws_CO_07092023195244460720136271",
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+// passthroughMiddleware stands in for the logging and metrics middlewares.
+type passthroughMiddleware struct {
+	SDK
+}
+
+func withPassthroughMiddleware() Option {
+	return func(sdk SDK) (SDK, error) {
+		return passthroughMiddleware{sdk}, nil
+	}
+}
+
+func newTestSDK(t *testing.T, server *httptest.Server, conf Config, opts ...Option) SDK {
+	t.Helper()
+
+	conf.Environment = Custom
+	conf.BaseURL = server.URL
+	conf.AppKey = appKey
+	conf.AppSecret = appSecret
+
+	sdk, err := NewSDK(conf, opts...)
+	require.NoError(t, err)
+
+	return sdk
+}
+
+func TestConfigHTTPClient(t *testing.T) {
+	server := httptest.NewServer(darajaHandler(0))
+	defer server.Close()
+
+	var requests atomic.Int64
+	client := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			requests.Add(1)
+
+			return http.DefaultTransport.RoundTrip(req)
+		}),
+	}
+
+	sdk := newTestSDK(t, server, Config{HTTPClient: client})
+
+	_, err := sdk.ExpressQuery(context.Background(), testExpressQueryReq)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), requests.Load(), "expected the token and query requests to use the configured client")
+}
+
+func TestTransportOptionOrder(t *testing.T) {
+	server := httptest.NewServer(darajaHandler(0))
+	defer server.Close()
+
+	conf := Config{Environment: Custom, BaseURL: server.URL, AppKey: appKey, AppSecret: appSecret}
+
+	sdk, err := NewSDK(conf, WithTimeout(time.Second), withPassthroughMiddleware())
+	require.NoError(t, err)
+	_, err = sdk.ExpressQuery(context.Background(), testExpressQueryReq)
+	assert.NoError(t, err)
+
+	_, err = NewSDK(conf, withPassthroughMiddleware(), WithTimeout(time.Second))
+	assert.ErrorIs(t, err, errTransportOptionOrder)
+}
+
+func TestTransportOptions(t *testing.T) {
+	proxyURL, err := url.Parse("http://proxy.example.com:3128")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		option      Option
+		check       func(t *testing.T, client *http.Client)
+		expectedErr error
+	}{
+		{
+			name:   "timeout",
+			option: WithTimeout(5 * time.Second),
+			check: func(t *testing.T, client *http.Client) {
+				assert.Equal(t, 5*time.Second, client.Timeout)
+			},
+		},
+		{
+			name:        "zero timeout",
+			option:      WithTimeout(0),
+			expectedErr: errInvalidTimeout,
+		},
+		{
+			name:   "proxy url",
+			option: WithProxyURL(proxyURL),
+			check: func(t *testing.T, client *http.Client) {
+				req := httptest.NewRequest(http.MethodGet, sandboxBaseURL, nil)
+				got, err := client.Transport.(*http.Transport).Proxy(req)
+				require.NoError(t, err)
+				assert.Equal(t, proxyURL, got)
+			},
+		},
+		{
+			name:   "connection pool",
+			option: WithConnectionPool(50, 10, 20, 30*time.Second),
+			check: func(t *testing.T, client *http.Client) {
+				transport := client.Transport.(*http.Transport)
+				assert.Equal(t, 50, transport.MaxIdleConns)
+				assert.Equal(t, 10, transport.MaxIdleConnsPerHost)
+				assert.Equal(t, 20, transport.MaxConnsPerHost)
+				assert.Equal(t, 30*time.Second, transport.IdleConnTimeout)
+			},
+		},
+		{
+			name:   "disable keep alive",
+			option: WithKeepAlive(-1),
+			check: func(t *testing.T, client *http.Client) {
+				assert.True(t, client.Transport.(*http.Transport).DisableKeepAlives)
+			},
+		},
+		{
+			name:   "disable http2",
+			option: WithHTTP2(false),
+			check: func(t *testing.T, client *http.Client) {
+				transport := client.Transport.(*http.Transport)
+				assert.False(t, transport.ForceAttemptHTTP2)
+				assert.NotNil(t, transport.TLSNextProto)
+			},
+		},
+		{
+			name:        "missing CA bundle",
+			option:      WithCABundle(filepath.Join(t.TempDir(), "missing.pem")),
+			expectedErr: os.ErrNotExist,
+		},
+		{
+			name:        "unknown operation timeout",
+			option:      WithOperationTimeout("Unknown", time.Second),
+			expectedErr: errUnknownOperation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := newHTTPClient()
+			originalTransport := original.Transport.(*http.Transport).Clone()
+
+			sdk, err := NewSDK(Config{Environment: Sandbox, AppKey: appKey, AppSecret: appSecret, HTTPClient: original}, tc.option)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			msdk, ok := sdk.(*mSDK)
+			require.True(t, ok)
+			tc.check(t, msdk.client)
+
+			assert.Equal(t, defaultTimeout, original.Timeout, "expected the configured client not to be modified")
+			assert.Equal(t, originalTransport.MaxIdleConns, original.Transport.(*http.Transport).MaxIdleConns)
+		})
+	}
+}
+
+func TestTransportOptionsWithRoundTripper(t *testing.T) {
+	rt := roundTripperFunc(http.DefaultTransport.RoundTrip)
+
+	_, err := NewSDK(Config{Environment: Sandbox, AppKey: appKey, AppSecret: appSecret}, WithRoundTripper(rt), WithHTTP2(false))
+	assert.ErrorIs(t, err, errUnsupportedTransport)
+}
+
+func TestWithCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(darajaHandler(0))
+	defer server.Close()
+
+	bundle := filepath.Join(t.TempDir(), "ca.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(bundle, data, 0o600))
+
+	sdk := newTestSDK(t, server, Config{})
+	_, err := sdk.ExpressQuery(context.Background(), testExpressQueryReq)
+	assert.Error(t, err, "expected the test server certificate to be untrusted")
+
+	sdk = newTestSDK(t, server, Config{}, WithCABundle(bundle))
+	_, err = sdk.ExpressQuery(context.Background(), testExpressQueryReq)
+	assert.NoError(t, err)
+
+	invalid := filepath.Join(t.TempDir(), "invalid.pem")
+	require.NoError(t, os.WriteFile(invalid, []byte("invalid"), 0o600))
+	_, err = NewSDK(Config{Environment: Sandbox, AppKey: appKey, AppSecret: appSecret}, WithCABundle(invalid))
+	assert.ErrorIs(t, err, errInvalidCABundle)
+}
+
+func TestWithProxy(t *testing.T) {
+	var proxied atomic.Int64
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied.Add(1)
+		darajaHandler(0).ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+
+	sdk, err := NewSDK(Config{
+		Environment: Custom,
+		BaseURL:     "http://daraja.invalid",
+		AppKey:      appKey,
+		AppSecret:   appSecret,
+	}, WithProxyURL(proxyURL))
+	require.NoError(t, err)
+
+	_, err = sdk.ExpressQuery(context.Background(), testExpressQueryReq)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), proxied.Load())
+}
+
+func TestWithOperationTimeout(t *testing.T) {
+	server := httptest.NewServer(darajaHandler(200 * time.Millisecond))
+	defer server.Close()
+
+	sdk := newTestSDK(t, server, Config{}, WithOperationTimeout(OpExpressQuery, 50*time.Millisecond))
+	_, err := sdk.ExpressQuery(context.Background(), testExpressQueryReq)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	sdk = newTestSDK(t, server, Config{}, WithOperationTimeout(OpExpressSimulate, 50*time.Millisecond))
+	_, err = sdk.ExpressQuery(context.Background(), testExpressQueryReq)
+	assert.NoError(t, err)
+}
@@ -12,7 +12,9 @@ package mpesa
 import (
 	"errors"
 	"fmt"
+	"net/mail"
 	"net/url"
+	"time"
 )
 
 const (
@@ -22,8 +24,37 @@ const (
 	maxRemarksLen          = 100
 	customerPayBillOnline  = "CustomerPayBillOnline"
 	customerBuyGoodsOnline = "CustomerBuyGoodsOnline"
+	maxBillManagerInvoices = 1000
+	billManagerDueDate     = "2006-01-02 15:04:05"
+	billManagerPaymentDate = "2006-01-02"
+	pullRequestType        = "Pull"
+	pullTransactionsDate   = "2006-01-02 15:04:05"
+	standingOrderPayBill   = "Standing Order Customer Pay Bill"
+	standingOrderBuyGoods  = "Standing Order Customer Pay Marchant"
+	standingOrderDate      = "20060102"
+	tillNumberIdentifier   = 2
+	shortCodeIdentifier    = 4
+	b2cAccountTopUpCommand = "BusinessPayToBulk"
+	b2PochiCommand         = "BusinessPayToPochi"
 )
 
+// b2bParties are the identifier types of the parties of a B2B command.
+type b2bParties struct {
+	sender   uint8
+	receiver uint8
+}
+
+// b2bCommands maps every B2B CommandID to the identifier types of its parties.
+var b2bCommands = map[string]b2bParties{
+	"BusinessPayBill":                       {sender: shortCodeIdentifier, receiver: shortCodeIdentifier},
+	"BusinessBuyGoods":                      {sender: shortCodeIdentifier, receiver: tillNumberIdentifier},
+	"BusinessToBusinessTransfer":            {sender: shortCodeIdentifier, receiver: shortCodeIdentifier},
+	"MerchantToMerchantTransfer":            {sender: tillNumberIdentifier, receiver: tillNumberIdentifier},
+	"MerchantTransferFromMerchantToWorking": {sender: tillNumberIdentifier, receiver: shortCodeIdentifier},
+	"MerchantServicesMMFAccountTransfer":    {sender: tillNumberIdentifier, receiver: shortCodeIdentifier},
+	"AgencyFloatAdvance":                    {sender: shortCodeIdentifier, receiver: shortCodeIdentifier},
+}
+
 var (
 	// errInvalidCommandID indicates the CommandID is invalid.
 	errInvalidCommandID = errors.New("invalid command id")
@@ -57,6 +88,45 @@ var (
 
 	// errInvalidURL indicates invalid url.
 	errInvalidURL = errors.New("invalid url")
+
+	// errInvalidEmail indicates invalid email address.
+	errInvalidEmail = errors.New("invalid email address")
+
+	// errInvalidSendReminders indicates that send reminders is neither 0 nor 1.
+	errInvalidSendReminders = errors.New("invalid send reminders, must be 0 or 1")
+
+	// errInvalidExternalReference indicates a missing or duplicated invoice external reference.
+	errInvalidExternalReference = errors.New("invalid external reference")
+
+	// errInvalidInvoice indicates that a required invoice field is missing.
+	errInvalidInvoice = errors.New("invalid invoice")
+
+	// errInvalidInvoiceCount indicates that a bulk request has no or too many invoices.
+	errInvalidInvoiceCount = errors.New("invalid number of invoices, must be between 1 and 1000")
+
+	// errInvalidDate indicates invalid date.
+	errInvalidDate = errors.New("invalid date")
+
+	// errInvalidTransactionID indicates invalid transaction id.
+	errInvalidTransactionID = errors.New("invalid transaction id")
+
+	// errInvalidRequestType indicates invalid request type.
+	errInvalidRequestType = errors.New("invalid request type, must be Pull")
+
+	// errInvalidDateRange indicates that the start date is not before the end date.
+	errInvalidDateRange = errors.New("invalid date range, start date must be before end date")
+
+	// errInvalidStandingOrderName indicates a missing standing order name.
+	errInvalidStandingOrderName = errors.New("invalid standing order name")
+
+	// errInvalidFrequency indicates a standing order frequency that Ratiba does not support.
+	errInvalidFrequency = errors.New("invalid frequency, must be between 1 and 8")
+
+	// errInvalidPaymentRef indicates a missing payment reference.
+	errInvalidPaymentRef = errors.New("invalid payment reference")
+
+	// errInvalidPartnerName indicates a missing partner name.
+	errInvalidPartnerName = errors.New("invalid partner name")
 )
 
 // Validate validate the ExpressSimulateReq Request.
@@ -109,7 +179,7 @@ func (c2b C2BRegisterURLReq) Validate() error {
 	if !isShortCode(c2b.ShortCode) {
 		return errInvalidShortCode
 	}
-	if c2b.ResponseType != "Completed" && c2b.ResponseType != "Cancelled" {
+	if c2b.ResponseType != C2BResponseCompleted && c2b.ResponseType != C2BResponseCancelled {
 		return errInvalidResponseType
 	}
 	if !isValidURL(c2b.ValidationURL) || !isValidURL(c2b.ConfirmationURL) {
@@ -155,11 +225,68 @@ func (r B2CPaymentReq) Validate() error {
 	return nil
 }
 
+// Validate validate the struct.
+func (r B2CAccountTopUpReq) Validate() error {
+	if r.CommandID != b2cAccountTopUpCommand {
+		return errInvalidCommandID
+	}
+	if !isShortCode(r.PartyA) || !isShortCode(r.PartyB) {
+		return errInvalidShortCode
+	}
+	if r.SenderIdentifierType != shortCodeIdentifier || r.RecieverIdentifierType != shortCodeIdentifier {
+		return errInvalidIdentifierType
+	}
+	if r.Amount == 0 {
+		return errInvalidAmount
+	}
+	if len(r.AccountReference) > maxAccountReferenceLen {
+		return errInvalidAccountReference
+	}
+	if !isValidURL(r.QueueTimeOutURL) || !isValidURL(r.ResultURL) {
+		return errInvalidURL
+	}
+	if r.Remarks != "" && len(r.Remarks) > maxRemarksLen {
+		return errInvalidRemarks
+	}
+
+	return nil
+}
+
+// Validate validate the struct.
+func (r B2PochiReq) Validate() error {
+	if r.CommandID != b2PochiCommand {
+		return errInvalidCommandID
+	}
+	if !isShortCode(r.PartyA) {
+		return errInvalidShortCode
+	}
+	if !isPhoneNumber(r.PartyB) {
+		return errInvalidPhoneNumber
+	}
+	if r.Amount == 0 {
+		return errInvalidAmount
+	}
+	if !isValidURL(r.QueueTimeOutURL) || !isValidURL(r.ResultURL) {
+		return errInvalidURL
+	}
+	if r.Remarks != "" && len(r.Remarks) > maxRemarksLen {
+		return errInvalidRemarks
+	}
+	if r.Occasion != "" && len(r.Occasion) > maxOccasionLen {
+		return errInvalidOccasion
+	}
+
+	return nil
+}
+
 // Validate validate the struct.
 func (r TransactionStatusReq) Validate() error {
 	if r.CommandID != "TransactionStatusQuery" {
 		return errInvalidCommandID
 	}
+	if r.TransactionID == "" && r.OriginatorConversationID == "" {
+		return errInvalidTransactionID
+	}
 	if r.Remarks != "" && len(r.Remarks) > maxRemarksLen {
 		return errInvalidRemarks
 	}
@@ -241,7 +368,8 @@ func (r BusinessPayBillReq) Validate() error {
 	if !isValidURL(r.QueueTimeOutURL) || !isValidURL(r.ResultURL) {
 		return errInvalidURL
 	}
-	if r.CommandID != "BusinessPayBill" {
+	parties, ok := b2bCommands[r.CommandID]
+	if !ok {
 		return errInvalidCommandID
 	}
 	if !isShortCode(r.PartyA) || !isShortCode(r.PartyB) {
@@ -253,13 +381,253 @@ func (r BusinessPayBillReq) Validate() error {
 	if r.Remarks != "" && len(r.Remarks) > maxRemarksLen {
 		return errInvalidRemarks
 	}
-	if r.SenderIdentifierType != 4 || r.RecieverIdentifierType != 4 {
+	// Identifier types that are not set are taken from the CommandID.
+	if r.SenderIdentifierType != 0 && r.SenderIdentifierType != parties.sender {
+		return errInvalidIdentifierType
+	}
+	if r.RecieverIdentifierType != 0 && r.RecieverIdentifierType != parties.receiver {
 		return errInvalidIdentifierType
 	}
 
 	return nil
 }
 
+// Validate validate the struct.
+func (r BillManagerOptInReq) Validate() error {
+	return validateBillManagerOptIn(r.ShortCode, r.Email, r.OfficialContact, r.SendReminders, r.CallbackURL)
+}
+
+// Validate validate the struct.
+func (r BillManagerUpdateOptInReq) Validate() error {
+	return validateBillManagerOptIn(r.ShortCode, r.Email, r.OfficialContact, r.SendReminders, r.CallbackURL)
+}
+
+// Validate validate the struct.
+func (r BillManagerSingleInvoiceReq) Validate() error {
+	if r.ExternalReference == "" {
+		return errInvalidExternalReference
+	}
+	if r.BilledFullName == "" {
+		return fmt.Errorf("%w: missing billed full name", errInvalidInvoice)
+	}
+	if !isPhoneNumber(r.BilledPhoneNumber) {
+		return errInvalidPhoneNumber
+	}
+	if r.BilledPeriod == "" {
+		return fmt.Errorf("%w: missing billed period", errInvalidInvoice)
+	}
+	if r.InvoiceName == "" {
+		return fmt.Errorf("%w: missing invoice name", errInvalidInvoice)
+	}
+	if _, err := time.Parse(billManagerDueDate, r.DueDate); err != nil {
+		return fmt.Errorf("%w: due date %q", errInvalidDate, r.DueDate)
+	}
+	if r.AccountReference == "" {
+		return errInvalidAccountReference
+	}
+	if r.Amount == 0 {
+		return errInvalidAmount
+	}
+	for _, item := range r.InvoiceItems {
+		if item.ItemName == "" {
+			return fmt.Errorf("%w: missing item name", errInvalidInvoice)
+		}
+		if item.Amount == 0 {
+			return fmt.Errorf("%w: item %s", errInvalidAmount, item.ItemName)
+		}
+	}
+
+	return nil
+}
+
+// Validate validate the struct.
+func (r BillManagerBulkInvoiceReq) Validate() error {
+	if len(r.Invoices) == 0 || len(r.Invoices) > maxBillManagerInvoices {
+		return errInvalidInvoiceCount
+	}
+
+	references := make(map[string]bool, len(r.Invoices))
+	for i, invoice := range r.Invoices {
+		if err := invoice.Validate(); err != nil {
+			return fmt.Errorf("invoice %d: %w", i, err)
+		}
+		if references[invoice.ExternalReference] {
+			return fmt.Errorf("%w: duplicate %s", errInvalidExternalReference, invoice.ExternalReference)
+		}
+		references[invoice.ExternalReference] = true
+	}
+
+	return nil
+}
+
+// Validate validate the struct.
+func (r BillManagerCancelInvoiceReq) Validate() error {
+	if len(r.ExternalReferences) == 0 || len(r.ExternalReferences) > maxBillManagerInvoices {
+		return errInvalidInvoiceCount
+	}
+	for _, reference := range r.ExternalReferences {
+		if reference == "" {
+			return errInvalidExternalReference
+		}
+	}
+
+	return nil
+}
+
+// Validate validate the struct.
+func (r BillManagerReconcileReq) Validate() error {
+	if _, err := time.Parse(billManagerPaymentDate, r.PaymentDate); err != nil {
+		return fmt.Errorf("%w: payment date %q", errInvalidDate, r.PaymentDate)
+	}
+	if r.PaidAmount == 0 {
+		return errInvalidAmount
+	}
+	if r.AccountReference == "" {
+		return errInvalidAccountReference
+	}
+	if r.TransactionID == "" {
+		return errInvalidTransactionID
+	}
+	if !isPhoneNumber(r.PhoneNumber) {
+		return errInvalidPhoneNumber
+	}
+	if r.ExternalReference == "" {
+		return errInvalidExternalReference
+	}
+
+	return nil
+}
+
+// Validate validate the struct.
+func (r PullTransactionsRegisterReq) Validate() error {
+	if !isShortCode(r.ShortCode) {
+		return errInvalidShortCode
+	}
+	if r.RequestType != pullRequestType {
+		return errInvalidRequestType
+	}
+	if r.NominatedNumber == "" {
+		return errInvalidPhoneNumber
+	}
+	if !isValidURL(r.CallBackURL) {
+		return errInvalidURL
+	}
+
+	return nil
+}
+
+// Validate validate the struct.
+func (r PullTransactionsQueryReq) Validate() error {
+	if !isShortCode(r.ShortCode) {
+		return errInvalidShortCode
+	}
+	start, err := time.Parse(pullTransactionsDate, r.StartDate)
+	if err != nil {
+		return fmt.Errorf("%w: start date %q", errInvalidDate, r.StartDate)
+	}
+	end, err := time.Parse(pullTransactionsDate, r.EndDate)
+	if err != nil {
+		return fmt.Errorf("%w: end date %q", errInvalidDate, r.EndDate)
+	}
+	if !start.Before(end) {
+		return errInvalidDateRange
+	}
+
+	return nil
+}
+
+// Validate validate the struct.
+func (r StandingOrderReq) Validate() error {
+	if r.StandingOrderName == "" {
+		return errInvalidStandingOrderName
+	}
+	if !isShortCode(r.BusinessShortCode) {
+		return errInvalidShortCode
+	}
+	switch {
+	case r.TransactionType == standingOrderPayBill && r.ReceiverPartyIdentifierType == 4:
+	case r.TransactionType == standingOrderBuyGoods && r.ReceiverPartyIdentifierType == 2:
+	case r.TransactionType != standingOrderPayBill && r.TransactionType != standingOrderBuyGoods:
+		return errInvalidTransactionType
+	default:
+		return errInvalidIdentifierType
+	}
+	if r.Amount == 0 {
+		return errInvalidAmount
+	}
+	if !isPhoneNumber(r.PartyA) {
+		return errInvalidPhoneNumber
+	}
+	if !isValidURL(r.CallBackURL) {
+		return errInvalidURL
+	}
+	if len(r.AccountReference) > maxAccountReferenceLen {
+		return errInvalidAccountReference
+	}
+	if len(r.TransactionDesc) > maxTransactionDescLen {
+		return errInvalidTransactionDesc
+	}
+	if !r.Frequency.valid() {
+		return errInvalidFrequency
+	}
+	start, err := time.Parse(standingOrderDate, r.StartDate)
+	if err != nil {
+		return fmt.Errorf("%w: start date %q", errInvalidDate, r.StartDate)
+	}
+	end, err := time.Parse(standingOrderDate, r.EndDate)
+	if err != nil {
+		return fmt.Errorf("%w: end date %q", errInvalidDate, r.EndDate)
+	}
+	// A one-off payment may start and end on the same day.
+	if end.Before(start) || (r.Frequency != StandingOrderOneOff && end.Equal(start)) {
+		return errInvalidDateRange
+	}
+
+	return nil
+}
+
+// Validate validate the struct.
+func (r B2BExpressCheckoutReq) Validate() error {
+	if !isShortCode(r.PrimaryShortCode) || !isShortCode(r.ReceiverShortCode) {
+		return errInvalidShortCode
+	}
+	if r.Amount == 0 {
+		return errInvalidAmount
+	}
+	if r.PaymentRef == "" {
+		return errInvalidPaymentRef
+	}
+	if !isValidURL(r.CallbackURL) {
+		return errInvalidURL
+	}
+	if r.PartnerName == "" {
+		return errInvalidPartnerName
+	}
+
+	return nil
+}
+
+// validateBillManagerOptIn validates the opt-in details of a shortcode.
+func validateBillManagerOptIn(shortCode uint64, email, officialContact string, sendReminders uint8, callbackURL string) error {
+	if !isShortCode(shortCode) {
+		return errInvalidShortCode
+	}
+	if _, err := mail.ParseAddress(email); err != nil {
+		return errInvalidEmail
+	}
+	if officialContact == "" {
+		return errInvalidPhoneNumber
+	}
+	if sendReminders > 1 {
+		return errInvalidSendReminders
+	}
+	if !isValidURL(callbackURL) {
+		return errInvalidURL
+	}
+
+	return nil
+}
+
 // isPhoneNumber checks if the number is a valid phone number.
 // MSISDN (12 digits Mobile Number) e.g. 2547XXXXXXXX.
 func isPhoneNumber(number uint64) bool {
@@ -302,3 +670,12 @@ func isValidURL(inputURL string) bool {
 func (e RespError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Code, e.Message)
 }
+
+// Validate validate the struct.
+func (r SIMSwapReq) Validate() error {
+	if !isPhoneNumber(r.CustomerNumber) {
+		return errInvalidPhoneNumber
+	}
+
+	return nil
+}